@@ -63,6 +63,10 @@ const (
 	// RepoReaderRole is a role which grants ability to both read from a repo
 	RepoReaderRole = "repoReader"
 
+	// RepoApproverRole is a role which grants the ability to read from a repo
+	// and approve its commits
+	RepoApproverRole = "repoApprover"
+
 	// IDPAdminRole is a role which grants the ability to configure OIDC apps.
 	OIDCAppAdminRole = "oidcAppAdmin"
 
@@ -122,6 +126,10 @@ var (
 	// ErrExpiredToken is returned by the Auth API if a restored token expired in
 	// the past.
 	ErrExpiredToken = status.Error(codes.Internal, "token expiration is in the past")
+
+	// ErrBadPresignCredentials is returned if the access key ID of a presigned
+	// URL is invalid, or its auth token has expired or been revoked.
+	ErrBadPresignCredentials = status.Error(codes.Unauthenticated, "presigned URL credentials are invalid or have expired")
 )
 
 var DefaultOIDCScopes = []string{"email", "profile", "groups", oidc.ScopeOpenID}
@@ -179,6 +187,14 @@ func IsErrExpiredToken(err error) bool {
 	return strings.Contains(err.Error(), status.Convert(ErrExpiredToken).Message())
 }
 
+// IsErrBadPresignCredentials returns true if 'err' is a ErrBadPresignCredentials
+func IsErrBadPresignCredentials(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), status.Convert(ErrBadPresignCredentials).Message())
+}
+
 const errNoRoleBindingMsg = "no role binding exists for"
 
 // ErrNoRoleBinding is returned if no role binding exists for a resource.
@@ -78,27 +78,34 @@ const (
 	Permission_CLUSTER_LICENSE_DELETE_CLUSTER             Permission = 136
 	Permission_CLUSTER_LICENSE_LIST_CLUSTERS              Permission = 137
 	// TODO(actgardner): Make k8s secrets into nouns and add an Update RPC
-	Permission_CLUSTER_CREATE_SECRET       Permission = 143
-	Permission_CLUSTER_LIST_SECRETS        Permission = 144
-	Permission_SECRET_DELETE               Permission = 145
-	Permission_SECRET_INSPECT              Permission = 146
-	Permission_CLUSTER_DELETE_ALL          Permission = 138
-	Permission_REPO_READ                   Permission = 200
-	Permission_REPO_WRITE                  Permission = 201
-	Permission_REPO_MODIFY_BINDINGS        Permission = 202
-	Permission_REPO_DELETE                 Permission = 203
-	Permission_REPO_INSPECT_COMMIT         Permission = 204
-	Permission_REPO_LIST_COMMIT            Permission = 205
-	Permission_REPO_DELETE_COMMIT          Permission = 206
-	Permission_REPO_CREATE_BRANCH          Permission = 207
-	Permission_REPO_LIST_BRANCH            Permission = 208
-	Permission_REPO_DELETE_BRANCH          Permission = 209
-	Permission_REPO_INSPECT_FILE           Permission = 210
-	Permission_REPO_LIST_FILE              Permission = 211
-	Permission_REPO_ADD_PIPELINE_READER    Permission = 212
-	Permission_REPO_REMOVE_PIPELINE_READER Permission = 213
-	Permission_REPO_ADD_PIPELINE_WRITER    Permission = 214
-	Permission_PIPELINE_LIST_JOB           Permission = 301
+	Permission_CLUSTER_CREATE_SECRET           Permission = 143
+	Permission_CLUSTER_LIST_SECRETS            Permission = 144
+	Permission_SECRET_DELETE                   Permission = 145
+	Permission_SECRET_INSPECT                  Permission = 146
+	Permission_CLUSTER_DELETE_ALL              Permission = 138
+	Permission_CLUSTER_PFS_MODIFY_QUOTAS       Permission = 150
+	Permission_CLUSTER_PFS_MANAGE_STORAGE_KEYS Permission = 152
+	Permission_CLUSTER_PFS_GARBAGE_COLLECT     Permission = 153
+	Permission_CLUSTER_PFS_APPLY_RETENTION     Permission = 154
+	Permission_CLUSTER_PPS_MODIFY_NOTIFIERS    Permission = 151
+	Permission_REPO_READ                       Permission = 200
+	Permission_REPO_WRITE                      Permission = 201
+	Permission_REPO_MODIFY_BINDINGS            Permission = 202
+	Permission_REPO_DELETE                     Permission = 203
+	Permission_REPO_INSPECT_COMMIT             Permission = 204
+	Permission_REPO_LIST_COMMIT                Permission = 205
+	Permission_REPO_DELETE_COMMIT              Permission = 206
+	Permission_REPO_CREATE_BRANCH              Permission = 207
+	Permission_REPO_LIST_BRANCH                Permission = 208
+	Permission_REPO_DELETE_BRANCH              Permission = 209
+	Permission_REPO_INSPECT_FILE               Permission = 210
+	Permission_REPO_LIST_FILE                  Permission = 211
+	Permission_REPO_ADD_PIPELINE_READER        Permission = 212
+	Permission_REPO_REMOVE_PIPELINE_READER     Permission = 213
+	Permission_REPO_ADD_PIPELINE_WRITER        Permission = 214
+	Permission_REPO_APPROVE_COMMIT             Permission = 215
+	Permission_REPO_BYPASS_BRANCH_PROTECTION   Permission = 216
+	Permission_PIPELINE_LIST_JOB               Permission = 301
 )
 
 var Permission_name = map[int32]string{
@@ -149,6 +156,11 @@ var Permission_name = map[int32]string{
 	145: "SECRET_DELETE",
 	146: "SECRET_INSPECT",
 	138: "CLUSTER_DELETE_ALL",
+	150: "CLUSTER_PFS_MODIFY_QUOTAS",
+	152: "CLUSTER_PFS_MANAGE_STORAGE_KEYS",
+	153: "CLUSTER_PFS_GARBAGE_COLLECT",
+	154: "CLUSTER_PFS_APPLY_RETENTION",
+	151: "CLUSTER_PPS_MODIFY_NOTIFIERS",
 	200: "REPO_READ",
 	201: "REPO_WRITE",
 	202: "REPO_MODIFY_BINDINGS",
@@ -164,6 +176,8 @@ var Permission_name = map[int32]string{
 	212: "REPO_ADD_PIPELINE_READER",
 	213: "REPO_REMOVE_PIPELINE_READER",
 	214: "REPO_ADD_PIPELINE_WRITER",
+	215: "REPO_APPROVE_COMMIT",
+	216: "REPO_BYPASS_BRANCH_PROTECTION",
 	301: "PIPELINE_LIST_JOB",
 }
 
@@ -215,6 +229,11 @@ var Permission_value = map[string]int32{
 	"SECRET_DELETE":                              145,
 	"SECRET_INSPECT":                             146,
 	"CLUSTER_DELETE_ALL":                         138,
+	"CLUSTER_PFS_MODIFY_QUOTAS":                  150,
+	"CLUSTER_PFS_MANAGE_STORAGE_KEYS":            152,
+	"CLUSTER_PFS_GARBAGE_COLLECT":                153,
+	"CLUSTER_PFS_APPLY_RETENTION":                154,
+	"CLUSTER_PPS_MODIFY_NOTIFIERS":               151,
 	"REPO_READ":                                  200,
 	"REPO_WRITE":                                 201,
 	"REPO_MODIFY_BINDINGS":                       202,
@@ -230,6 +249,8 @@ var Permission_value = map[string]int32{
 	"REPO_ADD_PIPELINE_READER":                   212,
 	"REPO_REMOVE_PIPELINE_READER":                213,
 	"REPO_ADD_PIPELINE_WRITER":                   214,
+	"REPO_APPROVE_COMMIT":                        215,
+	"REPO_BYPASS_BRANCH_PROTECTION":              216,
 	"PIPELINE_LIST_JOB":                          301,
 }
 
@@ -2286,6 +2307,105 @@ func (m *GetRobotTokenResponse) GetToken() string {
 	return ""
 }
 
+type GetPresignCredentialsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPresignCredentialsRequest) Reset()         { *m = GetPresignCredentialsRequest{} }
+func (m *GetPresignCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPresignCredentialsRequest) ProtoMessage()    {}
+func (*GetPresignCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{38}
+}
+func (m *GetPresignCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPresignCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPresignCredentialsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPresignCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPresignCredentialsRequest.Merge(m, src)
+}
+func (m *GetPresignCredentialsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPresignCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPresignCredentialsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPresignCredentialsRequest proto.InternalMessageInfo
+
+// GetPresignCredentialsResponse contains SigV4 credentials which any S3 SDK
+// can presign S3 gateway URLs with. They are derived from the caller's auth
+// token, so requests made with the URLs are authorized as that token, and the
+// URLs stop working when it expires or is revoked. The access key ID is
+// encrypted by pachd, so it doesn't reveal the token.
+type GetPresignCredentialsResponse struct {
+	AccessKeyID          string   `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	SecretAccessKey      string   `protobuf:"bytes,2,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPresignCredentialsResponse) Reset()         { *m = GetPresignCredentialsResponse{} }
+func (m *GetPresignCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPresignCredentialsResponse) ProtoMessage()    {}
+func (*GetPresignCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{39}
+}
+func (m *GetPresignCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPresignCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPresignCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPresignCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPresignCredentialsResponse.Merge(m, src)
+}
+func (m *GetPresignCredentialsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPresignCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPresignCredentialsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPresignCredentialsResponse proto.InternalMessageInfo
+
+func (m *GetPresignCredentialsResponse) GetAccessKeyID() string {
+	if m != nil {
+		return m.AccessKeyID
+	}
+	return ""
+}
+
+func (m *GetPresignCredentialsResponse) GetSecretAccessKey() string {
+	if m != nil {
+		return m.SecretAccessKey
+	}
+	return ""
+}
+
 type RevokeAuthTokenRequest struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2297,7 +2417,7 @@ func (m *RevokeAuthTokenRequest) Reset()         { *m = RevokeAuthTokenRequest{}
 func (m *RevokeAuthTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokenRequest) ProtoMessage()    {}
 func (*RevokeAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{38}
+	return fileDescriptor_712ec48c1eaf43a2, []int{40}
 }
 func (m *RevokeAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2343,7 +2463,7 @@ func (m *RevokeAuthTokenResponse) Reset()         { *m = RevokeAuthTokenResponse
 func (m *RevokeAuthTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokenResponse) ProtoMessage()    {}
 func (*RevokeAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{39}
+	return fileDescriptor_712ec48c1eaf43a2, []int{41}
 }
 func (m *RevokeAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2384,7 +2504,7 @@ func (m *SetGroupsForUserRequest) Reset()         { *m = SetGroupsForUserRequest
 func (m *SetGroupsForUserRequest) String() string { return proto.CompactTextString(m) }
 func (*SetGroupsForUserRequest) ProtoMessage()    {}
 func (*SetGroupsForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{40}
+	return fileDescriptor_712ec48c1eaf43a2, []int{42}
 }
 func (m *SetGroupsForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2437,7 +2557,7 @@ func (m *SetGroupsForUserResponse) Reset()         { *m = SetGroupsForUserRespon
 func (m *SetGroupsForUserResponse) String() string { return proto.CompactTextString(m) }
 func (*SetGroupsForUserResponse) ProtoMessage()    {}
 func (*SetGroupsForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{41}
+	return fileDescriptor_712ec48c1eaf43a2, []int{43}
 }
 func (m *SetGroupsForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2479,7 +2599,7 @@ func (m *ModifyMembersRequest) Reset()         { *m = ModifyMembersRequest{} }
 func (m *ModifyMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyMembersRequest) ProtoMessage()    {}
 func (*ModifyMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{42}
+	return fileDescriptor_712ec48c1eaf43a2, []int{44}
 }
 func (m *ModifyMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2539,7 +2659,7 @@ func (m *ModifyMembersResponse) Reset()         { *m = ModifyMembersResponse{} }
 func (m *ModifyMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyMembersResponse) ProtoMessage()    {}
 func (*ModifyMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{43}
+	return fileDescriptor_712ec48c1eaf43a2, []int{45}
 }
 func (m *ModifyMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2578,7 +2698,7 @@ func (m *GetGroupsRequest) Reset()         { *m = GetGroupsRequest{} }
 func (m *GetGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsRequest) ProtoMessage()    {}
 func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{44}
+	return fileDescriptor_712ec48c1eaf43a2, []int{46}
 }
 func (m *GetGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2618,7 +2738,7 @@ func (m *GetGroupsForPrincipalRequest) Reset()         { *m = GetGroupsForPrinci
 func (m *GetGroupsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsForPrincipalRequest) ProtoMessage()    {}
 func (*GetGroupsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{45}
+	return fileDescriptor_712ec48c1eaf43a2, []int{47}
 }
 func (m *GetGroupsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2665,7 +2785,7 @@ func (m *GetGroupsResponse) Reset()         { *m = GetGroupsResponse{} }
 func (m *GetGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsResponse) ProtoMessage()    {}
 func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{46}
+	return fileDescriptor_712ec48c1eaf43a2, []int{48}
 }
 func (m *GetGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2712,7 +2832,7 @@ func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
 func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUsersRequest) ProtoMessage()    {}
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{47}
+	return fileDescriptor_712ec48c1eaf43a2, []int{49}
 }
 func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2759,7 +2879,7 @@ func (m *GetUsersResponse) Reset()         { *m = GetUsersResponse{} }
 func (m *GetUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUsersResponse) ProtoMessage()    {}
 func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{48}
+	return fileDescriptor_712ec48c1eaf43a2, []int{50}
 }
 func (m *GetUsersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2807,7 +2927,7 @@ func (m *ExtractAuthTokensRequest) Reset()         { *m = ExtractAuthTokensReque
 func (m *ExtractAuthTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*ExtractAuthTokensRequest) ProtoMessage()    {}
 func (*ExtractAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{49}
+	return fileDescriptor_712ec48c1eaf43a2, []int{51}
 }
 func (m *ExtractAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2847,7 +2967,7 @@ func (m *ExtractAuthTokensResponse) Reset()         { *m = ExtractAuthTokensResp
 func (m *ExtractAuthTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*ExtractAuthTokensResponse) ProtoMessage()    {}
 func (*ExtractAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{50}
+	return fileDescriptor_712ec48c1eaf43a2, []int{52}
 }
 func (m *ExtractAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3015,7 @@ func (m *RestoreAuthTokenRequest) Reset()         { *m = RestoreAuthTokenRequest
 func (m *RestoreAuthTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreAuthTokenRequest) ProtoMessage()    {}
 func (*RestoreAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{51}
+	return fileDescriptor_712ec48c1eaf43a2, []int{53}
 }
 func (m *RestoreAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2941,7 +3061,7 @@ func (m *RestoreAuthTokenResponse) Reset()         { *m = RestoreAuthTokenRespon
 func (m *RestoreAuthTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreAuthTokenResponse) ProtoMessage()    {}
 func (*RestoreAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{52}
+	return fileDescriptor_712ec48c1eaf43a2, []int{54}
 }
 func (m *RestoreAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2981,7 +3101,7 @@ func (m *RevokeAuthTokensForUserRequest) Reset()         { *m = RevokeAuthTokens
 func (m *RevokeAuthTokensForUserRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokensForUserRequest) ProtoMessage()    {}
 func (*RevokeAuthTokensForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{53}
+	return fileDescriptor_712ec48c1eaf43a2, []int{55}
 }
 func (m *RevokeAuthTokensForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3027,7 +3147,7 @@ func (m *RevokeAuthTokensForUserResponse) Reset()         { *m = RevokeAuthToken
 func (m *RevokeAuthTokensForUserResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokensForUserResponse) ProtoMessage()    {}
 func (*RevokeAuthTokensForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{54}
+	return fileDescriptor_712ec48c1eaf43a2, []int{56}
 }
 func (m *RevokeAuthTokensForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3066,7 +3186,7 @@ func (m *DeleteExpiredAuthTokensRequest) Reset()         { *m = DeleteExpiredAut
 func (m *DeleteExpiredAuthTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteExpiredAuthTokensRequest) ProtoMessage()    {}
 func (*DeleteExpiredAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{55}
+	return fileDescriptor_712ec48c1eaf43a2, []int{57}
 }
 func (m *DeleteExpiredAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3105,7 +3225,7 @@ func (m *DeleteExpiredAuthTokensResponse) Reset()         { *m = DeleteExpiredAu
 func (m *DeleteExpiredAuthTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteExpiredAuthTokensResponse) ProtoMessage()    {}
 func (*DeleteExpiredAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{56}
+	return fileDescriptor_712ec48c1eaf43a2, []int{58}
 }
 func (m *DeleteExpiredAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3179,6 +3299,8 @@ func init() {
 	proto.RegisterType((*GetOIDCLoginResponse)(nil), "auth_v2.GetOIDCLoginResponse")
 	proto.RegisterType((*GetRobotTokenRequest)(nil), "auth_v2.GetRobotTokenRequest")
 	proto.RegisterType((*GetRobotTokenResponse)(nil), "auth_v2.GetRobotTokenResponse")
+	proto.RegisterType((*GetPresignCredentialsRequest)(nil), "auth_v2.GetPresignCredentialsRequest")
+	proto.RegisterType((*GetPresignCredentialsResponse)(nil), "auth_v2.GetPresignCredentialsResponse")
 	proto.RegisterType((*RevokeAuthTokenRequest)(nil), "auth_v2.RevokeAuthTokenRequest")
 	proto.RegisterType((*RevokeAuthTokenResponse)(nil), "auth_v2.RevokeAuthTokenResponse")
 	proto.RegisterType((*SetGroupsForUserRequest)(nil), "auth_v2.SetGroupsForUserRequest")
@@ -3203,184 +3325,195 @@ func init() {
 func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
 
 var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2821 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x59, 0x77, 0xdb, 0xc6,
-	0xf5, 0x37, 0x24, 0x5b, 0x22, 0xaf, 0x36, 0x78, 0x44, 0x49, 0x14, 0x64, 0x89, 0x12, 0x1c, 0xc7,
-	0xcb, 0xff, 0x1f, 0x29, 0x51, 0x9a, 0xd6, 0x49, 0xdc, 0x07, 0x2e, 0x10, 0x8d, 0x84, 0x22, 0x79,
-	0x00, 0xd0, 0x8e, 0x7b, 0x7a, 0x8a, 0x52, 0xe4, 0x58, 0x42, 0x2d, 0x11, 0x0c, 0x00, 0xaa, 0x56,
-	0xda, 0xb4, 0x4d, 0xf7, 0x3d, 0x69, 0xd3, 0xf6, 0x5b, 0xf4, 0xa5, 0xfd, 0x12, 0xe9, 0x9e, 0xae,
-	0x8f, 0x6e, 0x8e, 0x3e, 0x42, 0x1f, 0xfa, 0xdc, 0x33, 0x83, 0x01, 0x30, 0x00, 0x41, 0xd9, 0x49,
-	0x4e, 0x5e, 0x24, 0xcc, 0xbd, 0xbf, 0xbb, 0xcc, 0x9d, 0x3b, 0x83, 0x3b, 0x17, 0x84, 0xb9, 0xf6,
-	0xc0, 0x3b, 0xd8, 0x22, 0x7f, 0x36, 0xfb, 0x8e, 0xed, 0xd9, 0x68, 0x92, 0x3c, 0x9b, 0xc7, 0xdb,
-	0x52, 0x6e, 0xdf, 0xde, 0xb7, 0x29, 0x6d, 0x8b, 0x3c, 0xf9, 0x6c, 0xa9, 0xb0, 0x6f, 0xdb, 0xfb,
-	0x87, 0x78, 0x8b, 0x8e, 0xf6, 0x06, 0xf7, 0xb7, 0x3c, 0xeb, 0x08, 0xbb, 0x5e, 0xfb, 0xa8, 0xef,
-	0x03, 0xe4, 0x67, 0x61, 0xae, 0xd8, 0xf1, 0xac, 0xe3, 0xb6, 0x87, 0x35, 0xfc, 0xfa, 0x00, 0xbb,
-	0x1e, 0x5a, 0x05, 0x70, 0x6c, 0xdb, 0x33, 0x3d, 0xfb, 0x01, 0xee, 0xe5, 0x85, 0x75, 0xe1, 0x5a,
-	0x56, 0xcb, 0x12, 0x8a, 0x41, 0x08, 0xf2, 0x73, 0x20, 0x46, 0x12, 0x6e, 0xdf, 0xee, 0xb9, 0x98,
-	0x88, 0xf4, 0xdb, 0x9d, 0x83, 0xb8, 0x08, 0xa1, 0xf8, 0x22, 0xf3, 0x70, 0xb1, 0x82, 0xdb, 0x71,
-	0x33, 0x72, 0x0e, 0x10, 0x4f, 0xf4, 0x35, 0xc9, 0x9f, 0x81, 0x45, 0xcd, 0xf6, 0x08, 0x25, 0x30,
-	0xf8, 0x84, 0x6e, 0xdd, 0x84, 0xa5, 0x21, 0xc1, 0xc8, 0xbb, 0xb3, 0x24, 0x3f, 0x18, 0x03, 0x68,
-	0xa8, 0x95, 0x72, 0xd9, 0xee, 0xdd, 0xb7, 0xf6, 0xd1, 0x22, 0x4c, 0x58, 0xae, 0x3b, 0xc0, 0x0e,
-	0x43, 0xb2, 0x11, 0xba, 0x0e, 0xd9, 0xce, 0xa1, 0x85, 0x7b, 0x9e, 0x69, 0x75, 0xf3, 0x63, 0x84,
-	0x55, 0x9a, 0x3e, 0x7d, 0x54, 0xc8, 0x94, 0x29, 0x51, 0xad, 0x68, 0x19, 0x9f, 0xad, 0x76, 0xd1,
-	0x65, 0x98, 0x61, 0x50, 0x17, 0x77, 0x1c, 0xec, 0xe5, 0xc7, 0xa9, 0xa6, 0x69, 0x9f, 0xa8, 0x53,
-	0x1a, 0xda, 0x86, 0x69, 0x07, 0x77, 0x2d, 0x07, 0x77, 0x3c, 0x73, 0xe0, 0x58, 0xf9, 0xf3, 0x54,
-	0xe5, 0xdc, 0xe9, 0xa3, 0xc2, 0x94, 0xc6, 0xe8, 0x2d, 0x4d, 0xd5, 0xa6, 0x02, 0x50, 0xcb, 0xb1,
-	0x88, 0x6f, 0x6e, 0xc7, 0xee, 0x63, 0x37, 0x7f, 0x61, 0x7d, 0x9c, 0xf8, 0xe6, 0x8f, 0xd0, 0xa7,
-	0x60, 0xd1, 0xc1, 0xaf, 0x0f, 0x2c, 0x07, 0x9b, 0xf8, 0xa8, 0x6d, 0x1d, 0x9a, 0xc7, 0xd8, 0xb1,
-	0xee, 0x5b, 0xb8, 0x9b, 0x9f, 0x58, 0x17, 0xae, 0x65, 0xb4, 0x1c, 0xe3, 0x2a, 0x84, 0x79, 0x87,
-	0xf1, 0xd0, 0x75, 0x10, 0x0f, 0xed, 0x4e, 0xfb, 0xf0, 0xc0, 0x76, 0x3d, 0x93, 0xcd, 0x79, 0x92,
-	0xe2, 0xe7, 0x42, 0xba, 0xea, 0x4f, 0xfe, 0xb3, 0xb0, 0x32, 0x70, 0xb1, 0x63, 0xb6, 0x3b, 0x1d,
-	0xec, 0xba, 0xd6, 0xde, 0x21, 0x66, 0x02, 0x26, 0x01, 0xe5, 0x33, 0x74, 0x7e, 0x79, 0x02, 0x29,
-	0x86, 0x08, 0x5f, 0xf4, 0xb6, 0xed, 0x7a, 0xf2, 0x32, 0x2c, 0x55, 0xb1, 0xe7, 0x07, 0x78, 0xe0,
-	0xb4, 0x3d, 0xcb, 0x0e, 0x96, 0x55, 0x6e, 0x41, 0x7e, 0x98, 0xc5, 0x16, 0xee, 0x45, 0x98, 0xe9,
-	0xf0, 0x0c, 0xba, 0x22, 0x53, 0xdb, 0xf3, 0x9b, 0x2c, 0xe9, 0x37, 0xa3, 0x65, 0xd3, 0xe2, 0x48,
-	0xd9, 0x80, 0x25, 0x3d, 0xdd, 0xe2, 0xc7, 0xd1, 0x2a, 0x41, 0x5e, 0x1f, 0xe1, 0xac, 0xfc, 0x1b,
-	0x01, 0xb2, 0x34, 0xa1, 0xd4, 0xde, 0x7d, 0x1b, 0xe5, 0x61, 0xd2, 0x1d, 0xec, 0x7d, 0x09, 0x77,
-	0x3c, 0x96, 0x46, 0xc1, 0x10, 0xe9, 0x00, 0xf8, 0x61, 0xdf, 0x62, 0xb6, 0xc7, 0xa8, 0x6d, 0x69,
-	0xd3, 0xdf, 0xa7, 0x9b, 0xc1, 0x3e, 0xdd, 0x34, 0x82, 0x7d, 0x5a, 0x5a, 0xfa, 0xcf, 0xa3, 0xc2,
-	0x5c, 0x77, 0xef, 0x25, 0x39, 0x92, 0x92, 0xdf, 0xf9, 0x77, 0x41, 0xd0, 0x38, 0x35, 0xe8, 0xd3,
-	0x30, 0x7d, 0xd0, 0x76, 0x0f, 0x70, 0x97, 0x25, 0x39, 0x4d, 0xb8, 0xd2, 0x7c, 0x20, 0x4a, 0x89,
-	0x26, 0x41, 0xc8, 0xda, 0x94, 0x0f, 0xf4, 0x73, 0xff, 0x0b, 0x30, 0x5f, 0x1c, 0x78, 0x07, 0xb8,
-	0xe7, 0x59, 0x1d, 0xee, 0x08, 0xf8, 0x7f, 0x00, 0xdb, 0xea, 0x76, 0x4c, 0x97, 0x6c, 0x28, 0x7f,
-	0x02, 0xa5, 0x99, 0xd3, 0x47, 0x85, 0x2c, 0x09, 0x8d, 0x4e, 0x77, 0x59, 0x96, 0x00, 0xe8, 0x23,
-	0x5a, 0x86, 0x8c, 0x15, 0x18, 0x1e, 0xf3, 0x27, 0x6b, 0x31, 0xfd, 0x2f, 0x40, 0x2e, 0xae, 0xff,
-	0xc9, 0x0e, 0x8c, 0x39, 0x98, 0xb9, 0x7b, 0x60, 0x17, 0x8f, 0xd4, 0x20, 0x4b, 0xde, 0x12, 0x60,
-	0x36, 0xa0, 0x30, 0x15, 0x12, 0x64, 0x48, 0xbe, 0xf5, 0xda, 0x47, 0xcc, 0x43, 0x2d, 0x1c, 0x7f,
-	0x22, 0x31, 0x96, 0x75, 0xb8, 0x54, 0xc5, 0x9e, 0x66, 0x1f, 0x62, 0x77, 0xc7, 0x76, 0x9a, 0xd8,
-	0x39, 0xb2, 0x5c, 0x97, 0xcb, 0xab, 0xe7, 0x01, 0xfa, 0x21, 0x91, 0xba, 0x34, 0xcb, 0x25, 0x15,
-	0x87, 0xe7, 0x60, 0x72, 0x05, 0x56, 0x47, 0x28, 0x65, 0xd3, 0xbc, 0x0c, 0x17, 0x1c, 0xc2, 0xcd,
-	0x0b, 0xeb, 0xe3, 0xd7, 0xa6, 0xb6, 0x67, 0x42, 0x85, 0x44, 0x46, 0xf3, 0x79, 0xb2, 0x03, 0x17,
-	0xa8, 0x0a, 0xb4, 0x15, 0x47, 0x2f, 0xc7, 0xd0, 0xae, 0xff, 0x57, 0xe9, 0x79, 0xce, 0x09, 0x93,
-	0x94, 0x6e, 0x02, 0x44, 0x44, 0x24, 0xc2, 0xf8, 0x03, 0x7c, 0xc2, 0xc2, 0x49, 0x1e, 0x51, 0x0e,
-	0x2e, 0x1c, 0xb7, 0x0f, 0x07, 0x98, 0x06, 0x31, 0xa3, 0xf9, 0x83, 0x97, 0xc6, 0x6e, 0x0a, 0xf2,
-	0xaf, 0x04, 0x98, 0x22, 0xa2, 0x25, 0xab, 0xd7, 0xb5, 0x7a, 0xfb, 0xe8, 0x65, 0x98, 0xc4, 0x3d,
-	0xcf, 0xb1, 0x42, 0xe3, 0x1b, 0x31, 0xe3, 0x0c, 0xb6, 0xa9, 0xf8, 0x18, 0xdf, 0x89, 0x40, 0x42,
-	0x7a, 0x05, 0xa6, 0x79, 0x46, 0x8a, 0x23, 0x4f, 0xf1, 0x8e, 0x4c, 0x6d, 0xcf, 0xc6, 0x67, 0xc6,
-	0x3b, 0xa6, 0x42, 0x46, 0xc3, 0xae, 0x3d, 0x70, 0x3a, 0x18, 0x5d, 0x87, 0xf3, 0xde, 0x49, 0x1f,
-	0xb3, 0xd5, 0x58, 0x88, 0x84, 0x18, 0xc0, 0x38, 0xe9, 0x63, 0x8d, 0x42, 0x10, 0x82, 0xf3, 0x34,
-	0x97, 0xfc, 0x0c, 0xa6, 0xcf, 0xf2, 0x37, 0x05, 0xb8, 0xd0, 0x72, 0xb1, 0xe3, 0xa2, 0x97, 0x21,
-	0x1b, 0x64, 0x57, 0x30, 0xbf, 0xd5, 0x50, 0x1b, 0x85, 0xd0, 0xbf, 0x94, 0xef, 0xcf, 0x2d, 0xc2,
-	0x4b, 0xb7, 0x60, 0x36, 0xce, 0xfc, 0x50, 0x81, 0x7e, 0x08, 0x13, 0x55, 0xc7, 0x1e, 0xf4, 0x5d,
-	0xf4, 0x3c, 0x4c, 0xec, 0xd3, 0x27, 0xe6, 0xc1, 0x4a, 0xe8, 0x81, 0x0f, 0x60, 0xff, 0x7c, 0xfb,
-	0x0c, 0x2a, 0xbd, 0x08, 0x53, 0x1c, 0xf9, 0x43, 0x59, 0x7e, 0x5b, 0x80, 0xf3, 0x24, 0xbc, 0x61,
-	0x6c, 0x84, 0x28, 0x36, 0xe8, 0x05, 0x98, 0x8a, 0xf2, 0xd8, 0xcd, 0x8f, 0xad, 0x8f, 0x8f, 0xca,
-	0x77, 0x1e, 0x87, 0x6e, 0xc1, 0xac, 0xc3, 0x82, 0x6f, 0x92, 0xb8, 0xbb, 0xf9, 0x71, 0x2a, 0x39,
-	0x62, 0x6d, 0x66, 0x1c, 0x6e, 0xe4, 0xca, 0x0f, 0x41, 0x24, 0xe7, 0x89, 0xed, 0x58, 0x6f, 0x84,
-	0x87, 0xd5, 0x33, 0x90, 0x09, 0x40, 0xec, 0x28, 0xbf, 0x38, 0xa4, 0x4b, 0x0b, 0x21, 0x1f, 0xd1,
-	0x6f, 0xf9, 0xb7, 0x02, 0x5c, 0xe4, 0x4c, 0xb3, 0xdd, 0xb9, 0x06, 0xd0, 0x0e, 0x88, 0x5d, 0x6a,
-	0x3d, 0xa3, 0x71, 0x14, 0xf4, 0x1c, 0x64, 0xdd, 0xb6, 0x67, 0xb9, 0xf4, 0x5d, 0x7c, 0x86, 0xa9,
-	0x08, 0x85, 0x9e, 0x81, 0x49, 0x4a, 0xed, 0xed, 0xb3, 0xc8, 0xa4, 0x0a, 0x04, 0x18, 0x74, 0x09,
-	0xb2, 0x7d, 0xc7, 0xea, 0x75, 0xac, 0x7e, 0xfb, 0xd0, 0xaf, 0x21, 0xb4, 0x88, 0x20, 0xef, 0xc0,
-	0x42, 0x15, 0x7b, 0x91, 0x9c, 0xfb, 0xd1, 0x82, 0x26, 0xf7, 0x61, 0x23, 0xae, 0x87, 0x1c, 0x56,
-	0x81, 0x95, 0x8f, 0xb8, 0x10, 0x31, 0xcf, 0xc7, 0x92, 0x9e, 0x63, 0x58, 0x4c, 0x7a, 0xce, 0x62,
-	0x9e, 0x58, 0x40, 0xe1, 0x09, 0x13, 0x2f, 0x17, 0x1c, 0x8d, 0x63, 0xb4, 0x74, 0x62, 0x27, 0xe7,
-	0x9b, 0x90, 0xdf, 0xb5, 0xbb, 0xd6, 0xfd, 0x13, 0xee, 0x8c, 0xfa, 0x24, 0xe6, 0x13, 0x99, 0x1f,
-	0xe7, 0xcd, 0xaf, 0xc0, 0x72, 0x8a, 0x79, 0x56, 0x51, 0xf8, 0x8b, 0xf7, 0xb1, 0x1d, 0x93, 0x6f,
-	0xd3, 0x50, 0xa6, 0x58, 0x40, 0x9b, 0x30, 0xb9, 0xe7, 0x93, 0x98, 0x9e, 0x5c, 0xda, 0x99, 0xad,
-	0x05, 0x20, 0xf9, 0x8b, 0x30, 0xa5, 0x63, 0x1a, 0x4f, 0x5a, 0xe4, 0xe4, 0xe0, 0x42, 0xcf, 0xee,
-	0x75, 0x82, 0x73, 0xc1, 0x1f, 0x10, 0x2a, 0x2d, 0x42, 0x59, 0x0c, 0xfc, 0x01, 0xba, 0x02, 0xb3,
-	0x1d, 0xbb, 0x77, 0x8c, 0x1d, 0x22, 0x6d, 0x62, 0xc7, 0xa1, 0x35, 0x4a, 0x86, 0x56, 0x58, 0x8c,
-	0xaa, 0x38, 0x8e, 0xbc, 0x00, 0xf3, 0x55, 0xec, 0x91, 0x32, 0xa3, 0x66, 0xef, 0x5b, 0x61, 0x95,
-	0x78, 0x17, 0x72, 0x71, 0x32, 0x9b, 0xc0, 0x75, 0xc8, 0x1e, 0x12, 0x82, 0x39, 0x70, 0x0e, 0x59,
-	0x9d, 0x42, 0x8b, 0x72, 0x8a, 0x6a, 0x69, 0x35, 0x2d, 0x43, 0xd9, 0x2d, 0x87, 0x2e, 0x80, 0x5f,
-	0xce, 0x30, 0xb7, 0xe8, 0x40, 0xae, 0x52, 0xc5, 0x9a, 0xbd, 0x97, 0xb8, 0x6d, 0xd0, 0xe5, 0xda,
-	0xb3, 0x83, 0xea, 0xcd, 0x1f, 0xa0, 0x65, 0x18, 0xf7, 0x3c, 0x7f, 0x62, 0xe3, 0xa5, 0xc9, 0xd3,
-	0x47, 0x85, 0x71, 0xc3, 0xa8, 0x69, 0x84, 0x26, 0x3f, 0xc3, 0x16, 0x6b, 0x2f, 0x79, 0xfb, 0xc8,
-	0xc1, 0x05, 0xbe, 0xca, 0xf1, 0x07, 0xf2, 0x26, 0x2c, 0x6a, 0xf8, 0xd8, 0x7e, 0x80, 0xc9, 0x99,
-	0x92, 0xb4, 0x9c, 0x82, 0x5f, 0x86, 0xa5, 0x21, 0x3c, 0x4b, 0x93, 0x5d, 0x5a, 0xea, 0xfa, 0x67,
-	0xfc, 0x8e, 0xed, 0x90, 0x37, 0x4d, 0xa0, 0xeb, 0xac, 0x1a, 0x69, 0x31, 0x7c, 0x99, 0xf8, 0x1b,
-	0x82, 0x8d, 0x58, 0x8d, 0x9b, 0x50, 0xc7, 0x4c, 0xdd, 0x81, 0x9c, 0x9f, 0xae, 0xbb, 0xf8, 0x68,
-	0x0f, 0x3b, 0x2e, 0xe7, 0x33, 0x95, 0x0e, 0x7c, 0xa6, 0x03, 0xf2, 0xaa, 0x69, 0x77, 0xbb, 0x4c,
-	0x3d, 0x79, 0x24, 0x36, 0x1d, 0x7c, 0x64, 0x1f, 0x63, 0xb6, 0x0b, 0xd8, 0x48, 0x5e, 0x82, 0x85,
-	0x84, 0x5e, 0x66, 0x10, 0x81, 0x58, 0x0d, 0x9c, 0x09, 0x72, 0xe1, 0x16, 0xad, 0xc3, 0x42, 0x07,
-	0x87, 0x8e, 0xa1, 0xd8, 0x3e, 0x14, 0x92, 0xe7, 0xca, 0xff, 0xc1, 0x45, 0x4e, 0x23, 0x5b, 0xa3,
-	0xc5, 0xd8, 0x8b, 0x35, 0x8a, 0xc5, 0x55, 0x98, 0xab, 0x62, 0x8f, 0xbe, 0xde, 0xcf, 0x9c, 0xaa,
-	0xfc, 0x2c, 0xf5, 0x93, 0x01, 0x99, 0xd2, 0x4b, 0xc9, 0x92, 0x21, 0xcb, 0xd5, 0x04, 0x24, 0xcc,
-	0xca, 0x43, 0xcf, 0x69, 0x77, 0xbc, 0x70, 0x45, 0xc3, 0x19, 0x56, 0x61, 0x39, 0x85, 0xc7, 0xd4,
-	0xde, 0x80, 0x09, 0x9a, 0x12, 0x41, 0x11, 0x80, 0xc2, 0x2d, 0x1b, 0xde, 0x3e, 0x34, 0x86, 0x90,
-	0xcb, 0x24, 0x6b, 0x5c, 0xcf, 0x76, 0x86, 0xd3, 0xec, 0x1a, 0x9f, 0x66, 0xe9, 0x5a, 0x58, 0xea,
-	0x49, 0x90, 0x1f, 0x56, 0xc2, 0xd6, 0xe7, 0x16, 0xac, 0x25, 0xd2, 0xf2, 0x43, 0xa4, 0xa0, 0xbc,
-	0x01, 0x85, 0x91, 0xd2, 0xcc, 0xc0, 0x3a, 0xac, 0x55, 0xf0, 0x21, 0xf6, 0xb0, 0x42, 0x0a, 0x71,
-	0xdc, 0x1d, 0x0e, 0xd6, 0x06, 0x14, 0x46, 0x22, 0x7c, 0x25, 0x37, 0xde, 0x9d, 0x03, 0x88, 0x5e,
-	0x0b, 0x68, 0x11, 0x50, 0x53, 0xd1, 0x76, 0x55, 0x5d, 0x57, 0x1b, 0x75, 0xb3, 0x55, 0x7f, 0xb5,
-	0xde, 0xb8, 0x5b, 0x17, 0xcf, 0xa1, 0x15, 0x58, 0x2a, 0xd7, 0x5a, 0xba, 0xa1, 0x68, 0xe6, 0x6e,
-	0xa3, 0xa2, 0xee, 0xdc, 0x33, 0x4b, 0x6a, 0xbd, 0xa2, 0xd6, 0xab, 0xba, 0xd8, 0x45, 0x79, 0xc8,
-	0x05, 0xcc, 0xaa, 0x62, 0x44, 0x1c, 0x8c, 0x56, 0x60, 0x91, 0xe7, 0x34, 0x8b, 0xe5, 0xdb, 0x15,
-	0xb3, 0xd6, 0xa8, 0xea, 0xe2, 0x2f, 0x04, 0xb4, 0x0c, 0x0b, 0x01, 0xb3, 0xd8, 0x32, 0x6e, 0x9b,
-	0xc5, 0xb2, 0xa1, 0xde, 0x29, 0x1a, 0x8a, 0x78, 0x9f, 0x37, 0x47, 0x59, 0x15, 0x25, 0x64, 0xee,
-	0x0f, 0x31, 0x89, 0xe6, 0x72, 0xa3, 0xbe, 0xa3, 0x56, 0xc5, 0x83, 0x21, 0xa6, 0x1e, 0x31, 0x2d,
-	0xb4, 0x01, 0x97, 0x86, 0x24, 0xb5, 0x46, 0xa9, 0x61, 0x98, 0x46, 0xe3, 0x55, 0xa5, 0x2e, 0xfe,
-	0x48, 0x40, 0x57, 0x60, 0x23, 0x06, 0x61, 0xb3, 0xad, 0x6a, 0x8d, 0x56, 0xd3, 0xdc, 0x55, 0x76,
-	0x4b, 0x8a, 0xa6, 0x8b, 0x47, 0xa9, 0x3e, 0x50, 0x8c, 0x2e, 0xf6, 0xd0, 0x7a, 0x8a, 0x19, 0x5f,
-	0x41, 0x4b, 0x27, 0xe2, 0x36, 0x2a, 0xc0, 0x4a, 0x0c, 0xa1, 0xbc, 0x66, 0x68, 0xc5, 0x32, 0x73,
-	0x43, 0x17, 0xfb, 0x68, 0x0d, 0xa4, 0x18, 0x40, 0x53, 0x74, 0xa3, 0xa1, 0x29, 0xcc, 0xcf, 0xd7,
-	0xd1, 0x16, 0xdc, 0x18, 0x32, 0x11, 0x2d, 0x9c, 0x6e, 0xee, 0x34, 0x34, 0xb3, 0xa9, 0xa9, 0xf5,
-	0xb2, 0xda, 0x2c, 0xd6, 0xc4, 0x9f, 0x08, 0xe8, 0x2a, 0xc8, 0x89, 0x88, 0xd6, 0x14, 0x43, 0x31,
-	0x95, 0xd7, 0x9a, 0xaa, 0xa6, 0x54, 0x02, 0xc3, 0x3f, 0x16, 0xd0, 0x53, 0x50, 0x48, 0x58, 0xbe,
-	0xd3, 0x78, 0x55, 0xa1, 0x9e, 0x07, 0xa8, 0x9f, 0x0a, 0xe8, 0x32, 0xac, 0xc5, 0x51, 0x0d, 0xa3,
-	0x68, 0x28, 0xa6, 0xd6, 0x08, 0x63, 0xf9, 0xae, 0xc0, 0xcf, 0x52, 0xa9, 0x1b, 0x8a, 0xd6, 0xd4,
-	0x54, 0x5d, 0x89, 0x96, 0xd9, 0xe1, 0x03, 0xc5, 0x01, 0x6e, 0x2b, 0x45, 0xcd, 0x28, 0x29, 0x45,
-	0x43, 0x74, 0x47, 0xa8, 0xf0, 0x57, 0xbc, 0xa2, 0x88, 0x1e, 0xda, 0x80, 0xd5, 0x14, 0x00, 0x97,
-	0x2f, 0x03, 0xb4, 0x0a, 0xf9, 0x14, 0x48, 0xb3, 0xd8, 0xd2, 0x15, 0xf1, 0x97, 0x31, 0x2f, 0xd5,
-	0x8a, 0x52, 0x37, 0x54, 0xe3, 0x1e, 0x9f, 0x35, 0xc7, 0xa9, 0x00, 0x2e, 0xe7, 0xbe, 0x9c, 0x0a,
-	0x28, 0x6b, 0x0a, 0x09, 0x88, 0x5a, 0x69, 0x8a, 0x0f, 0x53, 0x01, 0xad, 0x66, 0x25, 0x00, 0x9c,
-	0xf0, 0xcb, 0x1d, 0x02, 0x6a, 0xaa, 0x6e, 0x10, 0xb6, 0x2e, 0xbe, 0x81, 0x2e, 0x45, 0x53, 0x88,
-	0xb9, 0x40, 0xa4, 0xbf, 0x92, 0xaa, 0x9e, 0xad, 0x2f, 0x01, 0x7c, 0x15, 0x5d, 0x85, 0xcb, 0xa3,
-	0x1c, 0x24, 0x75, 0x83, 0x59, 0xae, 0xa9, 0x4a, 0xdd, 0x10, 0xdf, 0x4c, 0x05, 0x32, 0x47, 0x79,
-	0xe0, 0xd7, 0xd0, 0xd3, 0x51, 0x3a, 0xc5, 0x1d, 0xe6, 0x60, 0xba, 0xf8, 0x75, 0x74, 0x05, 0xd6,
-	0x53, 0x1d, 0xe7, 0xb5, 0x7d, 0x43, 0x40, 0xd7, 0x52, 0xec, 0xb2, 0x19, 0xf0, 0xc8, 0xb7, 0x04,
-	0xb4, 0x04, 0x28, 0x40, 0x56, 0x94, 0x52, 0xab, 0x6a, 0x56, 0x5a, 0xbb, 0x4d, 0xf1, 0x5b, 0x02,
-	0xbf, 0xca, 0x35, 0xb5, 0xac, 0xd4, 0xf9, 0x4c, 0xfb, 0x76, 0x2a, 0x3b, 0xcc, 0xa2, 0xef, 0x08,
-	0x68, 0x3d, 0x0a, 0x61, 0x28, 0x5d, 0xa9, 0x98, 0x8c, 0x26, 0x7e, 0x37, 0x96, 0xf1, 0x01, 0x82,
-	0x45, 0x26, 0x00, 0x7d, 0x2f, 0x15, 0xc4, 0xa6, 0x11, 0x80, 0xbe, 0x2f, 0x20, 0x39, 0x4a, 0xd9,
-	0x00, 0x44, 0x43, 0xc7, 0x88, 0xba, 0xf8, 0x03, 0x01, 0x49, 0xd1, 0xd9, 0xc8, 0x16, 0x4a, 0x57,
-	0xca, 0x9a, 0x62, 0x88, 0x6f, 0x93, 0x73, 0x33, 0x17, 0xc9, 0xeb, 0x06, 0xe3, 0xe8, 0xe2, 0x3b,
-	0x02, 0x42, 0x30, 0xe3, 0x8f, 0x98, 0x59, 0xf1, 0x67, 0x02, 0x9a, 0x87, 0x59, 0x46, 0x53, 0xeb,
-	0x7a, 0x53, 0x29, 0x1b, 0xe2, 0xcf, 0x13, 0x61, 0xa4, 0x0e, 0x16, 0x6b, 0x35, 0xf1, 0x87, 0x02,
-	0x9a, 0x85, 0xac, 0xa6, 0x34, 0x1b, 0xa6, 0xa6, 0x14, 0x2b, 0xe2, 0x7b, 0x02, 0x9a, 0x03, 0xa0,
-	0xe3, 0xbb, 0x9a, 0x6a, 0x28, 0xe2, 0xef, 0xa8, 0x75, 0x4a, 0x48, 0xbe, 0x06, 0x7e, 0x2f, 0x20,
-	0x11, 0xa6, 0x28, 0x8b, 0xd9, 0xfe, 0x83, 0x80, 0xf2, 0x30, 0x4f, 0x29, 0xcc, 0xb2, 0x59, 0x6e,
-	0xec, 0xee, 0xaa, 0x86, 0xf8, 0x47, 0x01, 0x2d, 0x80, 0x48, 0x39, 0xfe, 0xcc, 0x7d, 0xf2, 0x9f,
-	0xa8, 0x5f, 0x9c, 0x8a, 0x80, 0xf1, 0xe7, 0x88, 0xc1, 0xa2, 0x51, 0xd2, 0x8a, 0xf5, 0xf2, 0x6d,
-	0xf1, 0x2f, 0x09, 0x45, 0x8c, 0xfc, 0xfe, 0x90, 0x22, 0xc6, 0xf8, 0xab, 0x80, 0x16, 0xe1, 0x62,
-	0xcc, 0xa5, 0x1d, 0xb5, 0xa6, 0x88, 0x7f, 0xa3, 0x61, 0x8a, 0xf4, 0x50, 0xe2, 0xdf, 0x69, 0xd6,
-	0x50, 0x22, 0xc9, 0x85, 0xa6, 0xda, 0x54, 0x6a, 0x6a, 0x5d, 0xa1, 0xa1, 0x51, 0x34, 0xf1, 0x1f,
-	0x34, 0x6b, 0x58, 0xb0, 0x76, 0x1b, 0x77, 0x94, 0x21, 0xc4, 0x3f, 0x47, 0x28, 0xa0, 0xb1, 0xd4,
-	0xc4, 0x7f, 0x51, 0x67, 0x42, 0x2a, 0x35, 0xfc, 0x4a, 0xa3, 0x24, 0xfe, 0x7a, 0xec, 0x46, 0x03,
-	0xa6, 0xf9, 0xab, 0x3e, 0x79, 0x55, 0x6a, 0x8a, 0xde, 0x68, 0x69, 0x65, 0xc5, 0x34, 0xee, 0x35,
-	0x15, 0xee, 0xcd, 0x3c, 0x05, 0x93, 0x41, 0x6e, 0x09, 0x28, 0x03, 0xe7, 0x89, 0x39, 0x71, 0x0c,
-	0xcd, 0x40, 0x96, 0xcc, 0xcf, 0xa4, 0xc3, 0xf1, 0xed, 0xff, 0x8a, 0x30, 0x5e, 0x6c, 0xaa, 0xa8,
-	0x08, 0x99, 0xe0, 0x0b, 0x05, 0xca, 0x87, 0x75, 0x4d, 0xe2, 0x33, 0x87, 0xb4, 0x9c, 0xc2, 0x61,
-	0x45, 0xc7, 0x39, 0x54, 0x05, 0x88, 0x3e, 0x4e, 0x20, 0x29, 0x84, 0x0e, 0x7d, 0xc6, 0x90, 0x56,
-	0x52, 0x79, 0xa1, 0xa2, 0x7b, 0xb4, 0x30, 0x8c, 0x75, 0x8c, 0xd1, 0x7a, 0xd4, 0xb6, 0x49, 0x6f,
-	0x51, 0x4b, 0x1b, 0x67, 0x20, 0x78, 0xd5, 0xfa, 0x68, 0xd5, 0xfa, 0x63, 0x55, 0xeb, 0xa3, 0x55,
-	0xef, 0xc2, 0x34, 0xdf, 0xb6, 0x45, 0x97, 0xa2, 0x58, 0x0d, 0x77, 0x8b, 0xa5, 0xd5, 0x11, 0xdc,
-	0x50, 0x5d, 0x05, 0xb2, 0x61, 0xeb, 0x04, 0x2d, 0xc7, 0xd0, 0x7c, 0x27, 0x47, 0x92, 0xd2, 0x58,
-	0xa1, 0x16, 0x1d, 0x66, 0xe3, 0x1d, 0x01, 0xb4, 0xc6, 0x87, 0x69, 0xb8, 0xc9, 0x21, 0x15, 0x46,
-	0xf2, 0x43, 0xa5, 0x0f, 0x40, 0x1a, 0xdd, 0xd8, 0x40, 0x37, 0x46, 0x28, 0x48, 0xb9, 0x76, 0x3c,
-	0x89, 0xb1, 0x97, 0x61, 0xc2, 0x6f, 0x62, 0xa3, 0xc5, 0x10, 0x1c, 0xeb, 0x73, 0x4b, 0x4b, 0x43,
-	0xf4, 0x50, 0xf8, 0x20, 0xec, 0x06, 0xc4, 0x3b, 0xc5, 0xe8, 0x0a, 0x6f, 0x78, 0x64, 0x7b, 0x5a,
-	0x7a, 0xfa, 0x71, 0xb0, 0xd0, 0xd2, 0xe7, 0xe1, 0xe2, 0x50, 0x53, 0x02, 0x45, 0x79, 0x33, 0xaa,
-	0x5f, 0x22, 0xc9, 0x67, 0x41, 0x12, 0xcb, 0xc8, 0xab, 0x5e, 0x4b, 0x7a, 0x96, 0xd0, 0x5b, 0x18,
-	0xc9, 0xe7, 0x13, 0x96, 0xef, 0x0f, 0x70, 0x09, 0x9b, 0xd2, 0x4d, 0xe0, 0x12, 0x36, 0xad, 0xa9,
-	0x20, 0x9f, 0x43, 0x4d, 0x98, 0x89, 0x5d, 0xe6, 0xd1, 0x6a, 0xdc, 0x85, 0x44, 0xb7, 0x40, 0x5a,
-	0x1b, 0xc5, 0x0e, 0x35, 0xde, 0x81, 0xb9, 0xc4, 0x55, 0x07, 0x15, 0xb8, 0x9e, 0x4d, 0x5a, 0x27,
-	0x40, 0x5a, 0x1f, 0x0d, 0x08, 0xf5, 0xf6, 0x86, 0xfa, 0x02, 0xc1, 0x15, 0x0a, 0x5d, 0x1d, 0x25,
-	0x9e, 0xb8, 0xa2, 0x49, 0xd7, 0x1e, 0x0f, 0x4c, 0x1c, 0x3a, 0xb1, 0xee, 0x40, 0xfc, 0xd0, 0x49,
-	0xeb, 0x43, 0xc4, 0x0f, 0x9d, 0xf4, 0xd6, 0x02, 0x0d, 0x7a, 0xac, 0x09, 0xc0, 0x05, 0x3d, 0xad,
-	0xe9, 0xc0, 0x05, 0x3d, 0xbd, 0x77, 0x40, 0xcf, 0x9d, 0xf0, 0xae, 0xcf, 0x9d, 0x3b, 0xc9, 0x8e,
-	0x02, 0x77, 0xee, 0x0c, 0xb5, 0x06, 0xe8, 0x76, 0x58, 0x48, 0xed, 0x37, 0xc4, 0x37, 0xde, 0xc8,
-	0x7e, 0xc4, 0x63, 0xb4, 0x17, 0x21, 0x13, 0x74, 0x0e, 0xb8, 0x97, 0x55, 0xa2, 0xeb, 0x20, 0x2d,
-	0xa7, 0x70, 0xf8, 0xfd, 0x3a, 0xd4, 0x2e, 0xe0, 0xf6, 0xeb, 0xa8, 0x36, 0x03, 0xb7, 0x5f, 0x47,
-	0x76, 0x1b, 0xfc, 0x15, 0x4f, 0x5e, 0xff, 0x11, 0x9f, 0x99, 0xa9, 0xed, 0x05, 0x6e, 0xc5, 0x47,
-	0xf6, 0x0e, 0x68, 0xf2, 0x8e, 0xb8, 0xba, 0x73, 0xc9, 0x7b, 0xf6, 0xf5, 0x9f, 0x4b, 0xde, 0xc7,
-	0x74, 0x01, 0xd8, 0x26, 0x8c, 0xff, 0x46, 0x80, 0xdf, 0x84, 0xa9, 0x3f, 0x3b, 0xe0, 0x37, 0x61,
-	0xfa, 0xcf, 0x0b, 0xe4, 0x73, 0xa5, 0x9b, 0xef, 0x9d, 0xae, 0x09, 0xef, 0x9f, 0xae, 0x09, 0x1f,
-	0x9c, 0xae, 0x09, 0x9f, 0xbb, 0xb1, 0x6f, 0x79, 0x07, 0x83, 0xbd, 0xcd, 0x8e, 0x7d, 0xb4, 0xd5,
-	0x6f, 0x77, 0x0e, 0x4e, 0xba, 0xd8, 0xe1, 0x9f, 0x8e, 0xb7, 0xb7, 0x5c, 0xa7, 0x43, 0x7f, 0xc4,
-	0xb1, 0x37, 0x41, 0x3f, 0x46, 0x3e, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x2a, 0x5a,
-	0x32, 0xd8, 0x21, 0x00, 0x00,
+	// 3007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x79, 0x77, 0xdc, 0xc6,
+	0x91, 0x37, 0x48, 0x49, 0x24, 0x8b, 0x22, 0x09, 0xb6, 0x78, 0x0c, 0xc1, 0x1b, 0xb2, 0xac, 0x63,
+	0xd7, 0xa4, 0x2d, 0xad, 0x77, 0x65, 0x5b, 0xfb, 0xc7, 0x1c, 0xe0, 0x08, 0xd6, 0x70, 0x66, 0xb6,
+	0x81, 0x91, 0xac, 0x7d, 0xfb, 0x16, 0x3b, 0x9c, 0x69, 0x91, 0x58, 0x91, 0x03, 0x1a, 0xc0, 0x70,
+	0x45, 0x6f, 0x9c, 0xd8, 0xb9, 0x6f, 0x3b, 0x97, 0x73, 0x7c, 0x87, 0xfc, 0x93, 0x7c, 0x09, 0xe7,
+	0x76, 0xee, 0xfc, 0xa5, 0xf8, 0xe9, 0x23, 0xe4, 0x03, 0xe4, 0xe5, 0x75, 0xa3, 0x01, 0x34, 0x30,
+	0x00, 0x25, 0xdb, 0xcf, 0xff, 0x90, 0xe8, 0xaa, 0x5f, 0x1d, 0x5d, 0x55, 0x68, 0x14, 0x0a, 0x03,
+	0x53, 0xed, 0xbe, 0xbf, 0xb7, 0x49, 0xff, 0x6c, 0x1c, 0xba, 0x8e, 0xef, 0xa0, 0x11, 0x7a, 0x6d,
+	0x1d, 0x5d, 0x55, 0x66, 0x76, 0x9d, 0x5d, 0x87, 0xd1, 0x36, 0xe9, 0x55, 0xc0, 0x56, 0x56, 0x77,
+	0x1d, 0x67, 0x77, 0x9f, 0x6c, 0xb2, 0xd5, 0x4e, 0xff, 0xde, 0xa6, 0x6f, 0x1f, 0x10, 0xcf, 0x6f,
+	0x1f, 0x1c, 0x06, 0x00, 0xf5, 0x39, 0x98, 0x2a, 0x76, 0x7c, 0xfb, 0xa8, 0xed, 0x13, 0x4c, 0x5e,
+	0xeb, 0x13, 0xcf, 0x47, 0xcb, 0x00, 0xae, 0xe3, 0xf8, 0x96, 0xef, 0xdc, 0x27, 0xbd, 0x82, 0xb4,
+	0x26, 0x5d, 0x1a, 0xc3, 0x63, 0x94, 0x62, 0x52, 0x82, 0xfa, 0x3c, 0xc8, 0xb1, 0x84, 0x77, 0xe8,
+	0xf4, 0x3c, 0x42, 0x45, 0x0e, 0xdb, 0x9d, 0xbd, 0xa4, 0x08, 0xa5, 0x04, 0x22, 0xe7, 0x60, 0xba,
+	0x42, 0xda, 0x49, 0x33, 0xea, 0x0c, 0x20, 0x91, 0x18, 0x68, 0x52, 0xff, 0x0d, 0xe6, 0xb0, 0xe3,
+	0x53, 0x4a, 0x68, 0xf0, 0x09, 0xdd, 0xba, 0x0e, 0xf3, 0x03, 0x82, 0xb1, 0x77, 0x27, 0x49, 0x7e,
+	0x30, 0x04, 0xd0, 0xd0, 0x2b, 0xe5, 0xb2, 0xd3, 0xbb, 0x67, 0xef, 0xa2, 0x39, 0x38, 0x63, 0x7b,
+	0x5e, 0x9f, 0xb8, 0x1c, 0xc9, 0x57, 0xe8, 0x32, 0x8c, 0x75, 0xf6, 0x6d, 0xd2, 0xf3, 0x2d, 0xbb,
+	0x5b, 0x18, 0xa2, 0xac, 0xd2, 0xd9, 0x47, 0x0f, 0x57, 0x47, 0xcb, 0x8c, 0xa8, 0x57, 0xf0, 0x68,
+	0xc0, 0xd6, 0xbb, 0xe8, 0x3c, 0x4c, 0x70, 0xa8, 0x47, 0x3a, 0x2e, 0xf1, 0x0b, 0xc3, 0x4c, 0xd3,
+	0xd9, 0x80, 0x68, 0x30, 0x1a, 0xba, 0x0a, 0x67, 0x5d, 0xd2, 0xb5, 0x5d, 0xd2, 0xf1, 0xad, 0xbe,
+	0x6b, 0x17, 0x4e, 0x31, 0x95, 0x53, 0x8f, 0x1e, 0xae, 0x8e, 0x63, 0x4e, 0x6f, 0x61, 0x1d, 0x8f,
+	0x87, 0xa0, 0x96, 0x6b, 0x53, 0xdf, 0xbc, 0x8e, 0x73, 0x48, 0xbc, 0xc2, 0xe9, 0xb5, 0x61, 0xea,
+	0x5b, 0xb0, 0x42, 0xff, 0x02, 0x73, 0x2e, 0x79, 0xad, 0x6f, 0xbb, 0xc4, 0x22, 0x07, 0x6d, 0x7b,
+	0xdf, 0x3a, 0x22, 0xae, 0x7d, 0xcf, 0x26, 0xdd, 0xc2, 0x99, 0x35, 0xe9, 0xd2, 0x28, 0x9e, 0xe1,
+	0x5c, 0x8d, 0x32, 0x6f, 0x73, 0x1e, 0xba, 0x0c, 0xf2, 0xbe, 0xd3, 0x69, 0xef, 0xef, 0x39, 0x9e,
+	0x6f, 0xf1, 0x3d, 0x8f, 0x30, 0xfc, 0x54, 0x44, 0xd7, 0x83, 0xcd, 0xff, 0x3b, 0x2c, 0xf6, 0x3d,
+	0xe2, 0x5a, 0xed, 0x4e, 0x87, 0x78, 0x9e, 0xbd, 0xb3, 0x4f, 0xb8, 0x80, 0x45, 0x41, 0x85, 0x51,
+	0xb6, 0xbf, 0x02, 0x85, 0x14, 0x23, 0x44, 0x20, 0x7a, 0xd3, 0xf1, 0x7c, 0x75, 0x01, 0xe6, 0xab,
+	0xc4, 0x0f, 0x02, 0xdc, 0x77, 0xdb, 0xbe, 0xed, 0x84, 0x69, 0x55, 0x5b, 0x50, 0x18, 0x64, 0xf1,
+	0xc4, 0xbd, 0x08, 0x13, 0x1d, 0x91, 0xc1, 0x32, 0x32, 0x7e, 0xf5, 0xdc, 0x06, 0x2f, 0xfa, 0x8d,
+	0x38, 0x6d, 0x38, 0x89, 0x54, 0x4d, 0x98, 0x37, 0xb2, 0x2d, 0x7e, 0x1c, 0xad, 0x0a, 0x14, 0x8c,
+	0x1c, 0x67, 0xd5, 0x9f, 0x48, 0x30, 0xc6, 0x0a, 0x4a, 0xef, 0xdd, 0x73, 0x50, 0x01, 0x46, 0xbc,
+	0xfe, 0xce, 0xff, 0x92, 0x8e, 0xcf, 0xcb, 0x28, 0x5c, 0x22, 0x03, 0x80, 0x3c, 0x38, 0xb4, 0xb9,
+	0xed, 0x21, 0x66, 0x5b, 0xd9, 0x08, 0xee, 0xd3, 0x8d, 0xf0, 0x3e, 0xdd, 0x30, 0xc3, 0xfb, 0xb4,
+	0x34, 0xff, 0xb7, 0x87, 0xab, 0x53, 0xdd, 0x9d, 0x97, 0xd4, 0x58, 0x4a, 0x7d, 0xe7, 0xaf, 0xab,
+	0x12, 0x16, 0xd4, 0xa0, 0x7f, 0x85, 0xb3, 0x7b, 0x6d, 0x6f, 0x8f, 0x74, 0x79, 0x91, 0xb3, 0x82,
+	0x2b, 0x9d, 0x0b, 0x45, 0x19, 0xd1, 0xa2, 0x08, 0x15, 0x8f, 0x07, 0xc0, 0xa0, 0xf6, 0xff, 0x1b,
+	0xce, 0x15, 0xfb, 0xfe, 0x1e, 0xe9, 0xf9, 0x76, 0x47, 0x38, 0x02, 0xfe, 0x19, 0xc0, 0xb1, 0xbb,
+	0x1d, 0xcb, 0xa3, 0x37, 0x54, 0xb0, 0x81, 0xd2, 0xc4, 0xa3, 0x87, 0xab, 0x63, 0x34, 0x34, 0x06,
+	0x25, 0xe2, 0x31, 0x0a, 0x60, 0x97, 0x68, 0x01, 0x46, 0xed, 0xd0, 0xf0, 0x50, 0xb0, 0x59, 0x9b,
+	0xeb, 0x7f, 0x01, 0x66, 0x92, 0xfa, 0x9f, 0xec, 0xc0, 0x98, 0x82, 0x89, 0x3b, 0x7b, 0x4e, 0xf1,
+	0x40, 0x0f, 0xab, 0xe4, 0x2d, 0x09, 0x26, 0x43, 0x0a, 0x57, 0xa1, 0xc0, 0x28, 0xad, 0xb7, 0x5e,
+	0xfb, 0x80, 0x7b, 0x88, 0xa3, 0xf5, 0x27, 0x12, 0x63, 0xd5, 0x80, 0xa5, 0x2a, 0xf1, 0xb1, 0xb3,
+	0x4f, 0xbc, 0x2d, 0xc7, 0x6d, 0x12, 0xf7, 0xc0, 0xf6, 0x3c, 0xa1, 0xae, 0xae, 0x01, 0x1c, 0x46,
+	0x44, 0xe6, 0xd2, 0xa4, 0x50, 0x54, 0x02, 0x5e, 0x80, 0xa9, 0x15, 0x58, 0xce, 0x51, 0xca, 0xb7,
+	0x79, 0x1e, 0x4e, 0xbb, 0x94, 0x5b, 0x90, 0xd6, 0x86, 0x2f, 0x8d, 0x5f, 0x9d, 0x88, 0x14, 0x52,
+	0x19, 0x1c, 0xf0, 0x54, 0x17, 0x4e, 0x33, 0x15, 0x68, 0x33, 0x89, 0x5e, 0x48, 0xa0, 0xbd, 0xe0,
+	0xaf, 0xd6, 0xf3, 0xdd, 0x63, 0x2e, 0xa9, 0x5c, 0x07, 0x88, 0x89, 0x48, 0x86, 0xe1, 0xfb, 0xe4,
+	0x98, 0x87, 0x93, 0x5e, 0xa2, 0x19, 0x38, 0x7d, 0xd4, 0xde, 0xef, 0x13, 0x16, 0xc4, 0x51, 0x1c,
+	0x2c, 0x5e, 0x1a, 0xba, 0x2e, 0xa9, 0xef, 0x4a, 0x30, 0x4e, 0x45, 0x4b, 0x76, 0xaf, 0x6b, 0xf7,
+	0x76, 0xd1, 0xcb, 0x30, 0x42, 0x7a, 0xbe, 0x6b, 0x47, 0xc6, 0xd7, 0x13, 0xc6, 0x39, 0x6c, 0x43,
+	0x0b, 0x30, 0x81, 0x13, 0xa1, 0x84, 0xf2, 0x0a, 0x9c, 0x15, 0x19, 0x19, 0x8e, 0x3c, 0x2d, 0x3a,
+	0x32, 0x7e, 0x75, 0x32, 0xb9, 0x33, 0xd1, 0x31, 0x1d, 0x46, 0x31, 0xf1, 0x9c, 0xbe, 0xdb, 0x21,
+	0xe8, 0x32, 0x9c, 0xf2, 0x8f, 0x0f, 0x09, 0xcf, 0xc6, 0x6c, 0x2c, 0xc4, 0x01, 0xe6, 0xf1, 0x21,
+	0xc1, 0x0c, 0x82, 0x10, 0x9c, 0x62, 0xb5, 0x14, 0x54, 0x30, 0xbb, 0x56, 0x3f, 0x2b, 0xc1, 0xe9,
+	0x96, 0x47, 0x5c, 0x0f, 0xbd, 0x0c, 0x63, 0x61, 0x75, 0x85, 0xfb, 0x5b, 0x8e, 0xb4, 0x31, 0xc8,
+	0x46, 0x2b, 0xe4, 0x07, 0x7b, 0x8b, 0xf1, 0xca, 0x0d, 0x98, 0x4c, 0x32, 0x3f, 0x54, 0xa0, 0x1f,
+	0xc0, 0x99, 0xaa, 0xeb, 0xf4, 0x0f, 0x3d, 0x74, 0x0d, 0xce, 0xec, 0xb2, 0x2b, 0xee, 0xc1, 0x62,
+	0xe4, 0x41, 0x00, 0xe0, 0xff, 0x02, 0xfb, 0x1c, 0xaa, 0xbc, 0x08, 0xe3, 0x02, 0xf9, 0x43, 0x59,
+	0x7e, 0x5b, 0x82, 0x53, 0x34, 0xbc, 0x51, 0x6c, 0xa4, 0x38, 0x36, 0xe8, 0x05, 0x18, 0x8f, 0xeb,
+	0xd8, 0x2b, 0x0c, 0xad, 0x0d, 0xe7, 0xd5, 0xbb, 0x88, 0x43, 0x37, 0x60, 0xd2, 0xe5, 0xc1, 0xb7,
+	0x68, 0xdc, 0xbd, 0xc2, 0xf0, 0xda, 0x70, 0x7e, 0x6e, 0x26, 0x5c, 0x61, 0xe5, 0xa9, 0x0f, 0x40,
+	0xa6, 0xe7, 0x89, 0xe3, 0xda, 0xaf, 0x47, 0x87, 0xd5, 0xb3, 0x30, 0x1a, 0x82, 0xf8, 0x51, 0x3e,
+	0x3d, 0xa0, 0x0b, 0x47, 0x90, 0x8f, 0xe8, 0xb7, 0xfa, 0x53, 0x09, 0xa6, 0x05, 0xd3, 0xfc, 0xee,
+	0x5c, 0x01, 0x68, 0x87, 0xc4, 0x2e, 0xb3, 0x3e, 0x8a, 0x05, 0x0a, 0x7a, 0x1e, 0xc6, 0xbc, 0xb6,
+	0x6f, 0x7b, 0xec, 0x59, 0x7c, 0x82, 0xa9, 0x18, 0x85, 0x9e, 0x85, 0x11, 0x46, 0xed, 0xed, 0x16,
+	0x86, 0xf3, 0x05, 0x42, 0x0c, 0x5a, 0x82, 0xb1, 0x43, 0xd7, 0xee, 0x75, 0xec, 0xc3, 0xf6, 0x7e,
+	0xd0, 0x43, 0xe0, 0x98, 0xa0, 0x6e, 0xc1, 0x6c, 0x95, 0xf8, 0xb1, 0x9c, 0xf7, 0xd1, 0x82, 0xa6,
+	0x1e, 0xc2, 0x7a, 0x52, 0x0f, 0x3d, 0xac, 0x42, 0x2b, 0x1f, 0x31, 0x11, 0x09, 0xcf, 0x87, 0xd2,
+	0x9e, 0x13, 0x98, 0x4b, 0x7b, 0xce, 0x63, 0x9e, 0x4a, 0xa0, 0xf4, 0x84, 0x85, 0x37, 0x13, 0x1e,
+	0x8d, 0x43, 0xac, 0x75, 0x0a, 0x16, 0xea, 0x1b, 0x50, 0xd8, 0x76, 0xba, 0xf6, 0xbd, 0x63, 0xe1,
+	0x8c, 0xfa, 0x24, 0xf6, 0x13, 0x9b, 0x1f, 0x16, 0xcd, 0x2f, 0xc2, 0x42, 0x86, 0x79, 0xde, 0x51,
+	0x04, 0xc9, 0xfb, 0xd8, 0x8e, 0xa9, 0x37, 0x61, 0x2e, 0xad, 0x87, 0x87, 0x72, 0x03, 0x46, 0x76,
+	0x02, 0x12, 0xd7, 0x33, 0x93, 0x75, 0x66, 0xe3, 0x10, 0xa4, 0xfe, 0x0f, 0x8c, 0x1b, 0x84, 0xc5,
+	0x93, 0x35, 0x39, 0x33, 0x70, 0xba, 0xe7, 0xf4, 0x3a, 0xe1, 0xb9, 0x10, 0x2c, 0x28, 0x95, 0x35,
+	0xa1, 0x3c, 0x06, 0xc1, 0x02, 0x5d, 0x80, 0xc9, 0x8e, 0xd3, 0x3b, 0x22, 0x2e, 0x95, 0xb6, 0x88,
+	0xeb, 0xb2, 0x1e, 0x65, 0x14, 0x4f, 0xc4, 0x54, 0xcd, 0x75, 0xd5, 0x59, 0x38, 0x57, 0x25, 0x3e,
+	0x6d, 0x33, 0x6a, 0xce, 0xae, 0x1d, 0x75, 0x89, 0x77, 0x60, 0x26, 0x49, 0xe6, 0x1b, 0xb8, 0x0c,
+	0x63, 0xfb, 0x94, 0x60, 0xf5, 0xdd, 0xfd, 0x82, 0x14, 0x37, 0xe5, 0x0c, 0xd5, 0xc2, 0x35, 0x3c,
+	0xca, 0xd8, 0x2d, 0x97, 0x25, 0x20, 0x68, 0x67, 0xb8, 0x5b, 0x6c, 0xa1, 0x56, 0x99, 0x62, 0xec,
+	0xec, 0xa4, 0xde, 0x36, 0x58, 0xba, 0x76, 0x9c, 0xb0, 0x7b, 0x0b, 0x16, 0x68, 0x01, 0x86, 0x7d,
+	0x3f, 0xd8, 0xd8, 0x70, 0x69, 0xe4, 0xd1, 0xc3, 0xd5, 0x61, 0xd3, 0xac, 0x61, 0x4a, 0x53, 0x9f,
+	0x85, 0xd9, 0x94, 0x22, 0xee, 0xe2, 0x0c, 0x9c, 0x16, 0xbb, 0x9c, 0x60, 0xa1, 0xae, 0xb0, 0x66,
+	0xa2, 0xe9, 0x12, 0xcf, 0xde, 0xed, 0x95, 0x5d, 0xd2, 0xa5, 0x2d, 0x52, 0x7b, 0x3f, 0xbc, 0x3f,
+	0xd5, 0x37, 0x25, 0x58, 0xce, 0x01, 0x70, 0xbd, 0xd7, 0x60, 0x22, 0xe8, 0xc6, 0xad, 0xfb, 0xe4,
+	0x98, 0xbe, 0x93, 0x48, 0xf1, 0x0b, 0x44, 0xd0, 0x84, 0xdf, 0x22, 0xc7, 0x7a, 0x05, 0x8f, 0xb7,
+	0xa3, 0x45, 0x17, 0x5d, 0x81, 0xe9, 0xe0, 0x95, 0xc4, 0x8a, 0x65, 0x79, 0x40, 0xa6, 0x02, 0x46,
+	0x24, 0xaa, 0x6e, 0xc0, 0x1c, 0x26, 0x47, 0xce, 0x7d, 0x42, 0x8f, 0xbd, 0x74, 0x70, 0x32, 0xb6,
+	0xb4, 0x00, 0xf3, 0x03, 0x78, 0x5e, 0xc9, 0xdb, 0xac, 0x1b, 0x0f, 0x1e, 0x43, 0x5b, 0x8e, 0x4b,
+	0x1f, 0x86, 0xa1, 0xae, 0x93, 0xda, 0xb8, 0xb9, 0xe8, 0x79, 0x17, 0xdc, 0xb3, 0x7c, 0xc5, 0xdb,
+	0xf0, 0x94, 0x3a, 0x6e, 0xea, 0x36, 0xcc, 0x04, 0x77, 0xd4, 0x36, 0x39, 0xd8, 0x21, 0xae, 0x27,
+	0xf8, 0xcc, 0xa4, 0x43, 0x9f, 0xd9, 0x82, 0x3e, 0x0d, 0xdb, 0xdd, 0x2e, 0x57, 0x4f, 0x2f, 0xa9,
+	0x4d, 0x97, 0x1c, 0x38, 0x47, 0x84, 0xdf, 0xa8, 0x7c, 0xa5, 0xce, 0xc3, 0x6c, 0x4a, 0x2f, 0x37,
+	0x88, 0x40, 0xae, 0x86, 0xce, 0x84, 0xd9, 0xbb, 0x01, 0x4b, 0x11, 0x2d, 0xeb, 0xa4, 0x4c, 0x1c,
+	0x15, 0x52, 0xfa, 0xe8, 0xfb, 0x27, 0x98, 0x16, 0x34, 0xf2, 0x74, 0xcf, 0x25, 0x9e, 0xfd, 0x71,
+	0x2c, 0x2e, 0xc2, 0x54, 0x95, 0xf8, 0xac, 0x03, 0x39, 0x71, 0xab, 0xea, 0x73, 0x20, 0xc7, 0x40,
+	0xae, 0x74, 0x29, 0xdd, 0xd5, 0x8c, 0x09, 0x6d, 0x0b, 0x0d, 0xb3, 0xf6, 0xc0, 0x77, 0xdb, 0x1d,
+	0x3f, 0xca, 0x68, 0xb4, 0xc3, 0x2a, 0x2c, 0x64, 0xf0, 0xb8, 0xda, 0x2b, 0x70, 0x86, 0x95, 0x44,
+	0xd8, 0xa7, 0xa0, 0xe8, 0x54, 0x89, 0x5e, 0x90, 0x30, 0x47, 0xa8, 0x65, 0x5a, 0x35, 0x9e, 0xef,
+	0xb8, 0x83, 0x65, 0x76, 0x49, 0x2c, 0xb3, 0x6c, 0x2d, 0xbc, 0xf4, 0x14, 0x28, 0x0c, 0x2a, 0xe1,
+	0xf9, 0xb9, 0x01, 0x2b, 0xa9, 0xb2, 0xfc, 0x10, 0x25, 0xa8, 0xae, 0xc3, 0x6a, 0xae, 0x34, 0x37,
+	0xb0, 0x06, 0x2b, 0x15, 0xb2, 0x4f, 0x7c, 0xa2, 0xd1, 0x77, 0x05, 0xd2, 0x1d, 0x0c, 0xd6, 0x3a,
+	0xac, 0xe6, 0x22, 0x02, 0x25, 0x57, 0xfe, 0x2e, 0x03, 0xc4, 0x4f, 0x2e, 0x34, 0x07, 0xa8, 0xa9,
+	0xe1, 0x6d, 0xdd, 0x30, 0xf4, 0x46, 0xdd, 0x6a, 0xd5, 0x6f, 0xd5, 0x1b, 0x77, 0xea, 0xf2, 0x53,
+	0x68, 0x11, 0xe6, 0xcb, 0xb5, 0x96, 0x61, 0x6a, 0xd8, 0xda, 0x6e, 0x54, 0xf4, 0xad, 0xbb, 0x56,
+	0x49, 0xaf, 0x57, 0xf4, 0x7a, 0xd5, 0x90, 0xbb, 0xa8, 0x00, 0x33, 0x21, 0xb3, 0xaa, 0x99, 0x31,
+	0x87, 0xa0, 0x45, 0x98, 0x13, 0x39, 0xcd, 0x62, 0xf9, 0x66, 0xc5, 0xaa, 0x35, 0xaa, 0x86, 0xfc,
+	0x5d, 0x09, 0x2d, 0xc0, 0x6c, 0xc8, 0x2c, 0xb6, 0xcc, 0x9b, 0x56, 0xb1, 0x6c, 0xea, 0xb7, 0x8b,
+	0xa6, 0x26, 0xdf, 0x13, 0xcd, 0x31, 0x56, 0x45, 0x8b, 0x98, 0xbb, 0x03, 0x4c, 0xaa, 0xb9, 0xdc,
+	0xa8, 0x6f, 0xe9, 0x55, 0x79, 0x6f, 0x80, 0x69, 0xc4, 0x4c, 0x1b, 0xad, 0xc3, 0xd2, 0x80, 0x24,
+	0x6e, 0x94, 0x1a, 0xa6, 0x65, 0x36, 0x6e, 0x69, 0x75, 0xf9, 0x6b, 0x12, 0xba, 0x00, 0xeb, 0x09,
+	0x08, 0xdf, 0x6d, 0x15, 0x37, 0x5a, 0x4d, 0x6b, 0x5b, 0xdb, 0x2e, 0x69, 0xd8, 0x90, 0x0f, 0x32,
+	0x7d, 0x60, 0x18, 0x43, 0xee, 0xa1, 0x35, 0x58, 0xca, 0x66, 0x5a, 0x2d, 0x83, 0x8a, 0x3b, 0x68,
+	0x15, 0x16, 0x13, 0x08, 0xed, 0x55, 0x13, 0x17, 0xcb, 0xdc, 0x0d, 0x43, 0x3e, 0x44, 0x2b, 0xa0,
+	0x24, 0x00, 0x58, 0x33, 0xcc, 0x06, 0xd6, 0xb8, 0x9f, 0xaf, 0xa1, 0x4d, 0xb8, 0x32, 0x60, 0x22,
+	0x4e, 0x9c, 0x61, 0x6d, 0x35, 0xb0, 0xd5, 0xc4, 0x7a, 0xbd, 0xac, 0x37, 0x8b, 0x35, 0xf9, 0x1b,
+	0x12, 0xba, 0x08, 0x6a, 0x2a, 0xa2, 0x35, 0xcd, 0xd4, 0x2c, 0xed, 0xd5, 0xa6, 0x8e, 0xb5, 0x4a,
+	0x68, 0xf8, 0xeb, 0x12, 0x7a, 0x1a, 0x56, 0x53, 0x96, 0x6f, 0x37, 0x6e, 0x69, 0xcc, 0xf3, 0x10,
+	0xf5, 0x4d, 0x09, 0x9d, 0x87, 0x95, 0x24, 0xaa, 0x61, 0x16, 0x4d, 0xcd, 0xc2, 0x8d, 0x28, 0x96,
+	0xdf, 0x91, 0xc4, 0x5d, 0x6a, 0x75, 0x53, 0xc3, 0x4d, 0xac, 0x1b, 0x5a, 0x9c, 0x66, 0x57, 0x0c,
+	0x94, 0x00, 0xb8, 0xa9, 0x15, 0xb1, 0x59, 0xd2, 0x8a, 0xa6, 0xec, 0xe5, 0xa8, 0x08, 0x32, 0x5e,
+	0xd1, 0x64, 0x1f, 0xad, 0xc3, 0x72, 0x06, 0x40, 0xa8, 0x97, 0x3e, 0x5a, 0x86, 0x42, 0x06, 0xa4,
+	0x59, 0x6c, 0x19, 0x9a, 0xfc, 0xbd, 0x84, 0x97, 0x7a, 0x45, 0xab, 0x9b, 0xba, 0x79, 0x57, 0xac,
+	0x9a, 0xa3, 0x4c, 0x80, 0x50, 0x73, 0xff, 0x97, 0x09, 0x28, 0x63, 0x8d, 0x06, 0x44, 0xaf, 0x34,
+	0xe5, 0x07, 0x99, 0x80, 0x56, 0xb3, 0x12, 0x02, 0x8e, 0xc5, 0x74, 0x47, 0x80, 0x9a, 0x6e, 0x98,
+	0x94, 0x6d, 0xc8, 0xaf, 0xa3, 0x25, 0x28, 0x0c, 0xf0, 0xa9, 0x0b, 0x54, 0xfa, 0xff, 0x33, 0xd5,
+	0xf3, 0xfc, 0x52, 0xc0, 0xa7, 0xd0, 0x45, 0x38, 0x9f, 0xe7, 0x20, 0x6d, 0x6d, 0xac, 0x72, 0x4d,
+	0xd7, 0xea, 0xa6, 0xfc, 0x46, 0x26, 0x90, 0x3b, 0x2a, 0x02, 0x3f, 0x8d, 0x9e, 0x01, 0x75, 0x00,
+	0xc8, 0x1c, 0x16, 0x60, 0x86, 0xfc, 0x19, 0x74, 0x01, 0xd6, 0x32, 0x1d, 0x17, 0xb5, 0xbd, 0x29,
+	0xa1, 0x4b, 0x70, 0x3e, 0x6f, 0x07, 0x22, 0xf2, 0x2d, 0x09, 0xcd, 0x03, 0x0a, 0x91, 0x15, 0xad,
+	0xd4, 0xaa, 0x5a, 0x95, 0xd6, 0x76, 0x53, 0xfe, 0x9c, 0x24, 0x66, 0xb9, 0xa6, 0x97, 0xb5, 0xba,
+	0x58, 0x69, 0x9f, 0xcf, 0x64, 0x47, 0x55, 0xf4, 0x05, 0x09, 0xad, 0xc1, 0x62, 0x9a, 0x5d, 0xac,
+	0x54, 0x2c, 0x4e, 0x93, 0xbf, 0x98, 0xa8, 0xf8, 0x10, 0xc1, 0x23, 0x13, 0x82, 0xbe, 0x94, 0x09,
+	0xe2, 0xdb, 0x08, 0x41, 0x5f, 0x96, 0x90, 0x0a, 0xcb, 0x69, 0x10, 0x0b, 0x1d, 0x27, 0x1a, 0xf2,
+	0x57, 0x24, 0xa4, 0xc4, 0x67, 0x23, 0x4f, 0x94, 0xa1, 0x95, 0xb1, 0x66, 0xca, 0x6f, 0xd3, 0x73,
+	0x73, 0x26, 0x96, 0x37, 0x4c, 0xce, 0x31, 0xe4, 0x77, 0x24, 0x84, 0x60, 0x22, 0x58, 0x71, 0xb3,
+	0xf2, 0xb7, 0x24, 0x74, 0x0e, 0x26, 0x39, 0x4d, 0xaf, 0x1b, 0x4d, 0xad, 0x6c, 0xca, 0xdf, 0x4e,
+	0x85, 0x91, 0x39, 0x58, 0xac, 0xd5, 0xe4, 0xaf, 0x4a, 0x68, 0x05, 0x16, 0x42, 0x46, 0x73, 0xcb,
+	0x08, 0x8f, 0xbf, 0xff, 0x68, 0x35, 0xcc, 0xa2, 0x21, 0xbf, 0x9b, 0x38, 0x1e, 0x18, 0xbf, 0x58,
+	0x2f, 0x56, 0x35, 0x8b, 0x1e, 0x4e, 0xf4, 0xff, 0x2d, 0xed, 0xae, 0x21, 0xff, 0x20, 0x11, 0x4e,
+	0x8a, 0xaa, 0x16, 0x71, 0x89, 0xb2, 0xcb, 0x8d, 0x5a, 0x8d, 0x3a, 0xf0, 0xc3, 0x01, 0x44, 0xb1,
+	0xd9, 0xac, 0xdd, 0xb5, 0xb0, 0x66, 0xd2, 0xe4, 0x37, 0xea, 0xf2, 0x8f, 0x24, 0xf1, 0xb0, 0x6e,
+	0x36, 0x23, 0x4f, 0xea, 0x0d, 0x53, 0xdf, 0xd2, 0x69, 0x94, 0xbe, 0x2f, 0xa1, 0x49, 0x18, 0xc3,
+	0x5a, 0xb3, 0x61, 0x61, 0xad, 0x58, 0x91, 0xdf, 0x93, 0xd0, 0x14, 0x00, 0x5b, 0xdf, 0xc1, 0xba,
+	0xa9, 0xc9, 0x3f, 0x63, 0xa1, 0x62, 0x84, 0xf4, 0x33, 0xeb, 0xe7, 0x12, 0x92, 0x61, 0x9c, 0xb1,
+	0x78, 0xa0, 0x7e, 0x21, 0xa1, 0x02, 0x9c, 0x63, 0x14, 0x1e, 0x26, 0xab, 0xdc, 0xd8, 0xde, 0xd6,
+	0x4d, 0xf9, 0x97, 0x12, 0x9a, 0x05, 0x99, 0x71, 0x82, 0x34, 0x05, 0xe4, 0x5f, 0xb1, 0x20, 0x0a,
+	0x2a, 0x42, 0xc6, 0xaf, 0x63, 0x06, 0x4f, 0x5d, 0x09, 0x17, 0xeb, 0xe5, 0x9b, 0xf2, 0x6f, 0x52,
+	0x8a, 0x38, 0xf9, 0xfd, 0x01, 0x45, 0x9c, 0xf1, 0x5b, 0x09, 0xcd, 0xc1, 0x74, 0xc2, 0xa5, 0x2d,
+	0xbd, 0xa6, 0xc9, 0xbf, 0x63, 0x39, 0x8d, 0xf5, 0x30, 0xe2, 0xef, 0x59, 0x89, 0x33, 0x22, 0x2d,
+	0xdc, 0xa6, 0xde, 0xd4, 0x6a, 0x7a, 0x5d, 0x63, 0xa1, 0xd1, 0xb0, 0xfc, 0x07, 0x16, 0x71, 0x1e,
+	0xac, 0xed, 0xc6, 0x6d, 0x6d, 0x00, 0xf1, 0xc7, 0x1c, 0x05, 0x2c, 0x96, 0x58, 0xfe, 0x53, 0x1c,
+	0x9f, 0x62, 0xb3, 0x89, 0x1b, 0xb7, 0xa3, 0xfd, 0xfe, 0x99, 0x55, 0x34, 0xe3, 0x94, 0xee, 0x36,
+	0x8b, 0x86, 0xc1, 0xfd, 0xb7, 0x9a, 0xb8, 0x61, 0x6a, 0x65, 0x96, 0xce, 0xbf, 0xb0, 0xad, 0x44,
+	0x3a, 0x99, 0xdb, 0xaf, 0x34, 0x4a, 0xf2, 0x8f, 0x87, 0xae, 0x34, 0xe0, 0xac, 0x38, 0x78, 0xa1,
+	0x5d, 0x01, 0xd6, 0x8c, 0x46, 0x0b, 0x97, 0x35, 0xcb, 0xbc, 0xdb, 0xd4, 0x84, 0x26, 0x64, 0x1c,
+	0x46, 0xc2, 0xdb, 0x48, 0x42, 0xa3, 0x70, 0x8a, 0xda, 0x94, 0x87, 0xd0, 0x04, 0x8c, 0xd1, 0xe8,
+	0x58, 0x6c, 0x39, 0x7c, 0xf5, 0x83, 0x69, 0x18, 0x2e, 0x36, 0x75, 0x54, 0x84, 0xd1, 0xf0, 0x7b,
+	0x11, 0x2a, 0x44, 0x2d, 0x5c, 0xea, 0xa3, 0x93, 0xb2, 0x90, 0xc1, 0xe1, 0xfd, 0xd5, 0x53, 0xa8,
+	0x0a, 0x10, 0x7f, 0x2a, 0x42, 0x4a, 0x04, 0x1d, 0xf8, 0xa8, 0xa4, 0x2c, 0x66, 0xf2, 0x22, 0x45,
+	0x77, 0x59, 0x0f, 0x9c, 0x98, 0xdf, 0xa3, 0xb5, 0x48, 0x24, 0xe7, 0x13, 0x85, 0xb2, 0x7e, 0x02,
+	0x42, 0x54, 0x6d, 0xe4, 0xab, 0x36, 0x1e, 0xab, 0xda, 0xc8, 0x57, 0xbd, 0x0d, 0x67, 0xc5, 0x21,
+	0x3a, 0x5a, 0x8a, 0x63, 0x35, 0x38, 0xbb, 0x57, 0x96, 0x73, 0xb8, 0x91, 0xba, 0x0a, 0x8c, 0x45,
+	0x83, 0x2c, 0xb4, 0x90, 0x40, 0x8b, 0x73, 0x35, 0x45, 0xc9, 0x62, 0x45, 0x5a, 0x0c, 0x98, 0x4c,
+	0xce, 0x67, 0xd0, 0x8a, 0x18, 0xa6, 0xc1, 0x91, 0x93, 0xb2, 0x9a, 0xcb, 0x8f, 0x94, 0xde, 0x07,
+	0x25, 0x7f, 0xcc, 0x84, 0xae, 0xe4, 0x28, 0xc8, 0x78, 0xc3, 0x7a, 0x12, 0x63, 0x2f, 0xc3, 0x99,
+	0xe0, 0x93, 0x02, 0x9a, 0x8b, 0xc0, 0x89, 0xaf, 0x0e, 0xca, 0xfc, 0x00, 0x3d, 0x12, 0xde, 0x8b,
+	0x66, 0x33, 0xc9, 0xb9, 0x3d, 0xba, 0x20, 0x1a, 0xce, 0xfd, 0x58, 0xa0, 0x3c, 0xf3, 0x38, 0x58,
+	0x64, 0xe9, 0xbf, 0x60, 0x7a, 0x60, 0x44, 0x84, 0xe2, 0xba, 0xc9, 0x9b, 0x5e, 0x29, 0xea, 0x49,
+	0x90, 0x54, 0x1a, 0x45, 0xd5, 0x2b, 0x69, 0xcf, 0x52, 0x7a, 0x57, 0x73, 0xf9, 0x62, 0xc1, 0x8a,
+	0xd3, 0x1a, 0xa1, 0x60, 0x33, 0x66, 0x3b, 0xca, 0x72, 0x0e, 0x37, 0x52, 0xd7, 0x84, 0x89, 0xc4,
+	0x68, 0x05, 0x2d, 0x27, 0x5d, 0x48, 0xcd, 0x6e, 0x94, 0x95, 0x3c, 0x76, 0x2a, 0x7b, 0x83, 0xc3,
+	0x95, 0x64, 0xf6, 0x72, 0xa7, 0x33, 0xca, 0x33, 0x8f, 0x83, 0x45, 0x96, 0x6e, 0xc3, 0x54, 0xea,
+	0xfd, 0x11, 0xad, 0x0a, 0xb3, 0xba, 0xac, 0xf1, 0x8a, 0xb2, 0x96, 0x0f, 0x88, 0xf4, 0xf6, 0x06,
+	0x86, 0x2d, 0xe1, 0x7b, 0x29, 0xba, 0x98, 0x27, 0x9e, 0x7a, 0xef, 0x55, 0x2e, 0x3d, 0x1e, 0x98,
+	0x3a, 0xde, 0x12, 0x23, 0x97, 0xe4, 0xf1, 0x96, 0x35, 0xdc, 0x51, 0xd6, 0x4f, 0x40, 0x88, 0xe9,
+	0x4d, 0x4c, 0x56, 0x84, 0xf4, 0x66, 0x4d, 0x72, 0x94, 0x95, 0x3c, 0xb6, 0x78, 0xc2, 0x45, 0x03,
+	0x14, 0xe1, 0x84, 0x4b, 0x8f, 0x69, 0x14, 0x25, 0x8b, 0x25, 0xdc, 0x78, 0xb3, 0x99, 0x43, 0x9c,
+	0x64, 0x91, 0xe4, 0x0e, 0x79, 0x1e, 0xa3, 0xbd, 0x08, 0xa3, 0xe1, 0x38, 0x46, 0x78, 0x2c, 0xa6,
+	0x46, 0x39, 0xca, 0x42, 0x06, 0x47, 0x3c, 0x19, 0x06, 0x66, 0x30, 0xc2, 0xc9, 0x90, 0x37, 0xbb,
+	0x51, 0xd4, 0x93, 0x20, 0x62, 0xc6, 0xd3, 0x33, 0x15, 0x24, 0x56, 0x66, 0xe6, 0xcc, 0x46, 0x59,
+	0x3f, 0x01, 0x21, 0x16, 0x6f, 0xce, 0x3c, 0x44, 0x28, 0xde, 0x93, 0x67, 0x2a, 0xca, 0xa5, 0xc7,
+	0x03, 0x13, 0x37, 0x61, 0xf2, 0xb7, 0x21, 0xe2, 0x4d, 0x98, 0xf9, 0x73, 0x13, 0x65, 0x2d, 0x1f,
+	0x10, 0xea, 0x2d, 0x5d, 0x7f, 0xef, 0xd1, 0x8a, 0xf4, 0xfe, 0xa3, 0x15, 0xe9, 0x83, 0x47, 0x2b,
+	0xd2, 0x7f, 0x5e, 0xd9, 0xb5, 0xfd, 0xbd, 0xfe, 0xce, 0x46, 0xc7, 0x39, 0xd8, 0xa4, 0x9f, 0xb2,
+	0x8f, 0xbb, 0xc4, 0x15, 0xaf, 0x8e, 0xae, 0x6e, 0x7a, 0x6e, 0x87, 0xfd, 0x78, 0x67, 0xe7, 0x0c,
+	0xfb, 0x08, 0x7d, 0xed, 0x1f, 0x03, 0x00, 0xcc, 0x3f, 0xf6, 0x4c, 0xd0, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3412,6 +3545,7 @@ type APIClient interface {
 	GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error)
 	GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error)
 	GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error)
+	GetPresignCredentials(ctx context.Context, in *GetPresignCredentialsRequest, opts ...grpc.CallOption) (*GetPresignCredentialsResponse, error)
 	RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error)
 	RevokeAuthTokensForUser(ctx context.Context, in *RevokeAuthTokensForUserRequest, opts ...grpc.CallOption) (*RevokeAuthTokensForUserResponse, error)
 	SetGroupsForUser(ctx context.Context, in *SetGroupsForUserRequest, opts ...grpc.CallOption) (*SetGroupsForUserResponse, error)
@@ -3559,6 +3693,15 @@ func (c *aPIClient) GetRobotToken(ctx context.Context, in *GetRobotTokenRequest,
 	return out, nil
 }
 
+func (c *aPIClient) GetPresignCredentials(ctx context.Context, in *GetPresignCredentialsRequest, opts ...grpc.CallOption) (*GetPresignCredentialsResponse, error) {
+	out := new(GetPresignCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/GetPresignCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error) {
 	out := new(RevokeAuthTokenResponse)
 	err := c.cc.Invoke(ctx, "/auth_v2.API/RevokeAuthToken", in, out, opts...)
@@ -3677,6 +3820,7 @@ type APIServer interface {
 	GetRoleBinding(context.Context, *GetRoleBindingRequest) (*GetRoleBindingResponse, error)
 	GetOIDCLogin(context.Context, *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error)
 	GetRobotToken(context.Context, *GetRobotTokenRequest) (*GetRobotTokenResponse, error)
+	GetPresignCredentials(context.Context, *GetPresignCredentialsRequest) (*GetPresignCredentialsResponse, error)
 	RevokeAuthToken(context.Context, *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error)
 	RevokeAuthTokensForUser(context.Context, *RevokeAuthTokensForUserRequest) (*RevokeAuthTokensForUserResponse, error)
 	SetGroupsForUser(context.Context, *SetGroupsForUserRequest) (*SetGroupsForUserResponse, error)
@@ -3736,6 +3880,9 @@ func (*UnimplementedAPIServer) GetOIDCLogin(ctx context.Context, req *GetOIDCLog
 func (*UnimplementedAPIServer) GetRobotToken(ctx context.Context, req *GetRobotTokenRequest) (*GetRobotTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRobotToken not implemented")
 }
+func (*UnimplementedAPIServer) GetPresignCredentials(ctx context.Context, req *GetPresignCredentialsRequest) (*GetPresignCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresignCredentials not implemented")
+}
 func (*UnimplementedAPIServer) RevokeAuthToken(ctx context.Context, req *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuthToken not implemented")
 }
@@ -4026,6 +4173,24 @@ func _API_GetRobotToken_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetPresignCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresignCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPresignCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/GetPresignCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPresignCredentials(ctx, req.(*GetPresignCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_RevokeAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeAuthTokenRequest)
 	if err := dec(in); err != nil {
@@ -4284,6 +4449,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRobotToken",
 			Handler:    _API_GetRobotToken_Handler,
 		},
+		{
+			MethodName: "GetPresignCredentials",
+			Handler:    _API_GetPresignCredentials_Handler,
+		},
 		{
 			MethodName: "RevokeAuthToken",
 			Handler:    _API_RevokeAuthToken_Handler,
@@ -5953,6 +6122,74 @@ func (m *GetRobotTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetPresignCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPresignCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPresignCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPresignCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPresignCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPresignCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SecretAccessKey) > 0 {
+		i -= len(m.SecretAccessKey)
+		copy(dAtA[i:], m.SecretAccessKey)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.SecretAccessKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccessKeyID) > 0 {
+		i -= len(m.AccessKeyID)
+		copy(dAtA[i:], m.AccessKeyID)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.AccessKeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RevokeAuthTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7314,6 +7551,38 @@ func (m *GetRobotTokenResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetPresignCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPresignCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccessKeyID)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.SecretAccessKey)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RevokeAuthTokenRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11897,6 +12166,172 @@ func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetPresignCredentialsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPresignCredentialsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPresignCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPresignCredentialsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPresignCredentialsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPresignCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretAccessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretAccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
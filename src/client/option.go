@@ -1,11 +1,17 @@
 //nolint:wrapcheck
 package client
 
-import "github.com/pachyderm/pachyderm/v2/src/pfs"
+import (
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata/split"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
 
 type putFileConfig struct {
-	datum  string
-	append bool
+	datum     string
+	append    bool
+	delimiter pfs.Delimiter
+	splitOpts split.Options
+	metadata  map[string]string
 }
 
 // PutFileOption configures a PutFile call.
@@ -25,6 +31,37 @@ func WithDatumPutFile(datum string) PutFileOption {
 	}
 }
 
+// WithSplitPutFile configures the PutFile call to split the file into a
+// directory of files which each contain whole records, where the format of the
+// records is given by delimiter. targetFileRecords and targetFileBytes set the
+// number of records and the number of bytes in each file, if neither is set
+// every record is put in its own file.
+func WithSplitPutFile(delimiter pfs.Delimiter, targetFileRecords, targetFileBytes int64) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.delimiter = delimiter
+		pf.splitOpts.TargetFileRecords = targetFileRecords
+		pf.splitOpts.TargetFileBytes = targetFileBytes
+	}
+}
+
+// WithHeaderRecordsPutFile configures a split PutFile call to write the first
+// headerRecords records of the file to the start of every split file. It is
+// only supported for line and CSV files.
+func WithHeaderRecordsPutFile(headerRecords int64) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.splitOpts.HeaderRecords = headerRecords
+	}
+}
+
+// WithMetadataPutFile configures the PutFile call to set metadata on the files
+// that are put. The metadata is merged into any existing metadata of the files
+// when appending.
+func WithMetadataPutFile(metadata map[string]string) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.metadata = metadata
+	}
+}
+
 type deleteFileConfig struct {
 	datum     string
 	recursive bool
@@ -79,3 +116,71 @@ func WithOffset(offset int64) GetFileOption {
 		gf.Offset = offset
 	}
 }
+
+// WithLength limits the get file request to length bytes after the offset.
+func WithLength(length int64) GetFileOption {
+	return func(gf *pfs.GetFileRequest) {
+		gf.Length = length
+	}
+}
+
+type listFileConfig struct {
+	filter    *pfs.FileFilter
+	pageSize  int64
+	pageToken string
+}
+
+// ListFileOption configures a ListFile, WalkFile or GlobFile call.
+type ListFileOption func(*listFileConfig)
+
+// WithFilterListFile configures the call to only return the files which
+// match filter.
+func WithFilterListFile(filter *pfs.FileFilter) ListFileOption {
+	return func(lf *listFileConfig) {
+		lf.filter = filter
+	}
+}
+
+// WithPageListFile configures the call to return at most pageSize files,
+// starting after the file that pageToken was created from. The token for
+// the next page is pfs.NewFilePageToken of the last file returned, an empty
+// pageToken starts from the first file.
+func WithPageListFile(pageSize int64, pageToken string) ListFileOption {
+	return func(lf *listFileConfig) {
+		lf.pageSize = pageSize
+		lf.pageToken = pageToken
+	}
+}
+
+// ListCommitOption configures a ListCommit call.
+type ListCommitOption func(*pfs.ListCommitRequest)
+
+// WithFilterListCommit configures the ListCommit call to only return the
+// commits which match filter.
+func WithFilterListCommit(filter *pfs.CommitFilter) ListCommitOption {
+	return func(lc *pfs.ListCommitRequest) {
+		lc.Filter = filter
+	}
+}
+
+// WithPageListCommit configures the ListCommit call to return at most
+// pageSize commits, starting after the commit that pageToken was created
+// from. The token for the next page is pfs.NewCommitPageToken of the last
+// commit returned, an empty pageToken starts from the first commit.
+func WithPageListCommit(pageSize int64, pageToken string) ListCommitOption {
+	return func(lc *pfs.ListCommitRequest) {
+		lc.PageSize = pageSize
+		lc.PageToken = pageToken
+	}
+}
+
+// StartCommitOption configures a StartCommit call.
+type StartCommitOption func(*pfs.StartCommitRequest)
+
+// WithMetadataStartCommit configures the StartCommit call to set metadata on
+// the commit.
+func WithMetadataStartCommit(metadata map[string]string) StartCommitOption {
+	return func(sc *pfs.StartCommitRequest) {
+		sc.Metadata = metadata
+	}
+}
@@ -35,6 +35,11 @@ func NewBranch(repoName string, branchName string) *pfs.Branch {
 	}
 }
 
+// NewTag creates a pfs.Tag
+func NewTag(repoName string, tagName string) *pfs.Tag {
+	return NewRepo(repoName).NewTag(tagName)
+}
+
 // NewCommit creates a pfs.Commit.
 func NewCommit(repoName string, branchName string, commitID string) *pfs.Commit {
 	return &pfs.Commit{
@@ -130,6 +135,48 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ExportRepo writes an archive of a repo's finished commits, branches and tags
+// to w.
+func (c APIClient) ExportRepo(repoName string, w io.Writer) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PfsAPIClient.ExportRepo(c.Ctx(), &pfs.ExportRepoRequest{Repo: NewRepo(repoName)})
+	if err != nil {
+		return err
+	}
+	return grpcutil.WriteFromStreamingBytesClient(client, w)
+}
+
+// ImportRepo creates a repo from an archive written by ExportRepo. If repoName
+// is empty, the repo gets the name of the exported repo. If from or to are set,
+// only the commits between the exported commits with those IDs are imported.
+func (c APIClient) ImportRepo(repoName string, r io.Reader, from, to string) (_ *pfs.ImportRepoResponse, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PfsAPIClient.ImportRepo(c.Ctx())
+	if err != nil {
+		return nil, err
+	}
+	request := &pfs.ImportRepoRequest{
+		From: from,
+		To:   to,
+	}
+	if repoName != "" {
+		request.Repo = NewRepo(repoName)
+	}
+	if err := client.Send(request); err != nil {
+		return nil, err
+	}
+	if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
+		return client.Send(&pfs.ImportRepoRequest{Data: data})
+	}); err != nil {
+		return nil, err
+	}
+	return client.CloseAndRecv()
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -139,16 +186,17 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 // alias for the created Commit. This enables a more intuitive access pattern.
 // When the commit is started on a branch the previous head of the branch is
 // used as the parent of the commit.
-func (c APIClient) StartCommit(repoName string, branchName string) (_ *pfs.Commit, retErr error) {
+func (c APIClient) StartCommit(repoName string, branchName string, opts ...StartCommitOption) (_ *pfs.Commit, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
-	return c.PfsAPIClient.StartCommit(
-		c.Ctx(),
-		&pfs.StartCommitRequest{
-			Branch: NewBranch(repoName, branchName),
-		},
-	)
+	request := &pfs.StartCommitRequest{
+		Branch: NewBranch(repoName, branchName),
+	}
+	for _, opt := range opts {
+		opt(request)
+	}
+	return c.PfsAPIClient.StartCommit(c.Ctx(), request)
 }
 
 // StartCommitParent begins the process of committing data to a Repo. Once started
@@ -229,12 +277,12 @@ func (c APIClient) inspectCommit(repoName string, branchName string, commitID st
 // If `to` and `from` are the same commit, no commits will be returned.
 // `number` determines how many commits are returned.  If `number` is 0,
 // all commits that match the aforementioned criteria are returned.
-func (c APIClient) ListCommit(repo *pfs.Repo, to, from *pfs.Commit, number int64) ([]*pfs.CommitInfo, error) {
+func (c APIClient) ListCommit(repo *pfs.Repo, to, from *pfs.Commit, number int64, opts ...ListCommitOption) ([]*pfs.CommitInfo, error) {
 	var result []*pfs.CommitInfo
 	if err := c.ListCommitF(repo, to, from, number, false, func(ci *pfs.CommitInfo) error {
 		result = append(result, ci)
 		return nil
-	}); err != nil {
+	}, opts...); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -250,7 +298,7 @@ func (c APIClient) ListCommit(repo *pfs.Repo, to, from *pfs.Commit, number int64
 // `number` determines how many commits are returned.  If `number` is 0,
 // `reverse` lists the commits from oldest to newest, rather than newest to oldest
 // all commits that match the aforementioned criteria are passed to f.
-func (c APIClient) ListCommitF(repo *pfs.Repo, to, from *pfs.Commit, number int64, reverse bool, f func(*pfs.CommitInfo) error) error {
+func (c APIClient) ListCommitF(repo *pfs.Repo, to, from *pfs.Commit, number int64, reverse bool, f func(*pfs.CommitInfo) error, opts ...ListCommitOption) error {
 	req := &pfs.ListCommitRequest{
 		Repo:    repo,
 		Number:  number,
@@ -258,6 +306,9 @@ func (c APIClient) ListCommitF(repo *pfs.Repo, to, from *pfs.Commit, number int6
 		To:      to,
 		From:    from,
 	}
+	for _, opt := range opts {
+		opt(req)
+	}
 	ctx, cf := context.WithCancel(c.Ctx())
 	defer cf()
 	stream, err := c.PfsAPIClient.ListCommit(ctx, req)
@@ -367,6 +418,113 @@ func (c APIClient) DeleteBranch(repoName string, branchName string, force bool)
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateTag creates a tag which refers to a finished commit. Unlike a branch,
+// a tag can't be moved once it is created. The tag can be used anywhere a
+// commit is accepted, as repo@tag.
+func (c APIClient) CreateTag(repoName string, tagName string, commitBranch string, commitID string, description string) error {
+	_, err := c.PfsAPIClient.CreateTag(
+		c.Ctx(),
+		&pfs.CreateTagRequest{
+			Tag:         NewTag(repoName, tagName),
+			Commit:      NewCommit(repoName, commitBranch, commitID),
+			Description: description,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectTag returns information on a specific tag.
+func (c APIClient) InspectTag(repoName string, tagName string) (*pfs.TagInfo, error) {
+	tagInfo, err := c.PfsAPIClient.InspectTag(
+		c.Ctx(),
+		&pfs.InspectTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return tagInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListTag lists the tags in a repo.
+func (c APIClient) ListTag(repoName string) ([]*pfs.TagInfo, error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PfsAPIClient.ListTag(
+		ctx,
+		&pfs.ListTagRequest{
+			Repo: NewRepo(repoName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	tagInfos, err := clientsdk.ListTagInfo(client)
+	return tagInfos, grpcutil.ScrubGRPC(err)
+}
+
+// DeleteTag deletes a tag, but leaves the commit it refers to intact.
+func (c APIClient) DeleteTag(repoName string, tagName string) error {
+	_, err := c.PfsAPIClient.DeleteTag(
+		c.Ctx(),
+		&pfs.DeleteTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ProtectBranch protects a branch so that commits can't be started on it
+// directly, and its head can only be moved to a finished commit from another
+// branch with at least requiredApprovals approvals.
+func (c APIClient) ProtectBranch(repoName string, branchName string, requiredApprovals int64) error {
+	_, err := c.PfsAPIClient.ProtectBranch(
+		c.Ctx(),
+		&pfs.ProtectBranchRequest{
+			Branch:     NewBranch(repoName, branchName),
+			Protection: &pfs.BranchProtection{RequiredApprovals: requiredApprovals},
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// UnprotectBranch removes the protection from a branch.
+func (c APIClient) UnprotectBranch(repoName string, branchName string) error {
+	_, err := c.PfsAPIClient.ProtectBranch(
+		c.Ctx(),
+		&pfs.ProtectBranchRequest{
+			Branch: NewBranch(repoName, branchName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ApproveCommit approves a commit as the caller, so that it can become the
+// head of a protected branch.
+func (c APIClient) ApproveCommit(repoName string, branchName string, commitID string) error {
+	_, err := c.PfsAPIClient.ApproveCommit(
+		c.Ctx(),
+		&pfs.ApproveCommitRequest{
+			Commit: NewCommit(repoName, branchName, commitID),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// MergeBranch merges the changes made on the branch source since its common
+// ancestor with the branch target into target, as a new commit. Paths which
+// were changed differently on both branches are resolved according to
+// strategy, and returned as conflicts.
+func (c APIClient) MergeBranch(repoName string, source string, target string, strategy pfs.MergeStrategy) (*pfs.MergeBranchResponse, error) {
+	resp, err := c.PfsAPIClient.MergeBranch(
+		c.Ctx(),
+		&pfs.MergeBranchRequest{
+			Source:   NewBranch(repoName, source),
+			Target:   NewBranch(repoName, target),
+			Strategy: strategy,
+		},
+	)
+	return resp, grpcutil.ScrubGRPC(err)
+}
+
 func (c APIClient) inspectCommitSet(id string, wait bool, cb func(*pfs.CommitInfo) error) error {
 	req := &pfs.InspectCommitSetRequest{
 		CommitSet: NewCommitSet(id),
@@ -455,6 +613,64 @@ func (c APIClient) DropCommitSet(id string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ApplyRetention squashes the CommitSets which have expired under the
+// retention policies of their branches, and returns them. If repoName is set,
+// only the CommitSets with a commit in the repo are squashed. If dryRun is set
+// the CommitSets which would be squashed are returned without squashing them.
+func (c APIClient) ApplyRetention(repoName string, dryRun bool) ([]*pfs.CommitSet, error) {
+	var repo *pfs.Repo
+	if repoName != "" {
+		repo = NewRepo(repoName)
+	}
+	resp, err := c.PfsAPIClient.ApplyRetention(
+		c.Ctx(),
+		&pfs.ApplyRetentionRequest{
+			Repo:   repo,
+			DryRun: dryRun,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.CommitSets, nil
+}
+
+// CreateQuota sets the storage quota of either the repo named repoName, or of
+// principal, whichever is set. An empty quota removes the existing quota.
+// Commits which would exceed a quota finish with an error.
+func (c APIClient) CreateQuota(repoName, principal string, quota *pfs.Quota) error {
+	var repo *pfs.Repo
+	if repoName != "" {
+		repo = NewRepo(repoName)
+	}
+	_, err := c.PfsAPIClient.CreateQuota(
+		c.Ctx(),
+		&pfs.CreateQuotaRequest{
+			Repo:      repo,
+			Principal: principal,
+			Quota:     quota,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectQuota returns the storage quota, and current usage, of either the
+// repo named repoName, or of principal, whichever is set.
+func (c APIClient) InspectQuota(repoName, principal string) (*pfs.QuotaInfo, error) {
+	var repo *pfs.Repo
+	if repoName != "" {
+		repo = NewRepo(repoName)
+	}
+	quotaInfo, err := c.PfsAPIClient.InspectQuota(
+		c.Ctx(),
+		&pfs.InspectQuotaRequest{
+			Repo:      repo,
+			Principal: principal,
+		},
+	)
+	return quotaInfo, grpcutil.ScrubGRPC(err)
+}
+
 // SubscribeCommit is like ListCommit but it keeps listening for commits as
 // they come in.
 func (c APIClient) SubscribeCommit(repo *pfs.Repo, branchName string, from string, state pfs.CommitState, cb func(*pfs.CommitInfo) error) (retErr error) {
@@ -3,9 +3,12 @@ package client
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"path"
 	"strings"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata/split"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
@@ -129,6 +133,9 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 	for _, opt := range opts {
 		opt(config)
 	}
+	if config.delimiter != pfs.Delimiter_NONE {
+		return mfc.putFileSplit(path, r, config)
+	}
 	return mfc.maybeError(func() error {
 		if !config.append {
 			if err := mfc.sendDeleteFile(&pfs.DeleteFile{
@@ -142,8 +149,9 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 		if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
 			emptyFile = false
 			return mfc.sendPutFile(&pfs.AddFile{
-				Path:  path,
-				Datum: config.datum,
+				Path:     path,
+				Datum:    config.datum,
+				Metadata: config.metadata,
 				Source: &pfs.AddFile_Raw{
 					Raw: &types.BytesValue{Value: data},
 				},
@@ -153,14 +161,46 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 		}
 		if emptyFile {
 			return mfc.sendPutFile(&pfs.AddFile{
-				Path:  path,
-				Datum: config.datum,
+				Path:     path,
+				Datum:    config.datum,
+				Metadata: config.metadata,
 			})
 		}
 		return nil
 	})
 }
 
+// putFileSplit puts the records in r into a directory at dir, with a file for
+// each group of records. The files are named with their index, so they sort in
+// the order of the records.
+func (mfc *modifyFileCore) putFileSplit(dir string, r io.Reader, config *putFileConfig) error {
+	return mfc.maybeError(func() error {
+		if config.append {
+			return errors.Errorf("cannot append to a split file")
+		}
+		if err := mfc.sendDeleteFile(&pfs.DeleteFile{
+			Path:  strings.TrimSuffix(dir, "/") + "/",
+			Datum: config.datum,
+		}); err != nil {
+			return err
+		}
+		return split.Split(r, config.delimiter, config.splitOpts, func(i int, data []byte) error {
+			p := path.Join(dir, fmt.Sprintf("%016x", i))
+			_, err := grpcutil.ChunkReader(bytes.NewReader(data), func(data []byte) error {
+				return mfc.sendPutFile(&pfs.AddFile{
+					Path:     p,
+					Datum:    config.datum,
+					Metadata: config.metadata,
+					Source: &pfs.AddFile_Raw{
+						Raw: &types.BytesValue{Value: data},
+					},
+				})
+			})
+			return err
+		})
+	})
+}
+
 func (mfc *modifyFileCore) maybeError(f func() error) (retErr error) {
 	if mfc.err != nil {
 		return mfc.err
@@ -187,6 +227,9 @@ func (mfc *modifyFileCore) PutFileTAR(r io.Reader, opts ...PutFileOption) error
 	for _, opt := range opts {
 		opt(config)
 	}
+	if config.delimiter != pfs.Delimiter_NONE {
+		return errors.Errorf("split is only supported when putting a file from a reader")
+	}
 	return mfc.maybeError(func() error {
 		tr := tar.NewReader(r)
 		for hdr, err := tr.Next(); err != io.EOF; hdr, err = tr.Next() {
@@ -207,16 +250,18 @@ func (mfc *modifyFileCore) PutFileTAR(r io.Reader, opts ...PutFileOption) error
 			}
 			if hdr.Size == 0 {
 				if err := mfc.sendPutFile(&pfs.AddFile{
-					Path:  p,
-					Datum: config.datum,
+					Path:     p,
+					Datum:    config.datum,
+					Metadata: config.metadata,
 				}); err != nil {
 					return err
 				}
 			} else {
 				if _, err := grpcutil.ChunkReader(tr, func(data []byte) error {
 					return mfc.sendPutFile(&pfs.AddFile{
-						Path:  p,
-						Datum: config.datum,
+						Path:     p,
+						Datum:    config.datum,
+						Metadata: config.metadata,
 						Source: &pfs.AddFile_Raw{
 							Raw: &types.BytesValue{Value: data},
 						},
@@ -235,6 +280,9 @@ func (mfc *modifyFileCore) PutFileURL(path, url string, recursive bool, opts ...
 	for _, opt := range opts {
 		opt(config)
 	}
+	if config.delimiter != pfs.Delimiter_NONE {
+		return errors.Errorf("split is only supported when putting a file from a reader")
+	}
 	return mfc.maybeError(func() error {
 		if !config.append {
 			if err := mfc.sendDeleteFile(&pfs.DeleteFile{
@@ -245,8 +293,9 @@ func (mfc *modifyFileCore) PutFileURL(path, url string, recursive bool, opts ...
 			}
 		}
 		pf := &pfs.AddFile{
-			Path:  path,
-			Datum: config.datum,
+			Path:     path,
+			Datum:    config.datum,
+			Metadata: config.metadata,
 			Source: &pfs.AddFile_Url{
 				Url: &pfs.AddFile_URLSource{
 					URL:       url,
@@ -331,9 +380,15 @@ func (c APIClient) WithRenewer(cb func(context.Context, *renew.StringSet) error)
 
 // WithCreateFileSetClient provides a scoped fileset client.
 func (c APIClient) WithCreateFileSetClient(cb func(ModifyFile) error) (resp *pfs.CreateFileSetResponse, retErr error) {
+	return c.WithRepoCreateFileSetClient(nil, cb)
+}
+
+// WithRepoCreateFileSetClient provides a scoped fileset client that writes
+// with the chunking parameters of repo. The fileset is not added to the repo.
+func (c APIClient) WithRepoCreateFileSetClient(repo *pfs.Repo, cb func(ModifyFile) error) (resp *pfs.CreateFileSetResponse, retErr error) {
 	cancelCtx, cancel := context.WithCancel(c.Ctx())
 	defer cancel()
-	ctfsc, err := c.WithCtx(cancelCtx).NewCreateFileSetClient()
+	ctfsc, err := c.WithCtx(cancelCtx).NewRepoCreateFileSetClient(repo)
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +408,12 @@ type CreateFileSetClient struct {
 
 // NewCreateFileSetClient returns a CreateFileSetClient instance backed by this client
 func (c APIClient) NewCreateFileSetClient() (_ *CreateFileSetClient, retErr error) {
+	return c.NewRepoCreateFileSetClient(nil)
+}
+
+// NewRepoCreateFileSetClient returns a CreateFileSetClient instance backed by
+// this client, which writes with the chunking parameters of repo if it is set.
+func (c APIClient) NewRepoCreateFileSetClient(repo *pfs.Repo) (_ *CreateFileSetClient, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
@@ -360,6 +421,13 @@ func (c APIClient) NewCreateFileSetClient() (_ *CreateFileSetClient, retErr erro
 	if err != nil {
 		return nil, err
 	}
+	if repo != nil {
+		if err := client.Send(&pfs.ModifyFileRequest{
+			Body: &pfs.ModifyFileRequest_SetCommit{SetCommit: repo.NewCommit("", "")},
+		}); err != nil {
+			return nil, err
+		}
+	}
 	return &CreateFileSetClient{
 		client: client,
 		modifyFileCore: modifyFileCore{
@@ -521,67 +589,96 @@ func (c APIClient) GetFileReader(commit *pfs.Commit, path string) (io.Reader, er
 }
 
 // GetFileReadSeeker returns a reader for the contents of a file at a specific
-// Commit that permits Seeking to different points in the file.
+// Commit that permits Seeking to different points in the file. Only the part
+// of the file after the current offset is requested from PFS, so seeking does
+// not download the data before the new offset.
 func (c APIClient) GetFileReadSeeker(commit *pfs.Commit, path string) (io.ReadSeeker, error) {
 	fi, err := c.InspectFile(commit, path)
 	if err != nil {
 		return nil, err
 	}
-	r, err := c.GetFileReader(commit, path)
-	if err != nil {
-		return nil, err
-	}
 	return &getFileReadSeeker{
-		Reader: r,
-		c:      c,
-		file:   commit.NewFile(path),
-		offset: 0,
-		size:   int64(fi.SizeBytes),
+		c:    c,
+		file: commit.NewFile(path),
+		size: int64(fi.SizeBytes),
 	}, nil
 }
 
 type getFileReadSeeker struct {
-	io.Reader
 	c            APIClient
 	file         *pfs.File
 	offset, size int64
-}
-
-func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
-	getFileReader := func(offset int64) (io.Reader, error) {
-		r, err := gfrs.c.GetFileReader(gfrs.file.Commit, gfrs.file.Path)
+	// stream is the GetFile stream the file is currently read from, which is
+	// nil until the next read.
+	stream pfs.API_GetFileClient
+	cancel context.CancelFunc
+	r      io.Reader
+}
+
+func (gfrs *getFileReadSeeker) Read(data []byte) (int, error) {
+	if gfrs.offset >= gfrs.size {
+		gfrs.closeStream()
+		return 0, io.EOF
+	}
+	if gfrs.stream == nil {
+		ctx, cf := context.WithCancel(gfrs.c.Ctx())
+		stream, err := gfrs.c.PfsAPIClient.GetFile(ctx, &pfs.GetFileRequest{
+			File:   gfrs.file,
+			Offset: gfrs.offset,
+		})
 		if err != nil {
-			return nil, err
+			cf()
+			return 0, grpcutil.ScrubGRPC(err)
 		}
-		// TODO: Replace with file range request when implemented in PFS.
-		if _, err := io.CopyN(ioutil.Discard, r, offset); err != nil {
-			return nil, err
+		gfrs.stream, gfrs.cancel = stream, cf
+		gfrs.r = grpcutil.NewStreamingBytesReader(stream, nil)
+	}
+	n, err := gfrs.r.Read(data)
+	gfrs.offset += int64(n)
+	// The stream isn't needed anymore once the whole file has been read, so
+	// it is released rather than left open until the next read or seek.
+	if err != nil || gfrs.offset >= gfrs.size {
+		gfrs.closeStream()
+	}
+	if errors.Is(err, io.EOF) && gfrs.offset < gfrs.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, grpcutil.ScrubGRPC(err)
+}
+
+// closeStream cancels the current GetFile stream, and drains it so the
+// stream's resources are released.
+func (gfrs *getFileReadSeeker) closeStream() {
+	if gfrs.stream == nil {
+		return
+	}
+	gfrs.cancel()
+	for {
+		if _, err := gfrs.stream.Recv(); err != nil {
+			break
 		}
-		return r, nil
 	}
+	gfrs.stream, gfrs.cancel, gfrs.r = nil, nil, nil
+}
+
+func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
 	switch whence {
 	case io.SeekStart:
-		r, err := getFileReader(offset)
-		if err != nil {
-			return gfrs.offset, err
-		}
-		gfrs.offset = offset
-		gfrs.Reader = r
 	case io.SeekCurrent:
-		r, err := getFileReader(gfrs.offset + offset)
-		if err != nil {
-			return gfrs.offset, err
-		}
-		gfrs.offset += offset
-		gfrs.Reader = r
+		offset += gfrs.offset
 	case io.SeekEnd:
-		r, err := getFileReader(gfrs.size - offset)
-		if err != nil {
-			return gfrs.offset, err
-		}
-		gfrs.offset = gfrs.size - offset
-		gfrs.Reader = r
+		offset += gfrs.size
+	default:
+		return gfrs.offset, errors.Errorf("invalid whence %d", whence)
 	}
+	if offset < 0 {
+		return gfrs.offset, errors.Errorf("cannot seek to negative offset %d", offset)
+	}
+	if offset != gfrs.offset {
+		// The next read requests the file from the new offset.
+		gfrs.closeStream()
+	}
+	gfrs.offset = offset
 	return gfrs.offset, nil
 }
 
@@ -617,14 +714,18 @@ func (c APIClient) InspectFile(commit *pfs.Commit, path string) (_ *pfs.FileInfo
 }
 
 // ListFile returns info about all files in a Commit under path, calling cb with each FileInfo.
-func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.FileInfo) error, opts ...ListFileOption) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
+	config := newListFileConfig(opts)
 	client, err := c.PfsAPIClient.ListFile(
 		c.Ctx(),
 		&pfs.ListFileRequest{
-			File: commit.NewFile(path),
+			File:      commit.NewFile(path),
+			Filter:    config.filter,
+			PageSize:  config.pageSize,
+			PageToken: config.pageToken,
 		},
 	)
 	if err != nil {
@@ -648,7 +749,7 @@ func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.Fil
 }
 
 // ListFileAll returns info about all files in a Commit under path.
-func (c APIClient) ListFileAll(commit *pfs.Commit, path string) (_ []*pfs.FileInfo, retErr error) {
+func (c APIClient) ListFileAll(commit *pfs.Commit, path string, opts ...ListFileOption) (_ []*pfs.FileInfo, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
@@ -656,7 +757,7 @@ func (c APIClient) ListFileAll(commit *pfs.Commit, path string) (_ []*pfs.FileIn
 	if err := c.ListFile(commit, path, func(fi *pfs.FileInfo) error {
 		fis = append(fis, fi)
 		return nil
-	}); err != nil {
+	}, opts...); err != nil {
 		return nil, err
 	}
 	return fis, nil
@@ -665,15 +766,19 @@ func (c APIClient) ListFileAll(commit *pfs.Commit, path string) (_ []*pfs.FileIn
 // GlobFile returns files that match a given glob pattern in a given commit,
 // calling cb with each FileInfo. The pattern is documented here:
 // https://golang.org/pkg/path/filepath/#Match
-func (c APIClient) GlobFile(commit *pfs.Commit, pattern string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+func (c APIClient) GlobFile(commit *pfs.Commit, pattern string, cb func(fi *pfs.FileInfo) error, opts ...ListFileOption) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
+	config := newListFileConfig(opts)
 	client, err := c.PfsAPIClient.GlobFile(
 		c.Ctx(),
 		&pfs.GlobFileRequest{
-			Commit:  commit,
-			Pattern: pattern,
+			Commit:    commit,
+			Pattern:   pattern,
+			Filter:    config.filter,
+			PageSize:  config.pageSize,
+			PageToken: config.pageToken,
 		},
 	)
 	if err != nil {
@@ -698,7 +803,7 @@ func (c APIClient) GlobFile(commit *pfs.Commit, pattern string, cb func(fi *pfs.
 
 // GlobFileAll returns files that match a given glob pattern in a given commit.
 // The pattern is documented here: https://golang.org/pkg/path/filepath/#Match
-func (c APIClient) GlobFileAll(commit *pfs.Commit, pattern string) (_ []*pfs.FileInfo, retErr error) {
+func (c APIClient) GlobFileAll(commit *pfs.Commit, pattern string, opts ...ListFileOption) (_ []*pfs.FileInfo, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
@@ -706,6 +811,57 @@ func (c APIClient) GlobFileAll(commit *pfs.Commit, pattern string) (_ []*pfs.Fil
 	if err := c.GlobFile(commit, pattern, func(fi *pfs.FileInfo) error {
 		fis = append(fis, fi)
 		return nil
+	}, opts...); err != nil {
+		return nil, err
+	}
+	return fis, nil
+}
+
+// SearchFile calls cb with the files in a repo's search index which contain
+// all of the words in content and have the base name name. Either can be empty
+// to match any file. Each FileInfo is for the commit which added or modified
+// the file.
+func (c APIClient) SearchFile(repoName string, content string, name string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PfsAPIClient.SearchFile(
+		c.Ctx(),
+		&pfs.SearchFileRequest{
+			Repo:    NewRepo(repoName),
+			Content: content,
+			Name:    name,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for {
+		fi, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := cb(fi); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// SearchFileAll is like SearchFile, but returns a slice of the files.
+func (c APIClient) SearchFileAll(repoName string, content string, name string) (_ []*pfs.FileInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var fis []*pfs.FileInfo
+	if err := c.SearchFile(repoName, content, name, func(fi *pfs.FileInfo) error {
+		fis = append(fis, fi)
+		return nil
 	}); err != nil {
 		return nil, err
 	}
@@ -768,11 +924,15 @@ func (c APIClient) DiffFileAll(newCommit *pfs.Commit, newPath string, oldCommit
 }
 
 // WalkFile walks the files under path.
-func (c APIClient) WalkFile(commit *pfs.Commit, path string, cb func(*pfs.FileInfo) error) (retErr error) {
+func (c APIClient) WalkFile(commit *pfs.Commit, path string, cb func(*pfs.FileInfo) error, opts ...ListFileOption) (retErr error) {
+	config := newListFileConfig(opts)
 	client, err := c.PfsAPIClient.WalkFile(
 		c.Ctx(),
 		&pfs.WalkFileRequest{
-			File: commit.NewFile(path),
+			File:      commit.NewFile(path),
+			Filter:    config.filter,
+			PageSize:  config.pageSize,
+			PageToken: config.pageToken,
 		})
 	if err != nil {
 		return err
@@ -793,3 +953,11 @@ func (c APIClient) WalkFile(commit *pfs.Commit, path string, cb func(*pfs.FileIn
 		}
 	}
 }
+
+func newListFileConfig(opts []ListFileOption) *listFileConfig {
+	config := &listFileConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
@@ -575,7 +575,10 @@ func (c APIClient) GetLogs(
 	follow bool,
 	since time.Duration,
 ) *LogsIter {
-	return c.getLogs(pipelineName, jobID, data, datumID, master, follow, since, false)
+	request := newGetLogsRequest(pipelineName, jobID, data, datumID, master)
+	request.Follow = follow
+	request.Since = types.DurationProto(since)
+	return c.getLogs(request)
 }
 
 // GetLogsLoki gets logs from a job (logs includes stdout and stderr). 'pipelineName',
@@ -591,24 +594,53 @@ func (c APIClient) GetLogsLoki(
 	follow bool,
 	since time.Duration,
 ) *LogsIter {
-	return c.getLogs(pipelineName, jobID, data, datumID, master, follow, since, true)
-}
-
-func (c APIClient) getLogs(
+	request := newGetLogsRequest(pipelineName, jobID, data, datumID, master)
+	request.Follow = follow
+	request.Since = types.DurationProto(since)
+	request.UseLokiBackend = true
+	return c.getLogs(request)
+}
+
+// GetLogsPFS gets logs from a job (logs includes stdout and stderr) from the
+// logs persisted in PFS, which are retained for every job. 'pipelineName',
+// 'jobID', 'data', and 'datumID' are filters, as in GetLogs, and 'from' and
+// 'to' restrict the logs to those logged within that window. To forego a time
+// bound, pass the zero time.
+func (c APIClient) GetLogsPFS(
 	pipelineName string,
 	jobID string,
 	data []string,
 	datumID string,
 	master bool,
-	follow bool,
-	since time.Duration,
-	useLoki bool,
+	from time.Time,
+	to time.Time,
 ) *LogsIter {
-	request := pps.GetLogsRequest{
-		Master:         master,
-		Follow:         follow,
-		UseLokiBackend: useLoki,
-		Since:          types.DurationProto(since),
+	request := newGetLogsRequest(pipelineName, jobID, data, datumID, master)
+	request.UsePfsBackend = true
+	var err error
+	if !from.IsZero() {
+		if request.From, err = types.TimestampProto(from); err != nil {
+			return &LogsIter{err: errors.EnsureStack(err)}
+		}
+	}
+	if !to.IsZero() {
+		if request.To, err = types.TimestampProto(to); err != nil {
+			return &LogsIter{err: errors.EnsureStack(err)}
+		}
+	}
+	return c.getLogs(request)
+}
+
+func (c APIClient) getLogs(request *pps.GetLogsRequest) *LogsIter {
+	resp := &LogsIter{}
+	resp.logsClient, resp.err = c.PpsAPIClient.GetLogs(c.Ctx(), request)
+	resp.err = grpcutil.ScrubGRPC(resp.err)
+	return resp
+}
+
+func newGetLogsRequest(pipelineName, jobID string, data []string, datumID string, master bool) *pps.GetLogsRequest {
+	request := &pps.GetLogsRequest{
+		Master: master,
 	}
 	if pipelineName != "" {
 		request.Pipeline = NewPipeline(pipelineName)
@@ -623,10 +655,7 @@ func (c APIClient) getLogs(
 			ID:  datumID,
 		}
 	}
-	resp := &LogsIter{}
-	resp.logsClient, resp.err = c.PpsAPIClient.GetLogs(c.Ctx(), &request)
-	resp.err = grpcutil.ScrubGRPC(resp.err)
-	return resp
+	return request
 }
 
 // CreatePipeline creates a new pipeline, pipelines are the main computation
@@ -842,6 +871,86 @@ func (c APIClient) ListSecret() ([]*pps.SecretInfo, error) {
 	return secretInfos.SecretInfo, nil
 }
 
+// CreateNotifier registers an HTTP webhook that is notified of the job,
+// pipeline and commit events matching the request's filters.
+func (c APIClient) CreateNotifier(request *pps.CreateNotifierRequest) error {
+	_, err := c.PpsAPIClient.CreateNotifier(c.Ctx(), request)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// DeleteNotifier deletes a notifier.
+func (c APIClient) DeleteNotifier(notifier string) error {
+	_, err := c.PpsAPIClient.DeleteNotifier(
+		c.Ctx(),
+		&pps.DeleteNotifierRequest{
+			Notifier: &pps.Notifier{Name: notifier},
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListNotifier returns info about all notifiers. Notifier secrets are not
+// returned, and only cluster admins can list notifiers, since their URLs may
+// contain credentials.
+func (c APIClient) ListNotifier() ([]*pps.NotifierInfo, error) {
+	notifierInfos, err := c.PpsAPIClient.ListNotifier(
+		c.Ctx(),
+		&types.Empty{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return notifierInfos.NotifierInfo, nil
+}
+
+// TraceFile calls cb with the lineage of each datum that file was derived
+// from, if direction is UPSTREAM, or that was derived from file, if direction
+// is DOWNSTREAM. depth limits the number of pipelines traced through, or is
+// unlimited if 0.
+func (c APIClient) TraceFile(file *pfs.File, direction pps.TraceDirection, depth int64, cb func(*pps.FileLineage) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PpsAPIClient.TraceFile(ctx, &pps.TraceFileRequest{
+		File:      file,
+		Direction: direction,
+		Depth:     depth,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		lineage, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := cb(lineage); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// TraceFileAll returns the lineage of each datum that file was derived from,
+// or that was derived from file, as TraceFile.
+func (c APIClient) TraceFileAll(file *pfs.File, direction pps.TraceDirection, depth int64) (_ []*pps.FileLineage, retErr error) {
+	var lineages []*pps.FileLineage
+	if err := c.TraceFile(file, direction, depth, func(lineage *pps.FileLineage) error {
+		lineages = append(lineages, lineage)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return lineages, nil
+}
+
 // CreatePipelineService creates a new pipeline service.
 func (c APIClient) CreatePipelineService(
 	name string,
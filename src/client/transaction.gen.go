@@ -83,6 +83,10 @@ func (c *unsupportedAuthBuilderClient) GetPermissionsForPrincipal(_ context.Cont
 	return nil, unsupportedError("GetPermissionsForPrincipal")
 }
 
+func (c *unsupportedAuthBuilderClient) GetPresignCredentials(_ context.Context, _ *auth_v2.GetPresignCredentialsRequest, opts ...grpc.CallOption) (*auth_v2.GetPresignCredentialsResponse, error) {
+	return nil, unsupportedError("GetPresignCredentials")
+}
+
 func (c *unsupportedAuthBuilderClient) GetRobotToken(_ context.Context, _ *auth_v2.GetRobotTokenRequest, opts ...grpc.CallOption) (*auth_v2.GetRobotTokenResponse, error) {
 	return nil, unsupportedError("GetRobotToken")
 }
@@ -285,6 +289,14 @@ func (c *unsupportedPfsBuilderClient) AddFileSet(_ context.Context, _ *pfs_v2.Ad
 	return nil, unsupportedError("AddFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) ApplyRetention(_ context.Context, _ *pfs_v2.ApplyRetentionRequest, opts ...grpc.CallOption) (*pfs_v2.ApplyRetentionResponse, error) {
+	return nil, unsupportedError("ApplyRetention")
+}
+
+func (c *unsupportedPfsBuilderClient) ApproveCommit(_ context.Context, _ *pfs_v2.ApproveCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("ApproveCommit")
+}
+
 func (c *unsupportedPfsBuilderClient) CheckStorage(_ context.Context, _ *pfs_v2.CheckStorageRequest, opts ...grpc.CallOption) (*pfs_v2.CheckStorageResponse, error) {
 	return nil, unsupportedError("CheckStorage")
 }
@@ -309,10 +321,18 @@ func (c *unsupportedPfsBuilderClient) CreateFileSet(_ context.Context, opts ...g
 	return nil, unsupportedError("CreateFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) CreateQuota(_ context.Context, _ *pfs_v2.CreateQuotaRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateQuota")
+}
+
 func (c *unsupportedPfsBuilderClient) CreateRepo(_ context.Context, _ *pfs_v2.CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("CreateRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) CreateTag(_ context.Context, _ *pfs_v2.CreateTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateTag")
+}
+
 func (c *unsupportedPfsBuilderClient) DeleteAll(_ context.Context, _ *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteAll")
 }
@@ -325,6 +345,10 @@ func (c *unsupportedPfsBuilderClient) DeleteRepo(_ context.Context, _ *pfs_v2.De
 	return nil, unsupportedError("DeleteRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) DeleteTag(_ context.Context, _ *pfs_v2.DeleteTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteTag")
+}
+
 func (c *unsupportedPfsBuilderClient) DiffFile(_ context.Context, _ *pfs_v2.DiffFileRequest, opts ...grpc.CallOption) (pfs_v2.API_DiffFileClient, error) {
 	return nil, unsupportedError("DiffFile")
 }
@@ -337,6 +361,10 @@ func (c *unsupportedPfsBuilderClient) Egress(_ context.Context, _ *pfs_v2.Egress
 	return nil, unsupportedError("Egress")
 }
 
+func (c *unsupportedPfsBuilderClient) ExportRepo(_ context.Context, _ *pfs_v2.ExportRepoRequest, opts ...grpc.CallOption) (pfs_v2.API_ExportRepoClient, error) {
+	return nil, unsupportedError("ExportRepo")
+}
+
 func (c *unsupportedPfsBuilderClient) FinishCommit(_ context.Context, _ *pfs_v2.FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("FinishCommit")
 }
@@ -345,6 +373,10 @@ func (c *unsupportedPfsBuilderClient) Fsck(_ context.Context, _ *pfs_v2.FsckRequ
 	return nil, unsupportedError("Fsck")
 }
 
+func (c *unsupportedPfsBuilderClient) GarbageCollectStorage(_ context.Context, _ *pfs_v2.GarbageCollectStorageRequest, opts ...grpc.CallOption) (*pfs_v2.GarbageCollectStorageResponse, error) {
+	return nil, unsupportedError("GarbageCollectStorage")
+}
+
 func (c *unsupportedPfsBuilderClient) GetCache(_ context.Context, _ *pfs_v2.GetCacheRequest, opts ...grpc.CallOption) (*pfs_v2.GetCacheResponse, error) {
 	return nil, unsupportedError("GetCache")
 }
@@ -365,6 +397,10 @@ func (c *unsupportedPfsBuilderClient) GlobFile(_ context.Context, _ *pfs_v2.Glob
 	return nil, unsupportedError("GlobFile")
 }
 
+func (c *unsupportedPfsBuilderClient) ImportRepo(_ context.Context, opts ...grpc.CallOption) (pfs_v2.API_ImportRepoClient, error) {
+	return nil, unsupportedError("ImportRepo")
+}
+
 func (c *unsupportedPfsBuilderClient) InspectBranch(_ context.Context, _ *pfs_v2.InspectBranchRequest, opts ...grpc.CallOption) (*pfs_v2.BranchInfo, error) {
 	return nil, unsupportedError("InspectBranch")
 }
@@ -381,10 +417,18 @@ func (c *unsupportedPfsBuilderClient) InspectFile(_ context.Context, _ *pfs_v2.I
 	return nil, unsupportedError("InspectFile")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectQuota(_ context.Context, _ *pfs_v2.InspectQuotaRequest, opts ...grpc.CallOption) (*pfs_v2.QuotaInfo, error) {
+	return nil, unsupportedError("InspectQuota")
+}
+
 func (c *unsupportedPfsBuilderClient) InspectRepo(_ context.Context, _ *pfs_v2.InspectRepoRequest, opts ...grpc.CallOption) (*pfs_v2.RepoInfo, error) {
 	return nil, unsupportedError("InspectRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectTag(_ context.Context, _ *pfs_v2.InspectTagRequest, opts ...grpc.CallOption) (*pfs_v2.TagInfo, error) {
+	return nil, unsupportedError("InspectTag")
+}
+
 func (c *unsupportedPfsBuilderClient) ListBranch(_ context.Context, _ *pfs_v2.ListBranchRequest, opts ...grpc.CallOption) (pfs_v2.API_ListBranchClient, error) {
 	return nil, unsupportedError("ListBranch")
 }
@@ -405,14 +449,30 @@ func (c *unsupportedPfsBuilderClient) ListRepo(_ context.Context, _ *pfs_v2.List
 	return nil, unsupportedError("ListRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) ListStorageKeyVersions(_ context.Context, _ *pfs_v2.ListStorageKeyVersionsRequest, opts ...grpc.CallOption) (*pfs_v2.ListStorageKeyVersionsResponse, error) {
+	return nil, unsupportedError("ListStorageKeyVersions")
+}
+
+func (c *unsupportedPfsBuilderClient) ListTag(_ context.Context, _ *pfs_v2.ListTagRequest, opts ...grpc.CallOption) (pfs_v2.API_ListTagClient, error) {
+	return nil, unsupportedError("ListTag")
+}
+
 func (c *unsupportedPfsBuilderClient) ListTask(_ context.Context, _ *taskapi.ListTaskRequest, opts ...grpc.CallOption) (pfs_v2.API_ListTaskClient, error) {
 	return nil, unsupportedError("ListTask")
 }
 
+func (c *unsupportedPfsBuilderClient) MergeBranch(_ context.Context, _ *pfs_v2.MergeBranchRequest, opts ...grpc.CallOption) (*pfs_v2.MergeBranchResponse, error) {
+	return nil, unsupportedError("MergeBranch")
+}
+
 func (c *unsupportedPfsBuilderClient) ModifyFile(_ context.Context, opts ...grpc.CallOption) (pfs_v2.API_ModifyFileClient, error) {
 	return nil, unsupportedError("ModifyFile")
 }
 
+func (c *unsupportedPfsBuilderClient) ProtectBranch(_ context.Context, _ *pfs_v2.ProtectBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("ProtectBranch")
+}
+
 func (c *unsupportedPfsBuilderClient) PutCache(_ context.Context, _ *pfs_v2.PutCacheRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("PutCache")
 }
@@ -421,6 +481,10 @@ func (c *unsupportedPfsBuilderClient) RenewFileSet(_ context.Context, _ *pfs_v2.
 	return nil, unsupportedError("RenewFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) RotateStorageKey(_ context.Context, _ *pfs_v2.RotateStorageKeyRequest, opts ...grpc.CallOption) (*pfs_v2.RotateStorageKeyResponse, error) {
+	return nil, unsupportedError("RotateStorageKey")
+}
+
 func (c *unsupportedPfsBuilderClient) RunLoadTest(_ context.Context, _ *pfs_v2.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs_v2.RunLoadTestResponse, error) {
 	return nil, unsupportedError("RunLoadTest")
 }
@@ -429,6 +493,10 @@ func (c *unsupportedPfsBuilderClient) RunLoadTestDefault(_ context.Context, _ *t
 	return nil, unsupportedError("RunLoadTestDefault")
 }
 
+func (c *unsupportedPfsBuilderClient) SearchFile(_ context.Context, _ *pfs_v2.SearchFileRequest, opts ...grpc.CallOption) (pfs_v2.API_SearchFileClient, error) {
+	return nil, unsupportedError("SearchFile")
+}
+
 func (c *unsupportedPfsBuilderClient) SquashCommitSet(_ context.Context, _ *pfs_v2.SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("SquashCommitSet")
 }
@@ -451,6 +519,10 @@ func (c *unsupportedPpsBuilderClient) ActivateAuth(_ context.Context, _ *pps_v2.
 	return nil, unsupportedError("ActivateAuth")
 }
 
+func (c *unsupportedPpsBuilderClient) CreateNotifier(_ context.Context, _ *pps_v2.CreateNotifierRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateNotifier")
+}
+
 func (c *unsupportedPpsBuilderClient) CreatePipeline(_ context.Context, _ *pps_v2.CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("CreatePipeline")
 }
@@ -467,6 +539,10 @@ func (c *unsupportedPpsBuilderClient) DeleteJob(_ context.Context, _ *pps_v2.Del
 	return nil, unsupportedError("DeleteJob")
 }
 
+func (c *unsupportedPpsBuilderClient) DeleteNotifier(_ context.Context, _ *pps_v2.DeleteNotifierRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteNotifier")
+}
+
 func (c *unsupportedPpsBuilderClient) DeletePipeline(_ context.Context, _ *pps_v2.DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeletePipeline")
 }
@@ -511,6 +587,10 @@ func (c *unsupportedPpsBuilderClient) ListJobSet(_ context.Context, _ *pps_v2.Li
 	return nil, unsupportedError("ListJobSet")
 }
 
+func (c *unsupportedPpsBuilderClient) ListNotifier(_ context.Context, _ *types.Empty, opts ...grpc.CallOption) (*pps_v2.NotifierInfos, error) {
+	return nil, unsupportedError("ListNotifier")
+}
+
 func (c *unsupportedPpsBuilderClient) ListPipeline(_ context.Context, _ *pps_v2.ListPipelineRequest, opts ...grpc.CallOption) (pps_v2.API_ListPipelineClient, error) {
 	return nil, unsupportedError("ListPipeline")
 }
@@ -563,6 +643,10 @@ func (c *unsupportedPpsBuilderClient) SubscribeJob(_ context.Context, _ *pps_v2.
 	return nil, unsupportedError("SubscribeJob")
 }
 
+func (c *unsupportedPpsBuilderClient) TraceFile(_ context.Context, _ *pps_v2.TraceFileRequest, opts ...grpc.CallOption) (pps_v2.API_TraceFileClient, error) {
+	return nil, unsupportedError("TraceFile")
+}
+
 func (c *unsupportedPpsBuilderClient) UpdateJobState(_ context.Context, _ *pps_v2.UpdateJobStateRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("UpdateJobState")
 }
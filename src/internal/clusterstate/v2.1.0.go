@@ -3,9 +3,16 @@ package clusterstate
 import (
 	"context"
 
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
+	"github.com/pachyderm/pachyderm/v2/src/server/auth"
 	enterpriseserver "github.com/pachyderm/pachyderm/v2/src/server/enterprise/server"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs/server"
 )
 
 var state_2_1_0 migrations.State = state_2_0_0.
@@ -17,4 +24,43 @@ var state_2_1_0 migrations.State = state_2_0_0.
 	}).
 	Apply("create pfs cache v1", func(ctx context.Context, env migrations.Env) error {
 		return fileset.CreatePostgresCacheV1(ctx, env.Tx)
+	}).
+	Apply("create storage chunk deks v0", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresDEKStoreV0(ctx, env.Tx)
+	}).
+	Apply("create pfs quotas collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.CollectionsV1()...)
+	}).
+	Apply("create pfs tags collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.CollectionsV2()...)
+	}).
+	Apply("create pfs search indexes v0", func(ctx context.Context, env migrations.Env) error {
+		return pfsserver.SetupSearchIndexesV0(ctx, env.Tx)
+	}).
+	Apply("create postgres task service tables v0", func(ctx context.Context, env migrations.Env) error {
+		return task.SetupPostgresTasksV0(ctx, env.Tx)
+	}).
+	Apply("create pps notifiers collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, ppsdb.CollectionsV1()...)
+	}).
+	Apply("create auth presign keys v0", func(ctx context.Context, env migrations.Env) error {
+		return auth.CreatePresignKeysTable(ctx, env.Tx)
+	}).
+	Apply("create pfs cache v2", func(ctx context.Context, env migrations.Env) error {
+		return fileset.CreatePostgresCacheV2(ctx, env.Tx)
+	}).
+	Apply("create pfs quota usage v0", func(ctx context.Context, env migrations.Env) error {
+		return pfsserver.SetupQuotaUsageV0(ctx, env.Tx)
+	}).
+	Apply("create pfs search indexes v1", func(ctx context.Context, env migrations.Env) error {
+		return pfsserver.SetupSearchIndexesV1(ctx, env.Tx)
+	}).
+	Apply("create postgres task service indexes v1", func(ctx context.Context, env migrations.Env) error {
+		return task.SetupPostgresTasksV1(ctx, env.Tx)
+	}).
+	Apply("create pfs quota usage v1", func(ctx context.Context, env migrations.Env) error {
+		return pfsserver.SetupQuotaUsageV1(ctx, env.Tx)
+	}).
+	Apply("create pfs search indexes v2", func(ctx context.Context, env migrations.Env) error {
+		return pfsserver.SetupSearchIndexesV2(ctx, env.Tx)
 	})
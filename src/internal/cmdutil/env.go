@@ -22,13 +22,24 @@ func Populate(object interface{}, decoders ...Decoder) error {
 	return populate(object, decoders)
 }
 
+// Validator is implemented by environments whose values need to be checked
+// after they're populated.
+type Validator interface {
+	Validate() error
+}
+
 // Main runs the common functionality needed in a go main function.
-// appEnv will be populated and passed to do, defaultEnv can be nil
-// if there is an error, os.Exit(1) will be called.
+// appEnv will be populated, validated if it's a Validator, and passed to do,
+// defaultEnv can be nil if there is an error, os.Exit(1) will be called.
 func Main(do func(interface{}) error, appEnv interface{}, decoders ...Decoder) {
 	if err := Populate(appEnv, decoders...); err != nil {
 		mainError(err)
 	}
+	if v, ok := appEnv.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			mainError(err)
+		}
+	}
 	if err := do(appEnv); err != nil {
 		mainError(err)
 	}
@@ -430,6 +430,19 @@ func (c *postgresCollection) list(
 
 	var last *model
 	var offset int
+	if opts.Start != "" {
+		if opts.Target != SortByCreateRevision && opts.Target != SortByModRevision || opts.Order == SortNone {
+			return errors.Errorf("listing from a start key is only supported when sorting by revision")
+		}
+		start := &model{}
+		queryString := fmt.Sprintf("select createdat, updatedat from collections.%s where key = $1;", c.table)
+		if err := sqlx.GetContext(ctx, q, start, queryString, opts.Start); err != nil {
+			return c.mapSQLError(err, opts.Start)
+		}
+		// resuming after an empty key includes every item with the start
+		// item's sort value, because keys are never empty
+		last = start
+	}
 	for {
 		rowsBuffer, fullBuffer, err := bufferResults(last, offset)
 		if err != nil {
@@ -194,6 +194,44 @@ func PostgresCollectionBasicTests(suite *testing.T, newCollection func(context.C
 		})
 	})
 
+	suite.Run("ListStart", func(t *testing.T) {
+		t.Parallel()
+		reader, writer := newCollection(context.Background(), t)
+		// the first three items are created together, so they have the same
+		// created time
+		createKeys := [][]string{{"3", "1", "2"}, {"0"}, {"4"}}
+		for _, ks := range createKeys {
+			require.NoError(t, writer(context.Background(), func(rw col.ReadWriteCollection) error {
+				for _, k := range ks {
+					if err := rw.Create(k, &col.TestItem{ID: k, Value: originalValue}); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
+			}))
+		}
+		collect := func(order col.SortOrder, start string) []string {
+			keys := []string{}
+			testProto := &col.TestItem{}
+			err := reader(context.Background()).List(testProto, &col.Options{Target: col.SortByCreateRevision, Order: order, Start: start}, func(string) error {
+				keys = append(keys, testProto.ID)
+				return nil
+			})
+			require.NoError(t, err)
+			return keys
+		}
+		require.Equal(t, []string{"0", "4"}, collect(col.SortAscend, "0"))
+		require.Equal(t, []string{"0", "1", "2", "3"}, collect(col.SortDescend, "0"))
+		// items created at the same time as the start item are included
+		require.Equal(t, []string{"1", "2", "3", "0", "4"}, collect(col.SortAscend, "2"))
+		require.Equal(t, []string{"1", "2", "3"}, collect(col.SortDescend, "2"))
+
+		err := reader(context.Background()).List(&col.TestItem{}, &col.Options{Target: col.SortByCreateRevision, Order: col.SortAscend, Start: "5"}, func(string) error {
+			return nil
+		})
+		require.True(t, col.IsErrNotFound(err))
+	})
+
 	// TODO: postgres-specific collection tests:
 	// GetRevByIndex(index *Index, indexVal string, val proto.Message, opts *Options, f func(int64) error) error
 	// DeleteByIndex(index *Index, indexVal string) error
@@ -34,12 +34,18 @@ type Options struct {
 	Order  SortOrder
 	// Limit is only implemented for postgres collections
 	Limit int
+	// Start is the key of an item to start the listing at, rather than at the
+	// beginning. Only the items sorted before the item's sort value are
+	// skipped, so the listing also includes the items with the same sort
+	// value, which the caller must skip itself. Start is only implemented for
+	// postgres collections sorted by create or mod revision.
+	Start string
 }
 
 // DefaultOptions are the default sort options when iterating through etcd
 // key/values.
 func DefaultOptions() *Options {
-	return &Options{SortByCreateRevision, SortDescend, 0}
+	return &Options{SortByCreateRevision, SortDescend, 0, ""}
 }
 
 func listFuncs(opts *Options) (func(*mvccpb.KeyValue) etcd.OpOption, func(kv1 *mvccpb.KeyValue, kv2 *mvccpb.KeyValue) int) {
@@ -148,7 +148,9 @@ func (r *Reporter) reportClusterMetrics() {
 		time.Sleep(reportingInterval)
 		metrics := &Metrics{}
 		r.internalMetrics(metrics)
-		externalMetrics(r.env.GetKubeClient(), metrics)
+		if kubeClient := r.env.GetKubeClient(); kubeClient != nil {
+			externalMetrics(kubeClient, metrics)
+		}
 		metrics.ClusterID = r.clusterID
 		metrics.PodID = uuid.NewWithoutDashes()
 		metrics.Version = version.PrettyPrintVersion(version.Version)
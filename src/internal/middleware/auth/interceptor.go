@@ -44,6 +44,7 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/GetGroups":             authenticated,
 	"/auth_v2.API/GetPermissions":        authenticated,
 	"/auth_v2.API/GetRolesForPermission": authenticated,
+	"/auth_v2.API/GetPresignCredentials": authenticated,
 
 	"/auth_v2.API/GetGroupsForPrincipal":      clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_GROUPS),
 	"/auth_v2.API/GetPermissionsForPrincipal": clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL),
@@ -128,6 +129,8 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/InspectRepo":      authDisabledOr(authenticated),
 	"/pfs_v2.API/ListRepo":         authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteRepo":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ExportRepo":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ImportRepo":       authDisabledOr(authenticated),
 	"/pfs_v2.API/StartCommit":      authDisabledOr(authenticated),
 	"/pfs_v2.API/FinishCommit":     authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectCommit":    authDisabledOr(authenticated),
@@ -138,6 +141,17 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/ListCommitSet":    authDisabledOr(authenticated),
 	"/pfs_v2.API/SquashCommitSet":  authDisabledOr(authenticated),
 	"/pfs_v2.API/DropCommitSet":    authDisabledOr(authenticated),
+	"/pfs_v2.API/ProtectBranch":    authDisabledOr(authenticated),
+	"/pfs_v2.API/ApproveCommit":    authDisabledOr(authenticated),
+	"/pfs_v2.API/MergeBranch":      authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateTag":        authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectTag":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ListTag":          authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteTag":        authDisabledOr(authenticated),
+	"/pfs_v2.API/SearchFile":       authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateQuota":      authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PFS_MODIFY_QUOTAS)),
+	"/pfs_v2.API/InspectQuota":     authDisabledOr(authenticated),
+	"/pfs_v2.API/ApplyRetention":   authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PFS_APPLY_RETENTION)),
 	"/pfs_v2.API/CreateBranch":     authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectBranch":    authDisabledOr(authenticated),
 	"/pfs_v2.API/ListBranch":       authDisabledOr(authenticated),
@@ -147,27 +161,30 @@ var authHandlers = map[string]authHandler{
 	// TODO: GetFileTAR is unauthenticated for performance reasons. Normal authentication
 	// will be applied internally when a commit is used. When a file set id is used, we lean
 	// on the capability based authentication of file sets.
-	"/pfs_v2.API/GetFileTAR":         unauthenticated,
-	"/pfs_v2.API/InspectFile":        authDisabledOr(authenticated),
-	"/pfs_v2.API/ListFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/WalkFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/GlobFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/DiffFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteAll":          authDisabledOr(authenticated),
-	"/pfs_v2.API/Fsck":               authDisabledOr(authenticated),
-	"/pfs_v2.API/CreateFileSet":      authDisabledOr(authenticated),
-	"/pfs_v2.API/GetFileSet":         authDisabledOr(authenticated),
-	"/pfs_v2.API/AddFileSet":         authDisabledOr(authenticated),
-	"/pfs_v2.API/RenewFileSet":       authDisabledOr(authenticated),
-	"/pfs_v2.API/ComposeFileSet":     authDisabledOr(authenticated),
-	"/pfs_v2.API/CheckStorage":       authDisabledOr(authenticated),
-	"/pfs_v2.API/PutCache":           authDisabledOr(authenticated),
-	"/pfs_v2.API/GetCache":           authDisabledOr(authenticated),
-	"/pfs_v2.API/ClearCache":         authDisabledOr(authenticated),
-	"/pfs_v2.API/RunLoadTest":        authDisabledOr(authenticated),
-	"/pfs_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
-	"/pfs_v2.API/ListTask":           authDisabledOr(authenticated),
-	"/pfs_v2.API/Egress":             authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFileTAR":             unauthenticated,
+	"/pfs_v2.API/InspectFile":            authDisabledOr(authenticated),
+	"/pfs_v2.API/ListFile":               authDisabledOr(authenticated),
+	"/pfs_v2.API/WalkFile":               authDisabledOr(authenticated),
+	"/pfs_v2.API/GlobFile":               authDisabledOr(authenticated),
+	"/pfs_v2.API/DiffFile":               authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteAll":              authDisabledOr(authenticated),
+	"/pfs_v2.API/Fsck":                   authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateFileSet":          authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFileSet":             authDisabledOr(authenticated),
+	"/pfs_v2.API/AddFileSet":             authDisabledOr(authenticated),
+	"/pfs_v2.API/RenewFileSet":           authDisabledOr(authenticated),
+	"/pfs_v2.API/ComposeFileSet":         authDisabledOr(authenticated),
+	"/pfs_v2.API/CheckStorage":           authDisabledOr(authenticated),
+	"/pfs_v2.API/ListStorageKeyVersions": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PFS_MANAGE_STORAGE_KEYS)),
+	"/pfs_v2.API/RotateStorageKey":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PFS_MANAGE_STORAGE_KEYS)),
+	"/pfs_v2.API/GarbageCollectStorage":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PFS_GARBAGE_COLLECT)),
+	"/pfs_v2.API/PutCache":               authDisabledOr(authenticated),
+	"/pfs_v2.API/GetCache":               authDisabledOr(authenticated),
+	"/pfs_v2.API/ClearCache":             authDisabledOr(authenticated),
+	"/pfs_v2.API/RunLoadTest":            authDisabledOr(authenticated),
+	"/pfs_v2.API/RunLoadTestDefault":     authDisabledOr(authenticated),
+	"/pfs_v2.API/ListTask":               authDisabledOr(authenticated),
+	"/pfs_v2.API/Egress":                 authDisabledOr(authenticated),
 
 	//
 	// PPS API
@@ -195,6 +212,7 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/RunPipeline":     authDisabledOr(authenticated),
 	"/pps_v2.API/RunCron":         authDisabledOr(authenticated),
 	"/pps_v2.API/GetLogs":         authDisabledOr(authenticated),
+	"/pps_v2.API/TraceFile":       authDisabledOr(authenticated),
 	"/pps_v2.API/GarbageCollect":  authDisabledOr(authenticated),
 	"/pps_v2.API/UpdateJobState":  authDisabledOr(authenticated),
 	"/pps_v2.API/ListPipeline":    authDisabledOr(authenticated),
@@ -205,6 +223,9 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/ListSecret":         authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LIST_SECRETS)),
 	"/pps_v2.API/DeleteSecret":       authDisabledOr(clusterPermissions(auth.Permission_SECRET_DELETE)),
 	"/pps_v2.API/InspectSecret":      authDisabledOr(clusterPermissions(auth.Permission_SECRET_INSPECT)),
+	"/pps_v2.API/CreateNotifier":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PPS_MODIFY_NOTIFIERS)),
+	"/pps_v2.API/ListNotifier":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PPS_MODIFY_NOTIFIERS)),
+	"/pps_v2.API/DeleteNotifier":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_PPS_MODIFY_NOTIFIERS)),
 	"/pps_v2.API/RunLoadTest":        authDisabledOr(authenticated),
 	"/pps_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
 	"/pps_v2.API/RenderTemplate":     authDisabledOr(authenticated),
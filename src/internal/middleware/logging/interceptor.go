@@ -287,6 +287,15 @@ var endpoints = map[string]logConfig{
 		},
 	},
 
+	"/auth_v2.API/GetPresignCredentials": {
+		level: authConfig.level,
+		transformResponse: func(r interface{}) interface{} {
+			copyResp := proto.Clone(r.(*auth.GetPresignCredentialsResponse)).(*auth.GetPresignCredentialsResponse)
+			copyResp.SecretAccessKey = ""
+			return copyResp
+		},
+	},
+
 	"/auth_v2.API/RevokeAuthToken": {
 		level: authConfig.level,
 		transformRequest: func(r interface{}) interface{} {
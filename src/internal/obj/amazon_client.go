@@ -197,7 +197,11 @@ func (c *amazonClient) Walk(ctx context.Context, name string, fn func(name strin
 	return fnErr
 }
 
-func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, w, 0, 0)
+}
+
+func (c *amazonClient) GetRange(ctx context.Context, name string, w io.Writer, offset, size int64) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	var reader io.ReadCloser
 	if c.cloudfrontDistribution != "" {
@@ -216,6 +220,9 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
+		if offset != 0 || size != 0 {
+			req.Header.Set("Range", rangeHeader(offset, size))
+		}
 
 		backoff.RetryNotify(func() (retErr error) {
 			span, _ := tracing.AddSpanToAnyExisting(ctx, "/Amazon.Cloudfront/Get")
@@ -244,6 +251,9 @@ func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retEr
 			Bucket: aws.String(c.bucket),
 			Key:    aws.String(name),
 		}
+		if offset != 0 || size != 0 {
+			objIn.Range = aws.String(rangeHeader(offset, size))
+		}
 		getObjectOutput, err := c.s3.GetObjectWithContext(ctx, objIn)
 		if err != nil {
 			return errors.EnsureStack(err)
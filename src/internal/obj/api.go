@@ -13,11 +13,17 @@ type Client interface {
 	Put(ctx context.Context, name string, r io.Reader) error
 
 	// Get writes the data for an object to w
-	// If `size == 0`, the reader should read from the offset till the end of the object.
 	// It should error if the object doesn't exist or we don't have sufficient
 	// permission to read it.
 	Get(ctx context.Context, name string, w io.Writer) error
 
+	// GetRange writes `size` bytes of the data for an object, starting at
+	// `offset`, to w.
+	// If `size == 0`, the reader should read from the offset till the end of the object.
+	// It should error if the object doesn't exist or we don't have sufficient
+	// permission to read it.
+	GetRange(ctx context.Context, name string, w io.Writer, offset, size int64) error
+
 	// Delete deletes an object.
 	// It should error if the object doesn't exist or we don't have sufficient
 	// permission to delete it.
@@ -120,6 +120,28 @@ func (c *cacheClient) getSlow(ctx context.Context, p string, w io.Writer) error
 	})
 }
 
+// GetRange reads the range from c.fast if the object is cached. Otherwise the
+// range is read from c.slow, without populating the cache, since only part of
+// the object is read.
+func (c *cacheClient) GetRange(ctx context.Context, p string, w io.Writer, offset, size int64) error {
+	c.doPopulateOnce(ctx) // always call before acquiring locks
+	c.mu.Lock()
+	_, exists := c.cache.Get(p)
+	c.mu.Unlock()
+	if exists {
+		err := c.fast.GetRange(ctx, p, w, offset, size)
+		if err == nil {
+			cacheHitMetric.Inc()
+			return nil
+		} else if !pacherr.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+		// could have been deleted since we released the lock, but that's fine.
+	}
+	cacheMissMetric.Inc()
+	return errors.EnsureStack(c.slow.GetRange(ctx, p, w, offset, size))
+}
+
 func (c *cacheClient) Put(ctx context.Context, p string, r io.Reader) error {
 	return errors.EnsureStack(c.slow.Put(ctx, p, r))
 }
@@ -86,9 +86,18 @@ func (c *googleClient) Walk(ctx context.Context, name string, fn func(name strin
 	return nil
 }
 
-func (c *googleClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+func (c *googleClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, w, 0, 0)
+}
+
+func (c *googleClient) GetRange(ctx context.Context, name string, w io.Writer, offset, size int64) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
-	reader, err := c.bucket.Object(name).NewReader(ctx)
+	// A negative length reads to the end of the object.
+	length := int64(-1)
+	if size > 0 {
+		length = size
+	}
+	reader, err := c.bucket.Object(name).NewRangeReader(ctx, offset, length)
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -79,3 +79,14 @@ func (loc *limitedClient) Get(ctx context.Context, name string, w io.Writer) err
 	defer loc.readersSem.Release(limitClientSemCost)
 	return errors.EnsureStack(loc.Client.Get(ctx, name, w))
 }
+
+func (loc *limitedClient) GetRange(ctx context.Context, name string, w io.Writer, offset, size int64) error {
+	blockStartedMetric.WithLabelValues("get").Inc()
+	t := time.Now()
+	if err := loc.readersSem.Acquire(ctx, limitClientSemCost); err != nil {
+		return errors.EnsureStack(err)
+	}
+	blockedSecondsMetric.WithLabelValues("get").Observe(time.Since(t).Seconds())
+	defer loc.readersSem.Release(limitClientSemCost)
+	return errors.EnsureStack(loc.Client.GetRange(ctx, name, w, offset, size))
+}
@@ -62,14 +62,25 @@ func (c *fsClient) Put(ctx context.Context, name string, r io.Reader) (retErr er
 	return errors.EnsureStack(os.Rename(staging, final))
 }
 
-func (c *fsClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+func (c *fsClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, w, 0, 0)
+}
+
+func (c *fsClient) GetRange(ctx context.Context, name string, w io.Writer, offset, size int64) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	f, err := os.Open(c.finalPathFor(name))
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
 	defer c.closeFile(&retErr, f)
-	_, err = io.Copy(w, f)
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if size == 0 {
+		_, err = io.Copy(w, f)
+		return errors.EnsureStack(err)
+	}
+	_, err = io.CopyN(w, f, size)
 	return errors.EnsureStack(err)
 }
 
@@ -54,10 +54,26 @@ func (c *microsoftClient) Put(ctx context.Context, name string, r io.Reader) (re
 	return w.Close()
 }
 
+func (c *microsoftClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, w, 0, 0)
+}
+
 // TODO: should respect context
-func (c *microsoftClient) Get(_ context.Context, name string, w io.Writer) (retErr error) {
+func (c *microsoftClient) GetRange(_ context.Context, name string, w io.Writer, offset, size int64) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
-	r, err := c.container.GetBlobReference(name).Get(nil)
+	blob := c.container.GetBlobReference(name)
+	var r io.ReadCloser
+	var err error
+	if offset == 0 && size == 0 {
+		r, err = blob.Get(nil)
+	} else {
+		// An end of 0 reads to the end of the blob.
+		blobRange := &storage.BlobRange{Start: uint64(offset)}
+		if size > 0 {
+			blobRange.End = uint64(offset + size - 1)
+		}
+		r, err = blob.GetRange(&storage.GetBlobRangeOptions{Range: blobRange})
+	}
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
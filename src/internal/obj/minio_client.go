@@ -73,9 +73,17 @@ func (c *minioClient) Walk(_ context.Context, name string, fn func(name string)
 	return nil
 }
 
-func (c *minioClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
+func (c *minioClient) Get(ctx context.Context, name string, w io.Writer) error {
+	return c.GetRange(ctx, name, w, 0, 0)
+}
+
+func (c *minioClient) GetRange(ctx context.Context, name string, w io.Writer, offset, size int64) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
-	rc, err := c.GetObjectWithContext(ctx, c.bucket, name, minio.GetObjectOptions{})
+	opts := minio.GetObjectOptions{}
+	if offset != 0 || size != 0 {
+		opts.Set("Range", rangeHeader(offset, size))
+	}
+	rc, err := c.GetObjectWithContext(ctx, c.bucket, name, opts)
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -55,6 +55,14 @@ func (c *monkeyClient) Get(ctx context.Context, path string, w io.Writer) error
 	return errors.EnsureStack(c.c.Get(ctx, path, w))
 }
 
+// GetRange wraps the get range operation.
+func (c *monkeyClient) GetRange(ctx context.Context, path string, w io.Writer, offset, size int64) error {
+	if enabled && localRand.Float64() < failProb {
+		return errMsg
+	}
+	return errors.EnsureStack(c.c.GetRange(ctx, path, w, offset, size))
+}
+
 // Put wraps the put operation.
 func (c *monkeyClient) Put(ctx context.Context, path string, r io.Reader) error {
 	if enabled && localRand.Float64() < failProb {
@@ -65,6 +65,30 @@ func TestSuite(t *testing.T, newClient func(t testing.TB) Client) {
 		actualHash := pachhash.Sum(buf.Bytes())
 		require.Equal(t, expectedHash, actualHash)
 	})
+
+	t.Run("TestGetRange", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		name := randutil.UniqueString("test-get-range-")
+		data, err := ioutil.ReadAll(io.LimitReader(rand.Reader, 1<<20))
+		require.NoError(t, err)
+		require.NoError(t, client.Put(ctx, name, bytes.NewReader(data)))
+		for _, r := range []struct{ offset, size int64 }{
+			{0, 0},
+			{0, 1},
+			{1000, 4096},
+			{1000, 0},
+			{int64(len(data)) - 10, 10},
+		} {
+			buf := &bytes.Buffer{}
+			require.NoError(t, client.GetRange(ctx, name, buf, r.offset, r.size))
+			expected := data[r.offset:]
+			if r.size > 0 {
+				expected = expected[:r.size]
+			}
+			require.Equal(t, expected, buf.Bytes())
+		}
+	})
 }
 
 func TestEmptyWrite(t *testing.T, client Client) {
@@ -98,6 +98,20 @@ func (o *tracingObjClient) Get(ctx context.Context, name string, w io.Writer) (r
 	return errors.EnsureStack(err)
 }
 
+// GetRange implements the corresponding method in the Client interface
+func (o *tracingObjClient) GetRange(ctx context.Context, name string, w io.Writer, offset, size int64) (retErr error) {
+	objectOperationMetric.WithLabelValues(o.provider, "get").Inc()
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/GetRange", "name", name, "offset", offset, "size", size)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	err := o.Client.GetRange(ctx, name, &promutil.CountingWriter{
+		Writer:  w,
+		Counter: objectBytesReadMetrics.WithLabelValues(o.provider),
+	}, offset, size)
+	return errors.EnsureStack(err)
+}
+
 // Delete implements the corresponding method in the Client interface
 func (o *tracingObjClient) Delete(ctx context.Context, name string) (retErr error) {
 	objectOperationMetric.WithLabelValues(o.provider, "delete").Inc()
@@ -37,6 +37,14 @@ func (cc *uniformClient) Get(ctx context.Context, name string, w io.Writer) (ret
 	return errors.EnsureStack(cc.c.Get(ctx, name, w))
 }
 
+func (cc *uniformClient) GetRange(ctx context.Context, name string, w io.Writer, offset, size int64) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	name = strings.Trim(name, "/")
+	return errors.EnsureStack(cc.c.GetRange(ctx, name, w, offset, size))
+}
+
 func (cc *uniformClient) Delete(ctx context.Context, name string) (retErr error) {
 	defer func() {
 		retErr = errors.EnsureStack(retErr)
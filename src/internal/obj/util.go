@@ -2,6 +2,7 @@ package obj
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -17,6 +18,15 @@ func Copy(ctx context.Context, src, dst Client, srcPath, dstPath string) (retErr
 	})
 }
 
+// rangeHeader returns the value of an HTTP Range header which requests size
+// bytes starting at offset, or everything after offset if size is 0.
+func rangeHeader(offset, size int64) string {
+	if size == 0 {
+		return fmt.Sprintf("bytes=%d-", offset)
+	}
+	return fmt.Sprintf("bytes=%d-%d", offset, offset+size-1)
+}
+
 type testURL struct {
 	Client
 }
@@ -23,6 +23,24 @@ type ColumnInfo struct {
 	IsNullable bool
 }
 
+// NewTableInfoFromColumnTypes returns a TableInfo describing the columns of a result set.
+// The Name and Schema are left empty, since a result set does not necessarily come from a single table.
+func NewTableInfoFromColumnTypes(driver string, cTypes []*sql.ColumnType) *TableInfo {
+	cinfos := make([]ColumnInfo, len(cTypes))
+	for i, cType := range cTypes {
+		nullable, ok := cType.Nullable()
+		if !ok {
+			nullable = true
+		}
+		cinfos[i] = ColumnInfo{
+			Name:       cType.Name(),
+			DataType:   cType.DatabaseTypeName(),
+			IsNullable: nullable,
+		}
+	}
+	return &TableInfo{Driver: driver, Columns: cinfos}
+}
+
 // GetTableInfo looks up information about the table using INFORMATION_SCHEMA
 func GetTableInfo(ctx context.Context, db *DB, tableName string) (*TableInfo, error) {
 	readonly := true
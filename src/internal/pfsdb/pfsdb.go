@@ -18,6 +18,8 @@ const (
 	reposCollectionName    = "repos"
 	branchesCollectionName = "branches"
 	commitsCollectionName  = "commits"
+	quotasCollectionName   = "quotas"
+	tagsCollectionName     = "tags"
 )
 
 var ReposTypeIndex = &col.Index{
@@ -175,6 +177,69 @@ func Branches(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollect
 	)
 }
 
+// QuotaKey returns the key of the quota on either repo or principal.
+func QuotaKey(repo *pfs.Repo, principal string) string {
+	if repo != nil {
+		return "repo/" + RepoKey(repo)
+	}
+	return "principal/" + principal
+}
+
+// Quotas returns a collection of quotas, keyed by QuotaKey.
+func Quotas(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		quotasCollectionName,
+		db,
+		listener,
+		&pfs.QuotaInfo{},
+		nil,
+	)
+}
+
+var TagsRepoIndex = &col.Index{
+	Name: "repo",
+	Extract: func(val proto.Message) string {
+		return RepoKey(val.(*pfs.TagInfo).Tag.Repo)
+	},
+}
+
+var TagsCommitIndex = &col.Index{
+	Name: "commit",
+	Extract: func(val proto.Message) string {
+		return CommitKey(val.(*pfs.TagInfo).Commit)
+	},
+}
+
+var tagsIndexes = []*col.Index{TagsRepoIndex, TagsCommitIndex}
+
+func TagKey(tag *pfs.Tag) string {
+	return RepoKey(tag.Repo) + "@" + tag.Name
+}
+
+// Tags returns a collection of tags
+func Tags(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		tagsCollectionName,
+		db,
+		listener,
+		&pfs.TagInfo{},
+		tagsIndexes,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if tag, ok := key.(*pfs.Tag); !ok {
+				return "", errors.New("key must be a tag")
+			} else {
+				return TagKey(tag), nil
+			}
+		}),
+		col.WithNotFoundMessage(func(key interface{}) string {
+			return pfsserver.ErrTagNotFound{Tag: key.(*pfs.Tag)}.Error()
+		}),
+		col.WithExistsMessage(func(key interface{}) string {
+			return pfsserver.ErrTagExists{Tag: key.(*pfs.Tag)}.Error()
+		}),
+	)
+}
+
 // AllCollections returns a list of all the PFS collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -187,3 +252,23 @@ func CollectionsV0() []col.PostgresCollection {
 		col.NewPostgresCollection(branchesCollectionName, nil, nil, nil, branchesIndexes),
 	}
 }
+
+// CollectionsV1 returns the PFS collections added after CollectionsV0, for
+// postgres-initialization purposes.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func CollectionsV1() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(quotasCollectionName, nil, nil, nil, nil),
+	}
+}
+
+// CollectionsV2 returns the PFS collections added after CollectionsV1, for
+// postgres-initialization purposes.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func CollectionsV2() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(tagsCollectionName, nil, nil, nil, tagsIndexes),
+	}
+}
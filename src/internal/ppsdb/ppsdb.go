@@ -18,6 +18,7 @@ import (
 const (
 	pipelinesCollectionName = "pipelines"
 	jobsCollectionName      = "jobs"
+	notifiersCollectionName = "notifiers"
 )
 
 // PipelinesVersionIndex records the version numbers of pipelines
@@ -125,6 +126,17 @@ func Jobs(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection
 	)
 }
 
+// Notifiers returns a PostgresCollection of notifiers, keyed by name
+func Notifiers(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		notifiersCollectionName,
+		db,
+		listener,
+		&pps.NotifierInfo{},
+		nil,
+	)
+}
+
 // CollectionsV0 returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -136,3 +148,13 @@ func CollectionsV0() []col.PostgresCollection {
 		col.NewPostgresCollection(jobsCollectionName, nil, nil, nil, jobsIndexes),
 	}
 }
+
+// CollectionsV1 returns the PPS collections added after CollectionsV0, for
+// postgres-initialization purposes.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func CollectionsV1() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(notifiersCollectionName, nil, nil, nil, nil),
+	}
+}
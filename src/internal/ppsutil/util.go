@@ -247,12 +247,13 @@ func SetPipelineState(ctx context.Context, db *pachsql.DB, pipelinesCollection c
 	return err
 }
 
-// JobInput fills in the commits for an Input
+// JobInput fills in the commits for an Input. PFS inputs which are pinned to a
+// commit or tag in the pipeline spec keep it.
 func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Input {
 	commitsetID := outputCommit.ID
 	jobInput := proto.Clone(pipelineInfo.Details.Input).(*pps.Input)
 	pps.VisitInput(jobInput, func(input *pps.Input) error {
-		if input.Pfs != nil {
+		if input.Pfs != nil && input.Pfs.Commit == "" {
 			input.Pfs.Commit = commitsetID
 		}
 		if input.Cron != nil {
@@ -290,6 +291,7 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		Metadata:              pipelineInfo.Details.Metadata,
 		ReprocessSpec:         pipelineInfo.Details.ReprocessSpec,
 		Autoscaling:           pipelineInfo.Details.Autoscaling,
+		DatumCache:            pipelineInfo.Details.DatumCache,
 	}
 }
 
@@ -363,6 +365,7 @@ func WriteJobInfo(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
 		DataFailed:    jobInfo.DataFailed,
 		DataRecovered: jobInfo.DataRecovered,
 		Stats:         jobInfo.Stats,
+		Egressed:      jobInfo.Egressed,
 	})
 	return errors.EnsureStack(err)
 }
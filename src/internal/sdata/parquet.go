@@ -0,0 +1,582 @@
+package sdata
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+	"unicode"
+
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go-source/writerfile"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/types"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// parquetParallelism is the number of goroutines used by the parquet library
+// to marshal and unmarshal column chunks.
+const parquetParallelism = 4
+
+// parquetBatchSize is the number of rows read from each column at a time by the ParquetParser.
+const parquetBatchSize = 1024
+
+// parquetType is the Parquet physical and logical type that a SQL column is stored as.
+type parquetType int
+
+const (
+	parquetBool parquetType = iota
+	parquetInt16
+	parquetInt32
+	parquetInt64
+	parquetFloat
+	parquetDouble
+	parquetString
+	parquetJSON
+	parquetDate
+	parquetTime
+	parquetTimestamp
+	parquetTimestampTZ
+	parquetBytes
+)
+
+// parquetTypeFromSQL maps a SQL data type, as returned by INFORMATION_SCHEMA or a driver, onto a Parquet type.
+// The set of names matches the ones understood by makeTupleElement.
+// Types it doesn't recognize are stored as strings, which is how makeTupleElement scans them.
+func parquetTypeFromSQL(dbType string) parquetType {
+	switch dbType {
+	case "BOOL", "BOOLEAN":
+		return parquetBool
+	case "TINYINT", "SMALLINT", "INT2", "UNSIGNED TINYINT":
+		return parquetInt16
+	case "MEDIUMINT", "INTEGER", "INT", "INT4", "UNSIGNED SMALLINT", "UNSIGNED MEDIUMINT":
+		return parquetInt32
+	case "BIGINT", "INT8", "UNSIGNED INT":
+		return parquetInt64
+	case "FLOAT4", "REAL":
+		return parquetFloat
+	// FLOAT is 4 bytes in MySQL but 8 bytes in Snowflake, so store it as a double to be safe.
+	case "FLOAT", "FLOAT8", "DOUBLE", "DOUBLE PRECISION":
+		return parquetDouble
+	// Arbitrary precision numbers are kept as strings, the same way they are represented in Tuples,
+	// because INFORMATION_SCHEMA does not reliably give us their precision and scale.
+	// UNSIGNED BIGINT doesn't fit in a signed 64 bit integer.
+	case "NUMERIC", "DECIMAL", "NUMBER", "FIXED", "UNSIGNED BIGINT":
+		return parquetString
+	case "DATE":
+		return parquetDate
+	case "TIME":
+		return parquetTime
+	case "TIMESTAMP", "TIMESTAMP_NTZ", "TIMESTAMP WITHOUT TIME ZONE", "DATETIME":
+		return parquetTimestamp
+	case "TIMESTAMP_LTZ", "TIMESTAMP_TZ", "TIMESTAMPTZ", "TIMESTAMP WITH TIME ZONE":
+		return parquetTimestampTZ
+	case "VARIANT", "JSON", "JSONB":
+		return parquetJSON
+	case "BYTEA", "BINARY", "VARBINARY", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB":
+		return parquetBytes
+	default:
+		return parquetString
+	}
+}
+
+// parquetColumnName returns name in a form that can be put in a parquet-go schema tag.
+// Tags have no escape syntax: they are split on commas, tabs are removed, and
+// surrounding whitespace is trimmed, so those characters are replaced with underscores.
+func parquetColumnName(name string, i int) string {
+	if name == "" {
+		return fmt.Sprintf("column_%d", i)
+	}
+	runes := []rune(name)
+	for j, r := range runes {
+		edge := j == 0 || j == len(runes)-1
+		if r == ',' || unicode.IsControl(r) || (edge && unicode.IsSpace(r)) {
+			runes[j] = '_'
+		}
+	}
+	return string(runes)
+}
+
+// metadata returns the parquet-go schema tag for a column of this type.
+// The internal name parquet-go derives from a column name isn't guaranteed to be unique,
+// so columns are named internally by their position i.
+func (t parquetType) metadata(name string, i int, nullable bool) string {
+	var typ string
+	switch t {
+	case parquetBool:
+		typ = "type=BOOLEAN"
+	case parquetInt16:
+		typ = "type=INT32, convertedtype=INT_16"
+	case parquetInt32:
+		typ = "type=INT32, convertedtype=INT_32"
+	case parquetInt64:
+		typ = "type=INT64, convertedtype=INT_64"
+	case parquetFloat:
+		typ = "type=FLOAT"
+	case parquetDouble:
+		typ = "type=DOUBLE"
+	case parquetString:
+		typ = "type=BYTE_ARRAY, convertedtype=UTF8"
+	case parquetJSON:
+		typ = "type=BYTE_ARRAY, convertedtype=JSON"
+	case parquetBytes:
+		typ = "type=BYTE_ARRAY"
+	case parquetDate:
+		typ = "type=INT32, convertedtype=DATE"
+	case parquetTime:
+		typ = "type=INT64, logicaltype=TIME, logicaltype.isadjustedtoutc=false, logicaltype.unit=MICROS"
+	case parquetTimestamp:
+		typ = "type=INT64, logicaltype=TIMESTAMP, logicaltype.isadjustedtoutc=false, logicaltype.unit=MICROS"
+	case parquetTimestampTZ:
+		typ = "type=INT64, logicaltype=TIMESTAMP, logicaltype.isadjustedtoutc=true, logicaltype.unit=MICROS"
+	}
+	repetition := "REQUIRED"
+	if nullable {
+		repetition = "OPTIONAL"
+	}
+	return fmt.Sprintf("name=%s, inname=Column%d, %s, repetitiontype=%s", parquetColumnName(name, i), i, typ, repetition)
+}
+
+// ParquetWriter writes Tuples in Parquet format.
+// Rows are buffered in memory until a row group is full, and the file footer
+// is written by Flush, so a ParquetWriter cannot be written to after it has been flushed.
+type ParquetWriter struct {
+	pw      *writer.CSVWriter
+	columns []pachsql.ColumnInfo
+	types   []parquetType
+	flushed bool
+}
+
+// NewParquetWriter returns a ParquetWriter writing to w.
+// The Parquet schema is derived from the columns in info.
+func NewParquetWriter(w io.Writer, info *pachsql.TableInfo) (*ParquetWriter, error) {
+	var md []string
+	var pts []parquetType
+	for i, ci := range info.Columns {
+		pt := parquetTypeFromSQL(ci.DataType)
+		pts = append(pts, pt)
+		md = append(md, pt.metadata(ci.Name, i, ci.IsNullable))
+	}
+	pw, err := writer.NewCSVWriter(md, writerfile.NewWriterFile(w), parquetParallelism)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	return &ParquetWriter{
+		pw:      pw,
+		columns: info.Columns,
+		types:   pts,
+	}, nil
+}
+
+func (m *ParquetWriter) WriteTuple(row Tuple) error {
+	if len(row) != len(m.columns) {
+		return ErrTupleFields{Writer: m, Fields: m.fieldNames(), Tuple: row}
+	}
+	if m.flushed {
+		return errors.Errorf("parquet writer has already been flushed")
+	}
+	// The parquet library holds on to each record until the row group is flushed,
+	// so a new record must be allocated for every row.
+	record := make([]interface{}, len(row))
+	for i := range row {
+		v, err := m.format(m.types[i], row[i])
+		if err != nil {
+			return err
+		}
+		if v == nil && !m.columns[i].IsNullable {
+			return errors.Errorf("null value in non-nullable column %q", m.columns[i].Name)
+		}
+		record[i] = v
+	}
+	return errors.EnsureStack(m.pw.Write(record))
+}
+
+// format converts a Tuple element to the Go type the parquet library uses for pt.
+// It returns nil for SQL NULL.
+func (m *ParquetWriter) format(pt parquetType, x interface{}) (interface{}, error) {
+	v := tupleValue(x)
+	if v == nil {
+		return nil, nil
+	}
+	switch pt {
+	case parquetBool:
+		var y bool
+		err := convert(&y, v)
+		return y, err
+	case parquetInt16, parquetInt32:
+		var y int32
+		err := convert(&y, v)
+		return y, err
+	case parquetInt64:
+		var y int64
+		err := convert(&y, v)
+		return y, err
+	case parquetFloat:
+		var y float64
+		err := convert(&y, v)
+		return float32(y), err
+	case parquetDouble:
+		var y float64
+		err := convert(&y, v)
+		return y, err
+	case parquetString:
+		switch v := v.(type) {
+		case string:
+			return v, nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		case []byte:
+			return string(v), nil
+		case time.Time:
+			return v.Format(time.RFC3339Nano), nil
+		default:
+			return nil, ErrCannotConvert{Dest: new(string), Value: v}
+		}
+	case parquetBytes:
+		switch v := v.(type) {
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		default:
+			return nil, ErrCannotConvert{Dest: new([]byte), Value: v}
+		}
+	case parquetJSON:
+		// JSON columns are scanned as their text, which is stored as is.
+		switch v := v.(type) {
+		case string:
+			if json.Valid([]byte(v)) {
+				return v, nil
+			}
+		case []byte:
+			if json.Valid(v) {
+				return string(v), nil
+			}
+		}
+		data, err := json.Marshal(v)
+		return string(data), errors.EnsureStack(err)
+	case parquetDate:
+		var t time.Time
+		if err := convert(&t, v); err != nil {
+			return nil, err
+		}
+		y, mo, d := t.Date()
+		return int32(time.Date(y, mo, d, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay), nil
+	case parquetTime:
+		var t time.Time
+		if err := convert(&t, v); err != nil {
+			return nil, err
+		}
+		h, mi, s := t.Clock()
+		return int64(h*3600+mi*60+s)*1e6 + int64(t.Nanosecond()/1e3), nil
+	case parquetTimestamp, parquetTimestampTZ:
+		var t time.Time
+		if err := convert(&t, v); err != nil {
+			return nil, err
+		}
+		return t.UnixMicro(), nil
+	default:
+		return nil, errors.Errorf("unrecognized parquet type %v", pt)
+	}
+}
+
+func (m *ParquetWriter) Flush() error {
+	if m.flushed {
+		return nil
+	}
+	m.flushed = true
+	return errors.EnsureStack(m.pw.WriteStop())
+}
+
+func (m *ParquetWriter) fieldNames() []string {
+	names := make([]string, len(m.columns))
+	for i := range m.columns {
+		names[i] = m.columns[i].Name
+	}
+	return names
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// tupleValue dereferences a Tuple element into a plain value understood by convert.
+// Integers are widened to int64, floats to float64, and SQL NULL becomes nil.
+func tupleValue(x interface{}) interface{} {
+	switch x := x.(type) {
+	case *bool:
+		return *x
+	case *int16:
+		return int64(*x)
+	case *int32:
+		return int64(*x)
+	case *int64:
+		return *x
+	case *float32:
+		return float64(*x)
+	case *float64:
+		return *x
+	case *string:
+		return *x
+	case *sql.RawBytes:
+		return string(*x)
+	case *time.Time:
+		return *x
+	case *sql.NullBool:
+		if !x.Valid {
+			return nil
+		}
+		return x.Bool
+	case *sql.NullByte:
+		if !x.Valid {
+			return nil
+		}
+		return int64(x.Byte)
+	case *sql.NullInt16:
+		if !x.Valid {
+			return nil
+		}
+		return int64(x.Int16)
+	case *sql.NullInt32:
+		if !x.Valid {
+			return nil
+		}
+		return int64(x.Int32)
+	case *sql.NullInt64:
+		if !x.Valid {
+			return nil
+		}
+		return x.Int64
+	case *sql.NullFloat64:
+		if !x.Valid {
+			return nil
+		}
+		return x.Float64
+	case *sql.NullString:
+		if !x.Valid {
+			return nil
+		}
+		return x.String
+	case *sql.NullTime:
+		if !x.Valid {
+			return nil
+		}
+		return x.Time
+	case *interface{}:
+		return *x
+	default:
+		return x
+	}
+}
+
+// ParquetParser reads Tuples from a Parquet file.
+// Columns are matched to Tuple elements by position.
+// Parquet stores its metadata at the end of the file, so the whole input
+// is buffered in memory on the first call to Next.
+type ParquetParser struct {
+	r io.Reader
+
+	pr       *reader.ParquetReader
+	decoders []func(interface{}) (interface{}, error)
+	rowsLeft int64
+	batch    [][]interface{}
+	pos      int
+}
+
+// NewParquetParser returns a TupleReader which reads a Parquet file from r.
+func NewParquetParser(r io.Reader) TupleReader {
+	return &ParquetParser{r: r}
+}
+
+func (p *ParquetParser) Next(row Tuple) error {
+	if p.pr == nil {
+		if err := p.init(); err != nil {
+			return err
+		}
+	}
+	if len(row) != len(p.decoders) {
+		return errors.Errorf("parquet parsing: wrong number of fields HAVE: %d WANT: %d ", len(p.decoders), len(row))
+	}
+	if len(p.batch) == 0 || p.pos >= len(p.batch[0]) {
+		if err := p.readBatch(); err != nil {
+			return err
+		}
+	}
+	for i := range row {
+		v, err := p.decoders[i](p.batch[i][p.pos])
+		if err != nil {
+			return err
+		}
+		if err := convert(row[i], v); err != nil {
+			return err
+		}
+	}
+	p.pos++
+	return nil
+}
+
+func (p *ParquetParser) init() error {
+	data, err := io.ReadAll(p.r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	// Treat an empty input as an empty table rather than a malformed file.
+	if len(data) == 0 {
+		return io.EOF
+	}
+	pf, err := buffer.NewBufferFile(data)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	pr, err := reader.NewParquetColumnReader(pf, parquetParallelism)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	// The first schema element is the root of the schema tree.
+	for _, se := range pr.SchemaHandler.SchemaElements[1:] {
+		dec, err := parquetDecoder(se)
+		if err != nil {
+			return err
+		}
+		p.decoders = append(p.decoders, dec)
+	}
+	p.pr = pr
+	p.rowsLeft = pr.GetNumRows()
+	return nil
+}
+
+func (p *ParquetParser) readBatch() error {
+	if p.rowsLeft <= 0 {
+		return io.EOF
+	}
+	n := p.rowsLeft
+	if n > parquetBatchSize {
+		n = parquetBatchSize
+	}
+	p.batch = p.batch[:0]
+	for i := range p.decoders {
+		values, _, _, err := p.pr.ReadColumnByIndex(int64(i), n)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if int64(len(values)) != n {
+			return errors.Errorf("parquet parsing: column %d has %d values, expected %d", i, len(values), n)
+		}
+		p.batch = append(p.batch, values)
+	}
+	p.rowsLeft -= n
+	p.pos = 0
+	return nil
+}
+
+// parquetDecoder returns a function converting values read from a column described by se
+// into values understood by convert.
+// Numbers are returned as json.Number so that they can be converted to both numeric and string Tuple elements.
+func parquetDecoder(se *parquet.SchemaElement) (func(interface{}) (interface{}, error), error) {
+	if se.Type == nil {
+		return nil, errors.Errorf("parquet parsing: nested column %q is not supported", se.GetName())
+	}
+	lt := se.GetLogicalType()
+	switch {
+	case se.IsSetConvertedType() && se.GetConvertedType() == parquet.ConvertedType_DECIMAL:
+		precision, scale := int(se.GetPrecision()), int(se.GetScale())
+		return func(x interface{}) (interface{}, error) {
+			switch x := x.(type) {
+			case int32:
+				return types.DECIMAL_INT_ToString(int64(x), precision, scale), nil
+			case int64:
+				return types.DECIMAL_INT_ToString(x, precision, scale), nil
+			case string:
+				return types.DECIMAL_BYTE_ARRAY_ToString([]byte(x), precision, scale), nil
+			}
+			return x, nil
+		}, nil
+	case se.IsSetConvertedType() && se.GetConvertedType() == parquet.ConvertedType_DATE:
+		return func(x interface{}) (interface{}, error) {
+			if days, ok := x.(int32); ok {
+				return time.Unix(int64(days)*secondsPerDay, 0).UTC(), nil
+			}
+			return x, nil
+		}, nil
+	case lt != nil && lt.IsSetTIME():
+		unit := timeUnit(lt.TIME.GetUnit())
+		return func(x interface{}) (interface{}, error) {
+			switch x := x.(type) {
+			case int32:
+				return time.Time{}.Add(time.Duration(x) * unit), nil
+			case int64:
+				return time.Time{}.Add(time.Duration(x) * unit), nil
+			}
+			return x, nil
+		}, nil
+	case lt != nil && lt.IsSetTIMESTAMP():
+		unit := timeUnit(lt.TIMESTAMP.GetUnit())
+		return func(x interface{}) (interface{}, error) {
+			if n, ok := x.(int64); ok {
+				return time.Unix(0, 0).Add(time.Duration(n) * unit).UTC(), nil
+			}
+			return x, nil
+		}, nil
+	case se.IsSetConvertedType() && se.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MILLIS:
+		return func(x interface{}) (interface{}, error) {
+			if n, ok := x.(int64); ok {
+				return time.Unix(0, 0).Add(time.Duration(n) * time.Millisecond).UTC(), nil
+			}
+			return x, nil
+		}, nil
+	case se.IsSetConvertedType() && se.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MICROS:
+		return func(x interface{}) (interface{}, error) {
+			if n, ok := x.(int64); ok {
+				return time.Unix(0, 0).Add(time.Duration(n) * time.Microsecond).UTC(), nil
+			}
+			return x, nil
+		}, nil
+	}
+	switch se.GetType() {
+	case parquet.Type_BOOLEAN, parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		return func(x interface{}) (interface{}, error) { return x, nil }, nil
+	case parquet.Type_INT32, parquet.Type_INT64, parquet.Type_FLOAT, parquet.Type_DOUBLE:
+		return func(x interface{}) (interface{}, error) {
+			switch x := x.(type) {
+			case int32:
+				return json.Number(strconv.FormatInt(int64(x), 10)), nil
+			case int64:
+				return json.Number(strconv.FormatInt(x, 10)), nil
+			case float32:
+				return json.Number(strconv.FormatFloat(float64(x), 'f', -1, 32)), nil
+			case float64:
+				return json.Number(strconv.FormatFloat(x, 'f', -1, 64)), nil
+			}
+			return x, nil
+		}, nil
+	case parquet.Type_INT96:
+		return func(x interface{}) (interface{}, error) {
+			if s, ok := x.(string); ok {
+				return types.INT96ToTime(s), nil
+			}
+			return x, nil
+		}, nil
+	default:
+		return nil, errors.Errorf("parquet parsing: unsupported type %v for column %q", se.GetType(), se.GetName())
+	}
+}
+
+func timeUnit(u *parquet.TimeUnit) time.Duration {
+	switch {
+	case u.IsSetMILLIS():
+		return time.Millisecond
+	case u.IsSetNANOS():
+		return time.Nanosecond
+	default:
+		return time.Microsecond
+	}
+}
@@ -132,8 +132,9 @@ func makeTupleElement(dbType string, nullable bool) (interface{}, error) {
 	// FIXED is returned by Snowflake's Go driver, while NUMBER is in INFORMATION_SCHEMA
 	// DECIMAL is used by MySQL
 	case
-		"SMALLINT", "INT2", "INTEGER", "INT", "INT4", "BIGINT", "INT8",
-		"FLOAT", "FLOAT4", "FLOAT8", "REAL", "DOUBLE PRECISION",
+		"TINYINT", "SMALLINT", "INT2", "MEDIUMINT", "INTEGER", "INT", "INT4", "BIGINT", "INT8",
+		"UNSIGNED TINYINT", "UNSIGNED SMALLINT", "UNSIGNED MEDIUMINT", "UNSIGNED INT", "UNSIGNED BIGINT",
+		"FLOAT", "FLOAT4", "FLOAT8", "REAL", "DOUBLE", "DOUBLE PRECISION",
 		"NUMERIC", "DECIMAL", "NUMBER", "FIXED":
 		if nullable {
 			return new(sql.NullString), nil
@@ -147,15 +148,20 @@ func makeTupleElement(dbType string, nullable bool) (interface{}, error) {
 	// TIMESTAMP means different things in different databases
 	//     - postgres and snowflake doesn't store time zone related info
 	//     - mysql stores time zone
-	case "DATE", "TIME", "TIMESTAMP", "TIMESTAMP_LTZ", "TIMESTAMP_NTZ", "TIMESTAMP_TZ", "TIMESTAMPTZ", "TIMESTAMP WITH TIME ZONE", "TIMESTAMP WITHOUT TIME ZONE":
+	case "DATE", "TIME", "DATETIME", "TIMESTAMP", "TIMESTAMP_LTZ", "TIMESTAMP_NTZ", "TIMESTAMP_TZ", "TIMESTAMPTZ", "TIMESTAMP WITH TIME ZONE", "TIMESTAMP WITHOUT TIME ZONE":
 		if nullable {
 			return new(sql.NullTime), nil
 		}
 		return new(time.Time), nil
 	case "VARIANT":
 		return new(interface{}), nil
+	// Anything else, including CHAR, UUID, JSON and binary types, is scanned as a string,
+	// which database/sql can convert any driver value to.
 	default:
-		return nil, errors.Errorf("unrecognized type: %v", dbType)
+		if nullable {
+			return new(sql.NullString), nil
+		}
+		return new(string), nil
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	fuzz "github.com/google/gofuzz"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -43,6 +44,26 @@ func TestFormatParse(t *testing.T) {
 				return NewJSONParser(r, fieldNames)
 			},
 		},
+		{
+			Name: "Parquet",
+			NewW: func(w io.Writer, fieldNames []string) TupleWriter {
+				tw, err := NewParquetWriter(w, &pachsql.TableInfo{
+					Columns: []pachsql.ColumnInfo{
+						{Name: fieldNames[0], DataType: "BIGINT"},
+						{Name: fieldNames[1], DataType: "DOUBLE PRECISION"},
+						{Name: fieldNames[2], DataType: "TEXT"},
+						{Name: fieldNames[3], DataType: "BIGINT", IsNullable: true},
+						{Name: fieldNames[4], DataType: "BOOLEAN"},
+						{Name: fieldNames[5], DataType: "TEXT", IsNullable: true},
+					},
+				})
+				require.NoError(t, err)
+				return tw
+			},
+			NewR: func(r io.Reader, _ []string) TupleReader {
+				return NewParquetParser(r)
+			},
+		},
 	}
 	newTuple := func() Tuple {
 		a := int64(0)
@@ -287,6 +308,64 @@ func TestSQLTupleWriter(t *testing.T) {
 	}
 }
 
+func TestSQLUpsertWriter(t *testing.T) {
+	for _, dbSpec := range []dbSpec{postgreSQLSpec{}, mySQLSpec{}} {
+		t.Run(dbSpec.String(), func(t *testing.T) {
+			var (
+				ctx              = context.Background()
+				db, _, tableName = dbSpec.create(t)
+			)
+			require.NoError(t, pachsql.CreateTestTable(db, tableName, dbSpec.testRow()))
+			tableInfo, err := pachsql.GetTableInfo(ctx, db, fmt.Sprintf("%s.%s", dbSpec.schema(), tableName))
+			require.NoError(t, err)
+			primaryKey := []string{"c_id"}
+
+			fz := fuzz.New()
+			fz.RandSource(rand.NewSource(0))
+			addFuzzFuncs(fz)
+			tuple := newTupleFromTestRow(dbSpec.testRow())
+			writeRows := func(w TupleWriter, ids ...int16) {
+				for _, id := range ids {
+					for j := range tuple {
+						fz.Fuzz(tuple[j])
+					}
+					*tuple[0].(*int16) = id
+					require.NoError(t, w.WriteTuple(tuple))
+				}
+				require.NoError(t, w.Flush())
+			}
+			count := func() (n int) {
+				require.NoError(t, db.QueryRow(fmt.Sprintf("select count(*) from %s", tableName)).Scan(&n))
+				return n
+			}
+
+			tx, err := db.Beginx()
+			require.NoError(t, err)
+			writeRows(NewSQLTupleWriter(tx, tableInfo), 0, 1, 2)
+			require.NoError(t, tx.Commit())
+			require.Equal(t, 3, count())
+
+			// rows 1 and 2 are replaced, row 3 is inserted
+			tx, err = db.Beginx()
+			require.NoError(t, err)
+			uw, err := NewSQLUpsertWriter(tx, tableInfo, primaryKey)
+			require.NoError(t, err)
+			writeRows(uw, 1, 2, 3)
+			require.NoError(t, tx.Commit())
+			require.Equal(t, 4, count())
+
+			tx, err = db.Beginx()
+			require.NoError(t, err)
+			dw := NewSQLDeleteWriter(tx, tableInfo, primaryKey)
+			id := int16(0)
+			require.NoError(t, dw.WriteTuple(Tuple{&id}))
+			require.NoError(t, dw.Flush())
+			require.NoError(t, tx.Commit())
+			require.Equal(t, 3, count())
+		})
+	}
+}
+
 func TestCSVNull(t *testing.T) {
 	buf := &bytes.Buffer{}
 	w := NewCSVWriter(buf, nil)
@@ -313,6 +392,82 @@ func TestCSVNull(t *testing.T) {
 	require.Equal(t, row, row2)
 }
 
+func TestParquetTypes(t *testing.T) {
+	info := &pachsql.TableInfo{
+		Columns: []pachsql.ColumnInfo{
+			{Name: "c_smallint", DataType: "SMALLINT"},
+			{Name: "c_numeric", DataType: "NUMERIC"},
+			{Name: "c_float", DataType: "REAL", IsNullable: true},
+			{Name: "c_date", DataType: "DATE"},
+			{Name: "c_time", DataType: "TIMESTAMP WITHOUT TIME ZONE"},
+			{Name: "c_time_null", DataType: "TIMESTAMP WITH TIME ZONE", IsNullable: true},
+		},
+	}
+	date := time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)
+	ts := time.Date(2022, 5, 6, 20, 18, 10, 123456000, time.UTC)
+	row := Tuple{
+		&sql.NullInt16{Int16: -7, Valid: true},
+		&sql.NullString{String: "12345678901234567890.123", Valid: true},
+		&sql.NullFloat64{Float64: 1.5, Valid: true},
+		&date,
+		&ts,
+		&sql.NullTime{},
+	}
+	buf := &bytes.Buffer{}
+	w, err := NewParquetWriter(buf, info)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteTuple(row))
+	require.NoError(t, w.Flush())
+
+	r := NewParquetParser(buf)
+	row2, err := NewTupleFromTableInfo(info)
+	require.NoError(t, err)
+	require.NoError(t, r.Next(row2))
+	require.Equal(t, "-7", *row2[0].(*string))
+	require.Equal(t, "12345678901234567890.123", *row2[1].(*string))
+	require.Equal(t, sql.NullString{String: "1.5", Valid: true}, *row2[2].(*sql.NullString))
+	require.Equal(t, row[3], row2[3])
+	require.Equal(t, row[4], row2[4])
+	require.Equal(t, row[5], row2[5])
+	require.True(t, errors.Is(r.Next(row2), io.EOF))
+}
+
+func TestParquetCommonTypes(t *testing.T) {
+	info := &pachsql.TableInfo{
+		Columns: []pachsql.ColumnInfo{
+			{Name: "c_datetime", DataType: "DATETIME"},
+			{Name: "c_tinyint", DataType: "TINYINT"},
+			{Name: "c_char, padded", DataType: "BPCHAR"},
+			{Name: " c_uuid", DataType: "UUID", IsNullable: true},
+			{Name: "c_jsonb", DataType: "JSONB"},
+			{Name: "c_bytea", DataType: "BYTEA"},
+			{Name: "c_unknown", DataType: "INTERVAL"},
+		},
+	}
+	row, err := NewTupleFromTableInfo(info)
+	require.NoError(t, err)
+	ts := time.Date(2022, 5, 6, 20, 18, 10, 0, time.UTC)
+	*row[0].(*time.Time) = ts
+	*row[1].(*string) = "-3"
+	*row[2].(*string) = "ab  "
+	*row[3].(*sql.NullString) = sql.NullString{String: "5f1a6e2c-7e3b-4c8a-9d4e-2b1f0c3a4d5e", Valid: true}
+	*row[4].(*string) = `{"a": [1, 2]}`
+	*row[5].(*string) = "\x00\xff"
+	*row[6].(*string) = "1 day"
+	buf := &bytes.Buffer{}
+	w, err := NewParquetWriter(buf, info)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteTuple(row))
+	require.NoError(t, w.Flush())
+
+	r := NewParquetParser(buf)
+	row2, err := NewTupleFromTableInfo(info)
+	require.NoError(t, err)
+	require.NoError(t, r.Next(row2))
+	require.Equal(t, row, row2)
+	require.True(t, errors.Is(r.Next(row2), io.EOF))
+}
+
 func newTupleFromTestRow(row interface{}) Tuple {
 	var process func(reflect.Type) Tuple
 	process = func(t reflect.Type) Tuple {
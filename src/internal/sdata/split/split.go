@@ -0,0 +1,364 @@
+// Package split splits files of records into files of whole records, where
+// every file is a valid standalone file of the same format.
+package split
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata/csv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// splitBufferSize is the amount of data buffered for a split file before it is written.
+const splitBufferSize = 8 * 1024 * 1024
+
+// parquetMagic is the magic number at the start and end of a Parquet file.
+const parquetMagic = "PAR1"
+
+// parquetParallelism is the number of goroutines used by the parquet library
+// to read the file metadata.
+const parquetParallelism = 4
+
+// Options configures how Split divides its input into files.
+// If neither TargetFileRecords nor TargetFileBytes is set, every record is split into its own file.
+type Options struct {
+	// TargetFileRecords is the number of records in each file.
+	TargetFileRecords int64
+	// TargetFileBytes is the target size of each file. A file is ended at
+	// the first record boundary after the target is reached.
+	TargetFileBytes int64
+	// HeaderRecords is the number of records at the start of the input which
+	// are written to the start of every file, rather than being split.
+	// It is only supported for line and CSV input.
+	HeaderRecords int64
+}
+
+// Split splits the data in r into files of whole records, where the format
+// of the records is given by delimiter. Every file is a valid standalone file
+// in that format: CSV headers (see Options.HeaderRecords) and the header
+// and footer of SQL dumps are written to every file, and Parquet files are
+// split into groups of row groups which keep the schema.
+//
+// write is called to append data to the i'th file, and data is not valid
+// after write returns. Files are written in order, except that the footer of
+// a SQL dump is appended to every file once the end of the dump is reached.
+func Split(r io.Reader, delimiter pfs.Delimiter, opts Options, write func(i int, data []byte) error) error {
+	if opts.TargetFileRecords < 0 || opts.TargetFileBytes < 0 || opts.HeaderRecords < 0 {
+		return errors.Errorf("split targets and header records cannot be negative")
+	}
+	if opts.HeaderRecords > 0 && delimiter != pfs.Delimiter_LINE && delimiter != pfs.Delimiter_CSV {
+		return errors.Errorf("header records are only supported for line and CSV input, not %v", delimiter)
+	}
+	s := &splitter{opts: opts, write: write}
+	switch delimiter {
+	case pfs.Delimiter_LINE:
+		return s.splitLines(r)
+	case pfs.Delimiter_JSON:
+		return s.splitJSON(r)
+	case pfs.Delimiter_CSV:
+		return s.splitCSV(r)
+	case pfs.Delimiter_SQL:
+		return s.splitSQL(r)
+	case pfs.Delimiter_PARQUET:
+		return s.splitParquet(r)
+	default:
+		return errors.Errorf("cannot split with delimiter %v", delimiter)
+	}
+}
+
+type splitter struct {
+	opts  Options
+	write func(int, []byte) error
+
+	header  []byte
+	headers int64
+	file    int
+	records int64
+	bytes   int64
+	buf     bytes.Buffer
+}
+
+// add adds a record to the current file, and ends the file if it has reached the target.
+func (s *splitter) add(record []byte) error {
+	if s.headers < s.opts.HeaderRecords {
+		s.header = append(s.header, record...)
+		s.headers++
+		return nil
+	}
+	if s.records == 0 {
+		s.buf.Write(s.header)
+	}
+	s.buf.Write(record)
+	s.records++
+	s.bytes += int64(len(record))
+	if s.full() {
+		return s.next()
+	}
+	if s.buf.Len() >= splitBufferSize {
+		return s.flush()
+	}
+	return nil
+}
+
+func (s *splitter) full() bool {
+	if s.opts.TargetFileRecords == 0 && s.opts.TargetFileBytes == 0 {
+		return true
+	}
+	return (s.opts.TargetFileRecords > 0 && s.records >= s.opts.TargetFileRecords) ||
+		(s.opts.TargetFileBytes > 0 && s.bytes >= s.opts.TargetFileBytes)
+}
+
+// next ends the current file.
+func (s *splitter) next() error {
+	if err := s.flush(); err != nil {
+		return err
+	}
+	s.file++
+	s.records = 0
+	s.bytes = 0
+	return nil
+}
+
+func (s *splitter) flush() error {
+	if s.buf.Len() == 0 {
+		return nil
+	}
+	defer s.buf.Reset()
+	return s.write(s.file, s.buf.Bytes())
+}
+
+// close ends the last file, if it is not empty, and returns the number of files.
+func (s *splitter) close() (int, error) {
+	if s.records > 0 {
+		if err := s.next(); err != nil {
+			return 0, err
+		}
+	}
+	return s.file, nil
+}
+
+func (s *splitter) splitLines(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			if err := s.add(line); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return errors.EnsureStack(err)
+		}
+	}
+	_, err := s.close()
+	return err
+}
+
+func (s *splitter) splitJSON(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	for {
+		var record json.RawMessage
+		if err := dec.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return errors.Wrapf(err, "error parsing json record")
+		}
+		if err := s.add(append(record, '\n')); err != nil {
+			return err
+		}
+	}
+	_, err := s.close()
+	return err
+}
+
+// splitCSV parses each record, and then re-encodes it, so that records which
+// contain quoted newlines are kept whole.
+func (s *splitter) splitCSV(r io.Reader) error {
+	cr := csv.NewReader(r)
+	// Records are not required to have the same number of fields.
+	cr.FieldsPerRecord = -1
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	for {
+		record, err := cr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return errors.Wrapf(err, "error parsing csv record")
+		}
+		buf.Reset()
+		if err := cw.Write(record); err != nil {
+			return errors.EnsureStack(err)
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := s.add(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	_, err := s.close()
+	return err
+}
+
+// splitSQL splits the rows of a pgdump file. The footer of the dump is only
+// known once all of the rows have been read, so it is appended to every file
+// at the end.
+func (s *splitter) splitSQL(r io.Reader) error {
+	pr := pachsql.NewPGDumpReader(bufio.NewReader(r))
+	for {
+		row, err := pr.ReadRow()
+		if s.header == nil {
+			s.header = pr.Header
+		}
+		if len(row) > 0 {
+			if err := s.add(row); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+	}
+	n, err := s.close()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if err := s.write(i, pr.Footer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitParquet splits a Parquet file into files of whole row groups. The
+// column chunks of each row group are copied without being decoded, and a
+// new footer is written for each file with the schema of the input.
+// Parquet stores its metadata at the end of the file, so the whole input is
+// buffered in memory.
+func (s *splitter) splitParquet(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	pf, err := buffer.NewBufferFile(data)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	pr, err := reader.NewParquetColumnReader(pf, parquetParallelism)
+	if err != nil {
+		return errors.Wrapf(err, "error parsing parquet file")
+	}
+	footer := pr.Footer
+	var rowGroups []*parquet.RowGroup
+	for _, rg := range footer.RowGroups {
+		rowGroups = append(rowGroups, rg)
+		s.records += rg.NumRows
+		s.bytes += rg.TotalByteSize
+		if !s.full() {
+			continue
+		}
+		if err := s.writeParquet(data, footer, rowGroups); err != nil {
+			return err
+		}
+		rowGroups = nil
+	}
+	if len(rowGroups) > 0 {
+		return s.writeParquet(data, footer, rowGroups)
+	}
+	return nil
+}
+
+func (s *splitter) writeParquet(data []byte, footer *parquet.FileMetaData, rowGroups []*parquet.RowGroup) error {
+	s.buf.WriteString(parquetMagic)
+	fileFooter := &parquet.FileMetaData{
+		Version:          footer.Version,
+		Schema:           footer.Schema,
+		KeyValueMetadata: footer.KeyValueMetadata,
+		CreatedBy:        footer.CreatedBy,
+		ColumnOrders:     footer.ColumnOrders,
+	}
+	for _, rg := range rowGroups {
+		fileRG := &parquet.RowGroup{
+			TotalByteSize:       rg.TotalByteSize,
+			NumRows:             rg.NumRows,
+			SortingColumns:      rg.SortingColumns,
+			TotalCompressedSize: rg.TotalCompressedSize,
+		}
+		for i, cc := range rg.Columns {
+			if cc.MetaData == nil || cc.FilePath != nil {
+				return errors.Errorf("cannot split parquet files with encrypted or external column chunks")
+			}
+			md := *cc.MetaData
+			start := md.DataPageOffset
+			if md.DictionaryPageOffset != nil && *md.DictionaryPageOffset > 0 && *md.DictionaryPageOffset < start {
+				start = *md.DictionaryPageOffset
+			}
+			end := start + md.TotalCompressedSize
+			if start < int64(len(parquetMagic)) || end > int64(len(data)) {
+				return errors.Errorf("parquet column chunk is out of bounds")
+			}
+			// Move the offsets in the column chunk to where it is in the new file.
+			delta := int64(s.buf.Len()) - start
+			s.buf.Write(data[start:end])
+			md.DataPageOffset += delta
+			if md.DictionaryPageOffset != nil {
+				offset := *md.DictionaryPageOffset + delta
+				md.DictionaryPageOffset = &offset
+			}
+			if md.IndexPageOffset != nil {
+				offset := *md.IndexPageOffset + delta
+				md.IndexPageOffset = &offset
+			}
+			// The page indexes and bloom filters are not copied.
+			md.BloomFilterOffset = nil
+			if i == 0 {
+				offset := start + delta
+				fileRG.FileOffset = &offset
+			}
+			fileRG.Columns = append(fileRG.Columns, &parquet.ColumnChunk{
+				FileOffset: cc.FileOffset + delta,
+				MetaData:   &md,
+			})
+		}
+		fileFooter.RowGroups = append(fileFooter.RowGroups, fileRG)
+		fileFooter.NumRows += rg.NumRows
+	}
+	ts := thrift.NewTSerializer()
+	ts.Protocol = thrift.NewTCompactProtocolFactory().GetProtocol(ts.Transport)
+	footerBuf, err := ts.Write(context.Background(), fileFooter)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	s.buf.Write(footerBuf)
+	if err := binary.Write(&s.buf, binary.LittleEndian, uint32(len(footerBuf))); err != nil {
+		return errors.EnsureStack(err)
+	}
+	s.buf.WriteString(parquetMagic)
+	return s.next()
+}
@@ -0,0 +1,148 @@
+package split_test
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xitongsys/parquet-go-source/writerfile"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata/split"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func splitFiles(t *testing.T, r io.Reader, delimiter pfs.Delimiter, opts split.Options) []string {
+	t.Helper()
+	var files []string
+	require.NoError(t, split.Split(r, delimiter, opts, func(i int, data []byte) error {
+		if i == len(files) {
+			files = append(files, "")
+		}
+		require.True(t, i < len(files))
+		files[i] += string(data)
+		return nil
+	}))
+	return files
+}
+
+func TestSplitLines(t *testing.T) {
+	input := "foo\nbar\nbuz\nfiz\n"
+	require.Equal(t, []string{"foo\n", "bar\n", "buz\n", "fiz\n"}, splitFiles(t, strings.NewReader(input), pfs.Delimiter_LINE, split.Options{}))
+	require.Equal(t, []string{"foo\nbar\n", "buz\nfiz\n"}, splitFiles(t, strings.NewReader(input), pfs.Delimiter_LINE, split.Options{TargetFileRecords: 2}))
+	require.Equal(t, []string{"foo\nbar\nbuz\n", "fiz\n"}, splitFiles(t, strings.NewReader(input), pfs.Delimiter_LINE, split.Options{TargetFileBytes: 9}))
+	require.Equal(t, []string{"foo\nbar\nbuz\n", "foo\nfiz\n"}, splitFiles(t, strings.NewReader(input), pfs.Delimiter_LINE, split.Options{TargetFileRecords: 2, HeaderRecords: 1}))
+	require.Equal(t, 0, len(splitFiles(t, strings.NewReader(""), pfs.Delimiter_LINE, split.Options{})))
+}
+
+func TestSplitJSON(t *testing.T) {
+	input := `{"a": 1}{"a": [2, 3]}
+{"a": "4"}`
+	require.Equal(t, []string{"{\"a\": 1}\n", "{\"a\": [2, 3]}\n", "{\"a\": \"4\"}\n"}, splitFiles(t, strings.NewReader(input), pfs.Delimiter_JSON, split.Options{}))
+	require.Equal(t, []string{"{\"a\": 1}\n{\"a\": [2, 3]}\n", "{\"a\": \"4\"}\n"}, splitFiles(t, strings.NewReader(input), pfs.Delimiter_JSON, split.Options{TargetFileRecords: 2}))
+	require.YesError(t, split.Split(strings.NewReader(input), pfs.Delimiter_JSON, split.Options{HeaderRecords: 1}, nil))
+	require.YesError(t, split.Split(strings.NewReader(`{"a": `), pfs.Delimiter_JSON, split.Options{}, func(int, []byte) error { return nil }))
+}
+
+func TestSplitCSV(t *testing.T) {
+	// "is\nonly" is quoted, so it is one field
+	input := "this,is,a,test\n" + "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n" + "1,2,3,4\n"
+	require.Equal(t, []string{
+		"this,is,a,test\n",
+		"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n",
+		"1,2,3,4\n",
+	}, splitFiles(t, strings.NewReader(input), pfs.Delimiter_CSV, split.Options{}))
+	require.Equal(t, []string{
+		"this,is,a,test\n\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n",
+		"this,is,a,test\n1,2,3,4\n",
+	}, splitFiles(t, strings.NewReader(input), pfs.Delimiter_CSV, split.Options{HeaderRecords: 1}))
+}
+
+func TestSplitSQL(t *testing.T) {
+	files := splitFiles(t, strings.NewReader(tu.TestPGDump), pfs.Delimiter_SQL, split.Options{TargetFileRecords: 2})
+	require.Equal(t, 3, len(files))
+	var rows []string
+	for _, file := range files {
+		require.Matches(t, "CREATE TABLE public\\.cars", file)
+		// every file is a valid pgdump file
+		pr := pachsql.NewPGDumpReader(bufio.NewReader(strings.NewReader(file)))
+		for {
+			row, err := pr.ReadRow()
+			if err != nil {
+				require.True(t, errors.Is(err, io.EOF))
+				break
+			}
+			rows = append(rows, string(row))
+		}
+	}
+	require.Equal(t, 5, len(rows))
+	require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", rows[0])
+	require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", rows[4])
+}
+
+func TestSplitParquet(t *testing.T) {
+	info := &pachsql.TableInfo{
+		Columns: []pachsql.ColumnInfo{
+			{Name: "c_int", DataType: "INTEGER"},
+			{Name: "c_text", DataType: "TEXT", IsNullable: true},
+		},
+	}
+	buf := &bytes.Buffer{}
+	w, err := writer.NewCSVWriter([]string{
+		"name=c_int, type=INT32, convertedtype=INT_32, repetitiontype=REQUIRED",
+		"name=c_text, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL",
+	}, writerfile.NewWriterFile(buf), 1)
+	require.NoError(t, err)
+	// write 5 row groups of 2 rows
+	for i := int32(0); i < 10; i++ {
+		var text interface{}
+		if i%3 == 0 {
+			text = "text"
+		}
+		require.NoError(t, w.Write([]interface{}{i, text}))
+		if i%2 == 1 {
+			require.NoError(t, w.Flush(true))
+		}
+	}
+	require.NoError(t, w.WriteStop())
+
+	files := splitFiles(t, bytes.NewReader(buf.Bytes()), pfs.Delimiter_PARQUET, split.Options{TargetFileRecords: 4})
+	require.Equal(t, 3, len(files))
+	var next int32
+	for i, file := range files {
+		// every file is a valid parquet file with the schema of the input
+		r := sdata.NewParquetParser(strings.NewReader(file))
+		row, err := sdata.NewTupleFromTableInfo(info)
+		require.NoError(t, err)
+		var rows int
+		for {
+			if err := r.Next(row); err != nil {
+				require.True(t, errors.Is(err, io.EOF))
+				break
+			}
+			require.Equal(t, strconv.Itoa(int(next)), *row[0].(*string))
+			if next%3 == 0 {
+				require.Equal(t, sql.NullString{String: "text", Valid: true}, *row[1].(*sql.NullString))
+			} else {
+				require.False(t, row[1].(*sql.NullString).Valid)
+			}
+			next++
+			rows++
+		}
+		if i < 2 {
+			require.Equal(t, 4, rows)
+		} else {
+			require.Equal(t, 2, rows)
+		}
+	}
+	require.Equal(t, int32(10), next)
+}
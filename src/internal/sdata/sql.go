@@ -17,6 +17,8 @@ type SQLTupleWriter struct {
 	tableInfo       *pachsql.TableInfo
 	insertStatement string
 	buf             []Tuple
+	// suffix is appended to the statement after the list of values.
+	suffix string
 }
 
 func (m *SQLTupleWriter) WriteTuple(t Tuple) error {
@@ -67,7 +69,7 @@ func (m *SQLTupleWriter) GeneratePreparedStatement() (*pachsql.Stmt, error) {
 		}
 		placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.Join(placeholderRow, ", ")))
 	}
-	sqlStr := m.insertStatement + strings.Join(placeholders, ", ")
+	sqlStr := m.insertStatement + strings.Join(placeholders, ", ") + m.suffix
 	stmt, err := m.tx.Preparex(sqlStr)
 	if err != nil {
 		return nil, errors.EnsureStack(err)
@@ -99,5 +101,102 @@ func NewSQLTupleWriter(tx *pachsql.Tx, tableInfo *pachsql.TableInfo) *SQLTupleWr
 			tableInfo.Name,
 			strings.Join(tableInfo.ColumnNames(), ", "))
 	}
-	return &SQLTupleWriter{tx, tableInfo, s, []Tuple{}}
+	return &SQLTupleWriter{tx: tx, tableInfo: tableInfo, insertStatement: s, buf: []Tuple{}}
+}
+
+// NewSQLUpsertWriter returns a SQLTupleWriter which replaces existing rows with the same
+// primary key instead of failing. The table must have a unique constraint on the primary key columns.
+// Only the Postgres and MySQL dialects are supported.
+func NewSQLUpsertWriter(tx *pachsql.Tx, tableInfo *pachsql.TableInfo, primaryKey []string) (*SQLTupleWriter, error) {
+	if len(primaryKey) == 0 {
+		return nil, errors.Errorf("upserting into %s.%s requires a primary key", tableInfo.Schema, tableInfo.Name)
+	}
+	isKey := make(map[string]bool)
+	for _, col := range primaryKey {
+		isKey[col] = true
+	}
+	var updates []string
+	var suffix string
+	switch tableInfo.Driver {
+	case "pgx":
+		for _, col := range tableInfo.ColumnNames() {
+			if !isKey[col] {
+				updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", col, col))
+			}
+		}
+		if len(updates) == 0 {
+			suffix = fmt.Sprintf(" ON CONFLICT (%s) DO NOTHING", strings.Join(primaryKey, ", "))
+		} else {
+			suffix = fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(primaryKey, ", "), strings.Join(updates, ", "))
+		}
+	case "mysql":
+		for _, col := range tableInfo.ColumnNames() {
+			if !isKey[col] {
+				updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", col, col))
+			}
+		}
+		if len(updates) == 0 {
+			// MySQL has no DO NOTHING, so assign a key column to itself.
+			updates = append(updates, fmt.Sprintf("%s = %s", primaryKey[0], primaryKey[0]))
+		}
+		suffix = " ON DUPLICATE KEY UPDATE " + strings.Join(updates, ", ")
+	default:
+		return nil, errors.Errorf("upserts are not supported for driver %s", tableInfo.Driver)
+	}
+	w := NewSQLTupleWriter(tx, tableInfo)
+	w.suffix = suffix
+	return w, nil
+}
+
+// SQLDeleteWriter deletes the rows of a SQL table whose primary key matches the tuples written to it.
+type SQLDeleteWriter struct {
+	tx         *pachsql.Tx
+	tableInfo  *pachsql.TableInfo
+	primaryKey []string
+	buf        []Tuple
+}
+
+// NewSQLDeleteWriter returns a SQLDeleteWriter. Tuples written to it must contain
+// only the primary key columns, in the same order as primaryKey.
+func NewSQLDeleteWriter(tx *pachsql.Tx, tableInfo *pachsql.TableInfo, primaryKey []string) *SQLDeleteWriter {
+	return &SQLDeleteWriter{tx: tx, tableInfo: tableInfo, primaryKey: primaryKey}
+}
+
+func (m *SQLDeleteWriter) WriteTuple(t Tuple) error {
+	if len(t) != len(m.primaryKey) {
+		return ErrTupleFields{Writer: m, Fields: m.primaryKey, Tuple: t}
+	}
+	if len(m.buf) >= rowLimit {
+		if err := m.Flush(); err != nil {
+			return err
+		}
+	}
+	m.buf = append(m.buf, CloneTuple(t))
+	return nil
+}
+
+func (m *SQLDeleteWriter) Flush() error {
+	if len(m.buf) == 0 {
+		return nil
+	}
+	var values Tuple
+	var placeholders []string
+	for r := range m.buf {
+		var placeholderRow []string
+		for c := range m.buf[r] {
+			placeholderRow = append(placeholderRow, pachsql.Placeholder(m.tableInfo.Driver, len(values)))
+			values = append(values, m.buf[r][c])
+		}
+		placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.Join(placeholderRow, ", ")))
+	}
+	sqlStr := fmt.Sprintf("DELETE FROM %s.%s WHERE (%s) IN (%s)",
+		m.tableInfo.Schema,
+		m.tableInfo.Name,
+		strings.Join(m.primaryKey, ", "),
+		strings.Join(placeholders, ", "))
+	if _, err := m.tx.Exec(sqlStr, values...); err != nil {
+		return errors.EnsureStack(err)
+	}
+	m.buf = m.buf[:0]
+	return nil
 }
@@ -1,5 +1,7 @@
 package serviceenv
 
+import "github.com/pachyderm/pachyderm/v2/src/internal/errors"
+
 // Configuration is the generic configuration structure used to access configuration fields.
 type Configuration struct {
 	*GlobalConfiguration
@@ -73,6 +75,27 @@ type GlobalConfiguration struct {
 
 	// The number of concurrent requests that the PPS Master can make against kubernetes
 	PPSMaxConcurrentK8sRequests int `env:"PPS_MAX_CONCURRENT_K8S_REQUESTS,default=10"`
+
+	// TaskServiceBackend is where the tasks distributed to pachd and the workers
+	// are stored: "etcd" or "postgres". It is propagated to workers.
+	TaskServiceBackend string `env:"TASK_SERVICE_BACKEND,default=etcd"`
+}
+
+// Task service backends, for TaskServiceBackend.
+const (
+	EtcdTaskServiceBackend     = "etcd"
+	PostgresTaskServiceBackend = "postgres"
+)
+
+// Validate returns an error if any of the configuration's values are invalid.
+func (c *GlobalConfiguration) Validate() error {
+	switch c.TaskServiceBackend {
+	case EtcdTaskServiceBackend, PostgresTaskServiceBackend:
+	default:
+		return errors.Errorf("unknown task service backend %q (TASK_SERVICE_BACKEND must be %q or %q)",
+			c.TaskServiceBackend, EtcdTaskServiceBackend, PostgresTaskServiceBackend)
+	}
+	return nil
 }
 
 // PachdFullConfiguration contains the full pachd configuration.
@@ -101,6 +124,14 @@ type PachdSpecificConfiguration struct {
 	PachdPodName                 string `env:"PACHD_POD_NAME,required"`
 	EnableWorkerSecurityContexts bool   `env:"ENABLE_WORKER_SECURITY_CONTEXTS,default=true"`
 	TLSCertSecretName            string `env:"TLS_CERT_SECRET_NAME,default="`
+	// PPSInfraDriver is how pipeline workers are run: "kubernetes" runs them as
+	// pods, and "local" runs them as processes on the same host as pachd.
+	PPSInfraDriver string `env:"PPS_INFRA_DRIVER,default=kubernetes"`
+	// LocalWorkerBinary and LocalWorkerRoot are the worker binary run by the
+	// local driver, and the directory in which its workers' files are kept
+	// (the system's temporary directory by default).
+	LocalWorkerBinary string `env:"LOCAL_WORKER_BINARY,default=worker"`
+	LocalWorkerRoot   string `env:"LOCAL_WORKER_ROOT,default="`
 }
 
 // EnterpriseServerConfiguration contains the full configuration for an enterprise server
@@ -116,18 +147,28 @@ type EnterpriseSpecifcConfiguration struct {
 
 // StorageConfiguration contains the storage configuration.
 type StorageConfiguration struct {
-	StorageMemoryThreshold               int64 `env:"STORAGE_MEMORY_THRESHOLD"`
-	StorageCompactionShardSizeThreshold  int64 `env:"STORAGE_COMPACTION_SHARD_SIZE_THRESHOLD"`
-	StorageCompactionShardCountThreshold int64 `env:"STORAGE_COMPACTION_SHARD_COUNT_THRESHOLD"`
-	StorageLevelFactor                   int64 `env:"STORAGE_LEVEL_FACTOR"`
-	StorageUploadConcurrencyLimit        int   `env:"STORAGE_UPLOAD_CONCURRENCY_LIMIT,default=100"`
-	StoragePutFileConcurrencyLimit       int   `env:"STORAGE_PUT_FILE_CONCURRENCY_LIMIT,default=100"`
-	StorageGCPeriod                      int64 `env:"STORAGE_GC_PERIOD,default=60"`
-	StorageChunkGCPeriod                 int64 `env:"STORAGE_CHUNK_GC_PERIOD,default=60"`
-	StorageCompactionMaxFanIn            int   `env:"STORAGE_COMPACTION_MAX_FANIN,default=10"`
-	StorageFileSetsMaxOpen               int   `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
-	StorageDiskCacheSize                 int   `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
-	StorageMemoryCacheSize               int   `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	StorageMemoryThreshold               int64  `env:"STORAGE_MEMORY_THRESHOLD"`
+	StorageCompactionShardSizeThreshold  int64  `env:"STORAGE_COMPACTION_SHARD_SIZE_THRESHOLD"`
+	StorageCompactionShardCountThreshold int64  `env:"STORAGE_COMPACTION_SHARD_COUNT_THRESHOLD"`
+	StorageLevelFactor                   int64  `env:"STORAGE_LEVEL_FACTOR"`
+	StorageUploadConcurrencyLimit        int    `env:"STORAGE_UPLOAD_CONCURRENCY_LIMIT,default=100"`
+	StoragePutFileConcurrencyLimit       int    `env:"STORAGE_PUT_FILE_CONCURRENCY_LIMIT,default=100"`
+	StorageGCPeriod                      int64  `env:"STORAGE_GC_PERIOD,default=60"`
+	StorageChunkGCPeriod                 int64  `env:"STORAGE_CHUNK_GC_PERIOD,default=60"`
+	StorageRetentionPeriod               int64  `env:"STORAGE_RETENTION_PERIOD,default=600"`
+	StorageCompactionMaxFanIn            int    `env:"STORAGE_COMPACTION_MAX_FANIN,default=10"`
+	StorageFileSetsMaxOpen               int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
+	StorageDiskCacheSize                 int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
+	StorageMemoryCacheSize               int    `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	StorageCompression                   string `env:"STORAGE_COMPRESSION"`
+	StorageCompressionLevel              int    `env:"STORAGE_COMPRESSION_LEVEL"`
+	StorageChunkKeySecret                bool   `env:"STORAGE_CHUNK_KEY_SECRET"`
+	StorageKEKProvider                   string `env:"STORAGE_KEK_PROVIDER"`
+	StorageKEKLocalPath                  string `env:"STORAGE_KEK_LOCAL_PATH"`
+	StorageKEKVaultAddress               string `env:"STORAGE_KEK_VAULT_ADDRESS"`
+	StorageKEKVaultToken                 string `env:"STORAGE_KEK_VAULT_TOKEN"`
+	StorageKEKVaultMount                 string `env:"STORAGE_KEK_VAULT_MOUNT,default=transit"`
+	StorageKEKVaultKey                   string `env:"STORAGE_KEK_VAULT_KEY,default=pachyderm"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -143,6 +184,10 @@ type WorkerSpecificConfiguration struct {
 	PPSWorkerIP string `env:"PPS_WORKER_IP,required"`
 	// The name of this pod
 	PodName string `env:"PPS_POD_NAME,required"`
+	// Workers run as processes by the local driver, rather than as pods, listen
+	// on their own IP and keep their files under their own root directory.
+	PPSWorkerLocal bool   `env:"PPS_WORKER_LOCAL,default=false"`
+	PPSWorkerRoot  string `env:"PPS_WORKER_ROOT,default=/"`
 }
 
 // FeatureFlags contains the configuration for feature flags.  XXX: if you're
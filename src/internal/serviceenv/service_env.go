@@ -405,19 +405,27 @@ func (env *NonblockingServiceEnv) GetEtcdClient() *etcd.Client {
 	return env.etcdClient
 }
 
+// GetTaskService returns a task service for the configured backend, scoped to
+// the given prefix. The backend is checked by GlobalConfiguration.Validate at
+// startup.
 func (env *NonblockingServiceEnv) GetTaskService(prefix string) task.Service {
-	return task.NewEtcdService(env.GetEtcdClient(), prefix)
+	switch env.config.TaskServiceBackend {
+	case PostgresTaskServiceBackend:
+		return task.NewPostgresService(env.GetDBClient(), env.GetPostgresListener(), prefix)
+	case EtcdTaskServiceBackend:
+		return task.NewEtcdService(env.GetEtcdClient(), prefix)
+	default:
+		panic(fmt.Sprintf("unknown task service backend %q", env.config.TaskServiceBackend))
+	}
 }
 
 // GetKubeClient returns the already connected Kubernetes API client without
-// modification.
+// modification, or nil if the env wasn't created with InitWithKube (e.g. pachd
+// runs outside of kubernetes, with the local PPS infrastructure driver).
 func (env *NonblockingServiceEnv) GetKubeClient() *kube.Clientset {
 	if err := env.kubeEg.Wait(); err != nil {
 		panic(err) // If env can't connect, there's no sensible way to recover
 	}
-	if env.kubeClient == nil {
-		panic("service env never connected to kubernetes")
-	}
 	return env.kubeClient
 }
 
@@ -22,6 +22,7 @@ type EntryFunc = func(interface{}, *DataRef) error
 // interface, entries are ordered within as well as across calls).
 type Batcher struct {
 	client    Client
+	opts      CreateOptions
 	entries   []*entry
 	buf       []byte
 	threshold int
@@ -42,6 +43,7 @@ func (s *Storage) NewBatcher(ctx context.Context, name string, threshold int, op
 	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL))
 	b := &Batcher{
 		client:    client,
+		opts:      s.createOpts,
 		threshold: threshold,
 		taskChain: NewTaskChain(ctx, semaphore.NewWeighted(chunkParallelism)),
 	}
@@ -71,7 +73,7 @@ func (b *Batcher) Add(meta interface{}, data []byte, pointsTo []*DataRef) error
 func (b *Batcher) createBatch(entries []*entry, buf []byte) error {
 	return b.taskChain.CreateTask(func(ctx context.Context) (func() error, error) {
 		pointsTo := getPointsTo(entries)
-		dataRef, err := upload(ctx, b.client, buf, pointsTo, false)
+		dataRef, err := upload(ctx, b.client, b.opts, buf, pointsTo, false)
 		if err != nil {
 			return nil, err
 		}
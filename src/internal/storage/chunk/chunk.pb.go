@@ -27,16 +27,22 @@ type CompressionAlgo int32
 const (
 	CompressionAlgo_NONE            CompressionAlgo = 0
 	CompressionAlgo_GZIP_BEST_SPEED CompressionAlgo = 1
+	CompressionAlgo_ZSTD            CompressionAlgo = 2
+	CompressionAlgo_LZ4             CompressionAlgo = 3
 )
 
 var CompressionAlgo_name = map[int32]string{
 	0: "NONE",
 	1: "GZIP_BEST_SPEED",
+	2: "ZSTD",
+	3: "LZ4",
 }
 
 var CompressionAlgo_value = map[string]int32{
 	"NONE":            0,
 	"GZIP_BEST_SPEED": 1,
+	"ZSTD":            2,
+	"LZ4":             3,
 }
 
 func (x CompressionAlgo) String() string {
@@ -246,33 +252,33 @@ func init() {
 }
 
 var fileDescriptor_4b743b4a788792d7 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
+	// 415 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x5d, 0x8b, 0xd3, 0x40,
-	0x14, 0xdd, 0x69, 0xba, 0x6b, 0xbd, 0x1b, 0x9a, 0x30, 0xa2, 0x06, 0xd4, 0x12, 0xfb, 0x14, 0xf6,
-	0xa1, 0x59, 0xe2, 0xa3, 0x22, 0xa4, 0xd9, 0xb0, 0xbb, 0x2a, 0x49, 0x99, 0x5d, 0x11, 0xf7, 0x25,
-	0xa4, 0xc9, 0xe4, 0x83, 0xed, 0x66, 0xc2, 0xcc, 0xac, 0x50, 0xc1, 0xff, 0xe7, 0xa3, 0x7f, 0x40,
-	0x90, 0xfe, 0x12, 0xc9, 0xb4, 0x54, 0x5b, 0xf6, 0x65, 0x38, 0xf7, 0xdc, 0x73, 0xcf, 0xb9, 0x03,
-	0x17, 0xc6, 0x75, 0x23, 0x29, 0x6f, 0xd2, 0x85, 0x2b, 0x24, 0xe3, 0x69, 0x49, 0xdd, 0xac, 0xba,
-	0x6f, 0x6e, 0xd7, 0xef, 0xa4, 0xe5, 0x4c, 0x32, 0x7c, 0xa8, 0x8a, 0xf1, 0x0f, 0x78, 0x74, 0x96,
-	0xca, 0x94, 0xd0, 0x02, 0xbf, 0x04, 0x8d, 0xd3, 0xc2, 0x42, 0x36, 0x72, 0x8e, 0x3d, 0x98, 0xac,
-	0xc5, 0x84, 0x16, 0xa4, 0xa3, 0x31, 0x86, 0x7e, 0x95, 0x8a, 0xca, 0xea, 0xd9, 0xc8, 0xd1, 0x89,
-	0xc2, 0xf8, 0x35, 0xe8, 0xac, 0x28, 0x04, 0x95, 0xc9, 0x7c, 0x29, 0xa9, 0xb0, 0x34, 0x1b, 0x39,
-	0x1a, 0x39, 0x5e, 0x73, 0xd3, 0x8e, 0xc2, 0xaf, 0x00, 0x44, 0xfd, 0x9d, 0x6e, 0x04, 0x7d, 0x25,
-	0x78, 0xdc, 0x31, 0xaa, 0x3d, 0xfe, 0x8d, 0x40, 0xeb, 0xb2, 0x87, 0xd0, 0xab, 0x73, 0x15, 0xad,
-	0x93, 0x5e, 0x9d, 0xef, 0x8d, 0xf5, 0xf6, 0xc6, 0xba, 0x65, 0x68, 0x5e, 0x52, 0x15, 0x38, 0x20,
-	0x0a, 0x63, 0x13, 0xb4, 0x9c, 0xde, 0xaa, 0x08, 0x9d, 0x74, 0x10, 0xbf, 0x07, 0x83, 0x36, 0x19,
-	0x5f, 0xb6, 0xb2, 0x66, 0x4d, 0x92, 0x2e, 0x4a, 0x66, 0x1d, 0xda, 0xc8, 0x19, 0x7a, 0x4f, 0x37,
-	0x9f, 0x0b, 0xb7, 0x5d, 0x7f, 0x51, 0x32, 0x32, 0xa4, 0x3b, 0x35, 0xf6, 0xc1, 0xcc, 0xd8, 0x5d,
-	0xcb, 0xa9, 0x10, 0x5b, 0x83, 0x23, 0x65, 0xf0, 0x6c, 0x63, 0x10, 0xfc, 0x6b, 0x2b, 0x07, 0x23,
-	0xdb, 0x25, 0x4e, 0x4e, 0xc1, 0xd8, 0xd3, 0xe0, 0x01, 0xf4, 0xa3, 0x38, 0x0a, 0xcd, 0x03, 0xfc,
-	0x04, 0x8c, 0xf3, 0x9b, 0xcb, 0x59, 0x32, 0x0d, 0xaf, 0xae, 0x93, 0xab, 0x59, 0x18, 0x9e, 0x99,
-	0xe8, 0xe4, 0x2d, 0x0c, 0x77, 0xd7, 0xc2, 0x2f, 0xe0, 0x79, 0x18, 0x05, 0xe4, 0xeb, 0xec, 0xfa,
-	0x32, 0x8e, 0x12, 0xff, 0xd3, 0x79, 0x9c, 0x7c, 0x8e, 0x3e, 0x46, 0xf1, 0x97, 0xc8, 0x3c, 0xc0,
-	0x3a, 0x0c, 0x82, 0x0b, 0x3f, 0xb8, 0xf0, 0xbd, 0x53, 0x13, 0x4d, 0x3f, 0xfc, 0x5c, 0x8d, 0xd0,
-	0xaf, 0xd5, 0x08, 0xfd, 0x59, 0x8d, 0xd0, 0xcd, 0xbb, 0xb2, 0x96, 0xd5, 0xfd, 0x7c, 0x92, 0xb1,
-	0x3b, 0xb7, 0x4d, 0xb3, 0x6a, 0x99, 0x53, 0xfe, 0x3f, 0xfa, 0xe6, 0xb9, 0x82, 0x67, 0xee, 0xc3,
-	0xc7, 0x32, 0x3f, 0x52, 0x77, 0xf2, 0xe6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xc8, 0xba,
-	0x42, 0x4d, 0x02, 0x00, 0x00,
+	0x14, 0xdd, 0x49, 0xba, 0xbb, 0xf5, 0x6e, 0x68, 0x87, 0x11, 0xb5, 0xa0, 0x96, 0xda, 0xa7, 0xb2,
+	0x0f, 0x8d, 0x54, 0xdf, 0x14, 0x21, 0x4d, 0xc3, 0xee, 0xea, 0x92, 0x96, 0x69, 0x45, 0xcc, 0x4b,
+	0x48, 0x93, 0xc9, 0x07, 0xdb, 0xcd, 0x84, 0x99, 0x59, 0xa1, 0x82, 0xff, 0xcf, 0x47, 0xff, 0x80,
+	0x20, 0xfd, 0x25, 0x92, 0x69, 0x59, 0x6d, 0xd9, 0x97, 0x70, 0xe6, 0x9c, 0x73, 0xcf, 0xb9, 0x81,
+	0x0b, 0xfd, 0xa2, 0x54, 0x4c, 0x94, 0xd1, 0xca, 0x96, 0x8a, 0x8b, 0x28, 0x63, 0x76, 0x9c, 0xdf,
+	0x95, 0x37, 0xdb, 0xef, 0xb0, 0x12, 0x5c, 0x71, 0x72, 0xac, 0x1f, 0xfd, 0x1f, 0x70, 0x3a, 0x89,
+	0x54, 0x44, 0x59, 0x4a, 0x5e, 0x80, 0x29, 0x58, 0xda, 0x41, 0x3d, 0x34, 0x38, 0x1b, 0xc1, 0x70,
+	0x6b, 0xa6, 0x2c, 0xa5, 0x35, 0x4d, 0x08, 0x34, 0xf2, 0x48, 0xe6, 0x1d, 0xa3, 0x87, 0x06, 0x16,
+	0xd5, 0x98, 0xbc, 0x02, 0x8b, 0xa7, 0xa9, 0x64, 0x2a, 0x5c, 0xae, 0x15, 0x93, 0x1d, 0xb3, 0x87,
+	0x06, 0x26, 0x3d, 0xdb, 0x72, 0xe3, 0x9a, 0x22, 0x2f, 0x01, 0x64, 0xf1, 0x9d, 0xed, 0x0c, 0x0d,
+	0x6d, 0x78, 0x54, 0x33, 0x5a, 0xee, 0xff, 0x46, 0x60, 0xd6, 0xdd, 0x2d, 0x30, 0x8a, 0x44, 0x57,
+	0x5b, 0xd4, 0x28, 0x92, 0x83, 0x31, 0xe3, 0x60, 0xac, 0x5e, 0x86, 0x25, 0x19, 0xd3, 0x85, 0x4d,
+	0xaa, 0x31, 0xc1, 0x60, 0x26, 0xec, 0x46, 0x57, 0x58, 0xb4, 0x86, 0xe4, 0x03, 0xb4, 0x59, 0x19,
+	0x8b, 0x75, 0xa5, 0x0a, 0x5e, 0x86, 0xd1, 0x2a, 0xe3, 0x9d, 0xe3, 0x1e, 0x1a, 0xb4, 0x46, 0x4f,
+	0x76, 0x3f, 0xe7, 0xdd, 0xab, 0xce, 0x2a, 0xe3, 0xb4, 0xc5, 0xf6, 0xde, 0xc4, 0x01, 0x1c, 0xf3,
+	0xdb, 0x4a, 0x30, 0x29, 0xef, 0x03, 0x4e, 0x74, 0xc0, 0xd3, 0x5d, 0x80, 0xfb, 0x4f, 0xd6, 0x09,
+	0xed, 0x78, 0x9f, 0x38, 0x77, 0xa1, 0x7d, 0xe0, 0x21, 0x4d, 0x68, 0xf8, 0x53, 0xdf, 0xc3, 0x47,
+	0xe4, 0x31, 0xb4, 0x2f, 0x82, 0xab, 0x59, 0x38, 0xf6, 0xe6, 0x8b, 0x70, 0x3e, 0xf3, 0xbc, 0x09,
+	0x46, 0xb5, 0x1c, 0xcc, 0x17, 0x13, 0x6c, 0x90, 0x53, 0x30, 0xaf, 0x83, 0xb7, 0xd8, 0x3c, 0x7f,
+	0x07, 0xad, 0xfd, 0x4d, 0xc9, 0x73, 0x78, 0xe6, 0xf9, 0x2e, 0xfd, 0x3a, 0x5b, 0x5c, 0x4d, 0xfd,
+	0xd0, 0xb9, 0xbe, 0x98, 0x86, 0x9f, 0xfd, 0x4f, 0xfe, 0xf4, 0x8b, 0x8f, 0x8f, 0x88, 0x05, 0x4d,
+	0xf7, 0xd2, 0x71, 0x2f, 0x9d, 0xd1, 0x6b, 0x8c, 0xc6, 0x1f, 0x7f, 0x6e, 0xba, 0xe8, 0xd7, 0xa6,
+	0x8b, 0xfe, 0x6c, 0xba, 0x28, 0x78, 0x9f, 0x15, 0x2a, 0xbf, 0x5b, 0x0e, 0x63, 0x7e, 0x6b, 0x57,
+	0x51, 0x9c, 0xaf, 0x13, 0x26, 0xfe, 0x47, 0xdf, 0x46, 0xb6, 0x14, 0xb1, 0xfd, 0xf0, 0xfd, 0x2c,
+	0x4f, 0xf4, 0xe9, 0xbc, 0xf9, 0x3b, 0x00, 0x96, 0xcb, 0x00, 0xa0, 0x60, 0x02, 0x00, 0x00,
 }
 
 func (m *DataRef) Marshal() (dAtA []byte, err error) {
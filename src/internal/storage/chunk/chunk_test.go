@@ -2,6 +2,7 @@ package chunk
 
 import (
 	"bytes"
+	"io/ioutil"
 	"math/rand"
 	"testing"
 	"time"
@@ -40,6 +41,57 @@ import (
 //	require.NoError(t, err)
 //}
 
+func TestComputeChunksParams(t *testing.T) {
+	random := rand.New(rand.NewSource(0))
+	data := randutil.Bytes(random, 10*units.MB)
+	params := ChunkingParams{
+		AverageBits:  16,
+		MinChunkSize: 32 * units.KB,
+		MaxChunkSize: 128 * units.KB,
+	}
+	require.NoError(t, params.Validate())
+	var chunks [][]byte
+	require.NoError(t, ComputeChunks(bytes.NewReader(data), params, func(chunk []byte) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}))
+	for i, chunk := range chunks {
+		require.True(t, len(chunk) <= params.MaxChunkSize)
+		if i < len(chunks)-1 {
+			require.True(t, len(chunk) >= params.MinChunkSize)
+		}
+	}
+	require.True(t, len(chunks) > len(data)/params.MaxChunkSize)
+	require.Equal(t, data, bytes.Join(chunks, nil))
+	require.YesError(t, ChunkingParams{AverageBits: 16, MinChunkSize: 2, MaxChunkSize: 1}.Validate())
+	require.YesError(t, ChunkingParams{AverageBits: 16, MinChunkSize: 1, MaxChunkSize: MaxChunkSizeLimit + 1}.Validate())
+	require.YesError(t, ChunkingParams{AverageBits: 40, MinChunkSize: 1, MaxChunkSize: MaxChunkSizeLimit}.Validate())
+}
+
+func TestCompression(t *testing.T) {
+	random := rand.New(rand.NewSource(0))
+	compressible := bytes.Repeat([]byte("pachyderm"), 100*units.KB)
+	incompressible := randutil.Bytes(random, 100*units.KB)
+	for _, algo := range []CompressionAlgo{CompressionAlgo_NONE, CompressionAlgo_GZIP_BEST_SPEED, CompressionAlgo_ZSTD, CompressionAlgo_LZ4} {
+		for _, level := range []int{0, 1, 19} {
+			for _, data := range [][]byte{compressible, incompressible} {
+				buf := make([]byte, len(data))
+				used, n, err := compress(algo, level, buf, data)
+				require.NoError(t, err)
+				if algo != CompressionAlgo_NONE && bytes.Equal(data, compressible) {
+					require.Equal(t, algo, used)
+					require.True(t, n < len(data))
+				}
+				r, err := decompress(used, bytes.NewReader(buf[:n]))
+				require.NoError(t, err)
+				actual, err := ioutil.ReadAll(r)
+				require.NoError(t, err)
+				require.Equal(t, data, actual)
+			}
+		}
+	}
+}
+
 func BenchmarkRollingHash(b *testing.B) {
 	seed := time.Now().UTC().UnixNano()
 	random := rand.New(rand.NewSource(seed))
@@ -67,7 +119,7 @@ func BenchmarkComputeChunks(b *testing.B) {
 	b.SetBytes(int64(len(data)))
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		require.NoError(b, ComputeChunks(bytes.NewReader(data), func(_ []byte) error { return nil }))
+		require.NoError(b, ComputeChunks(bytes.NewReader(data), DefaultChunkingParams(), func(_ []byte) error { return nil }))
 	}
 }
 
@@ -22,15 +22,61 @@ const (
 	DefaultSeed         = 1
 	DefaultMinChunkSize = 1 * units.MB
 	DefaultMaxChunkSize = 20 * units.MB
+	// MaxChunkSizeLimit is the largest chunk that chunking parameters may
+	// produce. Chunks are held in memory when they are uploaded and read, so
+	// this bounds the memory used per chunk.
+	MaxChunkSizeLimit = 64 * units.MB
 )
 
-// TODO: Expose configuration.
-func ComputeChunks(r io.Reader, cb func([]byte) error) error {
+// ChunkingParams are the parameters for content-defined chunking.
+type ChunkingParams struct {
+	// AverageBits determines the average chunk size, which is 2^AverageBits bytes.
+	AverageBits int
+	// MinChunkSize is the size below which a chunk boundary is ignored.
+	MinChunkSize int
+	// MaxChunkSize is the size at which a chunk is cut regardless of its content.
+	MaxChunkSize int
+}
+
+// DefaultChunkingParams returns the default chunking parameters.
+func DefaultChunkingParams() ChunkingParams {
+	return ChunkingParams{
+		AverageBits:  DefaultAverageBits,
+		MinChunkSize: DefaultMinChunkSize,
+		MaxChunkSize: DefaultMaxChunkSize,
+	}
+}
+
+// Validate returns an error if the chunking parameters are invalid.
+func (p ChunkingParams) Validate() error {
+	if p.AverageBits < 1 || p.AverageBits > 63 {
+		return errors.Errorf("average bits (%d) must be between 1 and 63", p.AverageBits)
+	}
+	if avg := uint64(1) << uint(p.AverageBits); avg > MaxChunkSizeLimit {
+		return errors.Errorf("average chunk size (%d) cannot be greater than %d bytes", avg, MaxChunkSizeLimit)
+	}
+	if p.MinChunkSize < 0 {
+		return errors.Errorf("min chunk size (%d) cannot be negative", p.MinChunkSize)
+	}
+	if p.MaxChunkSize <= 0 {
+		return errors.Errorf("max chunk size (%d) must be positive", p.MaxChunkSize)
+	}
+	if p.MaxChunkSize > MaxChunkSizeLimit {
+		return errors.Errorf("max chunk size (%d) cannot be greater than %d bytes", p.MaxChunkSize, MaxChunkSizeLimit)
+	}
+	if p.MinChunkSize > p.MaxChunkSize {
+		return errors.Errorf("min chunk size (%d) cannot be greater than max chunk size (%d)", p.MinChunkSize, p.MaxChunkSize)
+	}
+	return nil
+}
+
+// ComputeChunks splits the content of r into chunks with the provided parameters.
+func ComputeChunks(r io.Reader, params ChunkingParams, cb func([]byte) error) error {
 	buf := make([]byte, units.MB)
 	var chunkBuf []byte
 	hash := buzhash64.NewFromUint64Array(buzhash64.GenerateHashes(DefaultSeed))
 	resetHash(hash)
-	splitMask := uint64((1 << uint64(DefaultAverageBits)) - 1)
+	splitMask := uint64((1 << uint64(params.AverageBits)) - 1)
 	for {
 		n, err := r.Read(buf)
 		if err != nil && !errors.Is(err, io.EOF) {
@@ -39,7 +85,7 @@ func ComputeChunks(r io.Reader, cb func([]byte) error) error {
 		data := buf[:n]
 		for _, b := range data {
 			chunkBuf = append(chunkBuf, b)
-			if len(chunkBuf) >= DefaultMaxChunkSize {
+			if len(chunkBuf) >= params.MaxChunkSize {
 				if err := cb(chunkBuf); err != nil {
 					return err
 				}
@@ -49,7 +95,7 @@ func ComputeChunks(r io.Reader, cb func([]byte) error) error {
 			}
 			hash.Roll(b)
 			if hash.Sum64()&splitMask == 0 {
-				if len(chunkBuf) < DefaultMinChunkSize {
+				if len(chunkBuf) < params.MinChunkSize {
 					continue
 				}
 				if err := cb(chunkBuf); err != nil {
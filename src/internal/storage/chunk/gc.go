@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/sirupsen/logrus"
 )
 
@@ -86,9 +88,13 @@ func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen ui
 }
 
 func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uint64) error {
-	_, err := gc.s.db.ExecContext(ctx, `
-	DELETE FROM storage.chunk_objects
-	WHERE chunk_id = $1 AND gen = $2 AND tombstone = TRUE
-	`, chunkID, gen)
-	return errors.EnsureStack(err)
+	return dbutil.WithTx(ctx, gc.s.db, func(tx *pachsql.Tx) error {
+		if _, err := tx.Exec(`
+		DELETE FROM storage.chunk_objects
+		WHERE chunk_id = $1 AND gen = $2 AND tombstone = TRUE
+		`, chunkID, gen); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return deleteDEKTx(tx, chunkID)
+	})
 }
@@ -0,0 +1,192 @@
+package chunk
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// KEKProvider provides versioned key encryption keys (KEKs), which are used to wrap
+// the data encryption keys (DEKs) of chunks.
+// The KEK material never has to leave the provider.
+type KEKProvider interface {
+	// CurrentVersion returns the version of the key encryption key used by Wrap.
+	CurrentVersion(ctx context.Context) (int, error)
+	// Wrap encrypts dek with the current version of the key encryption key.
+	Wrap(ctx context.Context, dek []byte) (wrapped []byte, version int, _ error)
+	// Unwrap decrypts a dek which was wrapped with version of the key encryption key.
+	Unwrap(ctx context.Context, wrapped []byte, version int) ([]byte, error)
+	// Rotate creates a new version of the key encryption key, which becomes the current version.
+	Rotate(ctx context.Context) (int, error)
+}
+
+// SetupPostgresDEKStoreV0 sets up the table which stores the wrapped data encryption keys.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresDEKStoreV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE storage.chunk_deks (
+		chunk_id BYTEA NOT NULL,
+		key_version INT NOT NULL,
+		wrapped_dek BYTEA NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+		PRIMARY KEY(chunk_id)
+	);
+	CREATE INDEX ON storage.chunk_deks (key_version);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// DEKStore stores the data encryption keys of chunks, wrapped by a KEKProvider.
+// Chunks are content addressed, and their data encryption keys are derived from their
+// content, so there is at most one key per chunk.
+type DEKStore struct {
+	db  *pachsql.DB
+	kek KEKProvider
+}
+
+// NewDEKStore creates a DEKStore which stores keys in db, wrapped by kek.
+func NewDEKStore(db *pachsql.DB, kek KEKProvider) *DEKStore {
+	return &DEKStore{db: db, kek: kek}
+}
+
+// Put wraps and stores the data encryption key for a chunk, if it is not already stored.
+func (ds *DEKStore) Put(ctx context.Context, chunkID ID, dek []byte) error {
+	wrapped, version, err := ds.kek.Wrap(ctx, dek)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = ds.db.ExecContext(ctx, `
+	INSERT INTO storage.chunk_deks (chunk_id, key_version, wrapped_dek)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (chunk_id) DO NOTHING
+	`, chunkID, version, wrapped)
+	return errors.EnsureStack(err)
+}
+
+// Get returns the unwrapped data encryption key for a chunk.
+func (ds *DEKStore) Get(ctx context.Context, chunkID ID) ([]byte, error) {
+	var ent dekEntry
+	if err := ds.db.GetContext(ctx, &ent, `
+	SELECT chunk_id, key_version, wrapped_dek
+	FROM storage.chunk_deks
+	WHERE chunk_id = $1
+	`, chunkID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Errorf("no data encryption key for chunk %v", chunkID)
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	dek, err := ds.kek.Unwrap(ctx, ent.WrappedDEK, ent.KeyVersion)
+	return dek, errors.EnsureStack(err)
+}
+
+// KeyVersions returns the number of chunks which reference each version of the key encryption key.
+func (ds *DEKStore) KeyVersions(ctx context.Context) (map[int]int64, error) {
+	var rows []struct {
+		KeyVersion int   `db:"key_version"`
+		Count      int64 `db:"count"`
+	}
+	if err := ds.db.SelectContext(ctx, &rows, `
+	SELECT key_version, count(*) AS count
+	FROM storage.chunk_deks
+	GROUP BY key_version
+	`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	versions := make(map[int]int64)
+	for _, row := range rows {
+		versions[row.KeyVersion] = row.Count
+	}
+	return versions, nil
+}
+
+// CurrentVersion returns the current version of the key encryption key.
+func (ds *DEKStore) CurrentVersion(ctx context.Context) (int, error) {
+	version, err := ds.kek.CurrentVersion(ctx)
+	return version, errors.EnsureStack(err)
+}
+
+// Rotate creates a new version of the key encryption key and then re-wraps all of the
+// data encryption keys which were wrapped with an older version.
+// The chunk data is not rewritten. It returns the new version and the number of re-wrapped keys.
+func (ds *DEKStore) Rotate(ctx context.Context) (int, int64, error) {
+	version, err := ds.kek.Rotate(ctx)
+	if err != nil {
+		return 0, 0, errors.EnsureStack(err)
+	}
+	n, err := ds.Rewrap(ctx)
+	return version, n, err
+}
+
+// Rewrap re-wraps the data encryption keys which were not wrapped with the current version
+// of the key encryption key. It returns the number of re-wrapped keys.
+func (ds *DEKStore) Rewrap(ctx context.Context) (int64, error) {
+	const batchSize = 100
+	version, err := ds.kek.CurrentVersion(ctx)
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	var count int64
+	last := []byte{}
+	for {
+		var ents []dekEntry
+		if err := ds.db.SelectContext(ctx, &ents, `
+		SELECT chunk_id, key_version, wrapped_dek
+		FROM storage.chunk_deks
+		WHERE chunk_id > $1 AND key_version <> $2
+		ORDER BY chunk_id
+		LIMIT $3
+		`, last, version, batchSize); err != nil {
+			return count, errors.EnsureStack(err)
+		}
+		for _, ent := range ents {
+			dek, err := ds.kek.Unwrap(ctx, ent.WrappedDEK, ent.KeyVersion)
+			if err != nil {
+				return count, errors.EnsureStack(err)
+			}
+			wrapped, newVersion, err := ds.kek.Wrap(ctx, dek)
+			if err != nil {
+				return count, errors.EnsureStack(err)
+			}
+			// The key version is checked so that a concurrent re-wrap is not overwritten.
+			res, err := ds.db.ExecContext(ctx, `
+			UPDATE storage.chunk_deks
+			SET key_version = $1, wrapped_dek = $2
+			WHERE chunk_id = $3 AND key_version = $4
+			`, newVersion, wrapped, ent.ChunkID, ent.KeyVersion)
+			if err != nil {
+				return count, errors.EnsureStack(err)
+			}
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return count, errors.EnsureStack(err)
+			}
+			count += affected
+		}
+		if len(ents) < batchSize {
+			return count, nil
+		}
+		last = ents[len(ents)-1].ChunkID
+	}
+}
+
+// deleteDEKTx deletes the data encryption key of a chunk once there are no more objects for the chunk.
+func deleteDEKTx(tx *pachsql.Tx, chunkID ID) error {
+	_, err := tx.Exec(`
+	DELETE FROM storage.chunk_deks
+	WHERE chunk_id = $1 AND NOT EXISTS (
+		SELECT 1 FROM storage.chunk_objects WHERE chunk_id = $1
+	)
+	`, chunkID)
+	return errors.EnsureStack(err)
+}
+
+type dekEntry struct {
+	ChunkID    ID     `db:"chunk_id"`
+	KeyVersion int    `db:"key_version"`
+	WrappedDEK []byte `db:"wrapped_dek"`
+}
@@ -0,0 +1,199 @@
+package chunk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+type localKEKFile struct {
+	// Keys maps the key versions to the key material.
+	Keys map[string][]byte `json:"keys"`
+}
+
+type localKEKProvider struct {
+	path string
+	mu   sync.Mutex
+	keys map[int][]byte
+}
+
+// NewLocalKEKProvider creates a KEKProvider backed by a local key file.
+// The key file must exist and contain at least one key, see InitLocalKEKFile.
+// The key file is reloaded when a key version is not known, so keys rotated by another
+// process which shares the key file can be used.
+func NewLocalKEKProvider(path string) (KEKProvider, error) {
+	p := &localKEKProvider{path: path}
+	if err := p.load(); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errors.Errorf("key encryption key file %s does not exist, it must be created with pachd --mode init-kek", path)
+		}
+		return nil, err
+	}
+	if len(p.keys) == 0 {
+		return nil, errors.Errorf("key encryption key file %s has no keys", path)
+	}
+	return p, nil
+}
+
+// InitLocalKEKFile creates a local key file with a random key. It returns
+// false, without modifying the key file, if the key file already exists.
+func InitLocalKEKFile(path string) (bool, error) {
+	p := &localKEKProvider{path: path}
+	if err := p.load(); err == nil {
+		return false, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	p.keys = make(map[int][]byte)
+	if _, err := p.rotate(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (p *localKEKProvider) CurrentVersion(_ context.Context) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.currentVersion(), nil
+}
+
+func (p *localKEKProvider) Wrap(_ context.Context, dek []byte) ([]byte, int, error) {
+	p.mu.Lock()
+	version := p.currentVersion()
+	key := p.keys[version]
+	p.mu.Unlock()
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, 0, errors.EnsureStack(err)
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dek)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, 0, errors.EnsureStack(err)
+	}
+	return aead.Seal(nonce, nonce, dek, nil), version, nil
+}
+
+func (p *localKEKProvider) Unwrap(_ context.Context, wrapped []byte, version int) ([]byte, error) {
+	key, err := p.key(version)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.Errorf("wrapped data encryption key is too short")
+	}
+	nonce, ctext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dek, err := aead.Open(nil, nonce, ctext, nil)
+	return dek, errors.EnsureStack(err)
+}
+
+func (p *localKEKProvider) Rotate(_ context.Context) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// Reload first, so that keys added by another process are not dropped.
+	if err := p.load(); err != nil {
+		return 0, err
+	}
+	return p.rotate()
+}
+
+func (p *localKEKProvider) key(version int) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.keys[version]; ok {
+		return key, nil
+	}
+	if err := p.load(); err != nil {
+		return nil, err
+	}
+	key, ok := p.keys[version]
+	if !ok {
+		return nil, errors.Errorf("key encryption key version %d not found in %s", version, p.path)
+	}
+	return key, nil
+}
+
+func (p *localKEKProvider) currentVersion() int {
+	var version int
+	for v := range p.keys {
+		if v > version {
+			version = v
+		}
+	}
+	return version
+}
+
+func (p *localKEKProvider) rotate() (int, error) {
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	version := p.currentVersion() + 1
+	p.keys[version] = key
+	if err := p.store(); err != nil {
+		delete(p.keys, version)
+		return 0, err
+	}
+	return version, nil
+}
+
+func (p *localKEKProvider) load() error {
+	data, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	var f localKEKFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return errors.Wrapf(err, "parsing key file %s", p.path)
+	}
+	keys := make(map[int][]byte)
+	for v, key := range f.Keys {
+		version, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parsing key version %q in %s", v, p.path)
+		}
+		if len(key) != chacha20poly1305.KeySize {
+			return errors.Errorf("key version %d in %s has size %d, expected %d", version, p.path, len(key), chacha20poly1305.KeySize)
+		}
+		keys[version] = key
+	}
+	p.keys = keys
+	return nil
+}
+
+// store writes the keys to a temporary file, then renames it, so the key file is never partially written.
+func (p *localKEKProvider) store() error {
+	f := localKEKFile{Keys: make(map[string][]byte)}
+	for version, key := range p.keys {
+		f.Keys[strconv.Itoa(version)] = key
+	}
+	data, err := json.Marshal(f)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(p.path), filepath.Base(p.path)+".tmp")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.EnsureStack(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// TempFile creates the file with mode 0600.
+	return errors.EnsureStack(os.Rename(tmp.Name(), p.path))
+}
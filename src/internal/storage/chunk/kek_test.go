@@ -0,0 +1,183 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	units "github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+)
+
+func TestLocalKEKProvider(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "keys.json")
+	// The key file is not created implicitly.
+	_, err := NewLocalKEKProvider(path)
+	require.YesError(t, err)
+	created, err := InitLocalKEKFile(path)
+	require.NoError(t, err)
+	require.True(t, created)
+	created, err = InitLocalKEKFile(path)
+	require.NoError(t, err)
+	require.False(t, created)
+	kek, err := NewLocalKEKProvider(path)
+	require.NoError(t, err)
+	version, err := kek.CurrentVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	dek := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, version, err := kek.Wrap(ctx, dek)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	require.False(t, bytes.Contains(wrapped, dek))
+	// A second provider sharing the key file sees the rotated key.
+	kek2, err := NewLocalKEKProvider(path)
+	require.NoError(t, err)
+	version, err = kek.Rotate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+	wrapped2, version, err := kek.Wrap(ctx, dek)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+	for v, w := range map[int][]byte{1: wrapped, 2: wrapped2} {
+		unwrapped, err := kek2.Unwrap(ctx, w, v)
+		require.NoError(t, err)
+		require.Equal(t, dek, unwrapped)
+	}
+	_, err = kek2.Unwrap(ctx, wrapped2, 1)
+	require.YesError(t, err)
+	_, err = kek2.Unwrap(ctx, wrapped2, 3)
+	require.YesError(t, err)
+}
+
+func TestVaultKEKProvider(t *testing.T) {
+	ctx := context.Background()
+	vault := newFakeVaultTransit(t, "pachyderm")
+	kek := NewVaultKEKProvider(vault.URL, "test-token", "transit", "pachyderm")
+	dek := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, version, err := kek.Wrap(ctx, dek)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	version, err = kek.Rotate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+	unwrapped, err := kek.Unwrap(ctx, wrapped, 1)
+	require.NoError(t, err)
+	require.Equal(t, dek, unwrapped)
+	_, err = kek.Unwrap(ctx, wrapped, 2)
+	require.YesError(t, err)
+	_, _, err = NewVaultKEKProvider(vault.URL, "wrong-token", "transit", "pachyderm").Wrap(ctx, dek)
+	require.YesError(t, err)
+}
+
+func TestDEKStoreRotate(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	kek, err := NewLocalKEKProvider(filepath.Join(t.TempDir(), "keys.json"))
+	require.NoError(t, err)
+	_, s := NewTestStorage(t, db, tracker, WithSecret([]byte("secret")), WithKEKProvider(kek))
+	data := randutil.Bytes(rand.New(rand.NewSource(0)), 10*units.MB)
+	var dataRefs []*DataRef
+	u := s.NewUploader(ctx, "test-writer", false, func(_ interface{}, refs []*DataRef) error {
+		dataRefs = append(dataRefs, refs...)
+		return nil
+	})
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	for _, dataRef := range dataRefs {
+		require.Equal(t, 0, len(dataRef.Ref.Dek))
+	}
+	checkData := func() {
+		buf := &bytes.Buffer{}
+		require.NoError(t, s.NewReader(ctx, dataRefs).Get(buf))
+		require.True(t, bytes.Equal(data, buf.Bytes()))
+	}
+	checkData()
+	versions, err := s.DEKs().KeyVersions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(versions))
+	chunks := versions[1]
+	require.True(t, chunks > 0)
+	version, n, err := s.DEKs().Rotate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+	require.Equal(t, chunks, n)
+	versions, err = s.DEKs().KeyVersions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[int]int64{2: chunks}, versions)
+	// Clear the cache, so the chunks are decrypted with the re-wrapped keys.
+	s.memCache = kv.NewMemCache(10)
+	checkData()
+}
+
+type fakeVaultTransit struct {
+	*httptest.Server
+	mu   sync.Mutex
+	keys [][]byte
+}
+
+// newFakeVaultTransit creates a fake vault transit secrets engine, which "encrypts" by
+// XORing with the versioned key.
+func newFakeVaultTransit(t testing.TB, name string) *fakeVaultTransit {
+	v := &fakeVaultTransit{keys: [][]byte{[]byte("key-1")}}
+	v.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string]interface{}{"errors": []string{"permission denied"}})
+			return
+		}
+		var req map[string]string
+		json.NewDecoder(r.Body).Decode(&req)
+		var data map[string]interface{}
+		switch r.URL.Path {
+		case "/v1/transit/keys/" + name:
+			data = map[string]interface{}{"latest_version": len(v.keys)}
+		case "/v1/transit/keys/" + name + "/rotate":
+			v.keys = append(v.keys, []byte(fmt.Sprintf("key-%d", len(v.keys)+1)))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case "/v1/transit/encrypt/" + name:
+			ptext, _ := base64.StdEncoding.DecodeString(req["plaintext"])
+			version := len(v.keys)
+			ctext := xorKey(ptext, v.keys[version-1])
+			data = map[string]interface{}{"ciphertext": fmt.Sprintf("vault:v%d:%s", version, base64.StdEncoding.EncodeToString(ctext))}
+		case "/v1/transit/decrypt/" + name:
+			parts := strings.SplitN(req["ciphertext"], ":", 3)
+			var version int
+			fmt.Sscanf(parts[1], "v%d", &version)
+			ctext, _ := base64.StdEncoding.DecodeString(parts[2])
+			data = map[string]interface{}{"plaintext": base64.StdEncoding.EncodeToString(xorKey(ctext, v.keys[version-1]))}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(v.Server.Close)
+	return v
+}
+
+func xorKey(data, key []byte) []byte {
+	out := make([]byte, len(data))
+	for i := range data {
+		out[i] = data[i] ^ key[i%len(key)]
+	}
+	return out
+}
@@ -0,0 +1,142 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+type vaultKEKProvider struct {
+	address string
+	token   string
+	mount   string
+	key     string
+	client  *http.Client
+}
+
+// NewVaultKEKProvider creates a KEKProvider backed by a key in a Vault transit secrets engine.
+// The key material never leaves Vault, data encryption keys are sent to Vault to be wrapped and unwrapped.
+func NewVaultKEKProvider(address, token, mount, key string) KEKProvider {
+	return &vaultKEKProvider{
+		address: strings.TrimSuffix(address, "/"),
+		token:   token,
+		mount:   strings.Trim(mount, "/"),
+		key:     key,
+		client:  http.DefaultClient,
+	}
+}
+
+func (p *vaultKEKProvider) CurrentVersion(ctx context.Context) (int, error) {
+	var res struct {
+		Data struct {
+			LatestVersion int `json:"latest_version"`
+		} `json:"data"`
+	}
+	if err := p.do(ctx, http.MethodGet, "keys/"+p.key, nil, &res); err != nil {
+		return 0, err
+	}
+	return res.Data.LatestVersion, nil
+}
+
+func (p *vaultKEKProvider) Wrap(ctx context.Context, dek []byte) ([]byte, int, error) {
+	req := map[string]interface{}{
+		"plaintext": base64.StdEncoding.EncodeToString(dek),
+	}
+	var res struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	if err := p.do(ctx, http.MethodPost, "encrypt/"+p.key, req, &res); err != nil {
+		return nil, 0, err
+	}
+	version, err := parseVaultCiphertextVersion(res.Data.Ciphertext)
+	if err != nil {
+		return nil, 0, err
+	}
+	return []byte(res.Data.Ciphertext), version, nil
+}
+
+func (p *vaultKEKProvider) Unwrap(ctx context.Context, wrapped []byte, version int) ([]byte, error) {
+	wrappedVersion, err := parseVaultCiphertextVersion(string(wrapped))
+	if err != nil {
+		return nil, err
+	}
+	if wrappedVersion != version {
+		return nil, errors.Errorf("wrapped data encryption key has key version %d, expected %d", wrappedVersion, version)
+	}
+	req := map[string]interface{}{
+		"ciphertext": string(wrapped),
+	}
+	var res struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	if err := p.do(ctx, http.MethodPost, "decrypt/"+p.key, req, &res); err != nil {
+		return nil, err
+	}
+	dek, err := base64.StdEncoding.DecodeString(res.Data.Plaintext)
+	return dek, errors.EnsureStack(err)
+}
+
+func (p *vaultKEKProvider) Rotate(ctx context.Context) (int, error) {
+	if err := p.do(ctx, http.MethodPost, "keys/"+p.key+"/rotate", nil, nil); err != nil {
+		return 0, err
+	}
+	return p.CurrentVersion(ctx)
+}
+
+func (p *vaultKEKProvider) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body bytes.Buffer
+	if in != nil {
+		if err := json.NewEncoder(&body).Encode(in); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	url := fmt.Sprintf("%s/v1/%s/%s", p.address, p.mount, path)
+	req, err := http.NewRequestWithContext(ctx, method, url, &body)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var res struct {
+			Errors []string `json:"errors"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil || len(res.Errors) == 0 {
+			return errors.Errorf("vault request %s %s failed with status %d", method, url, resp.StatusCode)
+		}
+		return errors.Errorf("vault request %s %s failed with status %d: %s", method, url, resp.StatusCode, strings.Join(res.Errors, "; "))
+	}
+	if out == nil {
+		return nil
+	}
+	return errors.EnsureStack(json.NewDecoder(resp.Body).Decode(out))
+}
+
+// parseVaultCiphertextVersion parses the key version from a ciphertext of the form "vault:v<version>:<ciphertext>".
+func parseVaultCiphertextVersion(ciphertext string) (int, error) {
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" || !strings.HasPrefix(parts[1], "v") {
+		return 0, errors.Errorf("malformed vault ciphertext")
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if err != nil {
+		return 0, errors.Wrapf(err, "malformed vault ciphertext version %q", parts[1])
+	}
+	return version, nil
+}
@@ -3,7 +3,9 @@ package chunk
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -41,9 +43,50 @@ func WithCompression(algo CompressionAlgo) StorageOption {
 	}
 }
 
+// WithCompressionLevel sets the level used by compression algorithms which support levels.
+func WithCompressionLevel(level int) StorageOption {
+	return func(s *Storage) {
+		s.createOpts.CompressionLevel = level
+	}
+}
+
+// WithKEKProvider sets the provider of the key encryption keys used to wrap the data encryption keys
+// of new chunks. The wrapped keys are stored in the database, rather than in the chunk references.
+func WithKEKProvider(kek KEKProvider) StorageOption {
+	return func(s *Storage) {
+		s.createOpts.DEKs = NewDEKStore(s.db, kek)
+	}
+}
+
+// ParseCompressionAlgo parses the name of a compression algorithm (case insensitive).
+func ParseCompressionAlgo(name string) (CompressionAlgo, error) {
+	algo, ok := CompressionAlgo_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, errors.Errorf("unrecognized compression algorithm %q", name)
+	}
+	return CompressionAlgo(algo), nil
+}
+
 // StorageOptions returns the chunk storage options for the config.
 func StorageOptions(conf *serviceenv.StorageConfiguration) ([]StorageOption, error) {
 	var opts []StorageOption
+	if conf.StorageCompression != "" {
+		algo, err := ParseCompressionAlgo(conf.StorageCompression)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithCompression(algo))
+	}
+	if conf.StorageCompressionLevel != 0 {
+		opts = append(opts, WithCompressionLevel(conf.StorageCompressionLevel))
+	}
+	if conf.StorageKEKProvider != "" {
+		kek, err := newKEKProvider(conf)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithKEKProvider(kek))
+	}
 	if conf.StorageUploadConcurrencyLimit > 0 {
 		opts = append(opts, WithMaxConcurrentObjects(0, conf.StorageUploadConcurrencyLimit))
 	}
@@ -58,6 +101,33 @@ func StorageOptions(conf *serviceenv.StorageConfiguration) ([]StorageOption, err
 	return opts, nil
 }
 
+func newKEKProvider(conf *serviceenv.StorageConfiguration) (KEKProvider, error) {
+	switch strings.ToLower(conf.StorageKEKProvider) {
+	case "local":
+		if conf.StorageKEKLocalPath == "" {
+			return nil, errors.Errorf("the local key encryption key provider requires a key file path")
+		}
+		return NewLocalKEKProvider(conf.StorageKEKLocalPath)
+	case "vault":
+		if conf.StorageKEKVaultAddress == "" {
+			return nil, errors.Errorf("the vault key encryption key provider requires a vault address")
+		}
+		return NewVaultKEKProvider(conf.StorageKEKVaultAddress, conf.StorageKEKVaultToken, conf.StorageKEKVaultMount, conf.StorageKEKVaultKey), nil
+	default:
+		return nil, errors.Errorf("unrecognized key encryption key provider %q", conf.StorageKEKProvider)
+	}
+}
+
+// UploaderOption configures an uploader.
+type UploaderOption func(u *Uploader)
+
+// WithChunkingParams sets the parameters used to split uploaded content into chunks.
+func WithChunkingParams(params ChunkingParams) UploaderOption {
+	return func(u *Uploader) {
+		u.params = params
+	}
+}
+
 type BatcherOption func(b *Batcher)
 
 func WithChunkCallback(cb ChunkFunc) BatcherOption {
@@ -43,9 +43,6 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *pachsql.DB, tracker tra
 		memCache:      memCache,
 		deduper:       &miscutil.WorkDeduper{},
 		prefetchLimit: defaultPrefetchLimit,
-		createOpts: CreateOptions{
-			Compression: CompressionAlgo_GZIP_BEST_SPEED,
-		},
 	}
 	for _, opt := range opts {
 		opt(s)
@@ -58,7 +55,13 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *pachsql.DB, tracker tra
 // NewReader creates a new Reader.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
 	client := NewClient(s.store, s.db, s.tracker, nil)
-	return newReader(ctx, client, s.memCache, s.deduper, s.prefetchLimit, dataRefs, opts...)
+	return newReader(ctx, client, s.createOpts.DEKs, s.memCache, s.deduper, s.prefetchLimit, dataRefs, opts...)
+}
+
+// DEKs returns the store for the wrapped data encryption keys of chunks.
+// It is nil if no key encryption key provider is configured.
+func (s *Storage) DEKs() *DEKStore {
+	return s.createOpts.DEKs
 }
 
 // List lists all of the chunks in object storage.
@@ -68,6 +71,38 @@ func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
 	}))
 }
 
+// ObjectSizes returns the total size of the chunk objects, which have not been deleted, for the chunks.
+func (s *Storage) ObjectSizes(ctx context.Context, ids []ID) (int64, error) {
+	chunkIDs := make([][]byte, len(ids))
+	for i, id := range ids {
+		chunkIDs[i] = id
+	}
+	var size int64
+	if err := s.db.GetContext(ctx, &size, `
+	SELECT COALESCE(SUM(size), 0) FROM storage.chunk_objects
+	WHERE chunk_id = ANY($1) AND tombstone = FALSE
+	`, chunkIDs); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	return size, nil
+}
+
+// PendingDeletion returns the number and total size of the chunk objects which are no longer tracked,
+// and will be deleted by the next cycle of chunk garbage collection.
+func (s *Storage) PendingDeletion(ctx context.Context) (count, sizeBytes int64, _ error) {
+	var res struct {
+		Count     int64 `db:"count"`
+		SizeBytes int64 `db:"size"`
+	}
+	if err := s.db.GetContext(ctx, &res, `
+	SELECT count(*) AS count, COALESCE(SUM(size), 0) AS size FROM storage.chunk_objects
+	WHERE tombstone = TRUE
+	`); err != nil {
+		return 0, 0, errors.EnsureStack(err)
+	}
+	return res.Count, res.SizeBytes, nil
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
@@ -7,10 +7,13 @@ import (
 	"crypto/cipher"
 	io "io"
 	"io/ioutil"
+	"sync"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pierrec/lz4/v4"
 	"golang.org/x/crypto/chacha20"
 )
 
@@ -18,13 +21,19 @@ import (
 type CreateOptions struct {
 	Secret      []byte
 	Compression CompressionAlgo
+	// CompressionLevel is the level used by compression algorithms which support levels (zstd).
+	// Zero means the default level of the algorithm.
+	CompressionLevel int
+	// DEKs stores the data encryption keys of created chunks, wrapped by a key encryption key.
+	// If it is nil, the data encryption keys are stored in the chunk references.
+	DEKs *DEKStore
 }
 
 // Create calls createFunc to create a new chunk, but first compresses, and encrypts ptext.
 // ptext will not be modified.
 func Create(ctx context.Context, opts CreateOptions, ptext []byte, createFunc func(ctx context.Context, data []byte) (ID, error)) (*Ref, error) {
 	buf := make([]byte, len(ptext))
-	compressAlgo, n, err := compress(opts.Compression, buf, ptext)
+	compressAlgo, n, err := compress(opts.Compression, opts.CompressionLevel, buf, ptext)
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +44,12 @@ func Create(ctx context.Context, opts CreateOptions, ptext []byte, createFunc fu
 	if err != nil {
 		return nil, err
 	}
+	if opts.DEKs != nil {
+		if err := opts.DEKs.Put(ctx, id, dek); err != nil {
+			return nil, err
+		}
+		dek = nil
+	}
 	return &Ref{
 		Id:              id,
 		SizeBytes:       int64(len(buf)),
@@ -45,18 +60,29 @@ func Create(ctx context.Context, opts CreateOptions, ptext []byte, createFunc fu
 }
 
 // Get calls client.Get to retrieve a chunk, then verifies, decrypts, and decompresses the data.
+// The data encryption key is looked up in deks if it is not stored in ref.
 // cb is called with the uncompressed plaintext
-func Get(ctx context.Context, client Client, ref *Ref, cb kv.ValueCallback) error {
+func Get(ctx context.Context, client Client, deks *DEKStore, ref *Ref, cb kv.ValueCallback) error {
 	if ref.EncryptionAlgo != EncryptionAlgo_CHACHA20 {
 		return errors.Errorf("unknown encryption algorithm %d", ref.EncryptionAlgo)
 	}
+	dek := ref.Dek
+	if len(dek) == 0 {
+		if deks == nil {
+			return errors.Errorf("chunk %v has a wrapped data encryption key, but no key encryption key provider is configured", ref.Id)
+		}
+		var err error
+		if dek, err = deks.Get(ctx, ref.Id); err != nil {
+			return err
+		}
+	}
 	err := client.Get(ctx, ref.Id, func(ctext []byte) error {
 		if err := verifyData(ref.Id, ctext); err != nil {
 			return err
 		}
 		var r io.Reader = bytes.NewReader(ctext)
 		var err error
-		if r, err = decrypt(ref.Dek, r); err != nil {
+		if r, err = decrypt(dek, r); err != nil {
 			return err
 		}
 		if r, err = decompress(ref.CompressionAlgo, r); err != nil {
@@ -75,7 +101,7 @@ func Get(ctx context.Context, client Client, ref *Ref, cb kv.ValueCallback) erro
 // then no compression is used.
 // compress returns the compression algorithm used (algo or NONE), the number of bytes written to dst
 // or an error
-func compress(algo CompressionAlgo, dst, src []byte) (CompressionAlgo, int, error) {
+func compress(algo CompressionAlgo, level int, dst, src []byte) (CompressionAlgo, int, error) {
 	switch algo {
 	case CompressionAlgo_NONE:
 		copy(dst, src)
@@ -99,9 +125,39 @@ func compress(algo CompressionAlgo, dst, src []byte) (CompressionAlgo, int, erro
 			return errors.EnsureStack(gw.Close())
 		}()
 		if errors.Is(err, io.ErrShortWrite) {
-			return compress(CompressionAlgo_NONE, dst, src)
+			return compress(CompressionAlgo_NONE, 0, dst, src)
 		}
 		return CompressionAlgo_GZIP_BEST_SPEED, lw.pos, err
+	case CompressionAlgo_ZSTD:
+		enc, err := zstdEncoder(level)
+		if err != nil {
+			return 0, 0, err
+		}
+		// EncodeAll only allocates if the output does not fit in dst.
+		out := enc.EncodeAll(src, dst[:0])
+		if len(out) > len(dst) {
+			return compress(CompressionAlgo_NONE, 0, dst, src)
+		}
+		return CompressionAlgo_ZSTD, len(out), nil
+	case CompressionAlgo_LZ4:
+		lw := newLimitWriter(dst)
+		err := func() (retErr error) {
+			zw := lz4.NewWriter(lw)
+			defer func() {
+				if err := zw.Close(); retErr == nil {
+					retErr = err
+				}
+			}()
+			_, err := zw.Write(src)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			return errors.EnsureStack(zw.Close())
+		}()
+		if errors.Is(err, io.ErrShortWrite) {
+			return compress(CompressionAlgo_NONE, 0, dst, src)
+		}
+		return CompressionAlgo_LZ4, lw.pos, err
 	default:
 		return 0, 0, errors.Errorf("unrecognized compression: %v", algo)
 	}
@@ -117,11 +173,55 @@ func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
 			return nil, errors.EnsureStack(err)
 		}
 		return gr, nil
+	case CompressionAlgo_ZSTD:
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		data, err = zstdDecoder.DecodeAll(data, nil)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return bytes.NewReader(data), nil
+	case CompressionAlgo_LZ4:
+		return lz4.NewReader(r), nil
 	default:
 		return nil, errors.Errorf("unrecognized compression: %v", algo)
 	}
 }
 
+var (
+	// zstd encoders and decoders are safe for concurrent use of EncodeAll and DecodeAll,
+	// and are expensive to create, so they are shared.
+	zstdEncoders sync.Map // level -> *zstd.Encoder
+	zstdDecoder  *zstd.Decoder
+)
+
+func init() {
+	var err error
+	if zstdDecoder, err = zstd.NewReader(nil); err != nil {
+		panic(err) // this only happens with invalid options
+	}
+}
+
+// zstdEncoder returns an encoder for the zstd compression level.
+// Level zero is the default level.
+func zstdEncoder(level int) (*zstd.Encoder, error) {
+	if enc, ok := zstdEncoders.Load(level); ok {
+		return enc.(*zstd.Encoder), nil
+	}
+	encLevel := zstd.SpeedDefault
+	if level != 0 {
+		encLevel = zstd.EncoderLevelFromZstd(level)
+	}
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(encLevel))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	actual, _ := zstdEncoders.LoadOrStore(level, enc)
+	return actual.(*zstd.Encoder), nil
+}
+
 type limitWriter struct {
 	buf []byte
 	pos int
@@ -23,31 +23,39 @@ type UploadFunc = func(interface{}, []*DataRef) error
 type Uploader struct {
 	ctx       context.Context
 	client    Client
+	opts      CreateOptions
 	taskChain *TaskChain
 	chunkSem  *semaphore.Weighted
 	noUpload  bool
 	cb        UploadFunc
+	params    ChunkingParams
 }
 
-func (s *Storage) NewUploader(ctx context.Context, name string, noUpload bool, cb UploadFunc) *Uploader {
+func (s *Storage) NewUploader(ctx context.Context, name string, noUpload bool, cb UploadFunc, opts ...UploaderOption) *Uploader {
 	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL))
-	return &Uploader{
+	u := &Uploader{
 		ctx:       ctx,
 		client:    client,
+		opts:      s.createOpts,
 		taskChain: NewTaskChain(ctx, semaphore.NewWeighted(taskParallelism)),
 		chunkSem:  semaphore.NewWeighted(chunkParallelism),
 		noUpload:  noUpload,
 		cb:        cb,
+		params:    DefaultChunkingParams(),
 	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
 }
 
 // TODO: Need to think more about the context / error handling with the nested task chains.
 func (u *Uploader) Upload(meta interface{}, r io.Reader) error {
 	taskChain := NewTaskChain(u.ctx, u.chunkSem)
 	var dataRefs []*DataRef
-	if err := ComputeChunks(r, func(chunkBytes []byte) error {
+	if err := ComputeChunks(r, u.params, func(chunkBytes []byte) error {
 		return taskChain.CreateTask(func(ctx context.Context) (func() error, error) {
-			dataRef, err := upload(ctx, u.client, chunkBytes, nil, u.noUpload)
+			dataRef, err := upload(ctx, u.client, u.opts, chunkBytes, nil, u.noUpload)
 			if err != nil {
 				return nil, err
 			}
@@ -81,7 +89,7 @@ func (u *Uploader) Copy(meta interface{}, dataRefs []*DataRef) error {
 	})
 }
 
-func upload(ctx context.Context, client Client, chunkBytes []byte, pointsTo []ID, noUpload bool) (*DataRef, error) {
+func upload(ctx context.Context, client Client, opts CreateOptions, chunkBytes []byte, pointsTo []ID, noUpload bool) (*DataRef, error) {
 	md := Metadata{
 		Size:     len(chunkBytes),
 		PointsTo: pointsTo,
@@ -94,8 +102,10 @@ func upload(ctx context.Context, client Client, chunkBytes []byte, pointsTo []ID
 		createFunc = func(ctx context.Context, data []byte) (ID, error) {
 			return Hash(data), nil
 		}
+		// Nothing is uploaded, so there is no chunk to store a data encryption key for.
+		opts.DEKs = nil
 	}
-	ref, err := Create(ctx, CreateOptions{}, chunkBytes, createFunc)
+	ref, err := Create(ctx, opts, chunkBytes, createFunc)
 	if err != nil {
 		return nil, err
 	}
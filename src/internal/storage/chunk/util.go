@@ -19,6 +19,9 @@ func NewTestStorage(t testing.TB, db *pachsql.DB, tr track.Tracker, opts ...Stor
 	objC := dockertestenv.NewTestObjClient(t)
 	db.MustExec(`CREATE SCHEMA IF NOT EXISTS storage`)
 	require.NoError(t, dbutil.WithTx(context.Background(), db, SetupPostgresStoreV0))
+	require.NoError(t, dbutil.WithTx(context.Background(), db, func(tx *pachsql.Tx) error {
+		return SetupPostgresDEKStoreV0(context.Background(), tx)
+	}))
 	return objC, NewStorage(objC, kv.NewMemCache(10), db, tr, opts...)
 }
 
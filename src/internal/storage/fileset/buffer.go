@@ -17,6 +17,7 @@ type file struct {
 	path     string
 	datum    string
 	contents []fileContent
+	metadata map[string]string
 }
 
 // contents are either raw bytes to be appended or an existing file to be copied
@@ -59,6 +60,12 @@ func (b *Buffer) Add(path, datum string) io.Writer {
 	return buf
 }
 
+// SetMetadata merges metadata into the metadata of a file.
+func (b *Buffer) SetMetadata(path, datum string, metadata map[string]string) {
+	f := b.add(path, datum)
+	f.metadata = mergeMetadata(f.metadata, metadata)
+}
+
 func (b *Buffer) Delete(path, datum string) {
 	path = Clean(path, IsDir(path))
 	if IsDir(path) {
@@ -92,14 +99,21 @@ func (b *Buffer) Copy(file File, datum string) {
 	f.contents = append(f.contents, fileContent{copy: file})
 }
 
-func (b *Buffer) WalkAdditive(onAdd func(path, datum string, r io.Reader) error, onCopy func(file File, datum string) error) error {
+func (b *Buffer) WalkAdditive(onAdd func(path, datum string, r io.Reader, metadata map[string]string) error, onCopy func(file File, datum string, metadata map[string]string) error) error {
 	for _, file := range sortFiles(b.additive) {
+		// A file which only has metadata is written with no content.
+		if len(file.contents) == 0 {
+			if err := onAdd(file.path, file.datum, &bytes.Reader{}, file.metadata); err != nil {
+				return err
+			}
+			continue
+		}
 		for _, content := range file.contents {
 			if content.copy != nil {
-				if err := onCopy(content.copy, file.datum); err != nil {
+				if err := onCopy(content.copy, file.datum, file.metadata); err != nil {
 					return err
 				}
-			} else if err := onAdd(file.path, file.datum, bytes.NewReader(content.buf.Bytes())); err != nil {
+			} else if err := onAdd(file.path, file.datum, bytes.NewReader(content.buf.Bytes()), file.metadata); err != nil {
 				return err
 			}
 		}
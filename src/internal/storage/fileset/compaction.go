@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 )
 
@@ -53,8 +54,13 @@ func indexOfCompacted(factor int64, inputs []*Primitive) int {
 // Compact always returns the ID of a primitive fileset.
 // Compact does not renew ids.
 // It is the responsibility of the caller to renew ids.  In some cases they may be permanent and not require renewal.
-func (s *Storage) Compact(ctx context.Context, ids []ID, ttl time.Duration, opts ...index.Option) (*ID, error) {
-	w := s.newWriter(ctx, WithTTL(ttl))
+// Content that has to be rewritten is chunked with params, or the default parameters if params is nil.
+func (s *Storage) Compact(ctx context.Context, ids []ID, ttl time.Duration, params *chunk.ChunkingParams, opts ...index.Option) (*ID, error) {
+	writerOpts := []WriterOption{WithTTL(ttl)}
+	if params != nil {
+		writerOpts = append(writerOpts, WithWriterChunkingParams(*params))
+	}
+	w := s.newWriter(ctx, writerOpts...)
 	fs, err := s.Open(ctx, ids, opts...)
 	if err != nil {
 		return nil, err
@@ -193,6 +193,50 @@ func TestCopy(t *testing.T) {
 	require.Equal(t, initialChunkCount, finalChunkCount)
 }
 
+func TestMetadata(t *testing.T) {
+	ctx := context.Background()
+	storage := newTestStorage(t)
+	writeLayer := func(cb func(uw *UnorderedWriter)) ID {
+		uw, err := storage.NewUnorderedWriter(ctx)
+		require.NoError(t, err)
+		cb(uw)
+		id, err := uw.Close()
+		require.NoError(t, err)
+		return *id
+	}
+	checkMetadata := func(ids []ID, expected map[string]map[string]string) {
+		fs, err := storage.Open(ctx, ids)
+		require.NoError(t, err)
+		actual := make(map[string]map[string]string)
+		require.NoError(t, fs.Iterate(ctx, func(f File) error {
+			actual[f.Index().Path] = f.Index().File.Metadata
+			return nil
+		}))
+		require.Equal(t, expected, actual)
+	}
+	ids := []ID{writeLayer(func(uw *UnorderedWriter) {
+		require.NoError(t, uw.SetMetadata("/a", "", map[string]string{"k1": "v1", "k2": "v2"}))
+		require.NoError(t, uw.Put("/a", "", true, bytes.NewReader([]byte("a"))))
+		require.NoError(t, uw.Put("/b", "", true, bytes.NewReader([]byte("b"))))
+		require.NoError(t, uw.SetMetadata("/c", "", map[string]string{"k1": "v1"}))
+	})}
+	ids = append(ids, writeLayer(func(uw *UnorderedWriter) {
+		require.NoError(t, uw.SetMetadata("/a", "", map[string]string{"k2": "v3"}))
+		require.NoError(t, uw.Delete("/c", ""))
+		require.NoError(t, uw.Put("/c", "", true, bytes.NewReader([]byte("c"))))
+	}))
+	expected := map[string]map[string]string{
+		"/a": {"k1": "v1", "k2": "v3"},
+		"/b": nil,
+		"/c": nil,
+	}
+	checkMetadata(ids, expected)
+	// Metadata should be preserved by compaction.
+	id, err := storage.Compact(ctx, ids, time.Minute, nil)
+	require.NoError(t, err)
+	checkMetadata([]ID{*id}, expected)
+}
+
 func countChunks(t *testing.T, s *Storage) (count int64) {
 	require.NoError(t, s.ChunkStorage().List(context.Background(), func(chunk.ID) error {
 		count++
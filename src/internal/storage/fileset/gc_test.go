@@ -8,6 +8,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 )
 
@@ -40,3 +41,40 @@ func TestGC(t *testing.T) {
 	t.Log(countDeleted)
 	require.True(t, countDeleted > 0)
 }
+
+func TestGCReport(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	s := NewTestStorage(t, db, tr)
+	w := s.NewWriter(ctx, WithTTL(time.Hour))
+	require.NoError(t, w.Add("a.txt", "datum1", strings.NewReader("test data")))
+	id, err := w.Close()
+	require.NoError(t, err)
+	// nothing is reclaimable while the file set is live
+	report, err := s.GCReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(report.Prefixes))
+	// expire it, and the chunks
+	require.NoError(t, s.Drop(ctx, *id))
+	_, err = db.ExecContext(ctx, `UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - interval '1 hour'`)
+	require.NoError(t, err)
+	report, err = s.GCReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), report.Prefixes[TrackerPrefix].Objects)
+	require.True(t, report.Prefixes[chunk.TrackerPrefix].Objects > 0)
+	chunkBytes := report.Prefixes[chunk.TrackerPrefix].Bytes
+	require.True(t, chunkBytes > 0)
+	require.Equal(t, int64(0), report.PendingChunks)
+	// the report does not delete anything
+	exists, err := s.exists(ctx, *id)
+	require.NoError(t, err)
+	require.True(t, exists)
+	// after the tracker gc, the chunks are pending deletion
+	require.NoError(t, s.NewGC(time.Minute).RunUntilEmpty(ctx))
+	report, err = s.GCReport(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(report.Prefixes))
+	require.True(t, report.PendingChunks > 0)
+	require.Equal(t, chunkBytes, report.PendingChunkBytes)
+}
@@ -151,11 +151,12 @@ func (m *Range) GetChunkRef() *chunk.DataRef {
 }
 
 type File struct {
-	Datum                string           `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
-	DataRefs             []*chunk.DataRef `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Datum                string            `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
+	DataRefs             []*chunk.DataRef  `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *File) Reset()         { *m = File{} }
@@ -205,10 +206,18 @@ func (m *File) GetDataRefs() []*chunk.DataRef {
 	return nil
 }
 
+func (m *File) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Index)(nil), "index.Index")
 	proto.RegisterType((*Range)(nil), "index.Range")
 	proto.RegisterType((*File)(nil), "index.File")
+	proto.RegisterMapType((map[string]string)(nil), "index.File.MetadataEntry")
 }
 
 func init() {
@@ -216,26 +225,30 @@ func init() {
 }
 
 var fileDescriptor_dfa1b84c403551af = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xc1, 0x4a, 0xf4, 0x30,
-	0x10, 0x26, 0xdb, 0x76, 0xd9, 0x66, 0x7f, 0xfe, 0x43, 0x10, 0x29, 0x0a, 0xb5, 0xf4, 0xb4, 0x28,
-	0x34, 0xb0, 0xbe, 0x81, 0x2c, 0xc2, 0xde, 0x24, 0x47, 0x2f, 0x6b, 0xb6, 0x9d, 0xb4, 0xc1, 0x6e,
-	0x5b, 0x92, 0x54, 0xf4, 0x0d, 0x3d, 0xfa, 0x08, 0xd2, 0x27, 0x91, 0x24, 0x3d, 0x08, 0x8a, 0x97,
-	0x61, 0xbe, 0x99, 0x6f, 0xe6, 0xfb, 0x26, 0xc1, 0xd7, 0xb2, 0x33, 0xa0, 0x3a, 0xde, 0x52, 0x6d,
-	0x7a, 0xc5, 0x6b, 0xa0, 0x42, 0xb6, 0xa0, 0xc1, 0x50, 0xd9, 0x55, 0xf0, 0xea, 0x63, 0x31, 0xa8,
-	0xde, 0xf4, 0x24, 0x72, 0xe0, 0x22, 0xff, 0x31, 0x52, 0x36, 0x63, 0xf7, 0xec, 0xa3, 0xa7, 0xe6,
-	0x4f, 0x38, 0xda, 0x5b, 0x32, 0x21, 0x38, 0x1c, 0xb8, 0x69, 0x12, 0x94, 0xa1, 0x4d, 0xcc, 0x5c,
-	0x4e, 0x72, 0x1c, 0x29, 0xde, 0xd5, 0x90, 0x2c, 0x32, 0xb4, 0x59, 0x6f, 0xff, 0x15, 0x5e, 0x84,
-	0xd9, 0x1a, 0xf3, 0x2d, 0x72, 0x85, 0x43, 0x6b, 0x24, 0x09, 0x1c, 0x65, 0x3d, 0x53, 0xee, 0x65,
-	0x0b, 0xcc, 0x35, 0x72, 0x89, 0x23, 0x37, 0x40, 0xce, 0xf1, 0xb2, 0x17, 0x42, 0x83, 0x71, 0x1a,
-	0x01, 0x9b, 0x11, 0xb9, 0xc4, 0x71, 0xcb, 0xb5, 0x39, 0x38, 0xf9, 0x85, 0x93, 0x5f, 0xd9, 0xc2,
-	0x83, 0xb5, 0x70, 0x83, 0x63, 0x67, 0xf7, 0xa0, 0x40, 0xcc, 0x1a, 0xff, 0x0b, 0x7f, 0xc0, 0x8e,
-	0x1b, 0xce, 0x40, 0xb0, 0x95, 0x83, 0x0c, 0x44, 0xbe, 0xc7, 0xa1, 0x15, 0x26, 0x67, 0x38, 0xaa,
-	0xb8, 0x19, 0x4f, 0xf3, 0x31, 0x1e, 0xd8, 0x55, 0x15, 0x37, 0xdc, 0x6e, 0xd2, 0xc9, 0x22, 0x0b,
-	0x7e, 0x5b, 0x55, 0xf9, 0x44, 0xdf, 0xb1, 0xf7, 0x29, 0x45, 0x1f, 0x53, 0x8a, 0x3e, 0xa7, 0x14,
-	0x3d, 0xee, 0x6a, 0x69, 0x9a, 0xf1, 0x58, 0x94, 0xfd, 0x89, 0x0e, 0xbc, 0x6c, 0xde, 0x2a, 0x50,
-	0xdf, 0xb3, 0x97, 0x2d, 0xd5, 0xaa, 0xa4, 0x7f, 0x7f, 0xd1, 0x71, 0xe9, 0x9e, 0xfc, 0xf6, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0x33, 0x84, 0xa8, 0x77, 0xcb, 0x01, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x4b, 0xfb, 0x40,
+	0x14, 0x64, 0x93, 0xa6, 0xb4, 0xaf, 0xbf, 0x9f, 0xc8, 0x22, 0x12, 0x2b, 0xd4, 0x92, 0x53, 0x51,
+	0x48, 0xa0, 0x22, 0x88, 0xde, 0xa4, 0x0a, 0x1e, 0x04, 0xd9, 0xa3, 0x97, 0xba, 0x4d, 0x5e, 0x9a,
+	0xd0, 0x34, 0x29, 0x9b, 0x4d, 0xb1, 0x1f, 0xce, 0xbb, 0x47, 0x3f, 0x82, 0xf4, 0x93, 0xc8, 0xfe,
+	0x41, 0x2a, 0x8a, 0x97, 0xe5, 0xcd, 0xce, 0xec, 0x9b, 0x19, 0x12, 0x38, 0xcd, 0x4b, 0x89, 0xa2,
+	0xe4, 0x45, 0x54, 0xcb, 0x4a, 0xf0, 0x39, 0x46, 0x69, 0x5e, 0x60, 0x8d, 0x32, 0xca, 0xcb, 0x04,
+	0x5f, 0xcc, 0x19, 0xae, 0x44, 0x25, 0x2b, 0xea, 0x69, 0xd0, 0x0f, 0x7e, 0x3c, 0x89, 0xb3, 0xa6,
+	0x5c, 0x98, 0xd3, 0x48, 0x83, 0x67, 0xf0, 0xee, 0x95, 0x98, 0x52, 0x68, 0xad, 0xb8, 0xcc, 0x7c,
+	0x32, 0x24, 0xa3, 0x2e, 0xd3, 0x33, 0x0d, 0xc0, 0x13, 0xbc, 0x9c, 0xa3, 0xef, 0x0c, 0xc9, 0xa8,
+	0x37, 0xfe, 0x17, 0x1a, 0x13, 0xa6, 0xee, 0x98, 0xa1, 0xe8, 0x09, 0xb4, 0x54, 0x10, 0xdf, 0xd5,
+	0x92, 0x9e, 0x95, 0xdc, 0xe5, 0x05, 0x32, 0x4d, 0x04, 0x39, 0x78, 0xfa, 0x01, 0x3d, 0x84, 0x76,
+	0x95, 0xa6, 0x35, 0x4a, 0xed, 0xe1, 0x32, 0x8b, 0xe8, 0x31, 0x74, 0x0b, 0x5e, 0xcb, 0xa9, 0xb6,
+	0x77, 0xb4, 0x7d, 0x47, 0x5d, 0x3c, 0xaa, 0x08, 0x67, 0xd0, 0xd5, 0x71, 0xa7, 0x02, 0x53, 0xeb,
+	0xb1, 0x17, 0x9a, 0x02, 0x13, 0x2e, 0x39, 0xc3, 0x94, 0x75, 0x34, 0x64, 0x98, 0x06, 0xaf, 0x04,
+	0x5a, 0xca, 0x99, 0x1e, 0x80, 0x97, 0x70, 0xd9, 0x2c, 0x6d, 0x1b, 0x03, 0xd4, 0xae, 0x84, 0x4b,
+	0xae, 0x56, 0xd5, 0xbe, 0x33, 0x74, 0x7f, 0xdb, 0x95, 0x98, 0xa1, 0xa6, 0x17, 0xd0, 0x59, 0xa2,
+	0xe4, 0x0a, 0xfb, 0xae, 0xd6, 0x1e, 0xed, 0x74, 0x0b, 0x1f, 0x2c, 0x77, 0x5b, 0x4a, 0xb1, 0x61,
+	0x5f, 0xd2, 0xfe, 0x35, 0xfc, 0xff, 0x46, 0xd1, 0x7d, 0x70, 0x17, 0xb8, 0xb1, 0x41, 0xd4, 0xa8,
+	0xc2, 0xad, 0x79, 0xd1, 0xa0, 0xed, 0x6a, 0xc0, 0x95, 0x73, 0x49, 0x6e, 0xd8, 0xdb, 0x76, 0x40,
+	0xde, 0xb7, 0x03, 0xf2, 0xb1, 0x1d, 0x90, 0xa7, 0xc9, 0x3c, 0x97, 0x59, 0x33, 0x0b, 0xe3, 0x6a,
+	0x19, 0xad, 0x78, 0x9c, 0x6d, 0x12, 0x14, 0xbb, 0xd3, 0x7a, 0x1c, 0xd5, 0x22, 0x8e, 0xfe, 0xfe,
+	0x2f, 0x66, 0x6d, 0xfd, 0x9d, 0xcf, 0x3f, 0x07, 0x00, 0xee, 0xcd, 0xcf, 0xb1, 0x40, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Index) Marshal() (dAtA []byte, err error) {
@@ -371,6 +384,25 @@ func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintIndex(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintIndex(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintIndex(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DataRefs) > 0 {
 		for iNdEx := len(m.DataRefs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -469,6 +501,14 @@ func (m *File) Size() (n int) {
 			n += 1 + l + sovIndex(uint64(l))
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovIndex(uint64(len(k))) + 1 + len(v) + sovIndex(uint64(len(v)))
+			n += mapEntrySize + 1 + sovIndex(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -869,6 +909,133 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthIndex
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthIndex
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipIndex(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndex(dAtA[iNdEx:])
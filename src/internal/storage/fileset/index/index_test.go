@@ -111,6 +111,13 @@ func Check(t *testing.T, permString string) {
 		actual = actualFiles(t, topIdx, chunks, WithRange(pathRange(expected)))
 		require.Equal(t, expected, actual)
 	})
+	t.Run("PrefixAndRange", func(t *testing.T) {
+		prefix := string(fileNames[len(fileNames)/2][0])
+		expected := expectedFiles(fileNames, prefix)
+		expected = expected[len(expected)/2:]
+		actual := actualFiles(t, topIdx, chunks, WithPrefix(prefix), WithRange(&PathRange{Lower: expected[0]}))
+		require.Equal(t, expected, actual)
+	})
 }
 
 func TestSingleLevel(t *testing.T) {
@@ -11,16 +11,24 @@ type PathRange struct {
 }
 
 // WithRange sets a range filter for the read.
+// A range filter can be combined with a prefix filter, in which case only
+// the paths that match both are read.
 func WithRange(pathRange *PathRange) Option {
 	return func(r *Reader) {
-		r.filter = &pathFilter{pathRange: pathRange}
+		if r.filter == nil {
+			r.filter = &pathFilter{}
+		}
+		r.filter.pathRange = pathRange
 	}
 }
 
 // WithPrefix sets a prefix filter for the read.
 func WithPrefix(prefix string) Option {
 	return func(r *Reader) {
-		r.filter = &pathFilter{prefix: prefix}
+		if r.filter == nil {
+			r.filter = &pathFilter{}
+		}
+		r.filter.prefix = prefix
 	}
 }
 
@@ -103,8 +103,8 @@ func (r *Reader) atStart(name, datum string) bool {
 	if r.filter == nil {
 		return true
 	}
-	if r.filter.pathRange != nil {
-		return r.filter.pathRange.atStart(name, datum)
+	if r.filter.pathRange != nil && !r.filter.pathRange.atStart(name, datum) {
+		return false
 	}
 	return name >= r.filter.prefix
 }
@@ -117,8 +117,8 @@ func (r *Reader) atEnd(name, datum string) bool {
 	if r.filter == nil {
 		return false
 	}
-	if r.filter.pathRange != nil {
-		return r.filter.pathRange.atEnd(name, datum)
+	if r.filter.pathRange != nil && r.filter.pathRange.atEnd(name, datum) {
+		return true
 	}
 	// Name is past a prefix when the first len(prefix) bytes are greater than the prefix
 	// (use len(name) bytes for comparison when len(name) < len(prefix)).
@@ -62,12 +62,15 @@ func (mr *MergeReader) iterate(ctx context.Context, cb func(File) error) error {
 			return cb(newFileReader(mr.chunks, fss[0].file.Index()))
 		}
 		var dataRefs []*chunk.DataRef
+		var metadata map[string]string
 		for _, fs := range fss {
 			idx := fs.file.Index()
 			dataRefs = append(dataRefs, idx.File.DataRefs...)
+			metadata = mergeMetadata(metadata, idx.File.Metadata)
 		}
 		mergeIdx := fss[0].file.Index()
 		mergeIdx.File.DataRefs = dataRefs
+		mergeIdx.File.Metadata = metadata
 		return cb(newMergeFileReader(mr.chunks, mergeIdx))
 
 	})
@@ -6,6 +6,7 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 )
 
 // StorageOption configures a storage.
@@ -85,6 +86,13 @@ func WithCompact(maxFanIn int) UnorderedWriterOption {
 	}
 }
 
+// WithChunkingParams sets the parameters the unordered writer uses to chunk file content.
+func WithChunkingParams(params chunk.ChunkingParams) UnorderedWriterOption {
+	return func(uw *UnorderedWriter) {
+		uw.chunkingParams = &params
+	}
+}
+
 // WriterOption configures a file set writer.
 type WriterOption func(w *Writer)
 
@@ -95,6 +103,13 @@ func WithTTL(ttl time.Duration) WriterOption {
 	}
 }
 
+// WithWriterChunkingParams sets the parameters the writer uses to chunk file content.
+func WithWriterChunkingParams(params chunk.ChunkingParams) WriterOption {
+	return func(w *Writer) {
+		w.chunkingParams = &params
+	}
+}
+
 // StorageOptions returns the fileset storage options for the config.
 func StorageOptions(conf *serviceenv.StorageConfiguration) []StorageOption {
 	var opts []StorageOption
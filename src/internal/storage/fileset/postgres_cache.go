@@ -2,6 +2,7 @@ package fileset
 
 import (
 	"context"
+	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
@@ -31,6 +32,16 @@ func CreatePostgresCacheV1(ctx context.Context, tx *pachsql.Tx) error {
 	return errors.EnsureStack(err)
 }
 
+// CreatePostgresCacheV2 adds expiry times to the entries of a cache.
+func CreatePostgresCacheV2(ctx context.Context, tx *pachsql.Tx) error {
+	const schema = `
+	ALTER TABLE storage.cache ADD COLUMN expires_at TIMESTAMP;
+	CREATE INDEX ON storage.cache (expires_at);
+`
+	_, err := tx.ExecContext(ctx, schema)
+	return errors.EnsureStack(err)
+}
+
 const CacheTrackerPrefix = "cache/"
 
 func cacheTrackerKey(key string) string {
@@ -51,28 +62,40 @@ func NewCache(db *pachsql.DB, tracker track.Tracker, maxSize int) *Cache {
 	}
 }
 
-func (c *Cache) Put(ctx context.Context, key string, value *types.Any, ids []ID, tag string) error {
+// Put adds an entry to the cache. If ttl is not zero, the entry expires after
+// ttl.
+func (c *Cache) Put(ctx context.Context, key string, value *types.Any, ids []ID, tag string, ttl time.Duration) error {
 	data, err := proto.Marshal(value)
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
 	return dbutil.WithTx(ctx, c.db, func(tx *pachsql.Tx) error {
-		if err := c.put(tx, key, data, ids, tag); err != nil {
+		// expired entries are deleted first, so they can be replaced
+		if err := c.deleteExpired(tx); err != nil {
+			return err
+		}
+		if err := c.put(tx, key, data, ids, tag, ttl); err != nil {
 			return err
 		}
 		return c.applyEvictionPolicy(tx)
 	})
 }
 
-func (c *Cache) put(tx *pachsql.Tx, key string, value []byte, ids []ID, tag string) error {
+func (c *Cache) put(tx *pachsql.Tx, key string, value []byte, ids []ID, tag string, ttl time.Duration) error {
 	if ids == nil {
 		ids = []ID{}
 	}
+	// a NULL ttl means the entry doesn't expire
+	var ttlSeconds *float64
+	if ttl > 0 {
+		s := ttl.Seconds()
+		ttlSeconds = &s
+	}
 	_, err := tx.Exec(`
-		INSERT INTO storage.cache (key, value_pb, ids, tag)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO storage.cache (key, value_pb, ids, tag, expires_at)
+		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP + $5 * interval '1 sec')
 		ON CONFLICT (key) DO NOTHING
-	`, key, value, ids, tag)
+	`, key, value, ids, tag, ttlSeconds)
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -83,6 +106,23 @@ func (c *Cache) put(tx *pachsql.Tx, key string, value []byte, ids []ID, tag stri
 	return errors.EnsureStack(c.tracker.CreateTx(tx, cacheTrackerKey(key), pointsTo, track.NoTTL))
 }
 
+func (c *Cache) deleteExpired(tx *pachsql.Tx) error {
+	var keys []string
+	if err := tx.Select(&keys, `
+		DELETE FROM storage.cache
+		WHERE expires_at <= CURRENT_TIMESTAMP
+		RETURNING key
+	`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, key := range keys {
+		if err := c.tracker.DeleteTx(tx, cacheTrackerKey(key)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
 func (c *Cache) applyEvictionPolicy(tx *pachsql.Tx) error {
 	var size int
 	if err := tx.Get(&size, `
@@ -115,7 +155,7 @@ func (c *Cache) Get(ctx context.Context, key string) (*types.Any, error) {
 	if err := sqlx.GetContext(ctx, c.db, &data, `
 		UPDATE storage.cache
 		SET accessed_at = CURRENT_TIMESTAMP
-		WHERE key = $1
+		WHERE key = $1 AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
 		RETURNING value_pb
 	`, key); err != nil {
 		return nil, errors.EnsureStack(err)
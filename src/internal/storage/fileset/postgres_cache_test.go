@@ -19,6 +19,7 @@ func newTestCache(t *testing.T, db *pachsql.DB, tr track.Tracker, maxSize int) *
 	tx := db.MustBegin()
 	tx.MustExec(`CREATE SCHEMA IF NOT EXISTS storage`)
 	require.NoError(t, CreatePostgresCacheV1(ctx, tx))
+	require.NoError(t, CreatePostgresCacheV2(ctx, tx))
 	require.NoError(t, tx.Commit())
 	return NewCache(db, tr, maxSize)
 }
@@ -30,8 +31,8 @@ func TestPostgresCache(t *testing.T) {
 	storage := NewTestStorage(t, db, tr)
 	maxSize := 5
 	cache := newTestCache(t, db, tr, maxSize)
-	ids := make([]ID, maxSize+1)
-	put := func(i int) {
+	ids := make([]ID, maxSize+2)
+	put := func(i int, ttl time.Duration) {
 		id, err := storage.newWriter(ctx, WithTTL(track.ExpireNow)).Close()
 		require.NoError(t, err)
 		valueProto := &TestCacheValue{FileSetId: id.HexString()}
@@ -45,7 +46,7 @@ func TestPostgresCache(t *testing.T) {
 		if i%2 == 0 {
 			tag = "even"
 		}
-		require.NoError(t, cache.Put(ctx, strconv.Itoa(i), value, []ID{*id}, tag))
+		require.NoError(t, cache.Put(ctx, strconv.Itoa(i), value, []ID{*id}, tag, ttl))
 		ids[i] = *id
 	}
 	checkExists := func(i int) {
@@ -68,7 +69,7 @@ func TestPostgresCache(t *testing.T) {
 	// Fill the cache and confirm that the entries are retrievable and correct.
 	// Also, confirm the referenced file sets still exist after gc.
 	for i := 0; i < maxSize; i++ {
-		put(i)
+		put(i, 0)
 	}
 	gc := storage.NewGC(time.Second)
 	_, err := gc.RunOnce(ctx)
@@ -80,7 +81,7 @@ func TestPostgresCache(t *testing.T) {
 	// rest of the entries are retrievable and correct.
 	// Also, confirm the oldest entry's file set is deleted and the others
 	// still exist.
-	put(maxSize)
+	put(maxSize, 0)
 	_, err = gc.RunOnce(ctx)
 	require.NoError(t, err)
 	checkNotExists(0)
@@ -98,4 +99,19 @@ func TestPostgresCache(t *testing.T) {
 		}
 		checkExists(i)
 	}
+	// Add an entry with a TTL and confirm that it can't be retrieved once it
+	// expires, and that its file set is deleted once the entry is replaced.
+	put(maxSize+1, time.Second)
+	checkExists(maxSize + 1)
+	time.Sleep(2 * time.Second)
+	_, err = cache.Get(ctx, strconv.Itoa(maxSize+1))
+	require.YesError(t, err)
+	expiredID := ids[maxSize+1]
+	put(maxSize+1, 0)
+	_, err = gc.RunOnce(ctx)
+	require.NoError(t, err)
+	checkExists(maxSize + 1)
+	exists, err := storage.exists(ctx, expiredID)
+	require.NoError(t, err)
+	require.False(t, exists)
 }
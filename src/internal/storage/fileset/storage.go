@@ -97,6 +97,12 @@ func (s *Storage) NewUnorderedWriter(ctx context.Context, opts ...UnorderedWrite
 	return newUnorderedWriter(ctx, s, s.memThreshold, s.shardCountThreshold/2, opts...)
 }
 
+// NewFile returns a file with the index idx, whose content is read from the
+// data it references.
+func (s *Storage) NewFile(idx *index.Index) File {
+	return newFileReader(s.ChunkStorage(), idx)
+}
+
 // NewWriter creates a new file set writer.
 func (s *Storage) NewWriter(ctx context.Context, opts ...WriterOption) *Writer {
 	return s.newWriter(ctx, opts...)
@@ -343,6 +349,75 @@ func (s *Storage) NewGC(d time.Duration) *track.GarbageCollector {
 	return track.NewGarbageCollector(s.tracker, d, mux)
 }
 
+// GCReport describes the objects which garbage collection will reclaim.
+type GCReport struct {
+	// Prefixes maps tracker ID prefixes (e.g. "chunk/" or "fileset/") to the reclaimable objects with the prefix.
+	Prefixes map[string]*GCPrefixReport
+	// PendingChunks and PendingChunkBytes describe the chunk objects which are no longer tracked,
+	// and will be deleted by the next cycle of chunk garbage collection.
+	PendingChunks, PendingChunkBytes int64
+}
+
+// GCPrefixReport describes the reclaimable objects with a tracker ID prefix.
+type GCPrefixReport struct {
+	Objects int64
+	// Bytes is the uncompressed size of the reclaimable data in object storage.
+	// It is only known for chunks.
+	Bytes int64
+}
+
+// GCReport walks the tracker graph and reports what garbage collection will reclaim, without deleting anything.
+func (s *Storage) GCReport(ctx context.Context) (*GCReport, error) {
+	const batchSize = 1000
+	report := &GCReport{Prefixes: make(map[string]*GCPrefixReport)}
+	var chunkIDs []chunk.ID
+	flushChunks := func() error {
+		if len(chunkIDs) == 0 {
+			return nil
+		}
+		size, err := s.chunks.ObjectSizes(ctx, chunkIDs)
+		if err != nil {
+			return err
+		}
+		report.Prefixes[chunk.TrackerPrefix].Bytes += size
+		chunkIDs = chunkIDs[:0]
+		return nil
+	}
+	if err := s.tracker.IterateReclaimable(ctx, func(id string) error {
+		prefix := id
+		if i := strings.Index(id, "/"); i >= 0 {
+			prefix = id[:i+1]
+		}
+		if _, ok := report.Prefixes[prefix]; !ok {
+			report.Prefixes[prefix] = &GCPrefixReport{}
+		}
+		report.Prefixes[prefix].Objects++
+		if prefix != chunk.TrackerPrefix {
+			return nil
+		}
+		chunkID, err := chunk.ParseTrackerID(id)
+		if err != nil {
+			return err
+		}
+		chunkIDs = append(chunkIDs, chunkID)
+		if len(chunkIDs) < batchSize {
+			return nil
+		}
+		return flushChunks()
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := flushChunks(); err != nil {
+		return nil, err
+	}
+	var err error
+	report.PendingChunks, report.PendingChunkBytes, err = s.chunks.PendingDeletion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
 func (s *Storage) exists(ctx context.Context, id ID) (bool, error) {
 	exists, err := s.store.Exists(ctx, id)
 	return exists, errors.EnsureStack(err)
@@ -86,7 +86,7 @@ func (im *indexMap) Index() *index.Index {
 }
 
 func (im *indexMap) Content(ctx context.Context, w io.Writer, opts ...chunk.ReaderOption) error {
-	return errors.EnsureStack(im.inner.Content(ctx, w))
+	return errors.EnsureStack(im.inner.Content(ctx, w, opts...))
 }
 
 func (im *indexMap) Hash(ctx context.Context) ([]byte, error) {
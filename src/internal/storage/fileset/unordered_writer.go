@@ -8,6 +8,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 )
 
@@ -26,6 +27,7 @@ type UnorderedWriter struct {
 	getParentID                func() (*ID, error)
 	validator                  func(string) error
 	maxFanIn                   int
+	chunkingParams             *chunk.ChunkingParams
 }
 
 func newUnorderedWriter(ctx context.Context, storage *Storage, memThreshold, fileThreshold int64, opts ...UnorderedWriterOption) (*UnorderedWriter, error) {
@@ -83,6 +85,25 @@ func (uw *UnorderedWriter) Put(p, datum string, appendFile bool, r io.Reader) (r
 	return nil
 }
 
+// SetMetadata merges metadata into the metadata of a file, with the values in
+// metadata taking precedence.
+func (uw *UnorderedWriter) SetMetadata(p, datum string, metadata map[string]string) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+	if err := uw.validate(p); err != nil {
+		return err
+	}
+	if datum == "" {
+		datum = DefaultFileDatum
+	}
+	uw.buffer.SetMetadata(p, datum, metadata)
+	if int64(uw.buffer.Count()) >= uw.fileThreshold {
+		return uw.serialize()
+	}
+	return nil
+}
+
 func (uw *UnorderedWriter) validate(p string) error {
 	if uw.validator != nil {
 		return uw.validator(p)
@@ -98,10 +119,10 @@ func (uw *UnorderedWriter) serialize() error {
 	}
 	return miscutil.LogStep("UnorderedWriter.serialize", func() error {
 		return uw.withWriter(func(w *Writer) error {
-			if err := uw.buffer.WalkAdditive(func(path, datum string, r io.Reader) error {
-				return w.Add(path, datum, r)
-			}, func(f File, datum string) error {
-				return w.Copy(f, datum)
+			if err := uw.buffer.WalkAdditive(func(path, datum string, r io.Reader, metadata map[string]string) error {
+				return w.add(path, datum, r, metadata)
+			}, func(f File, datum string, metadata map[string]string) error {
+				return w.copy(f, datum, metadata)
 			}); err != nil {
 				return err
 			}
@@ -118,6 +139,9 @@ func (uw *UnorderedWriter) withWriter(cb func(*Writer) error) error {
 	if uw.ttl > 0 {
 		writerOpts = append(writerOpts, WithTTL(uw.ttl))
 	}
+	if uw.chunkingParams != nil {
+		writerOpts = append(writerOpts, WithWriterChunkingParams(*uw.chunkingParams))
+	}
 	w := uw.storage.newWriter(uw.ctx, writerOpts...)
 	if err := cb(w); err != nil {
 		return err
@@ -212,7 +236,7 @@ func (uw *UnorderedWriter) compact() error {
 			if end > len(uw.ids) {
 				end = len(uw.ids)
 			}
-			id, err := uw.storage.Compact(uw.ctx, uw.ids[start:end], uw.ttl)
+			id, err := uw.storage.Compact(uw.ctx, uw.ids[start:end], uw.ttl, uw.chunkingParams)
 			if err != nil {
 				return err
 			}
@@ -164,3 +164,18 @@ func SizeFromIndex(idx *index.Index) (size int64) {
 	}
 	return size
 }
+
+// mergeMetadata returns the union of the metadata maps, where the values in
+// later maps take precedence. The maps are not modified.
+func mergeMetadata(mds ...map[string]string) map[string]string {
+	var result map[string]string
+	for _, md := range mds {
+		for k, v := range md {
+			if result == nil {
+				result = make(map[string]string)
+			}
+			result[k] = v
+		}
+	}
+	return result
+}
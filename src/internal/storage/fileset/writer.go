@@ -32,6 +32,7 @@ type Writer struct {
 	deleteIdx                           *index.Index
 	ttl                                 time.Duration
 	sizeBytes                           int64
+	chunkingParams                      *chunk.ChunkingParams
 }
 
 func newWriter(ctx context.Context, storage *Storage, opts ...WriterOption) *Writer {
@@ -44,12 +45,16 @@ func newWriter(ctx context.Context, storage *Storage, opts ...WriterOption) *Wri
 		opt(w)
 	}
 	w.additive = index.NewWriter(ctx, storage.ChunkStorage(), "additive-index-writer")
+	var uploaderOpts []chunk.UploaderOption
+	if w.chunkingParams != nil {
+		uploaderOpts = append(uploaderOpts, chunk.WithChunkingParams(*w.chunkingParams))
+	}
 	w.uploader = storage.ChunkStorage().NewUploader(ctx, "chunk-uploader", false, func(meta interface{}, dataRefs []*chunk.DataRef) error {
 		idx := meta.(*index.Index)
 		idx.File.DataRefs = dataRefs
 		atomic.AddInt64(&w.sizeBytes, index.SizeBytes(idx))
 		return w.additive.WriteIndex(idx)
-	})
+	}, uploaderOpts...)
 	w.additiveBatched = index.NewWriter(ctx, storage.ChunkStorage(), "additive-batched-index-writer")
 	w.batcher = storage.ChunkStorage().NewBatcher(ctx, "chunk-batcher", w.batchThreshold, chunk.WithEntryCallback(func(meta interface{}, dataRef *chunk.DataRef) error {
 		idx := meta.(*index.Index)
@@ -64,10 +69,15 @@ func newWriter(ctx context.Context, storage *Storage, opts ...WriterOption) *Wri
 }
 
 func (w *Writer) Add(path, datum string, r io.Reader) error {
+	return w.add(path, datum, r, nil)
+}
+
+func (w *Writer) add(path, datum string, r io.Reader, metadata map[string]string) error {
 	idx := &index.Index{
 		Path: path,
 		File: &index.File{
-			Datum: datum,
+			Datum:    datum,
+			Metadata: metadata,
 		},
 	}
 	if err := w.checkIndex(w.idx, idx); err != nil {
@@ -118,7 +128,14 @@ func (w *Writer) Delete(path, datum string) error {
 
 // Copy copies a file to the file set writer.
 func (w *Writer) Copy(file File, datum string) error {
+	return w.copy(file, datum, nil)
+}
+
+// copy copies a file to the file set writer, merging metadata into the file's
+// metadata.
+func (w *Writer) copy(file File, datum string, metadata map[string]string) error {
 	idx := file.Index()
+	metadata = mergeMetadata(idx.File.Metadata, metadata)
 	size := index.SizeBytes(idx)
 	if size >= int64(w.batchThreshold) && chunk.StableDataRefs(idx.File.DataRefs) {
 		if err := w.checkIndex(w.idx, idx); err != nil {
@@ -128,7 +145,8 @@ func (w *Writer) Copy(file File, datum string) error {
 		copyIdx := &index.Index{
 			Path: idx.Path,
 			File: &index.File{
-				Datum: datum,
+				Datum:    datum,
+				Metadata: metadata,
 			},
 		}
 		return w.uploader.Copy(copyIdx, idx.File.DataRefs)
@@ -138,7 +156,7 @@ func (w *Writer) Copy(file File, datum string) error {
 		r := w.storage.ChunkStorage().NewReader(w.ctx, idx.File.DataRefs)
 		return r.Get(w2)
 	}, func(r io.Reader) error {
-		return w.Add(idx.Path, datum, r)
+		return w.add(idx.Path, datum, r, metadata)
 	})
 }
 
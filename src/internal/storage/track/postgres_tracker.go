@@ -236,6 +236,38 @@ func (t *postgresTracker) IterateDeletable(ctx context.Context, cb func(id strin
 	return nil
 }
 
+func (t *postgresTracker) IterateReclaimable(ctx context.Context, cb func(id string) error) (retErr error) {
+	rows, err := t.db.QueryxContext(ctx, `
+		WITH RECURSIVE live(int_id) AS (
+			SELECT int_id FROM storage.tracker_objects
+			WHERE expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP
+			UNION
+			SELECT refs.to_id FROM storage.tracker_refs AS refs
+			JOIN live ON refs.from_id = live.int_id
+		)
+		SELECT str_id FROM storage.tracker_objects
+		WHERE int_id NOT IN (SELECT int_id FROM live)
+		ORDER BY str_id`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(id); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
+
 func (t *postgresTracker) getDownstream(tx *pachsql.Tx, intID int) ([]string, error) {
 	dwn := []string{}
 	if err := tx.Select(&dwn, `
@@ -57,6 +57,11 @@ type Tracker interface {
 	// IterateDeletable calls cb with some top-level objects which are no longer referenced and have expired
 	// Even if it deletes all top-level objects, there may be more to delete after it runs
 	IterateDeletable(ctx context.Context, cb func(id string) error) error
+
+	// IterateReclaimable calls cb with all of the objects which garbage collection will eventually delete,
+	// in order of id. These are the objects which have expired, and are not reachable from an object which has not expired.
+	// Nothing is deleted.
+	IterateReclaimable(ctx context.Context, cb func(id string) error) error
 }
 
 // TestTracker runs a TestSuite to ensure Tracker is properly implemented
@@ -193,6 +198,25 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 				}
 			},
 		},
+		{
+			"IterateReclaimable",
+			func(t *testing.T, tracker Tracker) {
+				require.NoError(t, Create(ctx, tracker, "1", []string{}, ExpireNow))
+				require.NoError(t, Create(ctx, tracker, "2", []string{}, ExpireNow))
+				require.NoError(t, Create(ctx, tracker, "3", []string{"1"}, ExpireNow))
+				require.NoError(t, Create(ctx, tracker, "4", []string{"2"}, NoTTL))
+				require.NoError(t, Create(ctx, tracker, "5", []string{}, time.Hour))
+				var ids []string
+				require.NoError(t, tracker.IterateReclaimable(ctx, func(id string) error {
+					ids = append(ids, id)
+					return nil
+				}))
+				require.Equal(t, []string{"1", "3"}, ids)
+				// nothing was deleted
+				_, err := tracker.GetExpiresAt(ctx, "1")
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -0,0 +1,559 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"sync/atomic"
+	"time"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/version"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// leaseTTL is how long a doer or a claim on a task lives without being renewed.
+	leaseTTL = 30 * time.Second
+	// renewInterval is how often doers and claims are renewed.
+	renewInterval = leaseTTL / 3
+	// pollInterval is how often doers and sources check for work without
+	// being notified, which covers notifications lost to listener reconnects
+	// and claims that expired because a worker went away.
+	pollInterval = 10 * time.Second
+	// queueChannel is notified when tasks are created or claims are released.
+	queueChannel = "task_queue"
+	// doerChannelPrefix is the prefix of the channel that a doer is notified
+	// on when one of its tasks finishes.
+	doerChannelPrefix = "task_doer_"
+)
+
+// SetupPostgresTasksV0 creates the tables for the postgres task service.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresTasksV0(ctx context.Context, tx *pachsql.Tx) error {
+	const schema = `
+	CREATE SCHEMA task;
+
+	CREATE TABLE task.doers (
+		doer_id TEXT NOT NULL PRIMARY KEY,
+		prefix TEXT NOT NULL,
+		namespace TEXT NOT NULL,
+		group_id TEXT NOT NULL,
+		expires_at TIMESTAMP NOT NULL
+	);
+	CREATE INDEX ON task.doers (prefix, namespace, group_id);
+	CREATE INDEX ON task.doers (expires_at);
+
+	CREATE TABLE task.tasks (
+		seq BIGSERIAL PRIMARY KEY,
+		doer_id TEXT NOT NULL REFERENCES task.doers(doer_id) ON DELETE CASCADE,
+		state INT NOT NULL,
+		task_pb BYTEA NOT NULL,
+		collected BOOLEAN NOT NULL DEFAULT FALSE,
+		claimed_by TEXT,
+		claim_expires_at TIMESTAMP
+	);
+	CREATE INDEX ON task.tasks (doer_id, state);
+	CREATE INDEX ON task.tasks (state, claim_expires_at);
+`
+	_, err := tx.ExecContext(ctx, schema)
+	return errors.EnsureStack(err)
+}
+
+// SetupPostgresTasksV1 adds an index on the claimed tasks, which is used to
+// count the claims of a group when claiming tasks.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresTasksV1(ctx context.Context, tx *pachsql.Tx) error {
+	const schema = `
+	CREATE INDEX ON task.tasks (doer_id) WHERE claimed_by IS NOT NULL;
+`
+	_, err := tx.ExecContext(ctx, schema)
+	return errors.EnsureStack(err)
+}
+
+type postgresService struct {
+	db       *pachsql.DB
+	listener col.PostgresListener
+	prefix   string
+}
+
+// NewPostgresService returns a Service that stores tasks in postgres.
+// Workers claim tasks with SELECT ... FOR UPDATE SKIP LOCKED, and doers and
+// sources are woken up through the listener.
+func NewPostgresService(db *pachsql.DB, listener col.PostgresListener, prefix string) Service {
+	return &postgresService{
+		db:       db,
+		listener: listener,
+		prefix:   path.Join(prefix, version.PrettyVersion()),
+	}
+}
+
+func (ps *postgresService) NewDoer(namespace, group string, cache Cache) Doer {
+	if group == "" {
+		group = uuid.NewWithoutDashes()
+	}
+	return &postgresDoer{
+		postgresService: ps,
+		namespace:       namespace,
+		group:           group,
+		cache:           cache,
+	}
+}
+
+func (ps *postgresService) NewSource(namespace string) Source {
+	return &postgresSource{
+		postgresService: ps,
+		namespace:       namespace,
+	}
+}
+
+func (ps *postgresService) List(ctx context.Context, namespace, group string, cb func(string, string, *Task, bool) error) error {
+	if namespace == "" && group != "" {
+		return errors.New("must provide a task namespace to list a group")
+	}
+	rows, err := ps.db.QueryxContext(ctx, `
+		SELECT d.namespace, d.group_id, t.task_pb,
+			t.state = $4 AND COALESCE(t.claim_expires_at > CURRENT_TIMESTAMP, FALSE)
+		FROM task.tasks t JOIN task.doers d USING (doer_id)
+		WHERE d.prefix = $1
+			AND ($2 = '' OR d.namespace = $2)
+			AND ($3 = '' OR d.group_id = $3)
+		ORDER BY t.seq DESC
+	`, ps.prefix, namespace, group, State_RUNNING)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var taskNamespace, taskGroup string
+		var data []byte
+		var claimed bool
+		if err := rows.Scan(&taskNamespace, &taskGroup, &data, &claimed); err != nil {
+			return errors.EnsureStack(err)
+		}
+		task := &Task{}
+		if err := proto.Unmarshal(data, task); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(taskNamespace, taskGroup, task, claimed); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
+
+type postgresDoer struct {
+	*postgresService
+	namespace, group string
+	cache            Cache
+}
+
+func (pd *postgresDoer) Do(ctx context.Context, inputChan chan *types.Any, cb CollectFunc) error {
+	return pd.withDoer(ctx, func(ctx context.Context, doerID string) error {
+		n := newNotifier(doerChannelPrefix + doerID)
+		if err := pd.listener.Register(n); err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer func() {
+			if err := pd.listener.Unregister(n); err != nil {
+				fmt.Printf("errored unregistering task notifier: %v\n", err)
+			}
+		}()
+		var eg errgroup.Group
+		done := make(chan struct{})
+		var count int64
+		ctx, cancel := context.WithCancel(ctx)
+		defer func() {
+			cancel()
+			eg.Wait()
+		}()
+		eg.Go(func() error {
+			ticker := time.NewTicker(pollInterval)
+			defer ticker.Stop()
+			for {
+				tasks, err := pd.collect(ctx, doerID)
+				if err != nil {
+					return err
+				}
+				for _, task := range tasks {
+					var err error
+					if task.State == State_FAILURE {
+						err = errors.New(task.Reason)
+					}
+					if pd.cache != nil && err == nil {
+						if err := pd.cache.Put(ctx, task.ID, task.Output); err != nil {
+							fmt.Printf("errored putting task %v in cache: %v\n", task.ID, err)
+						}
+					}
+					if err := cb(task.Index, task.Output, err); err != nil {
+						return err
+					}
+					atomic.AddInt64(&count, -1)
+				}
+				select {
+				case <-done:
+					if atomic.LoadInt64(&count) == 0 {
+						return nil
+					}
+				default:
+				}
+				select {
+				case <-n.notifyChan:
+				case <-ticker.C:
+				case err := <-n.errChan:
+					return err
+				case <-ctx.Done():
+					return errors.EnsureStack(ctx.Err())
+				}
+			}
+		})
+		var index int64
+		for {
+			select {
+			case input, more := <-inputChan:
+				if !more {
+					close(done)
+					// If the tasks have already been collected (or there were none), then just return.
+					if atomic.LoadInt64(&count) == 0 {
+						return nil
+					}
+					return errors.EnsureStack(eg.Wait())
+				}
+				taskID, err := computeTaskID(input)
+				if err != nil {
+					return err
+				}
+				if pd.cache != nil {
+					output, err := pd.cache.Get(ctx, taskID)
+					if err == nil {
+						if err := cb(index, output, nil); err != nil {
+							return err
+						}
+						index++
+						continue
+					}
+				}
+				task := &Task{
+					ID:    taskID,
+					Input: input,
+					State: State_RUNNING,
+					Index: index,
+				}
+				index++
+				if err := pd.createTask(ctx, doerID, task); err != nil {
+					return err
+				}
+				atomic.AddInt64(&count, 1)
+			case <-ctx.Done():
+				return errors.EnsureStack(ctx.Err())
+			}
+		}
+	})
+}
+
+// withDoer registers a doer for the duration of the callback, and deletes it
+// along with its tasks afterwards. The doer is renewed in the background, and
+// the callback's context is canceled if it can't be.
+func (pd *postgresDoer) withDoer(ctx context.Context, cb func(ctx context.Context, doerID string) error) error {
+	doerID := uuid.NewWithoutDashes()
+	if _, err := pd.db.ExecContext(ctx, `
+		INSERT INTO task.doers (doer_id, prefix, namespace, group_id, expires_at)
+		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP + $5 * INTERVAL '1 second')
+	`, doerID, pd.prefix, pd.namespace, pd.group, leaseTTL.Seconds()); err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		// The doer's context may already be canceled, so clean up with a fresh one.
+		if _, err := pd.db.ExecContext(context.Background(), `
+			DELETE FROM task.doers WHERE doer_id = $1
+		`, doerID); err != nil {
+			fmt.Printf("errored deleting task doer %v: %v\n", doerID, err)
+		}
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go pd.renew(ctx, cancel, `
+		UPDATE task.doers
+		SET expires_at = CURRENT_TIMESTAMP + $2 * INTERVAL '1 second'
+		WHERE doer_id = $1
+	`, doerID, leaseTTL.Seconds())
+	return cb(ctx, doerID)
+}
+
+func (pd *postgresDoer) createTask(ctx context.Context, doerID string, task *Task) error {
+	data, err := proto.Marshal(task)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = pd.db.ExecContext(ctx, `
+		WITH inserted AS (
+			INSERT INTO task.tasks (doer_id, state, task_pb)
+			VALUES ($1, $2, $3)
+			RETURNING seq
+		)
+		SELECT pg_notify($4, '') FROM inserted
+	`, doerID, task.State, data, queueChannel)
+	return errors.EnsureStack(err)
+}
+
+// collect marks the finished tasks of a doer that have not been collected yet
+// as collected, and returns them.
+func (pd *postgresDoer) collect(ctx context.Context, doerID string) ([]*Task, error) {
+	var datas [][]byte
+	if err := pd.db.SelectContext(ctx, &datas, `
+		UPDATE task.tasks
+		SET collected = TRUE
+		WHERE doer_id = $1 AND state <> $2 AND NOT collected
+		RETURNING task_pb
+	`, doerID, State_RUNNING); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var tasks []*Task
+	for _, data := range datas {
+		task := &Task{}
+		if err := proto.Unmarshal(data, task); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// renew runs the update query every renew interval until the context is
+// canceled, and calls cancel if the update fails or matches no rows.
+func (ps *postgresService) renew(ctx context.Context, cancel context.CancelFunc, query string, args ...interface{}) {
+	ticker := time.NewTicker(renewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		res, err := ps.db.ExecContext(ctx, query, args...)
+		if err != nil {
+			if ctx.Err() == nil {
+				fmt.Printf("errored renewing task lease: %v\n", err)
+				cancel()
+			}
+			return
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			cancel()
+			return
+		}
+	}
+}
+
+type postgresSource struct {
+	*postgresService
+	namespace string
+}
+
+func (ps *postgresSource) Iterate(ctx context.Context, cb ProcessFunc) error {
+	n := newNotifier(queueChannel)
+	if err := ps.listener.Register(n); err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := ps.listener.Unregister(n); err != nil {
+			fmt.Printf("errored unregistering task notifier: %v\n", err)
+		}
+	}()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	claimID := uuid.NewWithoutDashes()
+	for {
+		seq, task, err := ps.claim(ctx, claimID)
+		if err != nil {
+			return err
+		}
+		if task != nil {
+			if err := ps.process(ctx, claimID, seq, task, cb); err != nil {
+				if errors.Is(ctx.Err(), context.Canceled) {
+					return errors.EnsureStack(ctx.Err())
+				}
+				fmt.Printf("errored in task callback: %v\n", err)
+			}
+			continue
+		}
+		select {
+		case <-n.notifyChan:
+		case <-ticker.C:
+			if err := ps.deleteExpiredDoers(ctx); err != nil {
+				return err
+			}
+		case err := <-n.errChan:
+			return err
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}
+}
+
+// claim claims the next task that is ready to run, if there is one.
+// Tasks are claimed from the group with the fewest claimed tasks first, so
+// that groups are scheduled fairly. Only the claims of the live groups in the
+// namespace are counted, through the index on claimed tasks.
+func (ps *postgresSource) claim(ctx context.Context, claimID string) (int64, *Task, error) {
+	var result struct {
+		Seq  int64  `db:"seq"`
+		Data []byte `db:"task_pb"`
+	}
+	if err := ps.db.GetContext(ctx, &result, `
+		UPDATE task.tasks
+		SET claimed_by = $3, claim_expires_at = CURRENT_TIMESTAMP + $5 * INTERVAL '1 second'
+		WHERE seq = (
+			SELECT t.seq
+			FROM (
+				SELECT DISTINCT namespace, group_id
+				FROM task.doers
+				WHERE prefix = $1
+					AND ($2 = '' OR namespace = $2)
+					AND expires_at > CURRENT_TIMESTAMP
+			) g
+			CROSS JOIN LATERAL (
+				SELECT COUNT(*) AS claims
+				FROM task.doers cd JOIN task.tasks ct USING (doer_id)
+				WHERE cd.prefix = $1
+					AND cd.namespace = g.namespace
+					AND cd.group_id = g.group_id
+					AND ct.claimed_by IS NOT NULL
+					AND ct.state = $4
+					AND ct.claim_expires_at > CURRENT_TIMESTAMP
+			) c
+			JOIN task.doers d ON d.prefix = $1 AND d.namespace = g.namespace AND d.group_id = g.group_id
+			JOIN task.tasks t ON t.doer_id = d.doer_id
+			WHERE d.expires_at > CURRENT_TIMESTAMP
+				AND t.state = $4
+				AND (t.claim_expires_at IS NULL OR t.claim_expires_at < CURRENT_TIMESTAMP)
+			ORDER BY c.claims, t.seq
+			LIMIT 1
+			FOR UPDATE OF t SKIP LOCKED
+		)
+		RETURNING seq, task_pb
+	`, ps.prefix, ps.namespace, claimID, State_RUNNING, leaseTTL.Seconds()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil, nil
+		}
+		return 0, nil, errors.EnsureStack(err)
+	}
+	task := &Task{}
+	if err := proto.Unmarshal(result.Data, task); err != nil {
+		return 0, nil, errors.EnsureStack(err)
+	}
+	return result.Seq, task, nil
+}
+
+// process runs the callback on a claimed task and records the result.
+// The claim is renewed while the callback runs, and the callback's context is
+// canceled if the claim is lost or the task is deleted. If the callback does
+// not finish the task, the claim is released so that another worker can pick
+// it up.
+func (ps *postgresSource) process(ctx context.Context, claimID string, seq int64, task *Task, cb ProcessFunc) (retErr error) {
+	taskCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go ps.renew(taskCtx, cancel, `
+		UPDATE task.tasks
+		SET claim_expires_at = CURRENT_TIMESTAMP + $3 * INTERVAL '1 second'
+		WHERE seq = $1 AND claimed_by = $2 AND state = $4
+	`, seq, claimID, leaseTTL.Seconds(), State_RUNNING)
+	taskOutput, taskErr := cb(taskCtx, task.Input)
+	// If the task context was canceled or the claim was lost, just release the claim.
+	if taskCtx.Err() != nil {
+		return ps.release(claimID, seq)
+	}
+	task.State = State_SUCCESS
+	task.Output = taskOutput
+	if taskErr != nil {
+		task.State = State_FAILURE
+		task.Reason = taskErr.Error()
+	}
+	data, err := proto.Marshal(task)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = ps.db.ExecContext(ctx, `
+		WITH finished AS (
+			UPDATE task.tasks
+			SET state = $3, task_pb = $4, claimed_by = NULL, claim_expires_at = NULL
+			WHERE seq = $1 AND claimed_by = $2 AND state = $5
+			RETURNING doer_id
+		)
+		SELECT pg_notify($6 || doer_id, '') FROM finished
+	`, seq, claimID, task.State, data, State_RUNNING, doerChannelPrefix)
+	return errors.EnsureStack(err)
+}
+
+// release releases a claim on a task, and notifies the other sources that the
+// task can be claimed.
+func (ps *postgresSource) release(claimID string, seq int64) error {
+	// The task context may already be canceled, so release with a fresh one.
+	_, err := ps.db.ExecContext(context.Background(), `
+		WITH released AS (
+			UPDATE task.tasks
+			SET claimed_by = NULL, claim_expires_at = NULL
+			WHERE seq = $1 AND claimed_by = $2
+			RETURNING seq
+		)
+		SELECT pg_notify($3, '') FROM released
+	`, seq, claimID, queueChannel)
+	return errors.EnsureStack(err)
+}
+
+// deleteExpiredDoers deletes the doers that have not been renewed, along with
+// their tasks.
+func (ps *postgresSource) deleteExpiredDoers(ctx context.Context) error {
+	_, err := ps.db.ExecContext(ctx, `
+		DELETE FROM task.doers WHERE expires_at < CURRENT_TIMESTAMP
+	`)
+	return errors.EnsureStack(err)
+}
+
+// notifier is a col.Notifier which coalesces notifications, since they are
+// only used to wake up doers and sources.
+type notifier struct {
+	id, channel string
+	notifyChan  chan struct{}
+	errChan     chan error
+}
+
+func newNotifier(channel string) *notifier {
+	return &notifier{
+		id:         uuid.NewWithoutDashes(),
+		channel:    channel,
+		notifyChan: make(chan struct{}, 1),
+		errChan:    make(chan error, 1),
+	}
+}
+
+func (n *notifier) ID() string {
+	return n.id
+}
+
+func (n *notifier) Channel() string {
+	return n.channel
+}
+
+func (n *notifier) Notify(_ *col.Notification) {
+	select {
+	case n.notifyChan <- struct{}{}:
+	default:
+	}
+}
+
+func (n *notifier) Error(err error) {
+	select {
+	case n.errChan <- err:
+	default:
+	}
+}
@@ -1,4 +1,4 @@
-package task
+package task_test
 
 import (
 	"context"
@@ -8,6 +8,11 @@ import (
 	"testing"
 	"time"
 
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	taskapi "github.com/pachyderm/pachyderm/v2/src/task"
 
@@ -23,7 +28,7 @@ var (
 	errTaskFailure = errors.Errorf("task failure")
 )
 
-func serializeTestTask(testTask *TestTask) (*types.Any, error) {
+func serializeTestTask(testTask *task.TestTask) (*types.Any, error) {
 	serializedTestTask, err := proto.Marshal(testTask)
 	if err != nil {
 		return nil, errors.EnsureStack(err)
@@ -34,17 +39,48 @@ func serializeTestTask(testTask *TestTask) (*types.Any, error) {
 	}, nil
 }
 
-func deserializeTestTask(any *types.Any) (*TestTask, error) {
-	testTask := &TestTask{}
+func deserializeTestTask(any *types.Any) (*task.TestTask, error) {
+	testTask := &task.TestTask{}
 	if err := types.UnmarshalAny(any, testTask); err != nil {
 		return nil, errors.EnsureStack(err)
 	}
 	return testTask, nil
 }
 
-func newTestEtcdService(t *testing.T) Service {
+func newTestEtcdService(t *testing.T) task.Service {
 	env := testetcd.NewEnv(t)
-	return NewEtcdService(env.EtcdClient, "")
+	return task.NewEtcdService(env.EtcdClient, "")
+}
+
+func newTestPostgresService(t *testing.T) task.Service {
+	ctx := context.Background()
+	options := dockertestenv.NewTestDirectDBOptions(t)
+	db := tu.OpenDB(t, options...)
+	require.NoError(t, dbutil.WithTx(ctx, db, func(tx *pachsql.Tx) error {
+		if err := task.SetupPostgresTasksV0(ctx, tx); err != nil {
+			return err
+		}
+		return task.SetupPostgresTasksV1(ctx, tx)
+	}))
+	listener := col.NewPostgresListener(dbutil.GetDSN(options...))
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+	})
+	return task.NewPostgresService(db, listener, "")
+}
+
+// testServices runs a test against each task service backend.
+func testServices(t *testing.T, cb func(t *testing.T, s task.Service)) {
+	for name, newService := range map[string]func(*testing.T) task.Service{
+		"etcd":     newTestEtcdService,
+		"postgres": newTestPostgresService,
+	} {
+		newService := newService
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cb(t, newService(t))
+		})
+	}
 }
 
 func seedRand() string {
@@ -53,7 +89,7 @@ func seedRand() string {
 	return fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
 }
 
-func test(t *testing.T, s Service, workerFailProb, groupCancelProb, taskFailProb float64, msg ...string) {
+func test(t *testing.T, s task.Service, workerFailProb, groupCancelProb, taskFailProb float64, msg ...string) {
 	numGroups := 10
 	numTasks := 10
 	numWorkers := 5
@@ -108,7 +144,7 @@ func test(t *testing.T, s Service, workerFailProb, groupCancelProb, taskFailProb
 		groupEg.Go(func() error {
 			var inputs []*types.Any
 			for j := 0; j < numTasks; j++ {
-				input, err := serializeTestTask(&TestTask{ID: strconv.Itoa(j)})
+				input, err := serializeTestTask(&task.TestTask{ID: strconv.Itoa(j)})
 				if err != nil {
 					return err
 				}
@@ -118,7 +154,7 @@ func test(t *testing.T, s Service, workerFailProb, groupCancelProb, taskFailProb
 			ctx, cancel := context.WithCancel(errCtx)
 			defer cancel()
 			d := s.NewDoer("", strconv.Itoa(i), nil)
-			if err := DoBatch(ctx, d, inputs, func(j int64, output *types.Any, err error) error {
+			if err := task.DoBatch(ctx, d, inputs, func(j int64, output *types.Any, err error) error {
 				if rand.Float64() < groupCancelProb {
 					created[i] = nil
 					collected[i] = nil
@@ -151,44 +187,56 @@ func test(t *testing.T, s Service, workerFailProb, groupCancelProb, taskFailProb
 
 func TestBasic(t *testing.T) {
 	t.Parallel()
-	test(t, newTestEtcdService(t), 0, 0, 0, seedRand())
+	testServices(t, func(t *testing.T, s task.Service) {
+		test(t, s, 0, 0, 0, seedRand())
+	})
 }
 
 func TestWorkerCrashes(t *testing.T) {
 	t.Parallel()
-	test(t, newTestEtcdService(t), 0.1, 0, 0, seedRand())
+	testServices(t, func(t *testing.T, s task.Service) {
+		test(t, s, 0.1, 0, 0, seedRand())
+	})
 }
 
 func TestCancelGroups(t *testing.T) {
 	t.Parallel()
-	test(t, newTestEtcdService(t), 0, 0.05, 0, seedRand())
+	testServices(t, func(t *testing.T, s task.Service) {
+		test(t, s, 0, 0.05, 0, seedRand())
+	})
 }
 
 func TestTaskFailures(t *testing.T) {
 	t.Parallel()
-	test(t, newTestEtcdService(t), 0, 0, 0.1, seedRand())
+	testServices(t, func(t *testing.T, s task.Service) {
+		test(t, s, 0, 0, 0.1, seedRand())
+	})
 }
 
 func TestEverything(t *testing.T) {
 	t.Parallel()
-	test(t, newTestEtcdService(t), 0.1, 0.2, 0.1, seedRand())
+	testServices(t, func(t *testing.T, s task.Service) {
+		test(t, s, 0.1, 0.2, 0.1, seedRand())
+	})
 }
 
 func TestRunZeroTasks(t *testing.T) {
 	t.Parallel()
-	env := testetcd.NewEnv(t)
-	s := NewEtcdService(env.EtcdClient, "")
-	d := s.NewDoer("", "", nil)
-	require.NoError(t, DoBatch(context.Background(), d, nil, func(_ int64, _ *types.Any, _ error) error {
-		return errors.New("no tasks should exist")
-	}))
+	testServices(t, func(t *testing.T, s task.Service) {
+		d := s.NewDoer("", "", nil)
+		require.NoError(t, task.DoBatch(context.Background(), d, nil, func(_ int64, _ *types.Any, _ error) error {
+			return errors.New("no tasks should exist")
+		}))
+	})
 }
 
 func TestListTask(t *testing.T) {
 	t.Parallel()
-	env := testetcd.NewEnv(t)
-	testNamespace := tu.UniqueString(t.Name())
-	s := NewEtcdService(env.EtcdClient, "")
+	testServices(t, testListTask)
+}
+
+func testListTask(t *testing.T, s task.Service) {
+	testNamespace := tu.UniqueString("TestListTask")
 
 	numGroups := 10
 	numTasks := 10
@@ -217,7 +265,7 @@ func TestListTask(t *testing.T) {
 				Namespace: namespace,
 				Group:     group,
 			}}
-			if err := List(context.Background(), s, req, func(info *taskapi.TaskInfo) error {
+			if err := task.List(context.Background(), s, req, func(info *taskapi.TaskInfo) error {
 				out = append(out, info)
 				return nil
 			}); err != nil {
@@ -277,7 +325,7 @@ func TestListTask(t *testing.T) {
 		groupEg.Go(func() error {
 			var inputs []*types.Any
 			for j := 0; j < numTasks; j++ {
-				input, err := serializeTestTask(&TestTask{ID: strconv.Itoa(g*numTasks + j)})
+				input, err := serializeTestTask(&task.TestTask{ID: strconv.Itoa(g*numTasks + j)})
 				if err != nil {
 					return err
 				}
@@ -286,7 +334,7 @@ func TestListTask(t *testing.T) {
 			ctx, cancel := context.WithCancel(errCtx)
 			defer cancel()
 			d := s.NewDoer(testNamespace, strconv.Itoa(g), nil)
-			if err := DoBatch(ctx, d, inputs, func(j int64, output *types.Any, err error) error {
+			if err := task.DoBatch(ctx, d, inputs, func(j int64, output *types.Any, err error) error {
 				if err != nil {
 					if err.Error() != errTaskFailure.Error() {
 						return errors.Errorf("task error message (%v) does not equal expected error message (%v)", err.Error(), errTaskFailure.Error())
@@ -90,6 +90,7 @@ type whoAmIFunc func(context.Context, *auth.WhoAmIRequest) (*auth.WhoAmIResponse
 type getRolesForPermissionFunc func(context.Context, *auth.GetRolesForPermissionRequest) (*auth.GetRolesForPermissionResponse, error)
 type getOIDCLoginFunc func(context.Context, *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error)
 type getRobotTokenFunc func(context.Context, *auth.GetRobotTokenRequest) (*auth.GetRobotTokenResponse, error)
+type getPresignCredentialsFunc func(context.Context, *auth.GetPresignCredentialsRequest) (*auth.GetPresignCredentialsResponse, error)
 type revokeAuthTokenFunc func(context.Context, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error)
 type revokeAuthTokensForUserFunc func(context.Context, *auth.RevokeAuthTokensForUserRequest) (*auth.RevokeAuthTokensForUserResponse, error)
 type setGroupsForUserFunc func(context.Context, *auth.SetGroupsForUserRequest) (*auth.SetGroupsForUserResponse, error)
@@ -119,6 +120,7 @@ type mockWhoAmI struct{ handler whoAmIFunc }
 type mockGetRolesForPermission struct{ handler getRolesForPermissionFunc }
 type mockGetOIDCLogin struct{ handler getOIDCLoginFunc }
 type mockGetRobotToken struct{ handler getRobotTokenFunc }
+type mockGetPresignCredentials struct{ handler getPresignCredentialsFunc }
 type mockRevokeAuthToken struct{ handler revokeAuthTokenFunc }
 type mockRevokeAuthTokensForUser struct{ handler revokeAuthTokensForUserFunc }
 type mockSetGroupsForUser struct{ handler setGroupsForUserFunc }
@@ -143,6 +145,7 @@ func (mock *mockWhoAmI) Use(cb whoAmIFunc)
 func (mock *mockGetRolesForPermission) Use(cb getRolesForPermissionFunc)           { mock.handler = cb }
 func (mock *mockGetOIDCLogin) Use(cb getOIDCLoginFunc)                             { mock.handler = cb }
 func (mock *mockGetRobotToken) Use(cb getRobotTokenFunc)                           { mock.handler = cb }
+func (mock *mockGetPresignCredentials) Use(cb getPresignCredentialsFunc)           { mock.handler = cb }
 func (mock *mockRevokeAuthToken) Use(cb revokeAuthTokenFunc)                       { mock.handler = cb }
 func (mock *mockRevokeAuthTokensForUser) Use(cb revokeAuthTokensForUserFunc)       { mock.handler = cb }
 func (mock *mockSetGroupsForUser) Use(cb setGroupsForUserFunc)                     { mock.handler = cb }
@@ -177,6 +180,7 @@ type mockAuthServer struct {
 	GetRolesForPermission      mockGetRolesForPermission
 	GetOIDCLogin               mockGetOIDCLogin
 	GetRobotToken              mockGetRobotToken
+	GetPresignCredentials      mockGetPresignCredentials
 	RevokeAuthToken            mockRevokeAuthToken
 	RevokeAuthTokensForUser    mockRevokeAuthTokensForUser
 	SetGroupsForUser           mockSetGroupsForUser
@@ -274,6 +278,12 @@ func (api *authServerAPI) GetRobotToken(ctx context.Context, req *auth.GetRobotT
 	}
 	return nil, errors.Errorf("unhandled pachd mock auth.GetRobotToken")
 }
+func (api *authServerAPI) GetPresignCredentials(ctx context.Context, req *auth.GetPresignCredentialsRequest) (*auth.GetPresignCredentialsResponse, error) {
+	if api.mock.GetPresignCredentials.handler != nil {
+		return api.mock.GetPresignCredentials.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.GetPresignCredentials")
+}
 func (api *authServerAPI) RevokeAuthToken(ctx context.Context, req *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error) {
 	if api.mock.RevokeAuthToken.handler != nil {
 		return api.mock.RevokeAuthToken.handler(ctx, req)
@@ -425,12 +435,25 @@ type createRepoFunc func(context.Context, *pfs.CreateRepoRequest) (*types.Empty,
 type inspectRepoFunc func(context.Context, *pfs.InspectRepoRequest) (*pfs.RepoInfo, error)
 type listRepoFunc func(*pfs.ListRepoRequest, pfs.API_ListRepoServer) error
 type deleteRepoFunc func(context.Context, *pfs.DeleteRepoRequest) (*types.Empty, error)
+type exportRepoFunc func(*pfs.ExportRepoRequest, pfs.API_ExportRepoServer) error
+type importRepoFunc func(pfs.API_ImportRepoServer) error
 type startCommitFunc func(context.Context, *pfs.StartCommitRequest) (*pfs.Commit, error)
 type finishCommitFunc func(context.Context, *pfs.FinishCommitRequest) (*types.Empty, error)
 type inspectCommitFunc func(context.Context, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error)
 type listCommitFunc func(*pfs.ListCommitRequest, pfs.API_ListCommitServer) error
 type squashCommitSetFunc func(context.Context, *pfs.SquashCommitSetRequest) (*types.Empty, error)
 type dropCommitSetFunc func(context.Context, *pfs.DropCommitSetRequest) (*types.Empty, error)
+type applyRetentionFunc func(context.Context, *pfs.ApplyRetentionRequest) (*pfs.ApplyRetentionResponse, error)
+type protectBranchFunc func(context.Context, *pfs.ProtectBranchRequest) (*types.Empty, error)
+type approveCommitFunc func(context.Context, *pfs.ApproveCommitRequest) (*types.Empty, error)
+type mergeBranchFunc func(context.Context, *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error)
+type createTagFunc func(context.Context, *pfs.CreateTagRequest) (*types.Empty, error)
+type inspectTagFunc func(context.Context, *pfs.InspectTagRequest) (*pfs.TagInfo, error)
+type listTagFunc func(*pfs.ListTagRequest, pfs.API_ListTagServer) error
+type deleteTagFunc func(context.Context, *pfs.DeleteTagRequest) (*types.Empty, error)
+type searchFileFunc func(*pfs.SearchFileRequest, pfs.API_SearchFileServer) error
+type createQuotaFunc func(context.Context, *pfs.CreateQuotaRequest) (*types.Empty, error)
+type inspectQuotaFunc func(context.Context, *pfs.InspectQuotaRequest) (*pfs.QuotaInfo, error)
 type inspectCommitSetFunc func(*pfs.InspectCommitSetRequest, pfs.API_InspectCommitSetServer) error
 type listCommitSetFunc func(*pfs.ListCommitSetRequest, pfs.API_ListCommitSetServer) error
 type subscribeCommitFunc func(*pfs.SubscribeCommitRequest, pfs.API_SubscribeCommitServer) error
@@ -455,6 +478,9 @@ type getFileSetFunc func(context.Context, *pfs.GetFileSetRequest) (*pfs.CreateFi
 type renewFileSetFunc func(context.Context, *pfs.RenewFileSetRequest) (*types.Empty, error)
 type composeFileSetFunc func(context.Context, *pfs.ComposeFileSetRequest) (*pfs.CreateFileSetResponse, error)
 type checkStorageFunc func(context.Context, *pfs.CheckStorageRequest) (*pfs.CheckStorageResponse, error)
+type listStorageKeyVersionsFunc func(context.Context, *pfs.ListStorageKeyVersionsRequest) (*pfs.ListStorageKeyVersionsResponse, error)
+type rotateStorageKeyFunc func(context.Context, *pfs.RotateStorageKeyRequest) (*pfs.RotateStorageKeyResponse, error)
+type garbageCollectStorageFunc func(context.Context, *pfs.GarbageCollectStorageRequest) (*pfs.GarbageCollectStorageResponse, error)
 type putCacheFunc func(context.Context, *pfs.PutCacheRequest) (*types.Empty, error)
 type getCacheFunc func(context.Context, *pfs.GetCacheRequest) (*pfs.GetCacheResponse, error)
 type clearCacheFunc func(context.Context, *pfs.ClearCacheRequest) (*types.Empty, error)
@@ -468,12 +494,25 @@ type mockCreateRepo struct{ handler createRepoFunc }
 type mockInspectRepo struct{ handler inspectRepoFunc }
 type mockListRepo struct{ handler listRepoFunc }
 type mockDeleteRepo struct{ handler deleteRepoFunc }
+type mockExportRepo struct{ handler exportRepoFunc }
+type mockImportRepo struct{ handler importRepoFunc }
 type mockStartCommit struct{ handler startCommitFunc }
 type mockFinishCommit struct{ handler finishCommitFunc }
 type mockInspectCommit struct{ handler inspectCommitFunc }
 type mockListCommit struct{ handler listCommitFunc }
 type mockSquashCommitSet struct{ handler squashCommitSetFunc }
 type mockDropCommitSet struct{ handler dropCommitSetFunc }
+type mockApplyRetention struct{ handler applyRetentionFunc }
+type mockProtectBranch struct{ handler protectBranchFunc }
+type mockApproveCommit struct{ handler approveCommitFunc }
+type mockMergeBranch struct{ handler mergeBranchFunc }
+type mockCreateTag struct{ handler createTagFunc }
+type mockInspectTag struct{ handler inspectTagFunc }
+type mockListTag struct{ handler listTagFunc }
+type mockDeleteTag struct{ handler deleteTagFunc }
+type mockSearchFile struct{ handler searchFileFunc }
+type mockCreateQuota struct{ handler createQuotaFunc }
+type mockInspectQuota struct{ handler inspectQuotaFunc }
 type mockInspectCommitSet struct{ handler inspectCommitSetFunc }
 type mockListCommitSet struct{ handler listCommitSetFunc }
 type mockSubscribeCommit struct{ handler subscribeCommitFunc }
@@ -498,6 +537,9 @@ type mockGetFileSet struct{ handler getFileSetFunc }
 type mockRenewFileSet struct{ handler renewFileSetFunc }
 type mockComposeFileSet struct{ handler composeFileSetFunc }
 type mockCheckStorage struct{ handler checkStorageFunc }
+type mockListStorageKeyVersions struct{ handler listStorageKeyVersionsFunc }
+type mockRotateStorageKey struct{ handler rotateStorageKeyFunc }
+type mockGarbageCollectStorage struct{ handler garbageCollectStorageFunc }
 type mockPutCache struct{ handler putCacheFunc }
 type mockGetCache struct{ handler getCacheFunc }
 type mockClearCache struct{ handler clearCacheFunc }
@@ -506,97 +548,129 @@ type mockRunLoadTestDefault struct{ handler runLoadTestDefaultFunc }
 type mockListTaskPFS struct{ handler listTaskPFSFunc }
 type mockEgress struct{ handler egressFunc }
 
-func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)       { mock.handler = cb }
-func (mock *mockCreateRepo) Use(cb createRepoFunc)                 { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)               { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)                     { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                 { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)               { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)             { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)           { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)                 { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)       { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)               { mock.handler = cb }
-func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)       { mock.handler = cb }
-func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)           { mock.handler = cb }
-func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)     { mock.handler = cb }
-func (mock *mockListCommitSet) Use(cb listCommitSetFunc)           { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)             { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)           { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)                 { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)             { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)                 { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                       { mock.handler = cb }
-func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                 { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)               { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)                     { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)                     { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)                     { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)                     { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)             { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                             { mock.handler = cb }
-func (mock *mockCreateFileSet) Use(cb createFileSetFunc)           { mock.handler = cb }
-func (mock *mockAddFileSet) Use(cb addFileSetFunc)                 { mock.handler = cb }
-func (mock *mockGetFileSet) Use(cb getFileSetFunc)                 { mock.handler = cb }
-func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)             { mock.handler = cb }
-func (mock *mockComposeFileSet) Use(cb composeFileSetFunc)         { mock.handler = cb }
-func (mock *mockCheckStorage) Use(cb checkStorageFunc)             { mock.handler = cb }
-func (mock *mockPutCache) Use(cb putCacheFunc)                     { mock.handler = cb }
-func (mock *mockGetCache) Use(cb getCacheFunc)                     { mock.handler = cb }
-func (mock *mockClearCache) Use(cb clearCacheFunc)                 { mock.handler = cb }
-func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)               { mock.handler = cb }
-func (mock *mockRunLoadTestDefault) Use(cb runLoadTestDefaultFunc) { mock.handler = cb }
-func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)               { mock.handler = cb }
-func (mock *mockEgress) Use(cb egressFunc)                         { mock.handler = cb }
+func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)               { mock.handler = cb }
+func (mock *mockCreateRepo) Use(cb createRepoFunc)                         { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)                       { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                             { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                         { mock.handler = cb }
+func (mock *mockExportRepo) Use(cb exportRepoFunc)                         { mock.handler = cb }
+func (mock *mockImportRepo) Use(cb importRepoFunc)                         { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)                       { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)                     { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)                   { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)                         { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)               { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)                       { mock.handler = cb }
+func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)               { mock.handler = cb }
+func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)                   { mock.handler = cb }
+func (mock *mockApplyRetention) Use(cb applyRetentionFunc)                 { mock.handler = cb }
+func (mock *mockProtectBranch) Use(cb protectBranchFunc)                   { mock.handler = cb }
+func (mock *mockApproveCommit) Use(cb approveCommitFunc)                   { mock.handler = cb }
+func (mock *mockMergeBranch) Use(cb mergeBranchFunc)                       { mock.handler = cb }
+func (mock *mockCreateTag) Use(cb createTagFunc)                           { mock.handler = cb }
+func (mock *mockInspectTag) Use(cb inspectTagFunc)                         { mock.handler = cb }
+func (mock *mockListTag) Use(cb listTagFunc)                               { mock.handler = cb }
+func (mock *mockDeleteTag) Use(cb deleteTagFunc)                           { mock.handler = cb }
+func (mock *mockSearchFile) Use(cb searchFileFunc)                         { mock.handler = cb }
+func (mock *mockCreateQuota) Use(cb createQuotaFunc)                       { mock.handler = cb }
+func (mock *mockInspectQuota) Use(cb inspectQuotaFunc)                     { mock.handler = cb }
+func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)             { mock.handler = cb }
+func (mock *mockListCommitSet) Use(cb listCommitSetFunc)                   { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)                     { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)                   { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)                         { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)                     { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)                         { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                               { mock.handler = cb }
+func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                         { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)                       { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                             { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                             { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                             { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                             { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)                     { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                                     { mock.handler = cb }
+func (mock *mockCreateFileSet) Use(cb createFileSetFunc)                   { mock.handler = cb }
+func (mock *mockAddFileSet) Use(cb addFileSetFunc)                         { mock.handler = cb }
+func (mock *mockGetFileSet) Use(cb getFileSetFunc)                         { mock.handler = cb }
+func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)                     { mock.handler = cb }
+func (mock *mockComposeFileSet) Use(cb composeFileSetFunc)                 { mock.handler = cb }
+func (mock *mockCheckStorage) Use(cb checkStorageFunc)                     { mock.handler = cb }
+func (mock *mockListStorageKeyVersions) Use(cb listStorageKeyVersionsFunc) { mock.handler = cb }
+func (mock *mockGarbageCollectStorage) Use(cb garbageCollectStorageFunc)   { mock.handler = cb }
+func (mock *mockRotateStorageKey) Use(cb rotateStorageKeyFunc)             { mock.handler = cb }
+func (mock *mockPutCache) Use(cb putCacheFunc)                             { mock.handler = cb }
+func (mock *mockGetCache) Use(cb getCacheFunc)                             { mock.handler = cb }
+func (mock *mockClearCache) Use(cb clearCacheFunc)                         { mock.handler = cb }
+func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)                       { mock.handler = cb }
+func (mock *mockRunLoadTestDefault) Use(cb runLoadTestDefaultFunc)         { mock.handler = cb }
+func (mock *mockListTaskPFS) Use(cb listTaskPFSFunc)                       { mock.handler = cb }
+func (mock *mockEgress) Use(cb egressFunc)                                 { mock.handler = cb }
 
 type pfsServerAPI struct {
 	mock *mockPFSServer
 }
 
 type mockPFSServer struct {
-	api                pfsServerAPI
-	ActivateAuth       mockActivateAuthPFS
-	CreateRepo         mockCreateRepo
-	InspectRepo        mockInspectRepo
-	ListRepo           mockListRepo
-	DeleteRepo         mockDeleteRepo
-	StartCommit        mockStartCommit
-	FinishCommit       mockFinishCommit
-	InspectCommit      mockInspectCommit
-	ListCommit         mockListCommit
-	SubscribeCommit    mockSubscribeCommit
-	ClearCommit        mockClearCommit
-	SquashCommitSet    mockSquashCommitSet
-	DropCommitSet      mockDropCommitSet
-	InspectCommitSet   mockInspectCommitSet
-	ListCommitSet      mockListCommitSet
-	CreateBranch       mockCreateBranch
-	InspectBranch      mockInspectBranch
-	ListBranch         mockListBranch
-	DeleteBranch       mockDeleteBranch
-	ModifyFile         mockModifyFile
-	GetFile            mockGetFile
-	GetFileTAR         mockGetFileTAR
-	InspectFile        mockInspectFile
-	ListFile           mockListFile
-	WalkFile           mockWalkFile
-	GlobFile           mockGlobFile
-	DiffFile           mockDiffFile
-	DeleteAll          mockDeleteAllPFS
-	Fsck               mockFsck
-	CreateFileSet      mockCreateFileSet
-	AddFileSet         mockAddFileSet
-	GetFileSet         mockGetFileSet
-	RenewFileSet       mockRenewFileSet
-	ComposeFileSet     mockComposeFileSet
-	CheckStorage       mockCheckStorage
-	PutCache           mockPutCache
-	GetCache           mockGetCache
-	ClearCache         mockClearCache
-	RunLoadTest        mockRunLoadTest
-	RunLoadTestDefault mockRunLoadTestDefault
-	ListTask           mockListTaskPFS
-	Egress             mockEgress
+	api                    pfsServerAPI
+	ActivateAuth           mockActivateAuthPFS
+	CreateRepo             mockCreateRepo
+	InspectRepo            mockInspectRepo
+	ListRepo               mockListRepo
+	DeleteRepo             mockDeleteRepo
+	ExportRepo             mockExportRepo
+	ImportRepo             mockImportRepo
+	StartCommit            mockStartCommit
+	FinishCommit           mockFinishCommit
+	InspectCommit          mockInspectCommit
+	ListCommit             mockListCommit
+	SubscribeCommit        mockSubscribeCommit
+	ClearCommit            mockClearCommit
+	SquashCommitSet        mockSquashCommitSet
+	DropCommitSet          mockDropCommitSet
+	ApplyRetention         mockApplyRetention
+	ProtectBranch          mockProtectBranch
+	ApproveCommit          mockApproveCommit
+	MergeBranch            mockMergeBranch
+	CreateTag              mockCreateTag
+	InspectTag             mockInspectTag
+	ListTag                mockListTag
+	DeleteTag              mockDeleteTag
+	SearchFile             mockSearchFile
+	CreateQuota            mockCreateQuota
+	InspectQuota           mockInspectQuota
+	InspectCommitSet       mockInspectCommitSet
+	ListCommitSet          mockListCommitSet
+	CreateBranch           mockCreateBranch
+	InspectBranch          mockInspectBranch
+	ListBranch             mockListBranch
+	DeleteBranch           mockDeleteBranch
+	ModifyFile             mockModifyFile
+	GetFile                mockGetFile
+	GetFileTAR             mockGetFileTAR
+	InspectFile            mockInspectFile
+	ListFile               mockListFile
+	WalkFile               mockWalkFile
+	GlobFile               mockGlobFile
+	DiffFile               mockDiffFile
+	DeleteAll              mockDeleteAllPFS
+	Fsck                   mockFsck
+	CreateFileSet          mockCreateFileSet
+	AddFileSet             mockAddFileSet
+	GetFileSet             mockGetFileSet
+	RenewFileSet           mockRenewFileSet
+	ComposeFileSet         mockComposeFileSet
+	CheckStorage           mockCheckStorage
+	ListStorageKeyVersions mockListStorageKeyVersions
+	RotateStorageKey       mockRotateStorageKey
+	GarbageCollectStorage  mockGarbageCollectStorage
+	PutCache               mockPutCache
+	GetCache               mockGetCache
+	ClearCache             mockClearCache
+	RunLoadTest            mockRunLoadTest
+	RunLoadTestDefault     mockRunLoadTestDefault
+	ListTask               mockListTaskPFS
+	Egress                 mockEgress
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -629,6 +703,18 @@ func (api *pfsServerAPI) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteRepo")
 }
+func (api *pfsServerAPI) ExportRepo(req *pfs.ExportRepoRequest, serv pfs.API_ExportRepoServer) error {
+	if api.mock.ExportRepo.handler != nil {
+		return api.mock.ExportRepo.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ExportRepo")
+}
+func (api *pfsServerAPI) ImportRepo(serv pfs.API_ImportRepoServer) error {
+	if api.mock.ImportRepo.handler != nil {
+		return api.mock.ImportRepo.handler(serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ImportRepo")
+}
 func (api *pfsServerAPI) StartCommit(ctx context.Context, req *pfs.StartCommitRequest) (*pfs.Commit, error) {
 	if api.mock.StartCommit.handler != nil {
 		return api.mock.StartCommit.handler(ctx, req)
@@ -665,6 +751,73 @@ func (api *pfsServerAPI) DropCommitSet(ctx context.Context, req *pfs.DropCommitS
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DropCommitSet")
 }
+func (api *pfsServerAPI) ApplyRetention(ctx context.Context, req *pfs.ApplyRetentionRequest) (*pfs.ApplyRetentionResponse, error) {
+	if api.mock.ApplyRetention.handler != nil {
+		return api.mock.ApplyRetention.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ApplyRetention")
+}
+func (api *pfsServerAPI) ProtectBranch(ctx context.Context, req *pfs.ProtectBranchRequest) (*types.Empty, error) {
+	if api.mock.ProtectBranch.handler != nil {
+		return api.mock.ProtectBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ProtectBranch")
+}
+func (api *pfsServerAPI) ApproveCommit(ctx context.Context, req *pfs.ApproveCommitRequest) (*types.Empty, error) {
+	if api.mock.ApproveCommit.handler != nil {
+		return api.mock.ApproveCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ApproveCommit")
+}
+func (api *pfsServerAPI) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error) {
+	if api.mock.MergeBranch.handler != nil {
+		return api.mock.MergeBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MergeBranch")
+}
+func (api *pfsServerAPI) CreateTag(ctx context.Context, req *pfs.CreateTagRequest) (*types.Empty, error) {
+	if api.mock.CreateTag.handler != nil {
+		return api.mock.CreateTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateTag")
+}
+func (api *pfsServerAPI) InspectTag(ctx context.Context, req *pfs.InspectTagRequest) (*pfs.TagInfo, error) {
+	if api.mock.InspectTag.handler != nil {
+		return api.mock.InspectTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectTag")
+}
+func (api *pfsServerAPI) ListTag(req *pfs.ListTagRequest, srv pfs.API_ListTagServer) error {
+	if api.mock.ListTag.handler != nil {
+		return api.mock.ListTag.handler(req, srv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListTag")
+}
+func (api *pfsServerAPI) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest) (*types.Empty, error) {
+	if api.mock.DeleteTag.handler != nil {
+		return api.mock.DeleteTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteTag")
+}
+
+func (api *pfsServerAPI) SearchFile(req *pfs.SearchFileRequest, srv pfs.API_SearchFileServer) error {
+	if api.mock.SearchFile.handler != nil {
+		return api.mock.SearchFile.handler(req, srv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.SearchFile")
+}
+func (api *pfsServerAPI) CreateQuota(ctx context.Context, req *pfs.CreateQuotaRequest) (*types.Empty, error) {
+	if api.mock.CreateQuota.handler != nil {
+		return api.mock.CreateQuota.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateQuota")
+}
+func (api *pfsServerAPI) InspectQuota(ctx context.Context, req *pfs.InspectQuotaRequest) (*pfs.QuotaInfo, error) {
+	if api.mock.InspectQuota.handler != nil {
+		return api.mock.InspectQuota.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectQuota")
+}
 func (api *pfsServerAPI) InspectCommitSet(req *pfs.InspectCommitSetRequest, serv pfs.API_InspectCommitSetServer) error {
 	if api.mock.InspectCommitSet.handler != nil {
 		return api.mock.InspectCommitSet.handler(req, serv)
@@ -809,6 +962,24 @@ func (api *pfsServerAPI) CheckStorage(ctx context.Context, req *pfs.CheckStorage
 	}
 	return nil, errors.Errorf("unhandled pachd mock CheckStorage")
 }
+func (api *pfsServerAPI) ListStorageKeyVersions(ctx context.Context, req *pfs.ListStorageKeyVersionsRequest) (*pfs.ListStorageKeyVersionsResponse, error) {
+	if api.mock.ListStorageKeyVersions.handler != nil {
+		return api.mock.ListStorageKeyVersions.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock ListStorageKeyVersions")
+}
+func (api *pfsServerAPI) RotateStorageKey(ctx context.Context, req *pfs.RotateStorageKeyRequest) (*pfs.RotateStorageKeyResponse, error) {
+	if api.mock.RotateStorageKey.handler != nil {
+		return api.mock.RotateStorageKey.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock RotateStorageKey")
+}
+func (api *pfsServerAPI) GarbageCollectStorage(ctx context.Context, req *pfs.GarbageCollectStorageRequest) (*pfs.GarbageCollectStorageResponse, error) {
+	if api.mock.GarbageCollectStorage.handler != nil {
+		return api.mock.GarbageCollectStorage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock GarbageCollectStorage")
+}
 func (api *pfsServerAPI) PutCache(ctx context.Context, req *pfs.PutCacheRequest) (*types.Empty, error) {
 	if api.mock.PutCache.handler != nil {
 		return api.mock.PutCache.handler(ctx, req)
@@ -877,8 +1048,12 @@ type createSecretFunc func(context.Context, *pps.CreateSecretRequest) (*types.Em
 type deleteSecretFunc func(context.Context, *pps.DeleteSecretRequest) (*types.Empty, error)
 type inspectSecretFunc func(context.Context, *pps.InspectSecretRequest) (*pps.SecretInfo, error)
 type listSecretFunc func(context.Context, *types.Empty) (*pps.SecretInfos, error)
+type createNotifierFunc func(context.Context, *pps.CreateNotifierRequest) (*types.Empty, error)
+type listNotifierFunc func(context.Context, *types.Empty) (*pps.NotifierInfos, error)
+type deleteNotifierFunc func(context.Context, *pps.DeleteNotifierRequest) (*types.Empty, error)
 type deleteAllPPSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type getLogsFunc func(*pps.GetLogsRequest, pps.API_GetLogsServer) error
+type traceFileFunc func(*pps.TraceFileRequest, pps.API_TraceFileServer) error
 type activateAuthPPSFunc func(context.Context, *pps.ActivateAuthRequest) (*pps.ActivateAuthResponse, error)
 type runLoadTestPPSFunc func(context.Context, *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error)
 type runLoadTestDefaultPPSFunc func(context.Context, *types.Empty) (*pfs.RunLoadTestResponse, error)
@@ -908,8 +1083,12 @@ type mockCreateSecret struct{ handler createSecretFunc }
 type mockDeleteSecret struct{ handler deleteSecretFunc }
 type mockInspectSecret struct{ handler inspectSecretFunc }
 type mockListSecret struct{ handler listSecretFunc }
+type mockCreateNotifier struct{ handler createNotifierFunc }
+type mockListNotifier struct{ handler listNotifierFunc }
+type mockDeleteNotifier struct{ handler deleteNotifierFunc }
 type mockDeleteAllPPS struct{ handler deleteAllPPSFunc }
 type mockGetLogs struct{ handler getLogsFunc }
+type mockTraceFile struct{ handler traceFileFunc }
 type mockActivateAuthPPS struct{ handler activateAuthPPSFunc }
 type mockRunLoadTestPPS struct{ handler runLoadTestPPSFunc }
 type mockRunLoadTestDefaultPPS struct{ handler runLoadTestDefaultPPSFunc }
@@ -939,8 +1118,12 @@ func (mock *mockCreateSecret) Use(cb createSecretFunc)                   { mock.
 func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)                   { mock.handler = cb }
 func (mock *mockInspectSecret) Use(cb inspectSecretFunc)                 { mock.handler = cb }
 func (mock *mockListSecret) Use(cb listSecretFunc)                       { mock.handler = cb }
+func (mock *mockCreateNotifier) Use(cb createNotifierFunc)               { mock.handler = cb }
+func (mock *mockListNotifier) Use(cb listNotifierFunc)                   { mock.handler = cb }
+func (mock *mockDeleteNotifier) Use(cb deleteNotifierFunc)               { mock.handler = cb }
 func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)                   { mock.handler = cb }
 func (mock *mockGetLogs) Use(cb getLogsFunc)                             { mock.handler = cb }
+func (mock *mockTraceFile) Use(cb traceFileFunc)                         { mock.handler = cb }
 func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc)             { mock.handler = cb }
 func (mock *mockRunLoadTestPPS) Use(cb runLoadTestPPSFunc)               { mock.handler = cb }
 func (mock *mockRunLoadTestDefaultPPS) Use(cb runLoadTestDefaultPPSFunc) { mock.handler = cb }
@@ -976,8 +1159,12 @@ type mockPPSServer struct {
 	DeleteSecret       mockDeleteSecret
 	InspectSecret      mockInspectSecret
 	ListSecret         mockListSecret
+	CreateNotifier     mockCreateNotifier
+	ListNotifier       mockListNotifier
+	DeleteNotifier     mockDeleteNotifier
 	DeleteAll          mockDeleteAllPPS
 	GetLogs            mockGetLogs
+	TraceFile          mockTraceFile
 	ActivateAuth       mockActivateAuthPPS
 	RunLoadTest        mockRunLoadTestPPS
 	RunLoadTestDefault mockRunLoadTestDefaultPPS
@@ -1123,6 +1310,24 @@ func (api *ppsServerAPI) ListSecret(ctx context.Context, in *types.Empty) (*pps.
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.ListSecret")
 }
+func (api *ppsServerAPI) CreateNotifier(ctx context.Context, req *pps.CreateNotifierRequest) (*types.Empty, error) {
+	if api.mock.CreateNotifier.handler != nil {
+		return api.mock.CreateNotifier.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.CreateNotifier")
+}
+func (api *ppsServerAPI) ListNotifier(ctx context.Context, in *types.Empty) (*pps.NotifierInfos, error) {
+	if api.mock.ListNotifier.handler != nil {
+		return api.mock.ListNotifier.handler(ctx, in)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ListNotifier")
+}
+func (api *ppsServerAPI) DeleteNotifier(ctx context.Context, req *pps.DeleteNotifierRequest) (*types.Empty, error) {
+	if api.mock.DeleteNotifier.handler != nil {
+		return api.mock.DeleteNotifier.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.DeleteNotifier")
+}
 func (api *ppsServerAPI) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
 	if api.mock.DeleteAll.handler != nil {
 		return api.mock.DeleteAll.handler(ctx, req)
@@ -1135,6 +1340,12 @@ func (api *ppsServerAPI) GetLogs(req *pps.GetLogsRequest, serv pps.API_GetLogsSe
 	}
 	return errors.Errorf("unhandled pachd mock pps.GetLogs")
 }
+func (api *ppsServerAPI) TraceFile(req *pps.TraceFileRequest, serv pps.API_TraceFileServer) error {
+	if api.mock.TraceFile.handler != nil {
+		return api.mock.TraceFile.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pps.TraceFile")
+}
 func (api *ppsServerAPI) ActivateAuth(ctx context.Context, req *pps.ActivateAuthRequest) (*pps.ActivateAuthResponse, error) {
 	if api.mock.ActivateAuth.handler != nil {
 		return api.mock.ActivateAuth.handler(ctx, req)
@@ -33,7 +33,7 @@ type SQLIngestParams struct {
 // read from files in the input.
 // The resulting rows are written to files in params.OutputDir.
 // The format of the output file is controlled by params.Format.
-// Valid options are "json" and "csv"
+// Valid options are "json", "csv" and "parquet"
 //
 // It makes outgoing connections using pachsql.OpenURL
 // It accesses the filesystem only within params.InputDir, and params.OutputDir
@@ -75,7 +75,14 @@ func SQLIngest(ctx context.Context, params SQLIngestParams) error {
 			return errors.EnsureStack(err)
 		}
 		log.Infof("Column names: %v", colNames)
-		tw := writerFactory(w, colNames)
+		cTypes, err := rows.ColumnTypes()
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		tw, err := writerFactory(w, pachsql.NewTableInfoFromColumnTypes(db.DriverName(), cTypes))
+		if err != nil {
+			return err
+		}
 		res, err := sdata.MaterializeSQL(tw, rows)
 		if err != nil {
 			return err
@@ -89,18 +96,22 @@ func SQLIngest(ctx context.Context, params SQLIngestParams) error {
 	return nil
 }
 
-type writerFactory = func(w io.Writer, fieldNames []string) sdata.TupleWriter
+type writerFactory = func(w io.Writer, info *pachsql.TableInfo) (sdata.TupleWriter, error)
 
 func makeWriterFactory(formatName string) (writerFactory, error) {
 	var factory writerFactory
 	switch formatName {
 	case "json", "jsonlines":
-		factory = func(w io.Writer, fieldNames []string) sdata.TupleWriter {
-			return sdata.NewJSONWriter(w, fieldNames)
+		factory = func(w io.Writer, info *pachsql.TableInfo) (sdata.TupleWriter, error) {
+			return sdata.NewJSONWriter(w, info.ColumnNames()), nil
 		}
 	case "csv":
-		factory = func(w io.Writer, fieldNames []string) sdata.TupleWriter {
-			return sdata.NewCSVWriter(w, nil)
+		factory = func(w io.Writer, _ *pachsql.TableInfo) (sdata.TupleWriter, error) {
+			return sdata.NewCSVWriter(w, nil), nil
+		}
+	case "parquet":
+		factory = func(w io.Writer, info *pachsql.TableInfo) (sdata.TupleWriter, error) {
+			return sdata.NewParquetWriter(w, info)
 		}
 	default:
 		return nil, errors.Errorf("unrecognized format %v", formatName)
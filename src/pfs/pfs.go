@@ -1,8 +1,10 @@
 package pfs
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"hash"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -78,3 +80,73 @@ func (b *Branch) NewCommit(id string) *Commit {
 func (b *Branch) String() string {
 	return b.Repo.String() + "@" + b.Name
 }
+
+// NewTag generates a Tag in the repo with the given name.
+func (r *Repo) NewTag(name string) *Tag {
+	return &Tag{
+		Repo: proto.Clone(r).(*Repo),
+		Name: name,
+	}
+}
+
+func (t *Tag) String() string {
+	return t.Repo.String() + "@" + t.Name
+}
+
+const (
+	filePageTokenKind   = "file"
+	commitPageTokenKind = "commit"
+)
+
+// NewFilePageToken returns a page token which resumes a ListFile, WalkFile or
+// GlobFile call after the file described by fi.
+func NewFilePageToken(fi *FileInfo) string {
+	return newPageToken(filePageTokenKind, fi.File.Path)
+}
+
+// ParseFilePageToken returns the path of the file that a page token created
+// by NewFilePageToken resumes after.
+func ParseFilePageToken(token string) (string, error) {
+	fields, err := parsePageToken(token, filePageTokenKind, 1)
+	if err != nil {
+		return "", err
+	}
+	return fields[0], nil
+}
+
+// NewCommitPageToken returns a page token which resumes a ListCommit call
+// after the commit described by ci.
+func NewCommitPageToken(ci *CommitInfo) string {
+	c := ci.Commit
+	return newPageToken(commitPageTokenKind, c.Branch.Repo.Name, c.Branch.Repo.Type, c.Branch.Name, c.ID)
+}
+
+// ParseCommitPageToken returns the commit that a page token created by
+// NewCommitPageToken resumes after.
+func ParseCommitPageToken(token string) (*Commit, error) {
+	fields, err := parsePageToken(token, commitPageTokenKind, 4)
+	if err != nil {
+		return nil, err
+	}
+	repo := &Repo{Name: fields[0], Type: fields[1]}
+	return repo.NewCommit(fields[2], fields[3]), nil
+}
+
+// Page tokens are the null separated kind and fields of the token, so that
+// they are opaque to users and a token for one kind of listing is rejected by
+// the others.
+func newPageToken(kind string, fields ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(append([]string{kind}, fields...), "\x00")))
+}
+
+func parsePageToken(token, kind string, numFields int) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Errorf("invalid page token %q", token)
+	}
+	fields := strings.Split(string(data), "\x00")
+	if len(fields) != numFields+1 || fields[0] != kind {
+		return nil, errors.Errorf("invalid %s page token %q", kind, token)
+	}
+	return fields[1:], nil
+}
@@ -207,6 +207,62 @@ func (PipelineState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_beade573c128ccc7, []int{3}
 }
 
+type NotificationType int32
+
+const (
+	NotificationType_NOTIFICATION_TYPE_UNKNOWN NotificationType = 0
+	NotificationType_JOB_STATE_CHANGED         NotificationType = 1
+	NotificationType_PIPELINE_STATE_CHANGED    NotificationType = 2
+	NotificationType_COMMIT_FINISHED           NotificationType = 3
+)
+
+var NotificationType_name = map[int32]string{
+	0: "NOTIFICATION_TYPE_UNKNOWN",
+	1: "JOB_STATE_CHANGED",
+	2: "PIPELINE_STATE_CHANGED",
+	3: "COMMIT_FINISHED",
+}
+
+var NotificationType_value = map[string]int32{
+	"NOTIFICATION_TYPE_UNKNOWN": 0,
+	"JOB_STATE_CHANGED":         1,
+	"PIPELINE_STATE_CHANGED":    2,
+	"COMMIT_FINISHED":           3,
+}
+
+func (x NotificationType) String() string {
+	return proto.EnumName(NotificationType_name, int32(x))
+}
+
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{4}
+}
+
+type TraceDirection int32
+
+const (
+	TraceDirection_UPSTREAM   TraceDirection = 0
+	TraceDirection_DOWNSTREAM TraceDirection = 1
+)
+
+var TraceDirection_name = map[int32]string{
+	0: "UPSTREAM",
+	1: "DOWNSTREAM",
+}
+
+var TraceDirection_value = map[string]int32{
+	"UPSTREAM":   0,
+	"DOWNSTREAM": 1,
+}
+
+func (x TraceDirection) String() string {
+	return proto.EnumName(TraceDirection_name, int32(x))
+}
+
+func (TraceDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{5}
+}
+
 // The pipeline type is stored here so that we can internally know the type of
 // the pipeline without loading the spec from PFS.
 type PipelineInfo_PipelineType int32
@@ -830,10 +886,12 @@ func (m *Spout) GetService() *Service {
 }
 
 type PFSInput struct {
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Repo      string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	RepoType  string `protobuf:"bytes,13,opt,name=repo_type,json=repoType,proto3" json:"repo_type,omitempty"`
-	Branch    string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repo     string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	RepoType string `protobuf:"bytes,13,opt,name=repo_type,json=repoType,proto3" json:"repo_type,omitempty"`
+	Branch   string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	// commit pins the input to a commit or tag in repo, rather than following
+	// branch. Jobs are still triggered by commits to branch.
 	Commit    string `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`
 	Glob      string `protobuf:"bytes,5,opt,name=glob,proto3" json:"glob,omitempty"`
 	JoinOn    string `protobuf:"bytes,6,opt,name=join_on,json=joinOn,proto3" json:"join_on,omitempty"`
@@ -2045,13 +2103,16 @@ type JobInfo struct {
 	DataFailed    int64 `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
 	DataRecovered int64 `protobuf:"varint,9,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
 	// Download/process/upload time and download/upload bytes
-	Stats                *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
-	State                JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
-	Reason               string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
-	Created              *types.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
-	Started              *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
-	Finished             *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
-	Details              *JobInfo_Details `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
+	Stats    *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	State    JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
+	Reason   string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	Created  *types.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
+	Started  *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
+	Finished *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
+	Details  *JobInfo_Details `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
+	// egressed is when the job finished egressing its output commit, or unset
+	// if it didn't egress.
+	Egressed             *types.Timestamp `protobuf:"bytes,17,opt,name=egressed,proto3" json:"egressed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -2202,6 +2263,13 @@ func (m *JobInfo) GetDetails() *JobInfo_Details {
 	return nil
 }
 
+func (m *JobInfo) GetEgressed() *types.Timestamp {
+	if m != nil {
+		return m.Egressed
+	}
+	return nil
+}
+
 type JobInfo_Details struct {
 	Transform             *Transform       `protobuf:"bytes,1,opt,name=transform,proto3" json:"transform,omitempty"`
 	ParallelismSpec       *ParallelismSpec `protobuf:"bytes,2,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
@@ -2660,6 +2728,7 @@ type PipelineInfo_Details struct {
 	UnclaimedTasks        int64            `protobuf:"varint,31,opt,name=unclaimed_tasks,json=unclaimedTasks,proto3" json:"unclaimed_tasks,omitempty"`
 	WorkerRc              string           `protobuf:"bytes,32,opt,name=worker_rc,json=workerRc,proto3" json:"worker_rc,omitempty"`
 	Autoscaling           bool             `protobuf:"varint,33,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	DatumCache            bool             `protobuf:"varint,34,opt,name=datum_cache,json=datumCache,proto3" json:"datum_cache,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
 	XXX_unrecognized      []byte           `json:"-"`
 	XXX_sizecache         int32            `json:"-"`
@@ -2915,6 +2984,13 @@ func (m *PipelineInfo_Details) GetAutoscaling() bool {
 	return false
 }
 
+func (m *PipelineInfo_Details) GetDatumCache() bool {
+	if m != nil {
+		return m.DatumCache
+	}
+	return false
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -3434,19 +3510,20 @@ func (m *StopJobRequest) GetReason() string {
 }
 
 type UpdateJobStateRequest struct {
-	Job                  *Job          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	State                JobState      `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
-	Reason               string        `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	Restart              uint64        `protobuf:"varint,5,opt,name=restart,proto3" json:"restart,omitempty"`
-	DataProcessed        int64         `protobuf:"varint,6,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
-	DataSkipped          int64         `protobuf:"varint,7,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
-	DataFailed           int64         `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
-	DataRecovered        int64         `protobuf:"varint,9,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
-	DataTotal            int64         `protobuf:"varint,10,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
-	Stats                *ProcessStats `protobuf:"bytes,11,opt,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Job                  *Job             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	State                JobState         `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
+	Reason               string           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Restart              uint64           `protobuf:"varint,5,opt,name=restart,proto3" json:"restart,omitempty"`
+	DataProcessed        int64            `protobuf:"varint,6,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataSkipped          int64            `protobuf:"varint,7,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	DataFailed           int64            `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
+	DataRecovered        int64            `protobuf:"varint,9,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	DataTotal            int64            `protobuf:"varint,10,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	Stats                *ProcessStats    `protobuf:"bytes,11,opt,name=stats,proto3" json:"stats,omitempty"`
+	Egressed             *types.Timestamp `protobuf:"bytes,12,opt,name=egressed,proto3" json:"egressed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *UpdateJobStateRequest) Reset()         { *m = UpdateJobStateRequest{} }
@@ -3552,6 +3629,13 @@ func (m *UpdateJobStateRequest) GetStats() *ProcessStats {
 	return nil
 }
 
+func (m *UpdateJobStateRequest) GetEgressed() *types.Timestamp {
+	if m != nil {
+		return m.Egressed
+	}
+	return nil
+}
+
 type GetLogsRequest struct {
 	// The pipeline from which we want to get logs (required if the job in 'job'
 	// was created as part of a pipeline. To get logs from a non-orphan job
@@ -3578,10 +3662,19 @@ type GetLogsRequest struct {
 	// setting the LOKI_LOGGING feature flag.
 	UseLokiBackend bool `protobuf:"varint,8,opt,name=use_loki_backend,json=useLokiBackend,proto3" json:"use_loki_backend,omitempty"`
 	// Since specifies how far in the past to return logs from. It defaults to 24 hours.
-	Since                *types.Duration `protobuf:"bytes,9,opt,name=since,proto3" json:"since,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Since *types.Duration `protobuf:"bytes,9,opt,name=since,proto3" json:"since,omitempty"`
+	// UsePfsBackend causes the logs request to be served from the logs that
+	// workers persist in each job's meta commit, rather than from kubernetes or
+	// loki. This allows logs to be retrieved for any historical job. Follow,
+	// tail and since are not supported by this backend.
+	UsePfsBackend bool `protobuf:"varint,10,opt,name=use_pfs_backend,json=usePfsBackend,proto3" json:"use_pfs_backend,omitempty"`
+	// From and To restrict the logs returned by the PFS backend to those
+	// logged within the given time window. Either may be unset.
+	From                 *types.Timestamp `protobuf:"bytes,11,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *types.Timestamp `protobuf:"bytes,12,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
@@ -3680,6 +3773,27 @@ func (m *GetLogsRequest) GetSince() *types.Duration {
 	return nil
 }
 
+func (m *GetLogsRequest) GetUsePfsBackend() bool {
+	if m != nil {
+		return m.UsePfsBackend
+	}
+	return false
+}
+
+func (m *GetLogsRequest) GetFrom() *types.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetTo() *types.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
 // LogMessage is a log line from a PPS worker, annotated with metadata
 // indicating when and why the line was logged.
 type LogMessage struct {
@@ -4112,24 +4226,29 @@ type CreatePipelineRequest struct {
 	Description           string        `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	Service              *Service        `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
-	DatumSetSpec         *DatumSetSpec   `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
-	DatumTries           int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	Autoscaling          bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess      bool            `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	Service        *Service        `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout          `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
+	DatumSetSpec   *DatumSetSpec   `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout   *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
+	DatumTries     int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit     *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata       *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec  string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	Autoscaling    bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	// datum_cache, if set, shares the output of datums with every other pipeline
+	// which sets datum_cache. A datum is restored from the cache, rather than
+	// processed, if a datum with the same user image, transform and input files
+	// has been processed by any of those pipelines in the last 7 days.
+	DatumCache           bool     `protobuf:"varint,31,opt,name=datum_cache,json=datumCache,proto3" json:"datum_cache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
@@ -4361,6 +4480,13 @@ func (m *CreatePipelineRequest) GetAutoscaling() bool {
 	return false
 }
 
+func (m *CreatePipelineRequest) GetDatumCache() bool {
+	if m != nil {
+		return m.DatumCache
+	}
+	return false
+}
+
 type InspectPipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	// When true, return PipelineInfos with the details field, which requires
@@ -5073,24 +5199,25 @@ func (m *SecretInfos) GetSecretInfo() []*SecretInfo {
 	return nil
 }
 
-type ActivateAuthRequest struct {
+type Notifier struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
-func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
-func (*ActivateAuthRequest) ProtoMessage()    {}
-func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
+func (m *Notifier) Reset()         { *m = Notifier{} }
+func (m *Notifier) String() string { return proto.CompactTextString(m) }
+func (*Notifier) ProtoMessage()    {}
+func (*Notifier) Descriptor() ([]byte, []int) {
 	return fileDescriptor_beade573c128ccc7, []int{59}
 }
-func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
+func (m *Notifier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Notifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActivateAuthRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Notifier.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5100,36 +5227,67 @@ func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ActivateAuthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActivateAuthRequest.Merge(m, src)
+func (m *Notifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notifier.Merge(m, src)
 }
-func (m *ActivateAuthRequest) XXX_Size() int {
+func (m *Notifier) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActivateAuthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActivateAuthRequest.DiscardUnknown(m)
+func (m *Notifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notifier.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActivateAuthRequest proto.InternalMessageInfo
+var xxx_messageInfo_Notifier proto.InternalMessageInfo
 
-type ActivateAuthResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Notifier) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
-func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
-func (*ActivateAuthResponse) ProtoMessage()    {}
-func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
+// NotifierInfo describes an HTTP webhook that pachd POSTs a Notification to
+// whenever an event matching its filters occurs.
+type NotifierInfo struct {
+	Notifier *Notifier `protobuf:"bytes,1,opt,name=notifier,proto3" json:"notifier,omitempty"`
+	// URL is the http or https URL that notifications are POSTed to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret is used to sign each notification, with an HMAC-SHA256 of the
+	// request body sent in the X-Pachyderm-Signature header. It is never
+	// returned by ListNotifier.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Pipelines restricts job and pipeline notifications to these pipelines.
+	// If empty, all pipelines match.
+	Pipelines []string `protobuf:"bytes,4,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	// Repos restricts notifications to events in these repos. The repo of a
+	// job or pipeline event is the pipeline's output repo. If empty, all repos
+	// match.
+	Repos []string `protobuf:"bytes,5,rep,name=repos,proto3" json:"repos,omitempty"`
+	// JobStates are the job states whose transitions are notified.
+	JobStates []JobState `protobuf:"varint,6,rep,packed,name=job_states,json=jobStates,proto3,enum=pps_v2.JobState" json:"job_states,omitempty"`
+	// PipelineStates are the pipeline states whose transitions are notified.
+	PipelineStates []PipelineState `protobuf:"varint,7,rep,packed,name=pipeline_states,json=pipelineStates,proto3,enum=pps_v2.PipelineState" json:"pipeline_states,omitempty"`
+	// CommitFinished causes a notification to be sent whenever a commit in a
+	// user repo finishes.
+	CommitFinished       bool             `protobuf:"varint,8,opt,name=commit_finished,json=commitFinished,proto3" json:"commit_finished,omitempty"`
+	Created              *types.Timestamp `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NotifierInfo) Reset()         { *m = NotifierInfo{} }
+func (m *NotifierInfo) String() string { return proto.CompactTextString(m) }
+func (*NotifierInfo) ProtoMessage()    {}
+func (*NotifierInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_beade573c128ccc7, []int{60}
 }
-func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
+func (m *NotifierInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NotifierInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActivateAuthResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NotifierInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5139,38 +5297,100 @@ func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ActivateAuthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActivateAuthResponse.Merge(m, src)
+func (m *NotifierInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifierInfo.Merge(m, src)
 }
-func (m *ActivateAuthResponse) XXX_Size() int {
+func (m *NotifierInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActivateAuthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActivateAuthResponse.DiscardUnknown(m)
+func (m *NotifierInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifierInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActivateAuthResponse proto.InternalMessageInfo
+var xxx_messageInfo_NotifierInfo proto.InternalMessageInfo
 
-type RenderTemplateRequest struct {
-	Template             string            `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
-	Args                 map[string]string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *NotifierInfo) GetNotifier() *Notifier {
+	if m != nil {
+		return m.Notifier
+	}
+	return nil
 }
 
-func (m *RenderTemplateRequest) Reset()         { *m = RenderTemplateRequest{} }
-func (m *RenderTemplateRequest) String() string { return proto.CompactTextString(m) }
-func (*RenderTemplateRequest) ProtoMessage()    {}
-func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
+func (m *NotifierInfo) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *NotifierInfo) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *NotifierInfo) GetPipelines() []string {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *NotifierInfo) GetRepos() []string {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *NotifierInfo) GetJobStates() []JobState {
+	if m != nil {
+		return m.JobStates
+	}
+	return nil
+}
+
+func (m *NotifierInfo) GetPipelineStates() []PipelineState {
+	if m != nil {
+		return m.PipelineStates
+	}
+	return nil
+}
+
+func (m *NotifierInfo) GetCommitFinished() bool {
+	if m != nil {
+		return m.CommitFinished
+	}
+	return false
+}
+
+func (m *NotifierInfo) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+type NotifierInfos struct {
+	NotifierInfo         []*NotifierInfo `protobuf:"bytes,1,rep,name=notifier_info,json=notifierInfo,proto3" json:"notifier_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NotifierInfos) Reset()         { *m = NotifierInfos{} }
+func (m *NotifierInfos) String() string { return proto.CompactTextString(m) }
+func (*NotifierInfos) ProtoMessage()    {}
+func (*NotifierInfos) Descriptor() ([]byte, []int) {
 	return fileDescriptor_beade573c128ccc7, []int{61}
 }
-func (m *RenderTemplateRequest) XXX_Unmarshal(b []byte) error {
+func (m *NotifierInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RenderTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NotifierInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RenderTemplateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NotifierInfos.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5180,52 +5400,53 @@ func (m *RenderTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *RenderTemplateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenderTemplateRequest.Merge(m, src)
+func (m *NotifierInfos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifierInfos.Merge(m, src)
 }
-func (m *RenderTemplateRequest) XXX_Size() int {
+func (m *NotifierInfos) XXX_Size() int {
 	return m.Size()
 }
-func (m *RenderTemplateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RenderTemplateRequest.DiscardUnknown(m)
+func (m *NotifierInfos) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifierInfos.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RenderTemplateRequest proto.InternalMessageInfo
-
-func (m *RenderTemplateRequest) GetTemplate() string {
-	if m != nil {
-		return m.Template
-	}
-	return ""
-}
+var xxx_messageInfo_NotifierInfos proto.InternalMessageInfo
 
-func (m *RenderTemplateRequest) GetArgs() map[string]string {
+func (m *NotifierInfos) GetNotifierInfo() []*NotifierInfo {
 	if m != nil {
-		return m.Args
+		return m.NotifierInfo
 	}
 	return nil
 }
 
-type RenderTemplateResponse struct {
-	Json                 string                   `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
-	Specs                []*CreatePipelineRequest `protobuf:"bytes,2,rep,name=specs,proto3" json:"specs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type CreateNotifierRequest struct {
+	Notifier       *Notifier       `protobuf:"bytes,1,opt,name=notifier,proto3" json:"notifier,omitempty"`
+	Url            string          `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret         string          `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Pipelines      []string        `protobuf:"bytes,4,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	Repos          []string        `protobuf:"bytes,5,rep,name=repos,proto3" json:"repos,omitempty"`
+	JobStates      []JobState      `protobuf:"varint,6,rep,packed,name=job_states,json=jobStates,proto3,enum=pps_v2.JobState" json:"job_states,omitempty"`
+	PipelineStates []PipelineState `protobuf:"varint,7,rep,packed,name=pipeline_states,json=pipelineStates,proto3,enum=pps_v2.PipelineState" json:"pipeline_states,omitempty"`
+	CommitFinished bool            `protobuf:"varint,8,opt,name=commit_finished,json=commitFinished,proto3" json:"commit_finished,omitempty"`
+	// Update replaces the notifier if it already exists.
+	Update               bool     `protobuf:"varint,9,opt,name=update,proto3" json:"update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RenderTemplateResponse) Reset()         { *m = RenderTemplateResponse{} }
-func (m *RenderTemplateResponse) String() string { return proto.CompactTextString(m) }
-func (*RenderTemplateResponse) ProtoMessage()    {}
-func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
+func (m *CreateNotifierRequest) Reset()         { *m = CreateNotifierRequest{} }
+func (m *CreateNotifierRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateNotifierRequest) ProtoMessage()    {}
+func (*CreateNotifierRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_beade573c128ccc7, []int{62}
 }
-func (m *RenderTemplateResponse) XXX_Unmarshal(b []byte) error {
+func (m *CreateNotifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RenderTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateNotifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RenderTemplateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateNotifierRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5235,3122 +5456,2560 @@ func (m *RenderTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *RenderTemplateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenderTemplateResponse.Merge(m, src)
+func (m *CreateNotifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateNotifierRequest.Merge(m, src)
 }
-func (m *RenderTemplateResponse) XXX_Size() int {
+func (m *CreateNotifierRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RenderTemplateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RenderTemplateResponse.DiscardUnknown(m)
+func (m *CreateNotifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateNotifierRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RenderTemplateResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateNotifierRequest proto.InternalMessageInfo
 
-func (m *RenderTemplateResponse) GetJson() string {
+func (m *CreateNotifierRequest) GetNotifier() *Notifier {
 	if m != nil {
-		return m.Json
+		return m.Notifier
+	}
+	return nil
+}
+
+func (m *CreateNotifierRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
 	return ""
 }
 
-func (m *RenderTemplateResponse) GetSpecs() []*CreatePipelineRequest {
+func (m *CreateNotifierRequest) GetSecret() string {
 	if m != nil {
-		return m.Specs
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *CreateNotifierRequest) GetPipelines() []string {
+	if m != nil {
+		return m.Pipelines
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("pps_v2.JobState", JobState_name, JobState_value)
-	proto.RegisterEnum("pps_v2.DatumState", DatumState_name, DatumState_value)
-	proto.RegisterEnum("pps_v2.WorkerState", WorkerState_name, WorkerState_value)
-	proto.RegisterEnum("pps_v2.PipelineState", PipelineState_name, PipelineState_value)
-	proto.RegisterEnum("pps_v2.PipelineInfo_PipelineType", PipelineInfo_PipelineType_name, PipelineInfo_PipelineType_value)
-	proto.RegisterType((*SecretMount)(nil), "pps_v2.SecretMount")
-	proto.RegisterType((*Transform)(nil), "pps_v2.Transform")
-	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Transform.EnvEntry")
-	proto.RegisterType((*TFJob)(nil), "pps_v2.TFJob")
-	proto.RegisterType((*Egress)(nil), "pps_v2.Egress")
-	proto.RegisterType((*Job)(nil), "pps_v2.Job")
-	proto.RegisterType((*Metadata)(nil), "pps_v2.Metadata")
-	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Metadata.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Metadata.LabelsEntry")
-	proto.RegisterType((*Service)(nil), "pps_v2.Service")
-	proto.RegisterType((*Spout)(nil), "pps_v2.Spout")
-	proto.RegisterType((*PFSInput)(nil), "pps_v2.PFSInput")
-	proto.RegisterType((*CronInput)(nil), "pps_v2.CronInput")
-	proto.RegisterType((*Input)(nil), "pps_v2.Input")
-	proto.RegisterType((*JobInput)(nil), "pps_v2.JobInput")
-	proto.RegisterType((*ParallelismSpec)(nil), "pps_v2.ParallelismSpec")
-	proto.RegisterType((*InputFile)(nil), "pps_v2.InputFile")
-	proto.RegisterType((*Datum)(nil), "pps_v2.Datum")
-	proto.RegisterType((*DatumInfo)(nil), "pps_v2.DatumInfo")
-	proto.RegisterType((*Aggregate)(nil), "pps_v2.Aggregate")
-	proto.RegisterType((*ProcessStats)(nil), "pps_v2.ProcessStats")
-	proto.RegisterType((*AggregateProcessStats)(nil), "pps_v2.AggregateProcessStats")
-	proto.RegisterType((*WorkerStatus)(nil), "pps_v2.WorkerStatus")
-	proto.RegisterType((*DatumStatus)(nil), "pps_v2.DatumStatus")
-	proto.RegisterType((*ResourceSpec)(nil), "pps_v2.ResourceSpec")
-	proto.RegisterType((*GPUSpec)(nil), "pps_v2.GPUSpec")
-	proto.RegisterType((*JobSetInfo)(nil), "pps_v2.JobSetInfo")
-	proto.RegisterType((*JobInfo)(nil), "pps_v2.JobInfo")
-	proto.RegisterType((*JobInfo_Details)(nil), "pps_v2.JobInfo.Details")
-	proto.RegisterType((*Worker)(nil), "pps_v2.Worker")
-	proto.RegisterType((*Pipeline)(nil), "pps_v2.Pipeline")
-	proto.RegisterType((*PipelineInfo)(nil), "pps_v2.PipelineInfo")
-	proto.RegisterType((*PipelineInfo_Details)(nil), "pps_v2.PipelineInfo.Details")
-	proto.RegisterType((*PipelineInfos)(nil), "pps_v2.PipelineInfos")
-	proto.RegisterType((*JobSet)(nil), "pps_v2.JobSet")
-	proto.RegisterType((*InspectJobSetRequest)(nil), "pps_v2.InspectJobSetRequest")
-	proto.RegisterType((*ListJobSetRequest)(nil), "pps_v2.ListJobSetRequest")
-	proto.RegisterType((*InspectJobRequest)(nil), "pps_v2.InspectJobRequest")
-	proto.RegisterType((*ListJobRequest)(nil), "pps_v2.ListJobRequest")
-	proto.RegisterType((*SubscribeJobRequest)(nil), "pps_v2.SubscribeJobRequest")
-	proto.RegisterType((*DeleteJobRequest)(nil), "pps_v2.DeleteJobRequest")
-	proto.RegisterType((*StopJobRequest)(nil), "pps_v2.StopJobRequest")
-	proto.RegisterType((*UpdateJobStateRequest)(nil), "pps_v2.UpdateJobStateRequest")
-	proto.RegisterType((*GetLogsRequest)(nil), "pps_v2.GetLogsRequest")
-	proto.RegisterType((*LogMessage)(nil), "pps_v2.LogMessage")
-	proto.RegisterType((*RestartDatumRequest)(nil), "pps_v2.RestartDatumRequest")
-	proto.RegisterType((*InspectDatumRequest)(nil), "pps_v2.InspectDatumRequest")
-	proto.RegisterType((*ListDatumRequest)(nil), "pps_v2.ListDatumRequest")
-	proto.RegisterType((*DatumSetSpec)(nil), "pps_v2.DatumSetSpec")
-	proto.RegisterType((*SchedulingSpec)(nil), "pps_v2.SchedulingSpec")
-	proto.RegisterMapType((map[string]string)(nil), "pps_v2.SchedulingSpec.NodeSelectorEntry")
-	proto.RegisterType((*CreatePipelineRequest)(nil), "pps_v2.CreatePipelineRequest")
-	proto.RegisterType((*InspectPipelineRequest)(nil), "pps_v2.InspectPipelineRequest")
-	proto.RegisterType((*ListPipelineRequest)(nil), "pps_v2.ListPipelineRequest")
-	proto.RegisterType((*DeletePipelineRequest)(nil), "pps_v2.DeletePipelineRequest")
-	proto.RegisterType((*StartPipelineRequest)(nil), "pps_v2.StartPipelineRequest")
-	proto.RegisterType((*StopPipelineRequest)(nil), "pps_v2.StopPipelineRequest")
-	proto.RegisterType((*RunPipelineRequest)(nil), "pps_v2.RunPipelineRequest")
-	proto.RegisterType((*RunCronRequest)(nil), "pps_v2.RunCronRequest")
-	proto.RegisterType((*CreateSecretRequest)(nil), "pps_v2.CreateSecretRequest")
-	proto.RegisterType((*DeleteSecretRequest)(nil), "pps_v2.DeleteSecretRequest")
-	proto.RegisterType((*InspectSecretRequest)(nil), "pps_v2.InspectSecretRequest")
-	proto.RegisterType((*Secret)(nil), "pps_v2.Secret")
-	proto.RegisterType((*SecretInfo)(nil), "pps_v2.SecretInfo")
-	proto.RegisterType((*SecretInfos)(nil), "pps_v2.SecretInfos")
-	proto.RegisterType((*ActivateAuthRequest)(nil), "pps_v2.ActivateAuthRequest")
-	proto.RegisterType((*ActivateAuthResponse)(nil), "pps_v2.ActivateAuthResponse")
-	proto.RegisterType((*RenderTemplateRequest)(nil), "pps_v2.RenderTemplateRequest")
-	proto.RegisterMapType((map[string]string)(nil), "pps_v2.RenderTemplateRequest.ArgsEntry")
-	proto.RegisterType((*RenderTemplateResponse)(nil), "pps_v2.RenderTemplateResponse")
+func (m *CreateNotifierRequest) GetRepos() []string {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
-
-var fileDescriptor_beade573c128ccc7 = []byte{
-	// 4812 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0x4b, 0x73, 0x1c, 0x47,
-	0x72, 0xe6, 0xbc, 0x67, 0x72, 0x1e, 0x18, 0x14, 0x1e, 0x1c, 0x0e, 0xdf, 0x2d, 0x2f, 0x45, 0x72,
-	0x25, 0x40, 0x02, 0xb5, 0xdc, 0x15, 0xb5, 0xa2, 0x16, 0x8f, 0x21, 0x05, 0x12, 0x02, 0xa1, 0x9e,
-	0x81, 0x14, 0xda, 0xb0, 0xa3, 0xb7, 0x67, 0xba, 0x66, 0xd0, 0x44, 0x4f, 0x77, 0xab, 0xab, 0x1b,
-	0x34, 0x74, 0xb1, 0x8f, 0x0e, 0x87, 0x4f, 0x96, 0x0f, 0x3e, 0xfa, 0xe2, 0xc3, 0xfa, 0x62, 0xff,
-	0x03, 0x7b, 0x23, 0x7c, 0xb0, 0x6f, 0x7b, 0xf2, 0xc5, 0x11, 0x0a, 0x07, 0xc3, 0xd7, 0xbd, 0xf8,
-	0xe8, 0xd3, 0x46, 0xbd, 0xfa, 0x31, 0xd3, 0x18, 0xbc, 0x74, 0x21, 0xab, 0x32, 0xb3, 0xb2, 0xaa,
-	0xb2, 0xaa, 0x32, 0xbf, 0xcc, 0x69, 0x40, 0xdd, 0x75, 0xc9, 0xaa, 0xeb, 0x92, 0x15, 0xd7, 0x73,
-	0x7c, 0x07, 0x15, 0x5d, 0x97, 0x68, 0x47, 0x6b, 0xed, 0xeb, 0x23, 0xc7, 0x19, 0x59, 0x78, 0x95,
-	0x51, 0xfb, 0xc1, 0x70, 0x15, 0x8f, 0x5d, 0xff, 0x98, 0x0b, 0xb5, 0x6f, 0x4f, 0x32, 0x7d, 0x73,
-	0x8c, 0x89, 0xaf, 0x8f, 0x5d, 0x21, 0x70, 0x6b, 0x52, 0xc0, 0x08, 0x3c, 0xdd, 0x37, 0x1d, 0x5b,
-	0xf0, 0x17, 0x47, 0xce, 0xc8, 0x61, 0xcd, 0x55, 0xda, 0x12, 0xd4, 0xba, 0x3b, 0x24, 0xab, 0xee,
-	0x50, 0x2c, 0xa5, 0x3d, 0xe7, 0xeb, 0xe4, 0x70, 0x95, 0xfe, 0xc3, 0x09, 0xca, 0x21, 0x54, 0xbb,
-	0x78, 0xe0, 0x61, 0xff, 0x0b, 0x27, 0xb0, 0x7d, 0x84, 0x20, 0x6f, 0xeb, 0x63, 0xdc, 0xca, 0xdc,
-	0xc9, 0xdc, 0xaf, 0xa8, 0xac, 0x8d, 0x9a, 0x90, 0x3b, 0xc4, 0xc7, 0xad, 0x2c, 0x23, 0xd1, 0x26,
-	0xba, 0x09, 0x30, 0xa6, 0xe2, 0x9a, 0xab, 0xfb, 0x07, 0xad, 0x1c, 0x63, 0x54, 0x18, 0x65, 0x4f,
-	0xf7, 0x0f, 0xd0, 0x55, 0x28, 0x61, 0xfb, 0x48, 0x3b, 0xd2, 0xbd, 0x56, 0x9e, 0xf1, 0x8a, 0xd8,
-	0x3e, 0xfa, 0x4a, 0xf7, 0x94, 0xff, 0xce, 0x41, 0xa5, 0xe7, 0xe9, 0x36, 0x19, 0x3a, 0xde, 0x18,
-	0x2d, 0x42, 0xc1, 0x1c, 0xeb, 0x23, 0x39, 0x19, 0xef, 0xd0, 0xd9, 0x06, 0x63, 0xa3, 0x95, 0xbd,
-	0x93, 0xa3, 0xb3, 0x0d, 0xc6, 0x06, 0x53, 0xe7, 0x79, 0x1a, 0xa5, 0xe6, 0x18, 0xb5, 0x88, 0x3d,
-	0x6f, 0x73, 0x6c, 0xa0, 0xf7, 0x20, 0x87, 0xed, 0xa3, 0x56, 0xfe, 0x4e, 0xee, 0x7e, 0x75, 0xad,
-	0xbd, 0xc2, 0xad, 0xbc, 0x12, 0x4e, 0xb0, 0xd2, 0xb1, 0x8f, 0x3a, 0xb6, 0xef, 0x1d, 0xab, 0x54,
-	0x0c, 0xbd, 0x0f, 0x25, 0xc2, 0x76, 0x4a, 0x5a, 0x05, 0x36, 0x62, 0x41, 0x8e, 0x88, 0x19, 0x40,
-	0x95, 0x32, 0xe8, 0x3d, 0x40, 0x6c, 0x41, 0x9a, 0x1b, 0x58, 0x96, 0x26, 0x47, 0x16, 0xd9, 0x02,
-	0x9a, 0x8c, 0xb3, 0x17, 0x58, 0x56, 0x57, 0x48, 0x2f, 0x42, 0x81, 0xf8, 0x86, 0x69, 0xb7, 0x4a,
-	0x4c, 0x80, 0x77, 0xd0, 0x75, 0xa8, 0xd0, 0x95, 0x73, 0x4e, 0x99, 0x71, 0xca, 0xd8, 0xf3, 0xba,
-	0x8c, 0xf9, 0x1e, 0x20, 0x7d, 0x30, 0xc0, 0xae, 0xaf, 0x79, 0xd8, 0x0f, 0x3c, 0x5b, 0x1b, 0x38,
-	0x06, 0x6e, 0x55, 0xee, 0xe4, 0xee, 0xe7, 0xd4, 0x26, 0xe7, 0xa8, 0x8c, 0xb1, 0xe9, 0x18, 0x98,
-	0x4e, 0x60, 0xe0, 0x7e, 0x30, 0x6a, 0xc1, 0x9d, 0xcc, 0xfd, 0xb2, 0xca, 0x3b, 0xf4, 0xb8, 0x02,
-	0x82, 0xbd, 0x56, 0x95, 0x1f, 0x17, 0x6d, 0xa3, 0xdb, 0x50, 0x7d, 0xe3, 0x78, 0x87, 0xa6, 0x3d,
-	0xd2, 0x0c, 0xd3, 0x6b, 0xd5, 0x18, 0x0b, 0x04, 0x69, 0xcb, 0xf4, 0xd0, 0x2d, 0x00, 0xc3, 0x19,
-	0x1c, 0x62, 0x6f, 0x68, 0x5a, 0xb8, 0x55, 0xe7, 0xfc, 0x88, 0xd2, 0x7e, 0x0c, 0x65, 0x69, 0x39,
-	0x79, 0xf6, 0x99, 0xe8, 0xec, 0x17, 0xa1, 0x70, 0xa4, 0x5b, 0x01, 0x16, 0xf7, 0x81, 0x77, 0x9e,
-	0x64, 0x7f, 0x91, 0x51, 0x1e, 0x40, 0xa1, 0xf7, 0xec, 0x85, 0xd3, 0x47, 0x77, 0xa0, 0xe8, 0x0f,
-	0xb5, 0xd7, 0x4e, 0x9f, 0x8f, 0xdb, 0xa8, 0xbc, 0xfd, 0xe1, 0x36, 0x67, 0xa9, 0x05, 0x7f, 0xf8,
-	0xc2, 0xe9, 0x2b, 0xff, 0x94, 0x81, 0x62, 0x67, 0xe4, 0x61, 0x42, 0xe8, 0x0c, 0xfb, 0xea, 0x8e,
-	0x9c, 0x61, 0x5f, 0xdd, 0x41, 0x5b, 0xd0, 0x70, 0xfa, 0xaf, 0xf1, 0xc0, 0xd7, 0x88, 0xef, 0x78,
-	0xf4, 0x82, 0xd0, 0xa9, 0xaa, 0x6b, 0xd7, 0x57, 0xdc, 0x21, 0x3b, 0xaf, 0x57, 0x8c, 0xdb, 0xe5,
-	0x4c, 0xae, 0xe6, 0xf3, 0x2b, 0x6a, 0xdd, 0x89, 0x93, 0xd1, 0x53, 0xa8, 0x91, 0x6f, 0x2d, 0xcd,
-	0xd0, 0x7d, 0xbd, 0xaf, 0x13, 0xcc, 0x6e, 0x69, 0x75, 0xed, 0x9a, 0xd4, 0xd1, 0xfd, 0x72, 0x67,
-	0x4b, 0xb0, 0x42, 0x0d, 0x55, 0xf2, 0xad, 0x25, 0x89, 0x1b, 0x65, 0x28, 0xfa, 0xba, 0x37, 0xc2,
-	0xbe, 0xf2, 0x25, 0xe4, 0xe8, 0xae, 0xde, 0x83, 0xb2, 0x6b, 0xba, 0xd8, 0x32, 0x6d, 0x7e, 0x63,
-	0xab, 0x6b, 0x4d, 0x79, 0x81, 0xf6, 0x04, 0x5d, 0x0d, 0x25, 0xd0, 0x32, 0x64, 0x4d, 0x83, 0xdb,
-	0x68, 0xa3, 0xf8, 0xf6, 0x87, 0xdb, 0xd9, 0xed, 0x2d, 0x35, 0x6b, 0x1a, 0x4f, 0xf2, 0x7f, 0xff,
-	0x0f, 0xb7, 0xaf, 0x28, 0x7f, 0x99, 0x85, 0xf2, 0x17, 0xd8, 0xd7, 0xe9, 0xea, 0xd0, 0x26, 0x54,
-	0x75, 0xdb, 0x76, 0x7c, 0xf6, 0x98, 0x49, 0x2b, 0xc3, 0x2e, 0xe7, 0x5d, 0xa9, 0x5b, 0x8a, 0xad,
-	0xac, 0x47, 0x32, 0xfc, 0x56, 0xc7, 0x47, 0xa1, 0x8f, 0xa0, 0x68, 0xe9, 0x7d, 0x6c, 0x11, 0xf6,
-	0x72, 0xaa, 0x6b, 0x37, 0xa6, 0xc6, 0xef, 0x30, 0x36, 0x1f, 0x2a, 0x64, 0xdb, 0x4f, 0xa1, 0x39,
-	0xa9, 0xf6, 0x3c, 0x47, 0xde, 0xfe, 0x18, 0xaa, 0x31, 0xb5, 0xe7, 0xba, 0x2d, 0x7f, 0x01, 0xa5,
-	0x2e, 0xf6, 0x8e, 0xcc, 0x01, 0x46, 0xef, 0x40, 0xdd, 0xb4, 0x7d, 0xec, 0xd9, 0xba, 0xa5, 0xb9,
-	0x8e, 0xe7, 0x33, 0x05, 0x05, 0xb5, 0x26, 0x89, 0x7b, 0x8e, 0xe7, 0x53, 0x21, 0xfc, 0xe7, 0x71,
-	0xa1, 0x2c, 0x17, 0x92, 0x44, 0x26, 0x44, 0xad, 0xee, 0x72, 0x87, 0x24, 0xac, 0xbe, 0xa7, 0x66,
-	0x4d, 0x97, 0xbe, 0x13, 0xff, 0xd8, 0xc5, 0xc2, 0x1d, 0xb1, 0xb6, 0xb2, 0x06, 0x85, 0xae, 0xeb,
-	0x04, 0x3e, 0x7a, 0x40, 0x1d, 0x03, 0x5b, 0x89, 0x38, 0xd7, 0xb9, 0xc8, 0x31, 0x30, 0xb2, 0x2a,
-	0xf9, 0xca, 0x7f, 0x65, 0xa1, 0xbc, 0xf7, 0xac, 0xbb, 0x6d, 0xbb, 0x41, 0xba, 0xaf, 0x44, 0x90,
-	0xf7, 0xb0, 0xeb, 0x88, 0xed, 0xb2, 0x36, 0xf5, 0x02, 0xf4, 0x7f, 0x8d, 0xad, 0x80, 0x3f, 0xb7,
-	0x32, 0x25, 0xf4, 0x8e, 0x5d, 0x7a, 0x4f, 0x8a, 0x7d, 0x4f, 0xb7, 0x07, 0xd2, 0x8d, 0x8a, 0x1e,
-	0xa5, 0x0f, 0x9c, 0xf1, 0xd8, 0xf4, 0xa5, 0x0b, 0xe5, 0x3d, 0x3a, 0xc1, 0xc8, 0x72, 0xfa, 0xad,
-	0x02, 0x9f, 0x80, 0xb6, 0xa9, 0x83, 0x7c, 0xed, 0x98, 0xb6, 0xe6, 0xd8, 0xad, 0x22, 0x17, 0xa6,
-	0xdd, 0x57, 0x36, 0xf5, 0xd3, 0x4e, 0xe0, 0x63, 0x4f, 0xa3, 0xfd, 0x56, 0x89, 0x79, 0x8e, 0x0a,
-	0xa3, 0xbc, 0x70, 0x4c, 0x1b, 0x5d, 0x83, 0xf2, 0xc8, 0x73, 0x02, 0x57, 0xeb, 0x1f, 0xb7, 0xca,
-	0x6c, 0x60, 0x89, 0xf5, 0x37, 0x8e, 0xe9, 0x34, 0x96, 0xfe, 0xdd, 0x71, 0xab, 0xc2, 0xc6, 0xb0,
-	0x36, 0x75, 0x2c, 0x2c, 0x60, 0x69, 0xd4, 0x4b, 0x10, 0xe1, 0x88, 0x80, 0x91, 0x9e, 0x51, 0x0a,
-	0x6a, 0x40, 0x96, 0x3c, 0x62, 0xbe, 0xa8, 0xac, 0x66, 0xc9, 0x23, 0x6a, 0x58, 0xdf, 0x33, 0x47,
-	0x23, 0xcc, 0xbd, 0x10, 0x33, 0xec, 0x50, 0xf8, 0x68, 0x46, 0x56, 0x25, 0x5f, 0xf9, 0xe7, 0x0c,
-	0x54, 0x36, 0x3d, 0xc7, 0x3e, 0x9f, 0x65, 0x23, 0x23, 0xe5, 0x26, 0x8d, 0x44, 0x5c, 0x3c, 0x90,
-	0xc7, 0x4d, 0xdb, 0xe8, 0x06, 0x54, 0x9c, 0x23, 0xec, 0xbd, 0xf1, 0x4c, 0x1f, 0x33, 0xeb, 0x51,
-	0x53, 0x48, 0x02, 0xfa, 0x80, 0xfa, 0x6f, 0xdd, 0xf3, 0x99, 0x01, 0x69, 0x30, 0xe1, 0xc1, 0x76,
-	0x45, 0x06, 0xdb, 0x95, 0x9e, 0x8c, 0xc6, 0x2a, 0x17, 0x54, 0xfe, 0x37, 0x03, 0x05, 0xbe, 0x5a,
-	0x05, 0x72, 0xee, 0x90, 0x4c, 0xf9, 0x04, 0x71, 0x4d, 0x54, 0xca, 0x44, 0x77, 0x21, 0xcf, 0xce,
-	0x80, 0x3f, 0xce, 0xba, 0x14, 0xe2, 0x12, 0x8c, 0x85, 0xde, 0x81, 0x02, 0xb3, 0x3e, 0x0b, 0x72,
-	0x53, 0x32, 0x9c, 0x47, 0x85, 0x06, 0x9e, 0x43, 0x88, 0x08, 0x7a, 0x93, 0x42, 0x8c, 0x47, 0x85,
-	0x02, 0xdb, 0x74, 0x6c, 0x11, 0xe7, 0x26, 0x85, 0x18, 0x0f, 0xfd, 0x04, 0xf2, 0x03, 0x4f, 0xdc,
-	0x98, 0xea, 0xda, 0xbc, 0x94, 0x09, 0x0f, 0x41, 0x65, 0x6c, 0xc5, 0x86, 0xf2, 0x0b, 0xa7, 0x7f,
-	0xf2, 0xb1, 0xdc, 0x0b, 0x8f, 0x80, 0x3b, 0xe9, 0x86, 0x3c, 0xe2, 0x4d, 0x46, 0x9d, 0xba, 0xb7,
-	0xb9, 0xd8, 0xbd, 0x95, 0x97, 0x2c, 0x1f, 0x5d, 0x32, 0xe5, 0x7d, 0x98, 0xdb, 0xd3, 0x3d, 0xdd,
-	0xb2, 0xb0, 0x65, 0x92, 0x71, 0x97, 0x9e, 0x5c, 0x1b, 0xca, 0x03, 0xc7, 0x26, 0xbe, 0x6e, 0x73,
-	0xcf, 0x90, 0x57, 0xc3, 0xbe, 0xf2, 0x08, 0x2a, 0x6c, 0x6d, 0xf4, 0x02, 0x52, 0x7d, 0x0c, 0x90,
-	0x88, 0xf5, 0xd1, 0x36, 0xa5, 0x1d, 0xe8, 0xe4, 0x80, 0xad, 0xae, 0xa6, 0xb2, 0xb6, 0xf2, 0x14,
-	0x0a, 0x5b, 0xba, 0x1f, 0x8c, 0xd1, 0x4d, 0xc8, 0xc9, 0x28, 0x55, 0x5d, 0xab, 0x4a, 0x13, 0xd0,
-	0x38, 0x45, 0xe9, 0x27, 0xf9, 0x70, 0xe5, 0xff, 0x32, 0x50, 0x61, 0x0a, 0xb6, 0xed, 0xa1, 0x43,
-	0xad, 0x6d, 0xd0, 0x8e, 0x50, 0x13, 0x5a, 0x9b, 0x49, 0xa8, 0x9c, 0x87, 0xee, 0xb3, 0xfb, 0xe5,
-	0x73, 0x3f, 0xd8, 0x58, 0x43, 0x09, 0xa1, 0x2e, 0xe5, 0xa8, 0x5c, 0x00, 0x3d, 0xe4, 0x92, 0x44,
-	0x04, 0xac, 0xc5, 0xf0, 0x3e, 0x79, 0xce, 0x00, 0x13, 0x42, 0x65, 0x09, 0x97, 0x25, 0xe8, 0x01,
-	0x54, 0xa8, 0xb5, 0xb9, 0xe6, 0x3c, 0x93, 0xaf, 0x49, 0xfb, 0x53, 0x8b, 0xa8, 0x65, 0x77, 0xc8,
-	0x46, 0x60, 0xf4, 0x27, 0x90, 0xa7, 0x51, 0x40, 0x5c, 0x89, 0x66, 0x5c, 0x8a, 0xee, 0x42, 0x65,
-	0x5c, 0xea, 0x11, 0x38, 0xe8, 0x31, 0x0d, 0xe1, 0x4a, 0x4a, 0xac, 0xbf, 0x6d, 0x28, 0xff, 0x92,
-	0x81, 0xca, 0xfa, 0x68, 0xe4, 0xe1, 0x11, 0x55, 0xb7, 0x08, 0x85, 0x01, 0xc5, 0x4b, 0x6c, 0xd3,
-	0x39, 0x95, 0x77, 0xa8, 0xb1, 0xc7, 0x58, 0xb7, 0xd9, 0x26, 0x33, 0x2a, 0x6b, 0xd3, 0x37, 0x4a,
-	0x7c, 0xc3, 0xc0, 0x47, 0x6c, 0x43, 0x19, 0x55, 0xf4, 0xd0, 0x03, 0x68, 0x0e, 0xcd, 0xa1, 0x7f,
-	0xa0, 0xb9, 0xd8, 0x1b, 0x60, 0xdb, 0xa7, 0x58, 0x24, 0xcf, 0x24, 0xe6, 0x18, 0x7d, 0x2f, 0x24,
-	0xa3, 0xc7, 0x70, 0xd5, 0x36, 0x6d, 0xcc, 0x3c, 0xcf, 0xc4, 0x88, 0x02, 0x1b, 0xb1, 0xc4, 0xd9,
-	0xcf, 0x92, 0xe3, 0x94, 0xbf, 0xcd, 0x42, 0x2d, 0x6e, 0x36, 0xf4, 0x14, 0xea, 0x86, 0xf3, 0xc6,
-	0xb6, 0x1c, 0xdd, 0xd0, 0x28, 0xbc, 0x16, 0x47, 0x76, 0x6d, 0xea, 0xb5, 0x6f, 0x09, 0x68, 0xad,
-	0xd6, 0xa4, 0x3c, 0x7d, 0xff, 0xe8, 0x97, 0x50, 0x73, 0xb9, 0x3e, 0x3e, 0x3c, 0x7b, 0xda, 0xf0,
-	0xaa, 0x10, 0x67, 0xa3, 0x9f, 0x40, 0x35, 0x70, 0xa3, 0xb9, 0x73, 0xa7, 0x0d, 0x06, 0x2e, 0xcd,
-	0xc6, 0xfe, 0x04, 0x1a, 0xe1, 0xca, 0xfb, 0xc7, 0x3e, 0x26, 0xcc, 0x56, 0x39, 0x35, 0xdc, 0xcf,
-	0x06, 0x25, 0xa2, 0xbb, 0x50, 0x13, 0x53, 0x70, 0xa1, 0x02, 0x13, 0x12, 0xd3, 0x32, 0x11, 0xe5,
-	0xb7, 0x59, 0x58, 0x0a, 0xcf, 0x31, 0x61, 0x9d, 0xc7, 0xe9, 0xd6, 0x09, 0x5d, 0x43, 0x38, 0x6a,
-	0xc2, 0x2a, 0x1f, 0xa5, 0x5a, 0x25, 0x65, 0x58, 0xc2, 0x1a, 0x6b, 0x69, 0xd6, 0x48, 0x19, 0x14,
-	0xb7, 0xc2, 0x2f, 0x52, 0xad, 0x90, 0x3a, 0x6c, 0xc2, 0x30, 0x1f, 0xa5, 0x18, 0x26, 0x7d, 0x8d,
-	0x71, 0x5b, 0x7d, 0x9f, 0x81, 0xda, 0xd7, 0x8e, 0x77, 0x88, 0x3d, 0x6a, 0xa1, 0x80, 0x3d, 0xb8,
-	0x37, 0xac, 0x4f, 0x1f, 0x08, 0x07, 0xb7, 0xb5, 0xb7, 0x3f, 0xdc, 0x2e, 0x73, 0xa1, 0xed, 0x2d,
-	0xb5, 0xcc, 0xd9, 0xdb, 0x06, 0x05, 0xc1, 0xaf, 0x9d, 0xbe, 0x16, 0x3a, 0x10, 0x06, 0x82, 0xa9,
-	0x2b, 0xdd, 0x52, 0x0b, 0xaf, 0x9d, 0xfe, 0xb6, 0x81, 0x1e, 0x43, 0x8d, 0x39, 0x07, 0xf6, 0x7e,
-	0x03, 0xf9, 0xe0, 0x17, 0xa6, 0x5c, 0x43, 0x40, 0xd4, 0xaa, 0x11, 0x75, 0x94, 0xd7, 0x50, 0x8d,
-	0xf1, 0xd0, 0x47, 0x50, 0x62, 0x11, 0x09, 0x1b, 0xe2, 0xc0, 0x66, 0x05, 0x2f, 0x29, 0x4a, 0xdd,
-	0x3f, 0xf3, 0x07, 0x3c, 0x20, 0xcd, 0x27, 0x42, 0x04, 0x73, 0x1d, 0x8c, 0xad, 0x38, 0x50, 0x53,
-	0x31, 0x71, 0x02, 0x6f, 0x80, 0x99, 0x2f, 0xa6, 0xd9, 0x99, 0x1b, 0xb0, 0x89, 0xb2, 0x2a, 0x6d,
-	0xd2, 0xf7, 0x3d, 0xc6, 0x63, 0xc7, 0x93, 0x09, 0xa2, 0xe8, 0xa1, 0xbb, 0x90, 0x1b, 0xb9, 0x81,
-	0xd8, 0x54, 0x88, 0xa8, 0x9e, 0xef, 0xed, 0x53, 0x3d, 0x2a, 0xe5, 0x51, 0x77, 0x61, 0x98, 0xe4,
-	0x50, 0x86, 0x69, 0xda, 0x56, 0x7e, 0x06, 0x25, 0x21, 0x13, 0x82, 0xb6, 0x4c, 0x04, 0xda, 0xe8,
-	0x6c, 0x76, 0x30, 0xee, 0x63, 0x8f, 0xcd, 0x96, 0x53, 0x45, 0x4f, 0xf9, 0x35, 0xc0, 0x0b, 0xa7,
-	0xdf, 0xc5, 0x3e, 0x73, 0xc9, 0xef, 0x52, 0x40, 0xd4, 0xd7, 0x08, 0xf6, 0x85, 0x49, 0x1a, 0x31,
-	0xdf, 0xde, 0xc5, 0x3e, 0x05, 0x48, 0xf4, 0x7f, 0xf4, 0x0e, 0x0d, 0xcb, 0x7d, 0x89, 0x99, 0xe7,
-	0x62, 0x52, 0xdc, 0x29, 0x52, 0xa6, 0xf2, 0x8f, 0x35, 0x28, 0x09, 0xca, 0x69, 0x11, 0xe3, 0x01,
-	0x34, 0x65, 0x06, 0xa0, 0x1d, 0x61, 0x8f, 0xd0, 0x20, 0x9c, 0x65, 0x21, 0x6b, 0x4e, 0xd2, 0xbf,
-	0xe2, 0x64, 0xf4, 0x08, 0xea, 0x4e, 0xe0, 0xbb, 0x81, 0xaf, 0xc5, 0x20, 0xcc, 0x74, 0xfc, 0xac,
-	0x71, 0x21, 0xde, 0x43, 0x2d, 0x28, 0x79, 0x98, 0x03, 0x95, 0x3c, 0x53, 0x2b, 0xbb, 0xcc, 0x41,
-	0xe8, 0xbe, 0xae, 0x89, 0x27, 0x86, 0x0d, 0xf1, 0xf6, 0xeb, 0x94, 0xba, 0x27, 0x89, 0xd4, 0x41,
-	0x30, 0x31, 0x72, 0x68, 0xba, 0x2e, 0xe6, 0x4e, 0x3e, 0xc7, 0xae, 0x97, 0xde, 0xe5, 0x24, 0x0a,
-	0x1a, 0x99, 0x88, 0xef, 0xf8, 0xba, 0xc5, 0x40, 0x63, 0x4e, 0xad, 0x50, 0x4a, 0x8f, 0x12, 0x28,
-	0x0a, 0x64, 0xec, 0xa1, 0x6e, 0x5a, 0xd8, 0x60, 0xb8, 0x31, 0xa7, 0xb2, 0x11, 0xcf, 0x18, 0x25,
-	0x5c, 0x89, 0x87, 0x07, 0x14, 0x5f, 0x61, 0x83, 0x81, 0x48, 0xb1, 0x12, 0x55, 0x12, 0xa3, 0x38,
-	0x07, 0xa7, 0xc7, 0xb9, 0x7b, 0x32, 0x7a, 0x56, 0x59, 0xf4, 0x6c, 0xc6, 0x4f, 0x33, 0x1e, 0x3b,
-	0x97, 0xa1, 0xe8, 0x61, 0x9d, 0x38, 0xb6, 0xc8, 0x7a, 0x45, 0x8f, 0x3e, 0x91, 0x81, 0x87, 0x75,
-	0xfa, 0x44, 0xea, 0xa7, 0x3f, 0x11, 0x21, 0x1a, 0x7f, 0x58, 0x8d, 0xb3, 0x3f, 0xac, 0xc7, 0x50,
-	0x1e, 0x9a, 0xb6, 0x49, 0x0e, 0xb0, 0xd1, 0x9a, 0x3b, 0x75, 0x58, 0x28, 0x8b, 0x3e, 0x84, 0x92,
-	0x81, 0x7d, 0xdd, 0xb4, 0x48, 0xab, 0xc9, 0x86, 0x5d, 0x9d, 0xb8, 0x8d, 0x2b, 0x5b, 0x9c, 0xad,
-	0x4a, 0xb9, 0xf6, 0xdf, 0x94, 0xa0, 0x24, 0x88, 0x68, 0x15, 0x2a, 0xbe, 0x2c, 0x7c, 0x4c, 0x3a,
-	0xee, 0xb0, 0x22, 0xa2, 0x46, 0x32, 0x68, 0x03, 0x9a, 0x6e, 0x04, 0xb4, 0x34, 0x86, 0x97, 0xb3,
-	0xc9, 0x89, 0x27, 0x80, 0x98, 0x3a, 0xe7, 0x4e, 0x20, 0xb3, 0x7b, 0x50, 0xc4, 0x2c, 0x79, 0x8e,
-	0x2e, 0x2f, 0x1f, 0xc9, 0x53, 0x6a, 0x55, 0x70, 0xe3, 0x19, 0x56, 0x7e, 0x76, 0x86, 0x45, 0xd1,
-	0x14, 0xa1, 0x59, 0x99, 0xf0, 0xd0, 0x21, 0x9a, 0x62, 0xa9, 0x9a, 0xca, 0x79, 0xe8, 0x63, 0xa8,
-	0x0b, 0x37, 0x2c, 0x5c, 0x67, 0x91, 0xbd, 0xdf, 0xf0, 0x0e, 0xc5, 0x7d, 0xb6, 0x5a, 0x7b, 0x13,
-	0xf7, 0xe0, 0xeb, 0x30, 0xef, 0x09, 0x87, 0xa6, 0x79, 0xf8, 0xdb, 0x00, 0x13, 0x9f, 0xb0, 0x4b,
-	0x1e, 0x1b, 0x1e, 0xf7, 0x78, 0x6a, 0x53, 0x8a, 0xab, 0x42, 0x1a, 0x7d, 0x0a, 0x73, 0xa1, 0x0a,
-	0xcb, 0x1c, 0x9b, 0x3e, 0x61, 0xaf, 0xe0, 0x24, 0x05, 0x0d, 0x29, 0xbc, 0xc3, 0x64, 0xd1, 0x0e,
-	0x5c, 0x25, 0xa6, 0x81, 0x07, 0xba, 0xa7, 0x4d, 0xaa, 0xa9, 0xcc, 0x50, 0xb3, 0x24, 0x06, 0xa9,
-	0x49, 0x6d, 0xef, 0x40, 0xc1, 0xa4, 0x3e, 0x5b, 0x3c, 0xa3, 0x49, 0xac, 0x6f, 0x4a, 0xe0, 0x4e,
-	0x74, 0xcb, 0x97, 0x65, 0x22, 0xda, 0x46, 0x4f, 0xd8, 0x33, 0xa5, 0xd1, 0x07, 0xfb, 0xfc, 0xf4,
-	0x6b, 0xc9, 0xd9, 0x79, 0x8c, 0xc1, 0x3e, 0x9b, 0x9d, 0x47, 0x2a, 0xd1, 0x63, 0x38, 0x8a, 0x8d,
-	0xa5, 0xa1, 0x9b, 0x1e, 0x56, 0xfd, 0x74, 0x1c, 0x45, 0xe5, 0x7b, 0x5c, 0x9c, 0x22, 0x21, 0xea,
-	0x9f, 0xe5, 0xe8, 0xc6, 0xa9, 0x48, 0xe8, 0xb5, 0xd3, 0x97, 0x63, 0xb9, 0xff, 0xa1, 0x73, 0x7b,
-	0x26, 0x26, 0xec, 0x89, 0x71, 0xff, 0x13, 0x8c, 0x7b, 0x94, 0x82, 0x3e, 0x83, 0x39, 0x32, 0x38,
-	0xc0, 0x46, 0x60, 0x99, 0xf6, 0x88, 0xef, 0x8c, 0x3f, 0xa8, 0xe5, 0xf0, 0x2e, 0x85, 0x6c, 0x7e,
-	0x40, 0x24, 0xd1, 0xa7, 0x20, 0xd8, 0x75, 0x0c, 0x3e, 0x72, 0x9e, 0x83, 0x60, 0xd7, 0x31, 0x18,
-	0xeb, 0x3a, 0x54, 0x28, 0xcb, 0xd5, 0xfd, 0xc1, 0x41, 0x0b, 0xf1, 0x54, 0xde, 0x75, 0x8c, 0x3d,
-	0xda, 0x57, 0x9e, 0x43, 0x91, 0x5f, 0xbc, 0xd4, 0x44, 0xe9, 0x41, 0x32, 0x03, 0x58, 0x98, 0xbe,
-	0xab, 0xd2, 0x8d, 0x29, 0xb7, 0xa0, 0x2c, 0x2b, 0x4a, 0x69, 0xaa, 0x94, 0x7f, 0x9b, 0x83, 0x9a,
-	0x14, 0x60, 0x51, 0xe9, 0x7c, 0xa5, 0xa9, 0x16, 0x94, 0x92, 0xb1, 0x49, 0x76, 0xd1, 0x2a, 0x54,
-	0xe9, 0xae, 0x67, 0x47, 0x24, 0xa0, 0x22, 0x51, 0x3c, 0x22, 0xbe, 0xc3, 0x22, 0x09, 0x4f, 0xe2,
-	0x64, 0x17, 0xfd, 0x54, 0x6e, 0xb7, 0xc0, 0xb6, 0xbb, 0x34, 0xb9, 0x9e, 0x13, 0xfc, 0x76, 0x31,
-	0xe1, 0xb7, 0x1f, 0x43, 0xc3, 0xd2, 0x89, 0xaf, 0xb1, 0x60, 0xce, 0xb4, 0x95, 0x4f, 0x08, 0x00,
-	0x35, 0x2a, 0x27, 0x7b, 0xe8, 0x0e, 0x54, 0x63, 0xae, 0x8a, 0x3d, 0xab, 0xbc, 0x1a, 0x27, 0xa1,
-	0x9f, 0x09, 0x6c, 0x01, 0x4c, 0xdf, 0xdd, 0xc9, 0xd5, 0x31, 0x7f, 0x2b, 0x3b, 0xbd, 0x63, 0x17,
-	0x0b, 0xf8, 0x71, 0x13, 0x40, 0x0f, 0xfc, 0x03, 0xcd, 0x77, 0x0e, 0xb1, 0x2d, 0x9e, 0x53, 0x85,
-	0x52, 0x7a, 0x94, 0x80, 0x1e, 0x47, 0x3e, 0x9c, 0x3f, 0xa6, 0x1b, 0xa9, 0x8a, 0xa7, 0x1c, 0xf9,
-	0x1f, 0xe0, 0x12, 0x8e, 0x7c, 0x35, 0xac, 0xb6, 0x66, 0x93, 0x2e, 0x80, 0x55, 0x5c, 0xa7, 0x8b,
-	0xaf, 0xa9, 0x9e, 0x3f, 0x77, 0x61, 0xcf, 0x9f, 0x9f, 0xe9, 0xf9, 0x3f, 0x06, 0x10, 0xe1, 0x54,
-	0xd3, 0xa5, 0x4f, 0x9f, 0x15, 0x0f, 0x2b, 0x42, 0x7a, 0xdd, 0xa7, 0x50, 0xc5, 0xc3, 0x34, 0x95,
-	0xd3, 0xb0, 0xe7, 0x39, 0x9e, 0xb8, 0x1a, 0x55, 0x4e, 0xeb, 0x50, 0x12, 0xfa, 0x29, 0xcc, 0x73,
-	0xe7, 0x4e, 0xa4, 0x2f, 0xc7, 0x86, 0x40, 0x2c, 0x4d, 0xc1, 0x50, 0x25, 0x3d, 0x2e, 0xac, 0x1f,
-	0xe9, 0xa6, 0xa5, 0xf7, 0x2d, 0x2c, 0xe0, 0x8b, 0x14, 0x5e, 0x97, 0x74, 0xf4, 0x4e, 0x88, 0xce,
-	0x44, 0x75, 0xae, 0xc2, 0x66, 0x17, 0x68, 0x6c, 0x83, 0xd7, 0xe8, 0x52, 0x63, 0x09, 0x5c, 0x36,
-	0x96, 0x54, 0x7f, 0x9c, 0x58, 0x52, 0xbb, 0x44, 0x2c, 0xa9, 0xcf, 0x88, 0x25, 0x77, 0xa0, 0x6a,
-	0x60, 0x32, 0xf0, 0x4c, 0x97, 0xba, 0x66, 0xe6, 0xbb, 0x2b, 0x6a, 0x9c, 0x14, 0x46, 0x9b, 0x66,
-	0x2c, 0xda, 0x44, 0x2f, 0x7c, 0x3e, 0xf1, 0xc2, 0x63, 0xc8, 0x60, 0xe1, 0xac, 0xc8, 0x60, 0x71,
-	0x06, 0x32, 0x98, 0x8e, 0x6a, 0x4b, 0x17, 0x8f, 0x6a, 0xcb, 0x97, 0x8a, 0x6a, 0x57, 0x2f, 0x11,
-	0xd5, 0x5a, 0x67, 0x89, 0x6a, 0xd7, 0x2e, 0x1c, 0xd5, 0xda, 0x33, 0xa2, 0xda, 0xf5, 0x64, 0x54,
-	0x43, 0x4b, 0x50, 0x24, 0x8f, 0x34, 0xba, 0xa1, 0x1b, 0xfc, 0x97, 0x27, 0xf2, 0xe8, 0x55, 0xe0,
-	0xd3, 0x90, 0x33, 0x16, 0xbf, 0x2c, 0xb4, 0x6e, 0x26, 0x43, 0x8e, 0xfc, 0xc5, 0x41, 0x0d, 0x25,
-	0x68, 0x4e, 0xe0, 0x61, 0x59, 0x24, 0x60, 0x4b, 0xb8, 0xc5, 0xa6, 0xa9, 0x87, 0x54, 0xb6, 0x90,
-	0x77, 0x61, 0x2e, 0xb0, 0x07, 0x96, 0x6e, 0x8e, 0xb1, 0xa1, 0xf9, 0x3a, 0x39, 0x24, 0xad, 0xdb,
-	0xcc, 0x12, 0x8d, 0x90, 0xdc, 0xa3, 0x54, 0xba, 0x62, 0x01, 0x00, 0xbd, 0x41, 0xeb, 0x0e, 0x5f,
-	0x31, 0x27, 0xa8, 0x03, 0x7a, 0x43, 0xf5, 0xc0, 0x77, 0xc8, 0x40, 0xa7, 0x9b, 0x6f, 0xdd, 0x65,
-	0xcb, 0x8e, 0x93, 0x94, 0xef, 0xa2, 0xf8, 0xc9, 0x8a, 0xf0, 0xd7, 0x60, 0x69, 0x6f, 0x7b, 0xaf,
-	0xb3, 0xb3, 0xbd, 0xdb, 0xd3, 0x7a, 0xdf, 0xec, 0x75, 0xb4, 0xfd, 0xdd, 0x97, 0xbb, 0xaf, 0xbe,
-	0xde, 0x6d, 0x5e, 0x41, 0xd7, 0xe1, 0xaa, 0x60, 0x75, 0x38, 0xab, 0xa7, 0xae, 0xef, 0x76, 0x9f,
-	0xbd, 0x52, 0xbf, 0x68, 0x66, 0xd0, 0x55, 0x58, 0x48, 0x32, 0xbb, 0x7b, 0xaf, 0xf6, 0x7b, 0xcd,
-	0x6c, 0x4c, 0xa1, 0x64, 0x74, 0xd4, 0xaf, 0xb6, 0x37, 0x3b, 0xcd, 0xdc, 0x8b, 0x7c, 0xb9, 0xd4,
-	0x2c, 0x2b, 0x2f, 0xa0, 0x1e, 0x0f, 0x09, 0xd4, 0x51, 0xd6, 0xc3, 0xcc, 0xd1, 0xb4, 0x87, 0x8e,
-	0xf8, 0x19, 0x68, 0x31, 0x2d, 0x80, 0xa8, 0x35, 0x37, 0xd6, 0x53, 0xee, 0x40, 0x91, 0xa7, 0xb5,
-	0xa2, 0x60, 0x99, 0x99, 0x2a, 0x58, 0x8e, 0x61, 0x71, 0xdb, 0xa6, 0x66, 0xf7, 0x45, 0xfe, 0xcb,
-	0xdd, 0xcf, 0xd9, 0xf3, 0x64, 0x04, 0xf9, 0x37, 0xba, 0xa8, 0xf1, 0x96, 0x55, 0xd6, 0xa6, 0xb1,
-	0x5f, 0x06, 0xbb, 0x1c, 0x8f, 0xfd, 0xa2, 0xab, 0xbc, 0x0f, 0xf3, 0x3b, 0x26, 0x99, 0x98, 0x2b,
-	0x26, 0x9e, 0x49, 0x8a, 0xff, 0x06, 0xe6, 0xa3, 0xd5, 0x49, 0xf1, 0x53, 0x12, 0xed, 0xf3, 0x2d,
-	0xe8, 0x77, 0x19, 0x68, 0x88, 0x15, 0x49, 0xfd, 0xe7, 0x83, 0x4c, 0x1f, 0x42, 0x8d, 0x79, 0x3f,
-	0x2d, 0xac, 0x75, 0xe7, 0x52, 0x90, 0x51, 0x95, 0xc9, 0x44, 0xd0, 0xe8, 0xc0, 0x24, 0xbe, 0xe3,
-	0x1d, 0x8b, 0x52, 0x9d, 0xec, 0xc6, 0xd7, 0x59, 0x48, 0xac, 0x13, 0xb5, 0xa1, 0xfc, 0xfa, 0xdb,
-	0x67, 0xa6, 0xe5, 0x63, 0x19, 0xee, 0xc2, 0xbe, 0xf2, 0x67, 0xb0, 0xd0, 0x0d, 0xfa, 0xd4, 0xcb,
-	0xf6, 0xf1, 0x85, 0xf7, 0x11, 0x9b, 0x3a, 0x9b, 0x34, 0xd1, 0x87, 0xd0, 0xdc, 0xc2, 0x16, 0xf6,
-	0xf1, 0x99, 0xcf, 0x40, 0x79, 0x0e, 0x8d, 0xae, 0xef, 0xb8, 0x67, 0x3f, 0xb4, 0x28, 0x08, 0xe4,
-	0xe2, 0x41, 0x40, 0xf9, 0x43, 0x16, 0x96, 0xf6, 0x5d, 0x43, 0x67, 0x93, 0x73, 0x3c, 0x77, 0x36,
-	0x85, 0xf7, 0x92, 0x98, 0xfa, 0x0c, 0x75, 0x81, 0xc4, 0xc4, 0xf1, 0x72, 0x4a, 0xe1, 0xb4, 0x72,
-	0x4a, 0xf1, 0x2c, 0xe5, 0x94, 0xd2, 0x74, 0x39, 0xe5, 0xc7, 0xaa, 0x97, 0x24, 0xcb, 0x32, 0x30,
-	0x59, 0x96, 0x09, 0xcb, 0x29, 0xd5, 0x53, 0xcb, 0x29, 0xca, 0xbf, 0x67, 0xa1, 0xf1, 0x1c, 0xfb,
-	0x3b, 0xce, 0x88, 0x5c, 0xec, 0x1a, 0x89, 0x63, 0xc9, 0x9e, 0x70, 0x2c, 0xd2, 0x2a, 0x43, 0x76,
-	0x73, 0x89, 0xf8, 0x6a, 0x83, 0x99, 0x81, 0x5f, 0x66, 0x12, 0xfd, 0x68, 0x92, 0x9f, 0xf1, 0xa3,
-	0xc9, 0x32, 0x14, 0xc7, 0x3a, 0xa1, 0x8f, 0x81, 0xbf, 0x13, 0xd1, 0xa3, 0xf4, 0xa1, 0x63, 0x59,
-	0xce, 0x1b, 0x76, 0x28, 0x65, 0x55, 0xf4, 0x58, 0xc1, 0x50, 0x37, 0x65, 0xcd, 0x8a, 0xb5, 0xd1,
-	0x7d, 0x68, 0x06, 0x04, 0x6b, 0x96, 0x73, 0x68, 0x6a, 0x7d, 0x7d, 0x70, 0x88, 0x6d, 0x7e, 0x06,
-	0x65, 0xb5, 0x11, 0x10, 0xbc, 0xe3, 0x1c, 0x9a, 0x1b, 0x9c, 0x8a, 0x56, 0xa1, 0x40, 0x4c, 0x7b,
-	0x80, 0x45, 0x16, 0x3e, 0x23, 0x70, 0x73, 0x39, 0xe5, 0x5f, 0xb3, 0x00, 0x3b, 0xce, 0xe8, 0x0b,
-	0x4c, 0x88, 0x3e, 0x62, 0x90, 0x31, 0xf4, 0xe0, 0xb1, 0x94, 0x2d, 0xf4, 0xd5, 0xbb, 0x34, 0x0b,
-	0x3c, 0xbd, 0x2a, 0x9c, 0x28, 0x31, 0xe7, 0x66, 0x96, 0x98, 0xef, 0x41, 0x99, 0x83, 0x06, 0x93,
-	0xa7, 0x5f, 0x95, 0x8d, 0xea, 0xdb, 0x1f, 0x6e, 0x97, 0xf8, 0x4f, 0x53, 0x5b, 0x6a, 0x89, 0x31,
-	0xb7, 0x8d, 0x13, 0xed, 0x28, 0x6b, 0xc0, 0xc5, 0x99, 0x35, 0xe0, 0xf0, 0x23, 0x13, 0xfe, 0xfb,
-	0x31, 0xff, 0xc8, 0xe4, 0x21, 0x64, 0xc3, 0xb2, 0xc7, 0x2c, 0x3c, 0x9f, 0xf5, 0x09, 0x7d, 0x65,
-	0x63, 0x6e, 0x23, 0x81, 0xa2, 0x65, 0x57, 0xf9, 0x1a, 0x16, 0x54, 0xfe, 0xe0, 0xf8, 0xb9, 0x9f,
-	0xed, 0xd5, 0x4f, 0x5e, 0xaf, 0xec, 0xd4, 0xf5, 0x52, 0x9e, 0xc0, 0x82, 0x08, 0x29, 0x09, 0xc5,
-	0x67, 0xf9, 0xa9, 0x4e, 0xf9, 0x0a, 0x9a, 0x34, 0x56, 0x9c, 0x67, 0x45, 0x21, 0x70, 0xce, 0x9e,
-	0x0c, 0x9c, 0x15, 0x03, 0x6a, 0x71, 0xf0, 0x19, 0x2b, 0x65, 0x67, 0xe2, 0xa5, 0x6c, 0xfa, 0xd0,
-	0x89, 0xf9, 0x1d, 0x16, 0x3f, 0x54, 0xf0, 0x32, 0x77, 0x85, 0x52, 0xf8, 0x2f, 0x19, 0x37, 0x01,
-	0x5c, 0xec, 0x69, 0xfc, 0x12, 0xb0, 0x0b, 0x92, 0x53, 0x2b, 0x2e, 0xf6, 0xf8, 0xfd, 0x50, 0x7e,
-	0x9f, 0x81, 0x46, 0x12, 0x09, 0xa2, 0x2f, 0xa0, 0x6e, 0x3b, 0x06, 0xd6, 0x08, 0xb6, 0xf0, 0xc0,
-	0x77, 0x3c, 0x01, 0x2d, 0xee, 0xa7, 0x03, 0xc7, 0x95, 0x5d, 0xc7, 0xc0, 0x5d, 0x21, 0xca, 0xbf,
-	0x16, 0xa9, 0xd9, 0x31, 0x12, 0x5a, 0x81, 0x05, 0xd7, 0x33, 0x1d, 0xcf, 0xf4, 0x8f, 0xb5, 0x81,
-	0xa5, 0x13, 0xc2, 0x6f, 0x3b, 0xaf, 0xfe, 0xcf, 0x4b, 0xd6, 0x26, 0xe5, 0xd0, 0x2b, 0xdf, 0xfe,
-	0x0c, 0xe6, 0xa7, 0x54, 0x9e, 0xeb, 0x4b, 0x91, 0xff, 0xaf, 0xc0, 0xd2, 0x26, 0x4b, 0x0b, 0x43,
-	0x57, 0x74, 0x21, 0xaf, 0x75, 0xee, 0x44, 0x39, 0x91, 0x8a, 0xe7, 0x2e, 0x58, 0x53, 0xcd, 0x5f,
-	0x38, 0xb3, 0x2e, 0xcc, 0xcc, 0xac, 0x97, 0xa1, 0x18, 0xb0, 0x98, 0x29, 0x9d, 0x20, 0xef, 0x4d,
-	0x67, 0xae, 0xa5, 0x94, 0xcc, 0x35, 0x02, 0xf5, 0xe5, 0x38, 0xa8, 0x4f, 0x4d, 0x68, 0x2b, 0x97,
-	0x4d, 0x68, 0xe1, 0xc7, 0x49, 0x68, 0xab, 0x97, 0x48, 0x68, 0x6b, 0x67, 0x4f, 0x68, 0xeb, 0xd3,
-	0x09, 0xed, 0x0d, 0xf6, 0x01, 0x0f, 0x0f, 0xa4, 0xac, 0xe0, 0x58, 0x56, 0x23, 0x42, 0x3c, 0x85,
-	0x9d, 0x3f, 0x6b, 0x0a, 0x8b, 0xce, 0x95, 0xc2, 0x2e, 0x5c, 0x3c, 0x85, 0x5d, 0xbc, 0x54, 0x0a,
-	0xbb, 0x74, 0x9e, 0x14, 0x56, 0xa6, 0xfd, 0xcb, 0xb1, 0xb4, 0x7f, 0x22, 0xad, 0xbd, 0x7a, 0x96,
-	0xb4, 0xb6, 0x75, 0xe1, 0xb4, 0xf6, 0xda, 0x8c, 0xb4, 0xb6, 0x3d, 0x91, 0xd6, 0x4e, 0x94, 0x3a,
-	0xaf, 0x9f, 0x5a, 0xea, 0x8c, 0x27, 0xbc, 0x37, 0x2e, 0x90, 0xf0, 0xde, 0x4c, 0x4b, 0x78, 0x27,
-	0x52, 0xd5, 0x5b, 0xd3, 0xa9, 0xea, 0x6f, 0x60, 0x59, 0x44, 0xb2, 0xcb, 0x39, 0xbf, 0x93, 0x91,
-	0xff, 0xf7, 0x19, 0x58, 0xa0, 0x01, 0xef, 0xd2, 0xfa, 0x65, 0xba, 0x93, 0x3d, 0x31, 0xdd, 0xc9,
-	0x9d, 0x9c, 0xee, 0xe4, 0x27, 0xd2, 0x9d, 0xbf, 0xce, 0xc0, 0x12, 0x4f, 0x48, 0x2e, 0xb7, 0xae,
-	0x26, 0xe4, 0x74, 0xcb, 0x12, 0x7b, 0xa6, 0x4d, 0x1a, 0x68, 0x86, 0x8e, 0x37, 0xc0, 0x62, 0x35,
-	0xbc, 0x43, 0x2f, 0xcb, 0x21, 0xc6, 0xae, 0xc6, 0xbe, 0x31, 0xe3, 0xb5, 0xec, 0x32, 0x25, 0xa8,
-	0xd8, 0x75, 0x94, 0x2d, 0x58, 0xec, 0x52, 0x94, 0x72, 0xa9, 0xa5, 0x28, 0x9b, 0xb0, 0x40, 0xf3,
-	0xa5, 0xcb, 0x29, 0xf9, 0xbb, 0x0c, 0x20, 0x35, 0xb0, 0x2f, 0x67, 0x94, 0x15, 0x00, 0xd7, 0x73,
-	0x8e, 0xb0, 0xad, 0x53, 0xbc, 0x9b, 0x9e, 0xcc, 0xc6, 0x24, 0x62, 0xa8, 0x35, 0x97, 0x8e, 0x5a,
-	0x95, 0xa7, 0xd0, 0x50, 0x03, 0x7b, 0xd3, 0x73, 0xec, 0x8b, 0x6d, 0xeb, 0x01, 0x2c, 0xf0, 0x10,
-	0xcf, 0x3f, 0xa8, 0x96, 0x4a, 0x10, 0xe4, 0xd9, 0x47, 0xca, 0x19, 0xfe, 0xf5, 0x16, 0x6d, 0x2b,
-	0x9f, 0xc2, 0x02, 0xbf, 0x18, 0x49, 0xd1, 0x7b, 0x50, 0xe4, 0x1f, 0x69, 0x4f, 0x96, 0x32, 0x84,
-	0x98, 0xe0, 0x2a, 0x4f, 0xc3, 0x5a, 0xc8, 0xc5, 0xc6, 0xdf, 0x80, 0x22, 0xa7, 0xa4, 0xfe, 0x34,
-	0xf3, 0x7d, 0x06, 0x80, 0xb3, 0xd9, 0x0f, 0x33, 0x67, 0x54, 0x1a, 0x7e, 0xea, 0x90, 0x8d, 0x7d,
-	0xea, 0xb0, 0x0d, 0x88, 0x15, 0xc3, 0x4d, 0xc7, 0xd6, 0xc2, 0xbf, 0x05, 0x10, 0x30, 0x64, 0x16,
-	0xe4, 0x9e, 0x97, 0xa3, 0x42, 0x92, 0xb2, 0x21, 0x3f, 0xf2, 0xe7, 0xb5, 0xa6, 0x47, 0x50, 0xe5,
-	0xf3, 0xc6, 0x2b, 0x4d, 0x28, 0xb9, 0x34, 0x56, 0x67, 0x02, 0x12, 0xb6, 0x95, 0x25, 0x58, 0x58,
-	0x1f, 0xf8, 0xe6, 0x91, 0xee, 0xe3, 0xf5, 0xc0, 0x3f, 0x10, 0x66, 0x53, 0x96, 0x61, 0x31, 0x49,
-	0x26, 0xae, 0x63, 0x13, 0xac, 0xfc, 0x36, 0x03, 0x4b, 0x2a, 0xb6, 0x0d, 0xec, 0xf5, 0xf0, 0xd8,
-	0xb5, 0x62, 0x39, 0x7d, 0x1b, 0xca, 0xbe, 0x20, 0x09, 0xd3, 0x85, 0x7d, 0xf4, 0x09, 0xe4, 0x75,
-	0x6f, 0x24, 0xbf, 0xc7, 0x78, 0x37, 0x8a, 0xf5, 0x29, 0x8a, 0x56, 0xd6, 0xbd, 0x91, 0xf8, 0x9c,
-	0x99, 0x0d, 0x6a, 0xff, 0x1c, 0x2a, 0x21, 0xe9, 0x5c, 0x00, 0x53, 0x87, 0xe5, 0xc9, 0x19, 0xf8,
-	0x2e, 0xe8, 0xb9, 0xbc, 0x26, 0x8e, 0x2d, 0x8f, 0x98, 0xb6, 0xd1, 0x23, 0x1a, 0xc4, 0xf1, 0x40,
-	0x2e, 0xf2, 0x66, 0xf4, 0xe5, 0x64, 0x0a, 0x44, 0x55, 0xb9, 0xec, 0xc3, 0xdf, 0x65, 0xd8, 0x77,
-	0x94, 0xfc, 0xe7, 0xa9, 0x25, 0x98, 0x7f, 0xf1, 0x6a, 0x43, 0xeb, 0xf6, 0xd6, 0x7b, 0xf1, 0x52,
-	0xe3, 0x1c, 0x54, 0x29, 0x79, 0x53, 0xed, 0xac, 0xf7, 0x3a, 0x5b, 0xcd, 0x0c, 0x6a, 0x42, 0x4d,
-	0xc8, 0xa9, 0xbd, 0xed, 0xdd, 0xe7, 0xcd, 0xac, 0x14, 0x51, 0xf7, 0x77, 0x77, 0x29, 0x21, 0x27,
-	0x09, 0xcf, 0xd6, 0xb7, 0x77, 0xf6, 0xd5, 0x4e, 0x33, 0x2f, 0x09, 0xdd, 0xfd, 0xcd, 0xcd, 0x4e,
-	0xb7, 0xdb, 0x2c, 0xa0, 0x06, 0x00, 0x25, 0xbc, 0xdc, 0xde, 0xd9, 0xe9, 0x6c, 0x35, 0x8b, 0x68,
-	0x1e, 0xea, 0xb4, 0xdf, 0x79, 0xae, 0x76, 0xba, 0x5d, 0xaa, 0xa4, 0x24, 0x49, 0xcf, 0xb6, 0x77,
-	0xb7, 0xbb, 0x9f, 0x53, 0x52, 0x19, 0x21, 0x68, 0x50, 0xd2, 0xfe, 0x2e, 0x9d, 0x6a, 0x7d, 0x63,
-	0xa7, 0xd3, 0xac, 0x3c, 0xfc, 0x53, 0x80, 0xe8, 0x73, 0x45, 0x54, 0x85, 0x52, 0xb4, 0x74, 0x80,
-	0x22, 0x5d, 0x02, 0x5b, 0x75, 0x15, 0x4a, 0x72, 0xf6, 0x2c, 0xeb, 0xbc, 0xdc, 0xde, 0xdb, 0xeb,
-	0x6c, 0x35, 0x73, 0xa8, 0x06, 0xe5, 0x70, 0x2f, 0x79, 0x54, 0x87, 0x8a, 0xda, 0xd9, 0x7c, 0xf5,
-	0x55, 0x47, 0xed, 0x6c, 0x35, 0x0b, 0x0f, 0xbf, 0x81, 0x6a, 0xec, 0xa7, 0x50, 0xd4, 0x82, 0xc5,
-	0xaf, 0x5f, 0xa9, 0x2f, 0x3b, 0x6a, 0x9a, 0x99, 0xf6, 0x5e, 0x6d, 0x85, 0x36, 0xc8, 0x48, 0x42,
-	0x34, 0x69, 0x03, 0x80, 0x12, 0xc4, 0x8a, 0x72, 0x0f, 0xff, 0x33, 0x13, 0x55, 0x5b, 0xb9, 0xf6,
-	0x36, 0x2c, 0x87, 0xf5, 0xd9, 0x49, 0xfd, 0x4b, 0x30, 0x1f, 0xe7, 0xf1, 0xe5, 0x66, 0xd0, 0x22,
-	0x34, 0x43, 0xb2, 0x9c, 0x3b, 0x9b, 0xa8, 0x00, 0xab, 0x9d, 0x50, 0x3c, 0x97, 0x10, 0x8f, 0x4e,
-	0x67, 0x01, 0xe6, 0x42, 0xea, 0xde, 0xfa, 0x7e, 0x97, 0xee, 0x3c, 0x21, 0xda, 0xed, 0xad, 0xef,
-	0x6e, 0x6d, 0x7c, 0xd3, 0x2c, 0x26, 0x96, 0xb1, 0xa9, 0xae, 0xf3, 0x83, 0x29, 0xad, 0xfd, 0x55,
-	0x13, 0x72, 0xeb, 0x7b, 0xdb, 0xe8, 0x09, 0x40, 0x54, 0x34, 0x45, 0xd7, 0x22, 0x64, 0x3b, 0x51,
-	0x48, 0x6d, 0x4f, 0x7e, 0xd4, 0xa4, 0x5c, 0x41, 0x1b, 0x50, 0x4f, 0x94, 0x83, 0xd1, 0x8d, 0xe9,
-	0xe1, 0x51, 0xe5, 0x36, 0x45, 0xc3, 0x07, 0x19, 0xf4, 0x18, 0x4a, 0xa2, 0xa2, 0x8a, 0x42, 0xa8,
-	0x96, 0x2c, 0xb1, 0xa6, 0x8f, 0xfb, 0x0c, 0x20, 0xaa, 0x0d, 0x47, 0xeb, 0x9e, 0xaa, 0x17, 0xb7,
-	0x51, 0xb2, 0x14, 0x1d, 0x2a, 0xf8, 0x15, 0xd4, 0xe2, 0x75, 0x50, 0x74, 0x3d, 0xf4, 0x5b, 0xd3,
-	0xd5, 0xd1, 0x93, 0x96, 0x50, 0x09, 0x4b, 0x9d, 0xa8, 0x15, 0xa2, 0xea, 0x89, 0xea, 0x67, 0x7b,
-	0x79, 0xca, 0xc7, 0x76, 0xc6, 0xae, 0x7f, 0xac, 0x5c, 0x41, 0x9f, 0x40, 0x49, 0x14, 0x3e, 0xa3,
-	0xbd, 0x27, 0x2b, 0xa1, 0x33, 0x06, 0xff, 0x0a, 0x6a, 0xf1, 0xd2, 0x44, 0xb4, 0xfe, 0x94, 0x82,
-	0x45, 0x7b, 0x3e, 0x81, 0xf9, 0xc5, 0xf1, 0xfd, 0x12, 0x2a, 0x61, 0x81, 0x22, 0x5a, 0xff, 0x64,
-	0xcd, 0x22, 0x75, 0xec, 0x07, 0x19, 0xd4, 0x61, 0x5f, 0xf4, 0x85, 0x35, 0x97, 0x68, 0xfe, 0x94,
-	0x4a, 0xcc, 0x8c, 0x6d, 0x6c, 0x43, 0x23, 0xe9, 0xf0, 0xd0, 0x6c, 0x47, 0x38, 0x53, 0xd5, 0xdc,
-	0x04, 0xc4, 0x45, 0xb7, 0x26, 0x8c, 0x32, 0xa9, 0x2c, 0xf5, 0x67, 0x11, 0xe5, 0x0a, 0xdd, 0x5c,
-	0x1c, 0xca, 0x46, 0x9b, 0x4b, 0x01, 0xb8, 0x27, 0x29, 0xf9, 0x20, 0x43, 0x37, 0x97, 0xc4, 0x9e,
-	0xd1, 0xe6, 0x52, 0x31, 0xe9, 0x8c, 0xcd, 0x3d, 0x87, 0x7a, 0x02, 0x3a, 0x46, 0x6f, 0x2d, 0x0d,
-	0x51, 0xce, 0x50, 0xd4, 0x81, 0x5a, 0x1c, 0x3d, 0xc6, 0xee, 0xfd, 0x34, 0xa6, 0x9c, 0xa1, 0x66,
-	0x13, 0xaa, 0x31, 0xf8, 0x88, 0xc2, 0xbf, 0x9a, 0x9b, 0xc6, 0x94, 0xb3, 0x1f, 0x80, 0x40, 0x7b,
-	0xd1, 0x03, 0x48, 0xc2, 0xbf, 0xd9, 0x1b, 0x89, 0x43, 0xbd, 0x68, 0x23, 0x29, 0x00, 0x70, 0xb6,
-	0x9a, 0x38, 0x0c, 0x8c, 0xd4, 0xa4, 0x80, 0xc3, 0x99, 0x5b, 0x61, 0xfe, 0x48, 0x28, 0x39, 0x41,
-	0xae, 0xbd, 0x30, 0x0d, 0x8e, 0x08, 0x33, 0x66, 0x3d, 0x81, 0x25, 0xa7, 0x1c, 0x69, 0x72, 0x15,
-	0x29, 0x10, 0x4b, 0xb9, 0x82, 0x3e, 0x95, 0xee, 0x68, 0xdd, 0xb2, 0x4e, 0x5c, 0xc0, 0xc9, 0x1b,
-	0xf8, 0x18, 0x4a, 0xa2, 0x96, 0x1f, 0x9d, 0x45, 0xb2, 0xb8, 0x1f, 0xcd, 0x1b, 0x55, 0xab, 0xd9,
-	0x35, 0x7f, 0x09, 0xb5, 0x38, 0x76, 0x8b, 0x4c, 0x98, 0x02, 0xf4, 0xda, 0x37, 0xd2, 0x99, 0x02,
-	0xee, 0x31, 0x87, 0x90, 0xfc, 0x0d, 0x27, 0x7a, 0x33, 0xa9, 0xbf, 0xed, 0xcc, 0xd8, 0xd2, 0xe7,
-	0xec, 0x8e, 0xee, 0x38, 0xba, 0xd1, 0x63, 0x80, 0x51, 0x66, 0x26, 0x31, 0xa2, 0x54, 0x72, 0x3d,
-	0x95, 0x17, 0x2e, 0xea, 0x25, 0x4b, 0x96, 0x24, 0x63, 0x0b, 0x0f, 0xf5, 0xc0, 0x3a, 0xf9, 0x94,
-	0x4f, 0x51, 0xf6, 0x25, 0x34, 0x92, 0x30, 0x31, 0xda, 0x61, 0x2a, 0x40, 0x6d, 0xdf, 0x3a, 0x89,
-	0x1d, 0xaa, 0xfc, 0x04, 0xca, 0xf4, 0xf6, 0xf5, 0x74, 0x72, 0x88, 0x5a, 0x2b, 0xbe, 0x4e, 0x0e,
-	0x75, 0xd7, 0x5c, 0x91, 0xa4, 0xc8, 0x93, 0x4b, 0x0e, 0xa5, 0x4a, 0x2f, 0xb5, 0xf1, 0xf3, 0xff,
-	0x78, 0x7b, 0x2b, 0xf3, 0xfb, 0xb7, 0xb7, 0x32, 0xff, 0xf3, 0xf6, 0x56, 0xe6, 0xd7, 0x0f, 0x46,
-	0xa6, 0x7f, 0x10, 0xf4, 0x57, 0x06, 0xce, 0x78, 0xd5, 0xd5, 0x07, 0x07, 0xc7, 0x06, 0xf6, 0xe2,
-	0xad, 0xa3, 0xb5, 0x55, 0xe2, 0x0d, 0x56, 0x5d, 0x97, 0xf4, 0x8b, 0x6c, 0xdf, 0x8f, 0xfe, 0x18,
-	0x00, 0x00, 0xff, 0xff, 0x25, 0xc7, 0x91, 0xb6, 0xa7, 0x3c, 0x00, 0x00,
+func (m *CreateNotifierRequest) GetJobStates() []JobState {
+	if m != nil {
+		return m.JobStates
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CreateNotifierRequest) GetPipelineStates() []PipelineState {
+	if m != nil {
+		return m.PipelineStates
+	}
+	return nil
+}
 
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error)
-	InspectJobSet(ctx context.Context, in *InspectJobSetRequest, opts ...grpc.CallOption) (API_InspectJobSetClient, error)
-	// ListJob returns information about current and past Pachyderm jobs.
-	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobClient, error)
-	ListJobSet(ctx context.Context, in *ListJobSetRequest, opts ...grpc.CallOption) (API_ListJobSetClient, error)
-	SubscribeJob(ctx context.Context, in *SubscribeJobRequest, opts ...grpc.CallOption) (API_SubscribeJobClient, error)
-	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error)
-	// ListDatum returns information about each datum fed to a Pachyderm job
-	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error)
-	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
-	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (API_ListPipelineClient, error)
-	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	RunPipeline(ctx context.Context, in *RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	RunCron(ctx context.Context, in *RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	ListSecret(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SecretInfos, error)
-	InspectSecret(ctx context.Context, in *InspectSecretRequest, opts ...grpc.CallOption) (*SecretInfo, error)
-	// DeleteAll deletes everything
-	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
-	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
-	// An internal call that causes PPS to put itself into an auth-enabled state
-	// (all pipeline have tokens, correct permissions, etcd)
-	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
-	// An internal call used to move a job from one state to another
-	UpdateJobState(ctx context.Context, in *UpdateJobStateRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// RunLoadTest runs a load test.
-	RunLoadTest(ctx context.Context, in *pfs.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error)
-	// RunLoadTestDefault runs the default load test.
-	RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error)
-	// RenderTemplate renders the provided template and arguments into a list of Pipeline specicifications
-	RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error)
-	// ListTask lists PPS tasks
-	ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error)
+func (m *CreateNotifierRequest) GetCommitFinished() bool {
+	if m != nil {
+		return m.CommitFinished
+	}
+	return false
 }
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *CreateNotifierRequest) GetUpdate() bool {
+	if m != nil {
+		return m.Update
+	}
+	return false
 }
 
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+type DeleteNotifierRequest struct {
+	Notifier             *Notifier `protobuf:"bytes,1,opt,name=notifier,proto3" json:"notifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (c *aPIClient) InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error) {
-	out := new(JobInfo)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteNotifierRequest) Reset()         { *m = DeleteNotifierRequest{} }
+func (m *DeleteNotifierRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteNotifierRequest) ProtoMessage()    {}
+func (*DeleteNotifierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{63}
+}
+func (m *DeleteNotifierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteNotifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteNotifierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DeleteNotifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNotifierRequest.Merge(m, src)
+}
+func (m *DeleteNotifierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteNotifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNotifierRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) InspectJobSet(ctx context.Context, in *InspectJobSetRequest, opts ...grpc.CallOption) (API_InspectJobSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pps_v2.API/InspectJobSet", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIInspectJobSetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteNotifierRequest proto.InternalMessageInfo
+
+func (m *DeleteNotifierRequest) GetNotifier() *Notifier {
+	if m != nil {
+		return m.Notifier
 	}
-	return x, nil
+	return nil
 }
 
-type API_InspectJobSetClient interface {
-	Recv() (*JobInfo, error)
-	grpc.ClientStream
+// Notification is the JSON payload that is POSTed to a notifier's URL.
+type Notification struct {
+	Notifier *Notifier        `protobuf:"bytes,1,opt,name=notifier,proto3" json:"notifier,omitempty"`
+	Type     NotificationType `protobuf:"varint,2,opt,name=type,proto3,enum=pps_v2.NotificationType" json:"type,omitempty"`
+	Time     *types.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Set for JOB_STATE_CHANGED notifications.
+	Job      *Job     `protobuf:"bytes,4,opt,name=job,proto3" json:"job,omitempty"`
+	JobState JobState `protobuf:"varint,5,opt,name=job_state,json=jobState,proto3,enum=pps_v2.JobState" json:"job_state,omitempty"`
+	// Set for JOB_STATE_CHANGED and PIPELINE_STATE_CHANGED notifications.
+	Pipeline *Pipeline `protobuf:"bytes,6,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// Set for PIPELINE_STATE_CHANGED notifications.
+	PipelineState PipelineState `protobuf:"varint,7,opt,name=pipeline_state,json=pipelineState,proto3,enum=pps_v2.PipelineState" json:"pipeline_state,omitempty"`
+	// The reason for the job or pipeline's state, if any.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Set for COMMIT_FINISHED notifications.
+	Commit               *pfs.Commit `protobuf:"bytes,9,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-type aPIInspectJobSetClient struct {
-	grpc.ClientStream
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{64}
 }
-
-func (x *aPIInspectJobSetClient) Recv() (*JobInfo, error) {
-	m := new(JobInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pps_v2.API/ListJob", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListJobClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_ListJobClient interface {
-	Recv() (*JobInfo, error)
-	grpc.ClientStream
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
 }
-
-type aPIListJobClient struct {
-	grpc.ClientStream
+func (m *Notification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
 }
 
-func (x *aPIListJobClient) Recv() (*JobInfo, error) {
-	m := new(JobInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetNotifier() *Notifier {
+	if m != nil {
+		return m.Notifier
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) ListJobSet(ctx context.Context, in *ListJobSetRequest, opts ...grpc.CallOption) (API_ListJobSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pps_v2.API/ListJobSet", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListJobSetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *Notification) GetType() NotificationType {
+	if m != nil {
+		return m.Type
 	}
-	return x, nil
+	return NotificationType_NOTIFICATION_TYPE_UNKNOWN
 }
 
-type API_ListJobSetClient interface {
-	Recv() (*JobSetInfo, error)
-	grpc.ClientStream
+func (m *Notification) GetTime() *types.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
 }
 
-type aPIListJobSetClient struct {
-	grpc.ClientStream
+func (m *Notification) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
 }
 
-func (x *aPIListJobSetClient) Recv() (*JobSetInfo, error) {
-	m := new(JobSetInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Notification) GetJobState() JobState {
+	if m != nil {
+		return m.JobState
 	}
-	return m, nil
+	return JobState_JOB_STATE_UNKNOWN
 }
 
-func (c *aPIClient) SubscribeJob(ctx context.Context, in *SubscribeJobRequest, opts ...grpc.CallOption) (API_SubscribeJobClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pps_v2.API/SubscribeJob", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPISubscribeJobClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *Notification) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
 	}
-	return x, nil
-}
-
-type API_SubscribeJobClient interface {
-	Recv() (*JobInfo, error)
-	grpc.ClientStream
-}
-
-type aPISubscribeJobClient struct {
-	grpc.ClientStream
+	return nil
 }
 
-func (x *aPISubscribeJobClient) Recv() (*JobInfo, error) {
-	m := new(JobInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Notification) GetPipelineState() PipelineState {
+	if m != nil {
+		return m.PipelineState
 	}
-	return m, nil
+	return PipelineState_PIPELINE_STATE_UNKNOWN
 }
 
-func (c *aPIClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/DeleteJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Notification) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/StopJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Notification) GetCommit() *pfs.Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error) {
-	out := new(DatumInfo)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectDatum", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TraceFileRequest struct {
+	File      *pfs.File      `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Direction TraceDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=pps_v2.TraceDirection" json:"direction,omitempty"`
+	// depth is the number of pipelines to trace the file through, or 0 to trace
+	// it through the whole DAG.
+	Depth                int64    `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pps_v2.API/ListDatum", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListDatumClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *TraceFileRequest) Reset()         { *m = TraceFileRequest{} }
+func (m *TraceFileRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceFileRequest) ProtoMessage()    {}
+func (*TraceFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{65}
+}
+func (m *TraceFileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceFileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_ListDatumClient interface {
-	Recv() (*DatumInfo, error)
-	grpc.ClientStream
+func (m *TraceFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceFileRequest.Merge(m, src)
 }
-
-type aPIListDatumClient struct {
-	grpc.ClientStream
+func (m *TraceFileRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *aPIListDatumClient) Recv() (*DatumInfo, error) {
-	m := new(DatumInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *TraceFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceFileRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/RestartDatum", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_TraceFileRequest proto.InternalMessageInfo
 
-func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/CreatePipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TraceFileRequest) GetFile() *pfs.File {
+	if m != nil {
+		return m.File
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error) {
-	out := new(PipelineInfo)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TraceFileRequest) GetDirection() TraceDirection {
+	if m != nil {
+		return m.Direction
 	}
-	return out, nil
+	return TraceDirection_UPSTREAM
 }
 
-func (c *aPIClient) ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (API_ListPipelineClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pps_v2.API/ListPipeline", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListPipelineClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *TraceFileRequest) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
 	}
-	return x, nil
+	return 0
 }
 
-type API_ListPipelineClient interface {
-	Recv() (*PipelineInfo, error)
-	grpc.ClientStream
+// FileLineage records that a datum read the input files and wrote the output
+// files. Only the files on the path of the trace are included: the outputs
+// when tracing upstream are the traced files, and likewise the inputs when
+// tracing downstream.
+type FileLineage struct {
+	Job     *Job        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	DatumID string      `protobuf:"bytes,2,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
+	Inputs  []*pfs.File `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs []*pfs.File `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// depth is the number of pipelines between the datum and the traced file,
+	// starting from 1.
+	Depth                int64    `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIListPipelineClient struct {
-	grpc.ClientStream
+func (m *FileLineage) Reset()         { *m = FileLineage{} }
+func (m *FileLineage) String() string { return proto.CompactTextString(m) }
+func (*FileLineage) ProtoMessage()    {}
+func (*FileLineage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{66}
 }
-
-func (x *aPIListPipelineClient) Recv() (*PipelineInfo, error) {
-	m := new(PipelineInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *FileLineage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/DeletePipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLineage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileLineage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *aPIClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/StartPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *FileLineage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileLineage.Merge(m, src)
 }
-
-func (c *aPIClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/StopPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *FileLineage) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *aPIClient) RunPipeline(ctx context.Context, in *RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/RunPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *FileLineage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileLineage.DiscardUnknown(m)
 }
 
-func (c *aPIClient) RunCron(ctx context.Context, in *RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/RunCron", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FileLineage proto.InternalMessageInfo
+
+func (m *FileLineage) GetJob() *Job {
+	if m != nil {
+		return m.Job
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/CreateSecret", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLineage) GetDatumID() string {
+	if m != nil {
+		return m.DatumID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/DeleteSecret", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLineage) GetInputs() []*pfs.File {
+	if m != nil {
+		return m.Inputs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) ListSecret(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SecretInfos, error) {
-	out := new(SecretInfos)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/ListSecret", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLineage) GetOutputs() []*pfs.File {
+	if m != nil {
+		return m.Outputs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) InspectSecret(ctx context.Context, in *InspectSecretRequest, opts ...grpc.CallOption) (*SecretInfo, error) {
-	out := new(SecretInfo)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectSecret", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileLineage) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/DeleteAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ActivateAuthRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pps_v2.API/GetLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
+func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateAuthRequest) ProtoMessage()    {}
+func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{67}
+}
+func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivateAuthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_GetLogsClient interface {
-	Recv() (*LogMessage, error)
-	grpc.ClientStream
+func (m *ActivateAuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateAuthRequest.Merge(m, src)
 }
-
-type aPIGetLogsClient struct {
-	grpc.ClientStream
+func (m *ActivateAuthRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *aPIGetLogsClient) Recv() (*LogMessage, error) {
-	m := new(LogMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *ActivateAuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateAuthRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
-	out := new(ActivateAuthResponse)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/ActivateAuth", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_ActivateAuthRequest proto.InternalMessageInfo
 
-func (c *aPIClient) UpdateJobState(ctx context.Context, in *UpdateJobStateRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/UpdateJobState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ActivateAuthResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) RunLoadTest(ctx context.Context, in *pfs.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error) {
-	out := new(pfs.RunLoadTestResponse)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/RunLoadTest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
+func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
+func (*ActivateAuthResponse) ProtoMessage()    {}
+func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{68}
 }
-
-func (c *aPIClient) RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error) {
-	out := new(pfs.RunLoadTestResponse)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/RunLoadTestDefault", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivateAuthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ActivateAuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateAuthResponse.Merge(m, src)
+}
+func (m *ActivateAuthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivateAuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateAuthResponse.DiscardUnknown(m)
 }
 
-func (c *aPIClient) RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error) {
-	out := new(RenderTemplateResponse)
-	err := c.cc.Invoke(ctx, "/pps_v2.API/RenderTemplate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_ActivateAuthResponse proto.InternalMessageInfo
+
+type RenderTemplateRequest struct {
+	Template             string            `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Args                 map[string]string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (c *aPIClient) ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pps_v2.API/ListTask", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListTaskClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RenderTemplateRequest) Reset()         { *m = RenderTemplateRequest{} }
+func (m *RenderTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*RenderTemplateRequest) ProtoMessage()    {}
+func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{69}
+}
+func (m *RenderTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenderTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenderTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_ListTaskClient interface {
-	Recv() (*task.TaskInfo, error)
-	grpc.ClientStream
+func (m *RenderTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderTemplateRequest.Merge(m, src)
 }
-
-type aPIListTaskClient struct {
-	grpc.ClientStream
+func (m *RenderTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenderTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderTemplateRequest.DiscardUnknown(m)
 }
 
-func (x *aPIListTaskClient) Recv() (*task.TaskInfo, error) {
-	m := new(task.TaskInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_RenderTemplateRequest proto.InternalMessageInfo
+
+func (m *RenderTemplateRequest) GetTemplate() string {
+	if m != nil {
+		return m.Template
 	}
-	return m, nil
+	return ""
 }
 
-// APIServer is the server API for API service.
-type APIServer interface {
-	InspectJob(context.Context, *InspectJobRequest) (*JobInfo, error)
-	InspectJobSet(*InspectJobSetRequest, API_InspectJobSetServer) error
-	// ListJob returns information about current and past Pachyderm jobs.
-	ListJob(*ListJobRequest, API_ListJobServer) error
-	ListJobSet(*ListJobSetRequest, API_ListJobSetServer) error
-	SubscribeJob(*SubscribeJobRequest, API_SubscribeJobServer) error
-	DeleteJob(context.Context, *DeleteJobRequest) (*types.Empty, error)
-	StopJob(context.Context, *StopJobRequest) (*types.Empty, error)
-	InspectDatum(context.Context, *InspectDatumRequest) (*DatumInfo, error)
-	// ListDatum returns information about each datum fed to a Pachyderm job
-	ListDatum(*ListDatumRequest, API_ListDatumServer) error
-	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
-	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
-	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
-	ListPipeline(*ListPipelineRequest, API_ListPipelineServer) error
-	DeletePipeline(context.Context, *DeletePipelineRequest) (*types.Empty, error)
-	StartPipeline(context.Context, *StartPipelineRequest) (*types.Empty, error)
-	StopPipeline(context.Context, *StopPipelineRequest) (*types.Empty, error)
-	RunPipeline(context.Context, *RunPipelineRequest) (*types.Empty, error)
-	RunCron(context.Context, *RunCronRequest) (*types.Empty, error)
-	CreateSecret(context.Context, *CreateSecretRequest) (*types.Empty, error)
-	DeleteSecret(context.Context, *DeleteSecretRequest) (*types.Empty, error)
-	ListSecret(context.Context, *types.Empty) (*SecretInfos, error)
-	InspectSecret(context.Context, *InspectSecretRequest) (*SecretInfo, error)
-	// DeleteAll deletes everything
-	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
-	GetLogs(*GetLogsRequest, API_GetLogsServer) error
-	// An internal call that causes PPS to put itself into an auth-enabled state
-	// (all pipeline have tokens, correct permissions, etcd)
-	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
-	// An internal call used to move a job from one state to another
-	UpdateJobState(context.Context, *UpdateJobStateRequest) (*types.Empty, error)
-	// RunLoadTest runs a load test.
-	RunLoadTest(context.Context, *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error)
-	// RunLoadTestDefault runs the default load test.
-	RunLoadTestDefault(context.Context, *types.Empty) (*pfs.RunLoadTestResponse, error)
-	// RenderTemplate renders the provided template and arguments into a list of Pipeline specicifications
-	RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error)
-	// ListTask lists PPS tasks
-	ListTask(*task.ListTaskRequest, API_ListTaskServer) error
+func (m *RenderTemplateRequest) GetArgs() map[string]string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
 }
 
-// UnimplementedAPIServer can be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+type RenderTemplateResponse struct {
+	Json                 string                   `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	Specs                []*CreatePipelineRequest `protobuf:"bytes,2,rep,name=specs,proto3" json:"specs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (*UnimplementedAPIServer) InspectJob(ctx context.Context, req *InspectJobRequest) (*JobInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectJob not implemented")
+func (m *RenderTemplateResponse) Reset()         { *m = RenderTemplateResponse{} }
+func (m *RenderTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*RenderTemplateResponse) ProtoMessage()    {}
+func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{70}
 }
-func (*UnimplementedAPIServer) InspectJobSet(req *InspectJobSetRequest, srv API_InspectJobSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method InspectJobSet not implemented")
+func (m *RenderTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAPIServer) ListJob(req *ListJobRequest, srv API_ListJobServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListJob not implemented")
+func (m *RenderTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenderTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAPIServer) ListJobSet(req *ListJobSetRequest, srv API_ListJobSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListJobSet not implemented")
+func (m *RenderTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderTemplateResponse.Merge(m, src)
 }
-func (*UnimplementedAPIServer) SubscribeJob(req *SubscribeJobRequest, srv API_SubscribeJobServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubscribeJob not implemented")
+func (m *RenderTemplateResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAPIServer) DeleteJob(ctx context.Context, req *DeleteJobRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
-}
-func (*UnimplementedAPIServer) StopJob(ctx context.Context, req *StopJobRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopJob not implemented")
-}
-func (*UnimplementedAPIServer) InspectDatum(ctx context.Context, req *InspectDatumRequest) (*DatumInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectDatum not implemented")
-}
-func (*UnimplementedAPIServer) ListDatum(req *ListDatumRequest, srv API_ListDatumServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListDatum not implemented")
-}
-func (*UnimplementedAPIServer) RestartDatum(ctx context.Context, req *RestartDatumRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestartDatum not implemented")
-}
-func (*UnimplementedAPIServer) CreatePipeline(ctx context.Context, req *CreatePipelineRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
-}
-func (*UnimplementedAPIServer) InspectPipeline(ctx context.Context, req *InspectPipelineRequest) (*PipelineInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectPipeline not implemented")
-}
-func (*UnimplementedAPIServer) ListPipeline(req *ListPipelineRequest, srv API_ListPipelineServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListPipeline not implemented")
-}
-func (*UnimplementedAPIServer) DeletePipeline(ctx context.Context, req *DeletePipelineRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePipeline not implemented")
-}
-func (*UnimplementedAPIServer) StartPipeline(ctx context.Context, req *StartPipelineRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartPipeline not implemented")
-}
-func (*UnimplementedAPIServer) StopPipeline(ctx context.Context, req *StopPipelineRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopPipeline not implemented")
-}
-func (*UnimplementedAPIServer) RunPipeline(ctx context.Context, req *RunPipelineRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunPipeline not implemented")
-}
-func (*UnimplementedAPIServer) RunCron(ctx context.Context, req *RunCronRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunCron not implemented")
-}
-func (*UnimplementedAPIServer) CreateSecret(ctx context.Context, req *CreateSecretRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
-}
-func (*UnimplementedAPIServer) DeleteSecret(ctx context.Context, req *DeleteSecretRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
-}
-func (*UnimplementedAPIServer) ListSecret(ctx context.Context, req *types.Empty) (*SecretInfos, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSecret not implemented")
-}
-func (*UnimplementedAPIServer) InspectSecret(ctx context.Context, req *InspectSecretRequest) (*SecretInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectSecret not implemented")
-}
-func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
-}
-func (*UnimplementedAPIServer) GetLogs(req *GetLogsRequest, srv API_GetLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
-}
-func (*UnimplementedAPIServer) ActivateAuth(ctx context.Context, req *ActivateAuthRequest) (*ActivateAuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
-}
-func (*UnimplementedAPIServer) UpdateJobState(ctx context.Context, req *UpdateJobStateRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobState not implemented")
-}
-func (*UnimplementedAPIServer) RunLoadTest(ctx context.Context, req *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTest not implemented")
-}
-func (*UnimplementedAPIServer) RunLoadTestDefault(ctx context.Context, req *types.Empty) (*pfs.RunLoadTestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTestDefault not implemented")
-}
-func (*UnimplementedAPIServer) RenderTemplate(ctx context.Context, req *RenderTemplateRequest) (*RenderTemplateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenderTemplate not implemented")
-}
-func (*UnimplementedAPIServer) ListTask(req *task.ListTaskRequest, srv API_ListTaskServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListTask not implemented")
+func (m *RenderTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderTemplateResponse.DiscardUnknown(m)
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
-}
+var xxx_messageInfo_RenderTemplateResponse proto.InternalMessageInfo
 
-func _API_InspectJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/InspectJob",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectJob(ctx, req.(*InspectJobRequest))
+func (m *RenderTemplateResponse) GetJson() string {
+	if m != nil {
+		return m.Json
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _API_InspectJobSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(InspectJobSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *RenderTemplateResponse) GetSpecs() []*CreatePipelineRequest {
+	if m != nil {
+		return m.Specs
 	}
-	return srv.(APIServer).InspectJobSet(m, &aPIInspectJobSetServer{stream})
+	return nil
 }
 
-type API_InspectJobSetServer interface {
-	Send(*JobInfo) error
-	grpc.ServerStream
+func init() {
+	proto.RegisterEnum("pps_v2.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("pps_v2.DatumState", DatumState_name, DatumState_value)
+	proto.RegisterEnum("pps_v2.WorkerState", WorkerState_name, WorkerState_value)
+	proto.RegisterEnum("pps_v2.PipelineState", PipelineState_name, PipelineState_value)
+	proto.RegisterEnum("pps_v2.NotificationType", NotificationType_name, NotificationType_value)
+	proto.RegisterEnum("pps_v2.TraceDirection", TraceDirection_name, TraceDirection_value)
+	proto.RegisterEnum("pps_v2.PipelineInfo_PipelineType", PipelineInfo_PipelineType_name, PipelineInfo_PipelineType_value)
+	proto.RegisterType((*SecretMount)(nil), "pps_v2.SecretMount")
+	proto.RegisterType((*Transform)(nil), "pps_v2.Transform")
+	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Transform.EnvEntry")
+	proto.RegisterType((*TFJob)(nil), "pps_v2.TFJob")
+	proto.RegisterType((*Egress)(nil), "pps_v2.Egress")
+	proto.RegisterType((*Job)(nil), "pps_v2.Job")
+	proto.RegisterType((*Metadata)(nil), "pps_v2.Metadata")
+	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Metadata.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Metadata.LabelsEntry")
+	proto.RegisterType((*Service)(nil), "pps_v2.Service")
+	proto.RegisterType((*Spout)(nil), "pps_v2.Spout")
+	proto.RegisterType((*PFSInput)(nil), "pps_v2.PFSInput")
+	proto.RegisterType((*CronInput)(nil), "pps_v2.CronInput")
+	proto.RegisterType((*Input)(nil), "pps_v2.Input")
+	proto.RegisterType((*JobInput)(nil), "pps_v2.JobInput")
+	proto.RegisterType((*ParallelismSpec)(nil), "pps_v2.ParallelismSpec")
+	proto.RegisterType((*InputFile)(nil), "pps_v2.InputFile")
+	proto.RegisterType((*Datum)(nil), "pps_v2.Datum")
+	proto.RegisterType((*DatumInfo)(nil), "pps_v2.DatumInfo")
+	proto.RegisterType((*Aggregate)(nil), "pps_v2.Aggregate")
+	proto.RegisterType((*ProcessStats)(nil), "pps_v2.ProcessStats")
+	proto.RegisterType((*AggregateProcessStats)(nil), "pps_v2.AggregateProcessStats")
+	proto.RegisterType((*WorkerStatus)(nil), "pps_v2.WorkerStatus")
+	proto.RegisterType((*DatumStatus)(nil), "pps_v2.DatumStatus")
+	proto.RegisterType((*ResourceSpec)(nil), "pps_v2.ResourceSpec")
+	proto.RegisterType((*GPUSpec)(nil), "pps_v2.GPUSpec")
+	proto.RegisterType((*JobSetInfo)(nil), "pps_v2.JobSetInfo")
+	proto.RegisterType((*JobInfo)(nil), "pps_v2.JobInfo")
+	proto.RegisterType((*JobInfo_Details)(nil), "pps_v2.JobInfo.Details")
+	proto.RegisterType((*Worker)(nil), "pps_v2.Worker")
+	proto.RegisterType((*Pipeline)(nil), "pps_v2.Pipeline")
+	proto.RegisterType((*PipelineInfo)(nil), "pps_v2.PipelineInfo")
+	proto.RegisterType((*PipelineInfo_Details)(nil), "pps_v2.PipelineInfo.Details")
+	proto.RegisterType((*PipelineInfos)(nil), "pps_v2.PipelineInfos")
+	proto.RegisterType((*JobSet)(nil), "pps_v2.JobSet")
+	proto.RegisterType((*InspectJobSetRequest)(nil), "pps_v2.InspectJobSetRequest")
+	proto.RegisterType((*ListJobSetRequest)(nil), "pps_v2.ListJobSetRequest")
+	proto.RegisterType((*InspectJobRequest)(nil), "pps_v2.InspectJobRequest")
+	proto.RegisterType((*ListJobRequest)(nil), "pps_v2.ListJobRequest")
+	proto.RegisterType((*SubscribeJobRequest)(nil), "pps_v2.SubscribeJobRequest")
+	proto.RegisterType((*DeleteJobRequest)(nil), "pps_v2.DeleteJobRequest")
+	proto.RegisterType((*StopJobRequest)(nil), "pps_v2.StopJobRequest")
+	proto.RegisterType((*UpdateJobStateRequest)(nil), "pps_v2.UpdateJobStateRequest")
+	proto.RegisterType((*GetLogsRequest)(nil), "pps_v2.GetLogsRequest")
+	proto.RegisterType((*LogMessage)(nil), "pps_v2.LogMessage")
+	proto.RegisterType((*RestartDatumRequest)(nil), "pps_v2.RestartDatumRequest")
+	proto.RegisterType((*InspectDatumRequest)(nil), "pps_v2.InspectDatumRequest")
+	proto.RegisterType((*ListDatumRequest)(nil), "pps_v2.ListDatumRequest")
+	proto.RegisterType((*DatumSetSpec)(nil), "pps_v2.DatumSetSpec")
+	proto.RegisterType((*SchedulingSpec)(nil), "pps_v2.SchedulingSpec")
+	proto.RegisterMapType((map[string]string)(nil), "pps_v2.SchedulingSpec.NodeSelectorEntry")
+	proto.RegisterType((*CreatePipelineRequest)(nil), "pps_v2.CreatePipelineRequest")
+	proto.RegisterType((*InspectPipelineRequest)(nil), "pps_v2.InspectPipelineRequest")
+	proto.RegisterType((*ListPipelineRequest)(nil), "pps_v2.ListPipelineRequest")
+	proto.RegisterType((*DeletePipelineRequest)(nil), "pps_v2.DeletePipelineRequest")
+	proto.RegisterType((*StartPipelineRequest)(nil), "pps_v2.StartPipelineRequest")
+	proto.RegisterType((*StopPipelineRequest)(nil), "pps_v2.StopPipelineRequest")
+	proto.RegisterType((*RunPipelineRequest)(nil), "pps_v2.RunPipelineRequest")
+	proto.RegisterType((*RunCronRequest)(nil), "pps_v2.RunCronRequest")
+	proto.RegisterType((*CreateSecretRequest)(nil), "pps_v2.CreateSecretRequest")
+	proto.RegisterType((*DeleteSecretRequest)(nil), "pps_v2.DeleteSecretRequest")
+	proto.RegisterType((*InspectSecretRequest)(nil), "pps_v2.InspectSecretRequest")
+	proto.RegisterType((*Secret)(nil), "pps_v2.Secret")
+	proto.RegisterType((*SecretInfo)(nil), "pps_v2.SecretInfo")
+	proto.RegisterType((*SecretInfos)(nil), "pps_v2.SecretInfos")
+	proto.RegisterType((*Notifier)(nil), "pps_v2.Notifier")
+	proto.RegisterType((*NotifierInfo)(nil), "pps_v2.NotifierInfo")
+	proto.RegisterType((*NotifierInfos)(nil), "pps_v2.NotifierInfos")
+	proto.RegisterType((*CreateNotifierRequest)(nil), "pps_v2.CreateNotifierRequest")
+	proto.RegisterType((*DeleteNotifierRequest)(nil), "pps_v2.DeleteNotifierRequest")
+	proto.RegisterType((*Notification)(nil), "pps_v2.Notification")
+	proto.RegisterType((*TraceFileRequest)(nil), "pps_v2.TraceFileRequest")
+	proto.RegisterType((*FileLineage)(nil), "pps_v2.FileLineage")
+	proto.RegisterType((*ActivateAuthRequest)(nil), "pps_v2.ActivateAuthRequest")
+	proto.RegisterType((*ActivateAuthResponse)(nil), "pps_v2.ActivateAuthResponse")
+	proto.RegisterType((*RenderTemplateRequest)(nil), "pps_v2.RenderTemplateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pps_v2.RenderTemplateRequest.ArgsEntry")
+	proto.RegisterType((*RenderTemplateResponse)(nil), "pps_v2.RenderTemplateResponse")
 }
 
-type aPIInspectJobSetServer struct {
-	grpc.ServerStream
-}
+func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
 
-func (x *aPIInspectJobSetServer) Send(m *JobInfo) error {
-	return x.ServerStream.SendMsg(m)
+var fileDescriptor_beade573c128ccc7 = []byte{
+	// 5363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0xcd, 0x73, 0x1b, 0xc9,
+	0x75, 0xb8, 0x80, 0xc1, 0xe7, 0xc3, 0x07, 0xc1, 0x26, 0x29, 0x8d, 0xa8, 0x2f, 0x6a, 0xd6, 0x96,
+	0x25, 0x79, 0x97, 0x5c, 0x53, 0x6b, 0xd9, 0x2b, 0xdb, 0x5a, 0xf3, 0x03, 0xd2, 0x42, 0xa2, 0x48,
+	0x7a, 0x00, 0xee, 0x96, 0x5d, 0xbf, 0x5f, 0xc1, 0x03, 0xa0, 0x01, 0x8e, 0x08, 0xcc, 0xcc, 0xce,
+	0x0c, 0x28, 0xd3, 0x97, 0xf8, 0x9c, 0x4a, 0xe5, 0x10, 0xfb, 0x90, 0xaa, 0x1c, 0x92, 0x4b, 0x0e,
+	0xce, 0x25, 0x39, 0xe6, 0x96, 0xf2, 0xcd, 0xb9, 0xf9, 0x92, 0x5c, 0x52, 0xd9, 0x4a, 0x54, 0x39,
+	0xe5, 0x98, 0xbf, 0x20, 0xf5, 0xfa, 0x63, 0x3e, 0x80, 0x21, 0xf8, 0xe5, 0x63, 0x2e, 0xe2, 0xf4,
+	0x7b, 0xaf, 0x5f, 0xbf, 0x7e, 0xdd, 0xfd, 0xbe, 0xba, 0x21, 0xa8, 0x38, 0x8e, 0xb7, 0xe6, 0x38,
+	0xde, 0xaa, 0xe3, 0xda, 0xbe, 0x4d, 0x72, 0x8e, 0xe3, 0xb5, 0x8f, 0xd7, 0x97, 0x6f, 0x0d, 0x6c,
+	0x7b, 0x30, 0xa4, 0x6b, 0x0c, 0xda, 0x19, 0xf7, 0xd7, 0xe8, 0xc8, 0xf1, 0x4f, 0x38, 0xd1, 0xf2,
+	0xbd, 0x49, 0xa4, 0x6f, 0x8e, 0xa8, 0xe7, 0x1b, 0x23, 0x47, 0x10, 0xdc, 0x9d, 0x24, 0xe8, 0x8d,
+	0x5d, 0xc3, 0x37, 0x6d, 0x4b, 0xe0, 0x17, 0x07, 0xf6, 0xc0, 0x66, 0x9f, 0x6b, 0xf8, 0x25, 0xa0,
+	0x15, 0xa7, 0xef, 0xad, 0x39, 0x7d, 0x21, 0xca, 0xf2, 0x9c, 0x6f, 0x78, 0x47, 0x6b, 0xf8, 0x0f,
+	0x07, 0x68, 0x47, 0x50, 0x6a, 0xd2, 0xae, 0x4b, 0xfd, 0x37, 0xf6, 0xd8, 0xf2, 0x09, 0x81, 0x8c,
+	0x65, 0x8c, 0xa8, 0x9a, 0x5a, 0x49, 0x3d, 0x2c, 0xea, 0xec, 0x9b, 0xd4, 0x40, 0x39, 0xa2, 0x27,
+	0x6a, 0x9a, 0x81, 0xf0, 0x93, 0xdc, 0x01, 0x18, 0x21, 0x79, 0xdb, 0x31, 0xfc, 0x43, 0x55, 0x61,
+	0x88, 0x22, 0x83, 0xec, 0x1b, 0xfe, 0x21, 0xb9, 0x01, 0x79, 0x6a, 0x1d, 0xb7, 0x8f, 0x0d, 0x57,
+	0xcd, 0x30, 0x5c, 0x8e, 0x5a, 0xc7, 0x5f, 0x18, 0xae, 0xf6, 0x6f, 0x0a, 0x14, 0x5b, 0xae, 0x61,
+	0x79, 0x7d, 0xdb, 0x1d, 0x91, 0x45, 0xc8, 0x9a, 0x23, 0x63, 0x20, 0x07, 0xe3, 0x0d, 0x1c, 0xad,
+	0x3b, 0xea, 0xa9, 0xe9, 0x15, 0x05, 0x47, 0xeb, 0x8e, 0x7a, 0x8c, 0x9d, 0xeb, 0xb6, 0x11, 0xaa,
+	0x30, 0x68, 0x8e, 0xba, 0xee, 0xd6, 0xa8, 0x47, 0x3e, 0x04, 0x85, 0x5a, 0xc7, 0x6a, 0x66, 0x45,
+	0x79, 0x58, 0x5a, 0x5f, 0x5e, 0xe5, 0x5a, 0x5e, 0x0d, 0x06, 0x58, 0xad, 0x5b, 0xc7, 0x75, 0xcb,
+	0x77, 0x4f, 0x74, 0x24, 0x23, 0x1f, 0x41, 0xde, 0x63, 0x33, 0xf5, 0xd4, 0x2c, 0xeb, 0xb1, 0x20,
+	0x7b, 0x44, 0x14, 0xa0, 0x4b, 0x1a, 0xf2, 0x21, 0x10, 0x26, 0x50, 0xdb, 0x19, 0x0f, 0x87, 0x6d,
+	0xd9, 0x33, 0xc7, 0x04, 0xa8, 0x31, 0xcc, 0xfe, 0x78, 0x38, 0x6c, 0x0a, 0xea, 0x45, 0xc8, 0x7a,
+	0x7e, 0xcf, 0xb4, 0xd4, 0x3c, 0x23, 0xe0, 0x0d, 0x72, 0x0b, 0x8a, 0x28, 0x39, 0xc7, 0x14, 0x18,
+	0xa6, 0x40, 0x5d, 0xb7, 0xc9, 0x90, 0x1f, 0x02, 0x31, 0xba, 0x5d, 0xea, 0xf8, 0x6d, 0x97, 0xfa,
+	0x63, 0xd7, 0x6a, 0x77, 0xed, 0x1e, 0x55, 0x8b, 0x2b, 0xca, 0x43, 0x45, 0xaf, 0x71, 0x8c, 0xce,
+	0x10, 0x5b, 0x76, 0x8f, 0xe2, 0x00, 0x3d, 0xda, 0x19, 0x0f, 0x54, 0x58, 0x49, 0x3d, 0x2c, 0xe8,
+	0xbc, 0x81, 0xcb, 0x35, 0xf6, 0xa8, 0xab, 0x96, 0xf8, 0x72, 0xe1, 0x37, 0xb9, 0x07, 0xa5, 0x77,
+	0xb6, 0x7b, 0x64, 0x5a, 0x83, 0x76, 0xcf, 0x74, 0xd5, 0x32, 0x43, 0x81, 0x00, 0x6d, 0x9b, 0x2e,
+	0xb9, 0x0b, 0xd0, 0xb3, 0xbb, 0x47, 0xd4, 0xed, 0x9b, 0x43, 0xaa, 0x56, 0x38, 0x3e, 0x84, 0x2c,
+	0x3f, 0x85, 0x82, 0xd4, 0x9c, 0x5c, 0xfb, 0x54, 0xb8, 0xf6, 0x8b, 0x90, 0x3d, 0x36, 0x86, 0x63,
+	0x2a, 0xf6, 0x03, 0x6f, 0x3c, 0x4b, 0x7f, 0x3f, 0xa5, 0x3d, 0x82, 0x6c, 0xeb, 0xc5, 0x2b, 0xbb,
+	0x43, 0x56, 0x20, 0xe7, 0xf7, 0xdb, 0x6f, 0xed, 0x0e, 0xef, 0xb7, 0x59, 0x7c, 0xff, 0xf5, 0x3d,
+	0x8e, 0xd2, 0xb3, 0x7e, 0xff, 0x95, 0xdd, 0xd1, 0xfe, 0x2e, 0x05, 0xb9, 0xfa, 0xc0, 0xa5, 0x9e,
+	0x87, 0x23, 0x1c, 0xe8, 0x3b, 0x72, 0x84, 0x03, 0x7d, 0x87, 0x6c, 0x43, 0xd5, 0xee, 0xbc, 0xa5,
+	0x5d, 0xbf, 0xed, 0xf9, 0xb6, 0x6b, 0x0c, 0xf8, 0x50, 0xa5, 0xf5, 0x5b, 0xab, 0x4e, 0x9f, 0xad,
+	0xd7, 0x1e, 0xc3, 0x36, 0x39, 0x92, 0xb3, 0xf9, 0xfc, 0x9a, 0x5e, 0xb1, 0xa3, 0x60, 0xf2, 0x1c,
+	0xca, 0xde, 0x57, 0xc3, 0x76, 0xcf, 0xf0, 0x8d, 0x8e, 0xe1, 0x51, 0xb6, 0x4b, 0x4b, 0xeb, 0x37,
+	0x25, 0x8f, 0xe6, 0x4f, 0x76, 0xb6, 0x05, 0x2a, 0xe0, 0x50, 0xf2, 0xbe, 0x1a, 0x4a, 0xe0, 0x66,
+	0x01, 0x72, 0xbe, 0xe1, 0x0e, 0xa8, 0xaf, 0xfd, 0x04, 0x14, 0x9c, 0xd5, 0x87, 0x50, 0x70, 0x4c,
+	0x87, 0x0e, 0x4d, 0x8b, 0xef, 0xd8, 0xd2, 0x7a, 0x4d, 0x6e, 0xa0, 0x7d, 0x01, 0xd7, 0x03, 0x0a,
+	0x72, 0x1d, 0xd2, 0x66, 0x8f, 0xeb, 0x68, 0x33, 0xf7, 0xfe, 0xeb, 0x7b, 0xe9, 0xc6, 0xb6, 0x9e,
+	0x36, 0x7b, 0xcf, 0x32, 0x7f, 0xf9, 0x37, 0xf7, 0xae, 0x69, 0xbf, 0x4a, 0x43, 0xe1, 0x0d, 0xf5,
+	0x0d, 0x94, 0x8e, 0x6c, 0x41, 0xc9, 0xb0, 0x2c, 0xdb, 0x67, 0x87, 0xd9, 0x53, 0x53, 0x6c, 0x73,
+	0xde, 0x97, 0xbc, 0x25, 0xd9, 0xea, 0x46, 0x48, 0xc3, 0x77, 0x75, 0xb4, 0x17, 0xf9, 0x04, 0x72,
+	0x43, 0xa3, 0x43, 0x87, 0x1e, 0x3b, 0x39, 0xa5, 0xf5, 0xdb, 0x53, 0xfd, 0x77, 0x18, 0x9a, 0x77,
+	0x15, 0xb4, 0xcb, 0xcf, 0xa1, 0x36, 0xc9, 0xf6, 0x22, 0x4b, 0xbe, 0xfc, 0x29, 0x94, 0x22, 0x6c,
+	0x2f, 0xb4, 0x5b, 0xfe, 0x04, 0xf2, 0x4d, 0xea, 0x1e, 0x9b, 0x5d, 0x4a, 0x3e, 0x80, 0x8a, 0x69,
+	0xf9, 0xd4, 0xb5, 0x8c, 0x61, 0xdb, 0xb1, 0x5d, 0x9f, 0x31, 0xc8, 0xea, 0x65, 0x09, 0xdc, 0xb7,
+	0x5d, 0x1f, 0x89, 0xe8, 0x2f, 0xa2, 0x44, 0x69, 0x4e, 0x44, 0x7f, 0x11, 0x21, 0x42, 0xad, 0x3b,
+	0xaa, 0x12, 0xd1, 0xfa, 0xbe, 0x9e, 0x36, 0x1d, 0x3c, 0x27, 0xfe, 0x89, 0x43, 0x85, 0x39, 0x62,
+	0xdf, 0xda, 0x3a, 0x64, 0x9b, 0x8e, 0x3d, 0xf6, 0xc9, 0x23, 0x34, 0x0c, 0x4c, 0x12, 0xb1, 0xae,
+	0x73, 0xa1, 0x61, 0x60, 0x60, 0x5d, 0xe2, 0xb5, 0x7f, 0x4d, 0x43, 0x61, 0xff, 0x45, 0xb3, 0x61,
+	0x39, 0xe3, 0x64, 0x5b, 0x49, 0x20, 0xe3, 0x52, 0xc7, 0x16, 0xd3, 0x65, 0xdf, 0x68, 0x05, 0xf0,
+	0x6f, 0x9b, 0x49, 0xc0, 0x8f, 0x5b, 0x01, 0x01, 0xad, 0x13, 0x07, 0xf7, 0x49, 0xae, 0xe3, 0x1a,
+	0x56, 0x57, 0x9a, 0x51, 0xd1, 0x42, 0x78, 0xd7, 0x1e, 0x8d, 0x4c, 0x5f, 0x9a, 0x50, 0xde, 0xc2,
+	0x01, 0x06, 0x43, 0xbb, 0xa3, 0x66, 0xf9, 0x00, 0xf8, 0x8d, 0x06, 0xf2, 0xad, 0x6d, 0x5a, 0x6d,
+	0xdb, 0x52, 0x73, 0x9c, 0x18, 0x9b, 0x7b, 0x16, 0xda, 0x69, 0x7b, 0xec, 0x53, 0xb7, 0x8d, 0x6d,
+	0x35, 0xcf, 0x2c, 0x47, 0x91, 0x41, 0x5e, 0xd9, 0xa6, 0x45, 0x6e, 0x42, 0x61, 0xe0, 0xda, 0x63,
+	0xa7, 0xdd, 0x39, 0x51, 0x0b, 0xac, 0x63, 0x9e, 0xb5, 0x37, 0x4f, 0x70, 0x98, 0xa1, 0xf1, 0xcb,
+	0x13, 0xb5, 0xc8, 0xfa, 0xb0, 0x6f, 0x34, 0x2c, 0xcc, 0x61, 0xb5, 0xd1, 0x4a, 0x78, 0xc2, 0x10,
+	0x01, 0x03, 0xbd, 0x40, 0x08, 0xa9, 0x42, 0xda, 0x7b, 0xc2, 0x6c, 0x51, 0x41, 0x4f, 0x7b, 0x4f,
+	0x50, 0xb1, 0xbe, 0x6b, 0x0e, 0x06, 0x94, 0x5b, 0x21, 0xa6, 0xd8, 0xbe, 0xb0, 0xd1, 0x0c, 0xac,
+	0x4b, 0xbc, 0xf6, 0xf7, 0x29, 0x28, 0x6e, 0xb9, 0xb6, 0x75, 0x31, 0xcd, 0x86, 0x4a, 0x52, 0x26,
+	0x95, 0xe4, 0x39, 0xb4, 0x2b, 0x97, 0x1b, 0xbf, 0xc9, 0x6d, 0x28, 0xda, 0xc7, 0xd4, 0x7d, 0xe7,
+	0x9a, 0x3e, 0x55, 0xb3, 0x42, 0x15, 0x12, 0x40, 0x3e, 0x46, 0xfb, 0x6d, 0xb8, 0x3e, 0x53, 0x20,
+	0x3a, 0x13, 0xee, 0x6c, 0x57, 0xa5, 0xb3, 0x5d, 0x6d, 0x49, 0x6f, 0xac, 0x73, 0x42, 0xed, 0xbf,
+	0x52, 0x90, 0xe5, 0xd2, 0x6a, 0xa0, 0x38, 0x7d, 0x6f, 0xca, 0x26, 0x88, 0x6d, 0xa2, 0x23, 0x92,
+	0xdc, 0x87, 0x0c, 0x5b, 0x03, 0x7e, 0x38, 0x2b, 0x92, 0x88, 0x53, 0x30, 0x14, 0xf9, 0x00, 0xb2,
+	0x4c, 0xfb, 0xaa, 0x92, 0x44, 0xc3, 0x71, 0x48, 0xd4, 0x75, 0x6d, 0xcf, 0x53, 0x33, 0x89, 0x44,
+	0x0c, 0x87, 0x44, 0x63, 0xcb, 0xb4, 0x2d, 0x35, 0x9b, 0x48, 0xc4, 0x70, 0xe4, 0x9b, 0x90, 0xe9,
+	0xba, 0x62, 0xc7, 0x94, 0xd6, 0xe7, 0x25, 0x4d, 0xb0, 0x08, 0x3a, 0x43, 0x6b, 0x16, 0x14, 0x5e,
+	0xd9, 0x9d, 0xd3, 0x97, 0xe5, 0x41, 0xb0, 0x04, 0xdc, 0x48, 0x57, 0xe5, 0x12, 0x6f, 0x31, 0xe8,
+	0xd4, 0xbe, 0x55, 0x22, 0xfb, 0x56, 0x6e, 0xb2, 0x4c, 0xb8, 0xc9, 0xb4, 0x8f, 0x60, 0x6e, 0xdf,
+	0x70, 0x8d, 0xe1, 0x90, 0x0e, 0x4d, 0x6f, 0xd4, 0xc4, 0x95, 0x5b, 0x86, 0x42, 0xd7, 0xb6, 0x3c,
+	0xdf, 0xb0, 0xb8, 0x65, 0xc8, 0xe8, 0x41, 0x5b, 0x7b, 0x02, 0x45, 0x26, 0x1b, 0x6e, 0x40, 0xe4,
+	0xc7, 0x02, 0x12, 0x21, 0x1f, 0x7e, 0x23, 0xec, 0xd0, 0xf0, 0x0e, 0x99, 0x74, 0x65, 0x9d, 0x7d,
+	0x6b, 0xcf, 0x21, 0xbb, 0x6d, 0xf8, 0xe3, 0x11, 0xb9, 0x03, 0x8a, 0xf4, 0x52, 0xa5, 0xf5, 0x92,
+	0x54, 0x01, 0xfa, 0x29, 0x84, 0x9f, 0x66, 0xc3, 0xb5, 0xff, 0x49, 0x41, 0x91, 0x31, 0x68, 0x58,
+	0x7d, 0x1b, 0xb5, 0xdd, 0xc3, 0x86, 0x60, 0x13, 0x68, 0x9b, 0x51, 0xe8, 0x1c, 0x47, 0x1e, 0xb2,
+	0xfd, 0xe5, 0x73, 0x3b, 0x58, 0x5d, 0x27, 0x31, 0xa2, 0x26, 0x62, 0x74, 0x4e, 0x40, 0x1e, 0x73,
+	0x4a, 0x4f, 0x38, 0xac, 0xc5, 0x60, 0x3f, 0xb9, 0x76, 0x97, 0x7a, 0x1e, 0xd2, 0x7a, 0x9c, 0xd6,
+	0x23, 0x8f, 0xa0, 0x88, 0xda, 0xe6, 0x9c, 0x33, 0x8c, 0xbe, 0x2c, 0xf5, 0x8f, 0x1a, 0xd1, 0x0b,
+	0x4e, 0x9f, 0xf5, 0xa0, 0xe4, 0x1b, 0x90, 0x41, 0x2f, 0x20, 0xb6, 0x44, 0x2d, 0x4a, 0x85, 0xb3,
+	0xd0, 0x19, 0x16, 0x2d, 0x02, 0x0f, 0x7a, 0xcc, 0x9e, 0x30, 0x25, 0x79, 0xd6, 0x6e, 0xf4, 0xb4,
+	0x7f, 0x48, 0x41, 0x71, 0x63, 0x30, 0x70, 0xe9, 0x00, 0xd9, 0x2d, 0x42, 0xb6, 0x8b, 0xf1, 0x12,
+	0x9b, 0xb4, 0xa2, 0xf3, 0x06, 0x2a, 0x7b, 0x44, 0x0d, 0x8b, 0x4d, 0x32, 0xa5, 0xb3, 0x6f, 0x3c,
+	0xa3, 0x9e, 0xdf, 0xeb, 0xd1, 0x63, 0x36, 0xa1, 0x94, 0x2e, 0x5a, 0xe4, 0x11, 0xd4, 0xfa, 0x66,
+	0xdf, 0x3f, 0x6c, 0x3b, 0xd4, 0xed, 0x52, 0xcb, 0x37, 0x87, 0x7c, 0x0a, 0x29, 0x7d, 0x8e, 0xc1,
+	0xf7, 0x03, 0x30, 0x79, 0x0a, 0x37, 0x2c, 0xd3, 0xa2, 0xcc, 0xf2, 0x4c, 0xf4, 0xc8, 0xb2, 0x1e,
+	0x4b, 0x1c, 0xfd, 0x22, 0xde, 0x4f, 0xfb, 0x8b, 0x34, 0x94, 0xa3, 0x6a, 0x23, 0xcf, 0xa1, 0xd2,
+	0xb3, 0xdf, 0x59, 0x43, 0xdb, 0xe8, 0xb5, 0x31, 0xbc, 0x16, 0x4b, 0x76, 0x73, 0xea, 0xb4, 0x6f,
+	0x8b, 0xd0, 0x5a, 0x2f, 0x4b, 0x7a, 0x3c, 0xff, 0xe4, 0x87, 0x50, 0x76, 0x38, 0x3f, 0xde, 0x3d,
+	0x7d, 0x56, 0xf7, 0x92, 0x20, 0x67, 0xbd, 0x9f, 0x41, 0x69, 0xec, 0x84, 0x63, 0x2b, 0x67, 0x75,
+	0x06, 0x4e, 0xcd, 0xfa, 0x7e, 0x13, 0xaa, 0x81, 0xe4, 0x9d, 0x13, 0x9f, 0x7a, 0x4c, 0x57, 0x8a,
+	0x1e, 0xcc, 0x67, 0x13, 0x81, 0xe4, 0x3e, 0x94, 0xc7, 0x4e, 0x84, 0x28, 0xcb, 0x88, 0xc4, 0xb0,
+	0x8c, 0x44, 0xfb, 0x6d, 0x1a, 0x96, 0x82, 0x75, 0x8c, 0x69, 0xe7, 0x69, 0xb2, 0x76, 0x02, 0xd3,
+	0x10, 0xf4, 0x9a, 0xd0, 0xca, 0x27, 0x89, 0x5a, 0x49, 0xe8, 0x16, 0xd3, 0xc6, 0x7a, 0x92, 0x36,
+	0x12, 0x3a, 0x45, 0xb5, 0xf0, 0xfd, 0x44, 0x2d, 0x24, 0x76, 0x9b, 0x50, 0xcc, 0x27, 0x09, 0x8a,
+	0x49, 0x96, 0x31, 0xaa, 0xab, 0x5f, 0xa7, 0xa0, 0xfc, 0xa5, 0xed, 0x1e, 0x51, 0x17, 0x35, 0x34,
+	0x66, 0x07, 0xee, 0x1d, 0x6b, 0xe3, 0x01, 0xe1, 0xc1, 0x6d, 0xf9, 0xfd, 0xd7, 0xf7, 0x0a, 0x9c,
+	0xa8, 0xb1, 0xad, 0x17, 0x38, 0xba, 0xd1, 0xc3, 0x20, 0xf8, 0xad, 0xdd, 0x69, 0x07, 0x06, 0x84,
+	0x05, 0xc1, 0x68, 0x4a, 0xb7, 0xf5, 0xec, 0x5b, 0xbb, 0xd3, 0xe8, 0x91, 0xa7, 0x50, 0x66, 0xc6,
+	0x81, 0x9d, 0xdf, 0xb1, 0x3c, 0xf0, 0x0b, 0x53, 0xa6, 0x61, 0xec, 0xe9, 0xa5, 0x5e, 0xd8, 0xd0,
+	0xde, 0x42, 0x29, 0x82, 0x23, 0x9f, 0x40, 0x9e, 0x79, 0x24, 0xda, 0x53, 0x53, 0x67, 0x3a, 0x2f,
+	0x49, 0x8a, 0xe6, 0x9f, 0xd9, 0x03, 0xee, 0x90, 0xe6, 0x63, 0x2e, 0x82, 0x99, 0x0e, 0x86, 0xd6,
+	0x6c, 0x28, 0xeb, 0xd4, 0xb3, 0xc7, 0x6e, 0x97, 0x32, 0x5b, 0x8c, 0xd9, 0x99, 0x33, 0x66, 0x03,
+	0xa5, 0x75, 0xfc, 0xc4, 0xf3, 0x3d, 0xa2, 0x23, 0xdb, 0x95, 0x09, 0xa2, 0x68, 0x91, 0xfb, 0xa0,
+	0x0c, 0x9c, 0xb1, 0xaa, 0xc4, 0x23, 0xaa, 0x97, 0xfb, 0x07, 0xc8, 0x47, 0x47, 0x1c, 0x9a, 0x8b,
+	0x9e, 0xe9, 0x1d, 0x49, 0x37, 0x8d, 0xdf, 0xda, 0x77, 0x21, 0x2f, 0x68, 0x82, 0xa0, 0x2d, 0x15,
+	0x06, 0x6d, 0x38, 0x9a, 0x35, 0x1e, 0x75, 0xa8, 0xcb, 0x46, 0x53, 0x74, 0xd1, 0xd2, 0x7e, 0x06,
+	0xf0, 0xca, 0xee, 0x34, 0xa9, 0xcf, 0x4c, 0xf2, 0xb7, 0x30, 0x20, 0xea, 0xb4, 0x3d, 0xea, 0x0b,
+	0x95, 0x54, 0x23, 0xb6, 0xbd, 0x49, 0x7d, 0x0c, 0x90, 0xf0, 0x2f, 0xf9, 0x00, 0xdd, 0x72, 0x47,
+	0xc6, 0xcc, 0x73, 0x11, 0x2a, 0x6e, 0x14, 0x11, 0xa9, 0xfd, 0x7b, 0x19, 0xf2, 0x02, 0x72, 0x96,
+	0xc7, 0x78, 0x04, 0x35, 0x99, 0x01, 0xb4, 0x8f, 0xa9, 0xeb, 0xa1, 0x13, 0x4e, 0x33, 0x97, 0x35,
+	0x27, 0xe1, 0x5f, 0x70, 0x30, 0x79, 0x02, 0x15, 0x7b, 0xec, 0x3b, 0x63, 0xbf, 0x1d, 0x09, 0x61,
+	0xa6, 0xfd, 0x67, 0x99, 0x13, 0xf1, 0x16, 0x51, 0x21, 0xef, 0x52, 0x1e, 0xa8, 0x64, 0x18, 0x5b,
+	0xd9, 0x64, 0x06, 0xc2, 0xf0, 0x8d, 0xb6, 0x38, 0x62, 0xb4, 0x27, 0xce, 0x7e, 0x05, 0xa1, 0xfb,
+	0x12, 0x88, 0x06, 0x82, 0x91, 0x79, 0x47, 0xa6, 0xe3, 0x50, 0x6e, 0xe4, 0x15, 0xb6, 0xbd, 0x8c,
+	0x26, 0x07, 0x61, 0xd0, 0xc8, 0x48, 0x7c, 0xdb, 0x37, 0x86, 0x2c, 0x68, 0x54, 0xf4, 0x22, 0x42,
+	0x5a, 0x08, 0xc0, 0x28, 0x90, 0xa1, 0xfb, 0x86, 0x39, 0xa4, 0x3d, 0x16, 0x37, 0x2a, 0x3a, 0xeb,
+	0xf1, 0x82, 0x41, 0x02, 0x49, 0x5c, 0xda, 0xc5, 0xf8, 0x8a, 0xf6, 0xd4, 0x62, 0x28, 0x89, 0x2e,
+	0x81, 0xa1, 0x9f, 0x83, 0xb3, 0xfd, 0xdc, 0x03, 0xe9, 0x3d, 0x4b, 0xcc, 0x7b, 0xd6, 0xa2, 0xab,
+	0x19, 0xf5, 0x9d, 0xd7, 0x21, 0xe7, 0x52, 0xc3, 0xb3, 0x2d, 0x91, 0xf5, 0x8a, 0x16, 0x1e, 0x91,
+	0xae, 0x4b, 0x0d, 0x3c, 0x22, 0x95, 0xb3, 0x8f, 0x88, 0x20, 0x8d, 0x1e, 0xac, 0xea, 0xf9, 0x0f,
+	0xd6, 0x53, 0x28, 0xf4, 0x4d, 0xcb, 0xf4, 0x0e, 0x69, 0x4f, 0x9d, 0x3b, 0xb3, 0x5b, 0x40, 0x4b,
+	0xbe, 0x03, 0xf9, 0x1e, 0xf5, 0x0d, 0x73, 0xe8, 0xa9, 0x35, 0xd6, 0xed, 0xc6, 0xc4, 0x6e, 0x5c,
+	0xdd, 0xe6, 0x68, 0x5d, 0xd2, 0xe1, 0x50, 0x94, 0xe5, 0xae, 0xb4, 0xa7, 0xce, 0x9f, 0x3d, 0x94,
+	0xa4, 0x5d, 0xfe, 0xb3, 0x3c, 0xe4, 0x05, 0x33, 0xb2, 0x06, 0x45, 0x5f, 0x16, 0x4c, 0x26, 0x0d,
+	0x7e, 0x50, 0x49, 0xd1, 0x43, 0x1a, 0xb2, 0x09, 0x35, 0x27, 0x0c, 0xd0, 0xda, 0x2c, 0xce, 0x4e,
+	0xc7, 0x05, 0x9e, 0x08, 0xe0, 0xf4, 0x39, 0x27, 0x0e, 0xc0, 0xa0, 0x91, 0x0b, 0x13, 0x6e, 0x7a,
+	0xde, 0x93, 0xa7, 0xe2, 0xba, 0xc0, 0x46, 0x33, 0xb3, 0xcc, 0xec, 0xcc, 0x0c, 0xa3, 0x30, 0x0f,
+	0xb3, 0x39, 0x35, 0x1b, 0x8f, 0xc2, 0x58, 0x8a, 0xa7, 0x73, 0x1c, 0xf9, 0x14, 0x2a, 0xc2, 0x7c,
+	0x0b, 0x93, 0x9b, 0x5b, 0x51, 0xa2, 0x7b, 0x2f, 0x6a, 0xeb, 0xf5, 0xf2, 0xbb, 0x48, 0x8b, 0x6c,
+	0xc0, 0xbc, 0x2b, 0x0c, 0x61, 0xdb, 0xa5, 0x5f, 0x8d, 0xa9, 0xe7, 0x7b, 0xec, 0x70, 0x44, 0xba,
+	0x47, 0x2d, 0xa5, 0x5e, 0x93, 0xe4, 0xba, 0xa0, 0x26, 0x3f, 0x82, 0xb9, 0x80, 0xc5, 0xd0, 0x1c,
+	0x99, 0xbe, 0xa7, 0x16, 0x66, 0x30, 0xa8, 0x4a, 0xe2, 0x1d, 0x46, 0x4b, 0x76, 0xe0, 0x86, 0x67,
+	0xf6, 0x68, 0xd7, 0x70, 0xdb, 0x93, 0x6c, 0x8a, 0x33, 0xd8, 0x2c, 0x89, 0x4e, 0x7a, 0x9c, 0xdb,
+	0x07, 0x90, 0x35, 0xd1, 0xd6, 0xab, 0x10, 0xd7, 0x97, 0xc8, 0x11, 0x4c, 0x19, 0xf0, 0x7b, 0xc6,
+	0xd0, 0x97, 0xe5, 0x25, 0xfc, 0x26, 0xcf, 0xa0, 0x2a, 0xbc, 0x16, 0xf5, 0xf9, 0xea, 0x97, 0xe3,
+	0xa3, 0x73, 0xdf, 0x44, 0x7d, 0x36, 0x7a, 0xb9, 0x17, 0x69, 0xb1, 0xf8, 0x8b, 0xf5, 0x45, 0x97,
+	0x8f, 0x8b, 0x55, 0x39, 0x3b, 0xfe, 0x42, 0xfa, 0x16, 0x27, 0xc7, 0x08, 0x0a, 0xed, 0xba, 0xec,
+	0x5d, 0x3d, 0xab, 0x37, 0xbc, 0xb5, 0x3b, 0xb2, 0x2f, 0xb7, 0x5b, 0x38, 0xb6, 0x6b, 0x52, 0x4f,
+	0x9d, 0x0b, 0xec, 0xd6, 0x78, 0xd4, 0x42, 0x08, 0xf9, 0x0c, 0xe6, 0xbc, 0xee, 0x21, 0xed, 0x8d,
+	0x87, 0x58, 0x3a, 0x63, 0x33, 0xe3, 0x07, 0xf1, 0x7a, 0xb0, 0x97, 0x02, 0x34, 0x5f, 0x20, 0x2f,
+	0xd6, 0xc6, 0xe0, 0xd9, 0xb1, 0x7b, 0xbc, 0xe7, 0x3c, 0x0f, 0x9e, 0x1d, 0xbb, 0xc7, 0x50, 0xb7,
+	0xa0, 0x88, 0x28, 0xc7, 0xf0, 0xbb, 0x87, 0x2a, 0x61, 0x38, 0xa4, 0xdd, 0xc7, 0xb6, 0xf6, 0x12,
+	0x72, 0x7c, 0xe3, 0x25, 0x26, 0x58, 0x8f, 0xe2, 0x99, 0xc3, 0xc2, 0xf4, 0x5e, 0x95, 0xe6, 0x4f,
+	0xbb, 0x0b, 0x05, 0x59, 0x89, 0x4a, 0x62, 0xa5, 0xfd, 0xe7, 0x1c, 0x94, 0x25, 0x01, 0xf3, 0x66,
+	0x17, 0x2b, 0x69, 0xa9, 0x90, 0x8f, 0xfb, 0x34, 0xd9, 0x24, 0x6b, 0x50, 0xc2, 0x59, 0xcf, 0xf6,
+	0x64, 0x80, 0x24, 0xa1, 0x1f, 0xf3, 0x7c, 0x9b, 0x79, 0x20, 0x9e, 0xfc, 0xc9, 0x26, 0xf9, 0xb6,
+	0x9c, 0x6e, 0x96, 0x4d, 0x77, 0x69, 0x52, 0x9e, 0x53, 0xec, 0x7d, 0x2e, 0x66, 0xef, 0x9f, 0x42,
+	0x75, 0x68, 0x78, 0x7e, 0x9b, 0x05, 0x01, 0x8c, 0x5b, 0xe1, 0x14, 0xc7, 0x51, 0x46, 0x3a, 0xd9,
+	0x22, 0x2b, 0x50, 0x8a, 0x98, 0x2a, 0x76, 0xac, 0x32, 0x7a, 0x14, 0x44, 0xbe, 0x2b, 0x62, 0x12,
+	0x60, 0xfc, 0xee, 0x4f, 0x4a, 0xc7, 0xec, 0xb4, 0x6c, 0x60, 0x7d, 0x47, 0x84, 0x2d, 0x77, 0x00,
+	0x8c, 0xb1, 0x7f, 0xd8, 0xf6, 0xed, 0x23, 0x6a, 0x89, 0xe3, 0x54, 0x44, 0x48, 0x0b, 0x01, 0xe4,
+	0x69, 0x68, 0xfb, 0xf9, 0x61, 0xba, 0x9d, 0xc8, 0x78, 0xd2, 0x01, 0x2c, 0xff, 0x79, 0xe9, 0x0a,
+	0x86, 0x7c, 0x2d, 0xa8, 0xd2, 0xa6, 0xe3, 0x26, 0x80, 0x55, 0x6a, 0xa7, 0x8b, 0xb6, 0x89, 0x96,
+	0x5f, 0xb9, 0xb4, 0xe5, 0xcf, 0xcc, 0xb4, 0xfc, 0x9f, 0x02, 0x08, 0x37, 0xdc, 0x36, 0xa4, 0x4d,
+	0x9f, 0xe5, 0xdc, 0x8a, 0x82, 0x7a, 0xc3, 0xc7, 0x10, 0xc7, 0xa5, 0x98, 0x02, 0xb6, 0xa9, 0xeb,
+	0xda, 0xae, 0xd8, 0x1a, 0x25, 0x0e, 0xab, 0x23, 0x88, 0x7c, 0x1b, 0xe6, 0xb9, 0x71, 0xf7, 0xa4,
+	0x2d, 0xa7, 0x3d, 0x11, 0xe9, 0xd4, 0x04, 0x42, 0x97, 0xf0, 0x28, 0xb1, 0x71, 0x6c, 0x98, 0x43,
+	0xa3, 0x33, 0xa4, 0x6a, 0x21, 0x46, 0xbc, 0x21, 0xe1, 0x58, 0xa5, 0x14, 0x51, 0x9d, 0xa8, 0xea,
+	0x15, 0xd9, 0xe8, 0x22, 0x8a, 0xdb, 0x64, 0xb0, 0x64, 0x5f, 0x02, 0x57, 0xf5, 0x25, 0xa5, 0x3f,
+	0x8e, 0x2f, 0x29, 0x5f, 0xc1, 0x97, 0x54, 0x66, 0xf8, 0x92, 0x15, 0x28, 0xf5, 0xa8, 0xd7, 0x75,
+	0x4d, 0x07, 0x4d, 0x33, 0xb3, 0xdd, 0x45, 0x3d, 0x0a, 0x0a, 0xbc, 0x4d, 0x2d, 0xe2, 0x6d, 0xc2,
+	0x13, 0x3e, 0x1f, 0x3b, 0xe1, 0x91, 0xc8, 0x60, 0xe1, 0xbc, 0x91, 0xc1, 0xe2, 0x8c, 0xc8, 0x60,
+	0xda, 0xab, 0x2d, 0x5d, 0xde, 0xab, 0x5d, 0xbf, 0x92, 0x57, 0xbb, 0x71, 0x05, 0xaf, 0xa6, 0x9e,
+	0xc7, 0xab, 0xdd, 0xbc, 0xb4, 0x57, 0x5b, 0x9e, 0xe1, 0xd5, 0x6e, 0xc5, 0xbd, 0x1a, 0x59, 0x82,
+	0x9c, 0xf7, 0xa4, 0x8d, 0x13, 0xba, 0xcd, 0x6f, 0xac, 0xbc, 0x27, 0x7b, 0x63, 0x1f, 0x5d, 0xce,
+	0x48, 0xdc, 0x48, 0xa8, 0x77, 0xe2, 0x2e, 0x47, 0xde, 0x54, 0xe8, 0x01, 0x05, 0xe6, 0x12, 0x2e,
+	0x95, 0xc5, 0x05, 0x26, 0xc2, 0x5d, 0x36, 0x4c, 0x25, 0x80, 0x32, 0x41, 0xbe, 0x05, 0x73, 0x63,
+	0xab, 0x3b, 0x34, 0xcc, 0x11, 0xed, 0xb5, 0xf1, 0x72, 0xd3, 0x53, 0xef, 0x31, 0x4d, 0x54, 0x03,
+	0x70, 0x0b, 0xa1, 0x28, 0xb1, 0x08, 0x00, 0xdd, 0xae, 0xba, 0xc2, 0x25, 0xe6, 0x00, 0xbd, 0x8b,
+	0x3b, 0xd4, 0x18, 0xfb, 0xb6, 0xd7, 0x35, 0x70, 0xf2, 0xea, 0x7d, 0x26, 0x76, 0x14, 0x14, 0x6a,
+	0xbb, 0x6b, 0x74, 0x0f, 0xa9, 0xaa, 0x31, 0x0a, 0xae, 0xed, 0x2d, 0x84, 0x68, 0xbf, 0x84, 0x72,
+	0xd4, 0xfa, 0x93, 0x9b, 0xb0, 0xb4, 0xdf, 0xd8, 0xaf, 0xef, 0x34, 0x76, 0x5b, 0xed, 0xd6, 0x4f,
+	0xf7, 0xeb, 0xed, 0x83, 0xdd, 0xd7, 0xbb, 0x7b, 0x5f, 0xee, 0xd6, 0xae, 0x91, 0x5b, 0x70, 0x43,
+	0xa0, 0xea, 0x1c, 0xd5, 0xd2, 0x37, 0x76, 0x9b, 0x2f, 0xf6, 0xf4, 0x37, 0xb5, 0x14, 0xb9, 0x01,
+	0x0b, 0x71, 0x64, 0x73, 0x7f, 0xef, 0xa0, 0x55, 0x4b, 0x47, 0x18, 0x4a, 0x44, 0x5d, 0xff, 0xa2,
+	0xb1, 0x55, 0xaf, 0x29, 0xaf, 0x32, 0x85, 0x7c, 0xad, 0xa0, 0xbd, 0x82, 0x4a, 0xd4, 0x67, 0xa0,
+	0x25, 0xad, 0x04, 0x29, 0xa9, 0x69, 0xf5, 0x6d, 0x71, 0xbf, 0xb4, 0x98, 0xe4, 0x61, 0xf4, 0xb2,
+	0x13, 0x69, 0x69, 0x2b, 0x90, 0xe3, 0xf9, 0xb2, 0xa8, 0x84, 0xa6, 0xa6, 0x2a, 0xa1, 0x23, 0x58,
+	0x6c, 0x58, 0xb8, 0x2e, 0x3e, 0x27, 0x14, 0xf6, 0xe9, 0xfc, 0x09, 0x38, 0x81, 0xcc, 0x3b, 0x43,
+	0x14, 0x8f, 0x0b, 0x3a, 0xfb, 0xc6, 0xe0, 0x40, 0x7a, 0x43, 0x85, 0x07, 0x07, 0xa2, 0xa9, 0x7d,
+	0x04, 0xf3, 0x3b, 0xa6, 0x37, 0x31, 0x56, 0x84, 0x3c, 0x15, 0x27, 0xff, 0x39, 0xcc, 0x87, 0xd2,
+	0x49, 0xf2, 0x33, 0x32, 0xf8, 0x8b, 0x09, 0xf4, 0xbb, 0x14, 0x54, 0x85, 0x44, 0x92, 0xff, 0xc5,
+	0x62, 0xaa, 0xef, 0x40, 0x99, 0x99, 0xc7, 0x76, 0x50, 0x44, 0x57, 0x12, 0x42, 0xa7, 0x12, 0xa3,
+	0x09, 0x63, 0xa7, 0x43, 0xd3, 0xf3, 0xb1, 0xe2, 0xc2, 0x6b, 0x80, 0xb2, 0x19, 0x95, 0x33, 0x1b,
+	0x93, 0x13, 0x4b, 0xe8, 0x6f, 0xbf, 0x7a, 0x61, 0x0e, 0x7d, 0x2a, 0xfd, 0x61, 0xd0, 0xd6, 0xfe,
+	0x3f, 0x2c, 0x34, 0xc7, 0x1d, 0x34, 0xc3, 0x1d, 0x7a, 0xe9, 0x79, 0x44, 0x86, 0x4e, 0xc7, 0x55,
+	0xf4, 0x1d, 0xa8, 0x6d, 0xd3, 0x21, 0xf5, 0xe9, 0xb9, 0xd7, 0x40, 0x7b, 0x09, 0xd5, 0xa6, 0x6f,
+	0x3b, 0xe7, 0x5f, 0xb4, 0xd0, 0x4b, 0x28, 0x51, 0x2f, 0xa1, 0xfd, 0xad, 0x02, 0x4b, 0x07, 0x4e,
+	0xcf, 0xf0, 0xa9, 0x0c, 0xf1, 0xce, 0xc9, 0xf0, 0x41, 0x3c, 0xe8, 0x3e, 0x47, 0xc1, 0x21, 0x36,
+	0x70, 0xb4, 0x4e, 0x93, 0x3d, 0xab, 0x4e, 0x93, 0x3b, 0x4f, 0x9d, 0x26, 0x3f, 0x5d, 0xa7, 0xf9,
+	0x63, 0x15, 0x62, 0xe2, 0xf5, 0x1e, 0x98, 0xac, 0xf7, 0x04, 0x75, 0x9a, 0xd2, 0xd9, 0x75, 0x9a,
+	0x68, 0x41, 0xa2, 0x7c, 0xfe, 0x82, 0x84, 0xf6, 0x7b, 0x05, 0xaa, 0x2f, 0xa9, 0xbf, 0x63, 0x0f,
+	0xbc, 0xcb, 0x6d, 0x3f, 0xb1, 0x9c, 0xe9, 0x53, 0x96, 0x53, 0x6a, 0xb3, 0xcf, 0x76, 0xbc, 0x27,
+	0x9e, 0x91, 0x30, 0xf5, 0xf1, 0x43, 0xe0, 0x85, 0xb7, 0x38, 0x99, 0x19, 0xb7, 0x38, 0x58, 0xeb,
+	0x34, 0x3c, 0x3c, 0x44, 0xfc, 0x7c, 0x89, 0x16, 0xc2, 0xfb, 0xf6, 0x70, 0x68, 0xbf, 0x63, 0x8b,
+	0x59, 0xd0, 0x45, 0x8b, 0x55, 0x30, 0x0d, 0x53, 0x16, 0xd1, 0xd8, 0x37, 0x79, 0x08, 0xb5, 0xb1,
+	0x47, 0xdb, 0x43, 0xfb, 0xc8, 0x6c, 0x77, 0x8c, 0xee, 0x11, 0xb5, 0xf8, 0xda, 0x15, 0xf4, 0xea,
+	0xd8, 0xa3, 0x3b, 0xf6, 0x91, 0xb9, 0xc9, 0xa1, 0x64, 0x0d, 0xb2, 0x9e, 0x69, 0x75, 0xa9, 0x5a,
+	0x3c, 0x2b, 0x22, 0xe0, 0x74, 0xe4, 0x01, 0xcc, 0x21, 0x6b, 0xb4, 0x1d, 0x92, 0x33, 0xbf, 0xa4,
+	0xad, 0x8c, 0x3d, 0xba, 0xdf, 0xf7, 0x24, 0xe3, 0x55, 0xc8, 0xf4, 0x5d, 0x7b, 0xa4, 0x96, 0xce,
+	0x5c, 0x22, 0x46, 0x47, 0x1e, 0x43, 0xda, 0xb7, 0xcf, 0xb1, 0xa0, 0x69, 0xdf, 0xd6, 0xfe, 0x29,
+	0x0d, 0xb0, 0x63, 0x0f, 0xde, 0x50, 0xcf, 0xc3, 0x57, 0x18, 0x1f, 0x44, 0xbc, 0x4f, 0x24, 0x1f,
+	0x0d, 0xfc, 0xcc, 0x2e, 0xa6, 0xb8, 0x67, 0x97, 0xca, 0x63, 0x75, 0x77, 0x65, 0x66, 0xdd, 0xfd,
+	0x01, 0x14, 0xb8, 0x8f, 0x36, 0x79, 0x6e, 0x59, 0xdc, 0x2c, 0xbd, 0xff, 0xfa, 0x5e, 0x9e, 0xdf,
+	0xd7, 0x6d, 0xeb, 0x79, 0x86, 0x6c, 0xf4, 0x4e, 0x5d, 0x4b, 0x59, 0x18, 0xcf, 0xcd, 0x2c, 0x8c,
+	0x07, 0x2f, 0x6f, 0xf8, 0xa5, 0x3a, 0xfb, 0x66, 0x7a, 0x92, 0x35, 0x9d, 0xd9, 0x7a, 0xf2, 0xd0,
+	0x42, 0x8c, 0xb8, 0x8e, 0x44, 0x8a, 0x20, 0x9b, 0xda, 0x97, 0xb0, 0xa0, 0x73, 0x63, 0xc1, 0xf7,
+	0xde, 0xf9, 0x2c, 0xd6, 0xe4, 0x16, 0x4f, 0x4f, 0x6d, 0x71, 0xed, 0x19, 0x2c, 0x08, 0x77, 0x18,
+	0x63, 0x7c, 0x9e, 0xfb, 0x4b, 0xed, 0x0b, 0xa8, 0xa1, 0x9f, 0xbb, 0x88, 0x44, 0x41, 0x56, 0x90,
+	0x3e, 0x3d, 0x2b, 0xd0, 0x7a, 0x50, 0x8e, 0x46, 0xd6, 0x91, 0xfa, 0x7e, 0x2a, 0x5a, 0xdf, 0x47,
+	0x23, 0xe5, 0x99, 0xbf, 0xa4, 0xe2, 0xf6, 0x86, 0xd7, 0xfe, 0x8b, 0x08, 0xe1, 0xd7, 0x3b, 0x77,
+	0x00, 0x1c, 0xea, 0xb6, 0xf9, 0x26, 0x60, 0x1b, 0x44, 0xd1, 0x8b, 0x0e, 0x75, 0xf9, 0xfe, 0xd0,
+	0xfe, 0x90, 0x82, 0x6a, 0x3c, 0xcc, 0x25, 0x6f, 0xa0, 0x62, 0xd9, 0x3d, 0xda, 0xf6, 0xe8, 0x90,
+	0x76, 0x7d, 0xdb, 0x15, 0x61, 0xd1, 0xc3, 0xe4, 0xa8, 0x78, 0x75, 0xd7, 0xee, 0xd1, 0xa6, 0x20,
+	0xe5, 0x4f, 0x68, 0xca, 0x56, 0x04, 0x44, 0x56, 0x61, 0xc1, 0x71, 0x4d, 0xdb, 0x35, 0xfd, 0x93,
+	0x76, 0x77, 0x68, 0x78, 0x1e, 0xdf, 0xed, 0xfc, 0x4a, 0x64, 0x5e, 0xa2, 0xb6, 0x10, 0x83, 0x5b,
+	0x7e, 0xf9, 0x33, 0x98, 0x9f, 0x62, 0x79, 0xa1, 0xe7, 0x33, 0x7f, 0x05, 0xb0, 0xb4, 0xc5, 0x72,
+	0xde, 0xc0, 0x1c, 0x5e, 0xca, 0x72, 0x5e, 0xb8, 0x0a, 0x10, 0xab, 0x33, 0x28, 0x97, 0x2c, 0x18,
+	0x67, 0x2e, 0x5d, 0x36, 0xc8, 0xce, 0x2c, 0x1b, 0x5c, 0x87, 0xdc, 0x98, 0xf9, 0x7b, 0x69, 0x88,
+	0x79, 0x6b, 0x3a, 0x2d, 0xcf, 0x27, 0xa4, 0xe5, 0x61, 0xc6, 0x52, 0x88, 0x66, 0x2c, 0x89, 0xd9,
+	0x7a, 0xf1, 0xaa, 0xd9, 0x3a, 0xfc, 0x71, 0xb2, 0xf5, 0xd2, 0x15, 0xb2, 0xf5, 0xf2, 0xf9, 0xb3,
+	0xf5, 0xca, 0x74, 0xb6, 0x7e, 0x9b, 0xbd, 0x6a, 0xe2, 0x41, 0x00, 0xab, 0xa6, 0x16, 0xf4, 0x10,
+	0x10, 0xcd, 0xcf, 0xe7, 0xcf, 0x9b, 0x9f, 0x93, 0x0b, 0xe5, 0xe7, 0x0b, 0x97, 0xcf, 0xcf, 0x17,
+	0xaf, 0x94, 0x9f, 0x2f, 0x5d, 0x24, 0x3f, 0x97, 0x35, 0x8d, 0xeb, 0x91, 0x9a, 0xc6, 0x44, 0xce,
+	0x7e, 0xe3, 0x3c, 0x39, 0xbb, 0x7a, 0xe9, 0x9c, 0xfd, 0xe6, 0x8c, 0x9c, 0x7d, 0x79, 0x22, 0x67,
+	0x9f, 0xa8, 0xe3, 0xde, 0x3a, 0xb3, 0x8e, 0x1b, 0xcd, 0xe6, 0x6f, 0x5f, 0x22, 0x9b, 0xbf, 0x93,
+	0x94, 0xcd, 0x4f, 0xe4, 0xe1, 0x77, 0xcf, 0xcc, 0xc3, 0xef, 0x4d, 0xe5, 0xe1, 0x3f, 0x87, 0xeb,
+	0xc2, 0xd5, 0x5d, 0xcd, 0x3a, 0x9e, 0x9e, 0xd6, 0xfc, 0x3a, 0x05, 0x0b, 0xe8, 0x11, 0xaf, 0xcc,
+	0x5f, 0xe6, 0x72, 0xe9, 0x53, 0x73, 0x39, 0xe5, 0xf4, 0x5c, 0x2e, 0x33, 0x91, 0xcb, 0xfd, 0x69,
+	0x0a, 0x96, 0x78, 0xb6, 0x75, 0x35, 0xb9, 0x6a, 0xa0, 0x18, 0xc3, 0xa1, 0x98, 0x33, 0x7e, 0xa2,
+	0x27, 0xea, 0xdb, 0x6e, 0x97, 0x0a, 0x69, 0x78, 0x03, 0x77, 0xd3, 0x11, 0xa5, 0x4e, 0x9b, 0xbd,
+	0xcc, 0xe3, 0x95, 0xfc, 0x02, 0x02, 0x74, 0xea, 0xd8, 0xda, 0x36, 0x2c, 0x36, 0x31, 0x8c, 0xb9,
+	0x92, 0x28, 0xda, 0x16, 0x2c, 0x60, 0x32, 0x78, 0x35, 0x26, 0xbf, 0x49, 0x01, 0xd1, 0xc7, 0xd6,
+	0xd5, 0x94, 0xb2, 0x0a, 0xe0, 0xb8, 0xf6, 0x31, 0xb5, 0x0c, 0x0c, 0xca, 0x93, 0x33, 0xf5, 0x08,
+	0x45, 0x24, 0xac, 0x55, 0x92, 0xc3, 0x5a, 0xed, 0x39, 0x54, 0xf5, 0xb1, 0x85, 0x4f, 0xee, 0x2e,
+	0x37, 0xad, 0x47, 0xb0, 0xc0, 0x63, 0x00, 0xfe, 0x0c, 0x5d, 0x32, 0x21, 0x90, 0x61, 0x4f, 0xbb,
+	0x53, 0xfc, 0xcd, 0x1b, 0x7e, 0x6b, 0x3f, 0x82, 0x05, 0xbe, 0x31, 0xe2, 0xa4, 0x0f, 0x20, 0xc7,
+	0x9f, 0xb6, 0x4f, 0xd6, 0x69, 0x04, 0x99, 0xc0, 0x6a, 0xcf, 0x83, 0x42, 0xcf, 0xe5, 0xfa, 0xdf,
+	0x86, 0x1c, 0x87, 0x24, 0x5e, 0x4c, 0xfd, 0x3a, 0x05, 0xc0, 0xd1, 0xec, 0x5a, 0xea, 0x9c, 0x4c,
+	0x83, 0x07, 0x22, 0xe9, 0xc8, 0x03, 0x91, 0x06, 0x10, 0x76, 0x15, 0x60, 0xda, 0x56, 0x3b, 0xf8,
+	0x05, 0x85, 0xaa, 0x9c, 0x19, 0x93, 0xcf, 0xcb, 0x5e, 0x01, 0x48, 0xdb, 0x84, 0x52, 0x28, 0x94,
+	0x47, 0x9e, 0x40, 0x89, 0x8f, 0x1b, 0x2d, 0xa3, 0x91, 0xb8, 0x68, 0x48, 0xa9, 0x83, 0x17, 0x7c,
+	0xe3, 0x95, 0xdc, 0xae, 0xed, 0x9b, 0x7d, 0x33, 0xf9, 0x76, 0x4f, 0xfb, 0xef, 0x34, 0x94, 0x25,
+	0x81, 0xbc, 0x92, 0xb3, 0x44, 0x7b, 0x72, 0x03, 0x48, 0x3a, 0x3d, 0xa0, 0xc0, 0x73, 0x3a, 0x76,
+	0x87, 0xf2, 0xa7, 0x19, 0x63, 0x77, 0xc8, 0x9e, 0xdb, 0x71, 0xdd, 0x89, 0x8a, 0x04, 0x6f, 0xa1,
+	0xbb, 0x96, 0xdb, 0x86, 0x3f, 0x1e, 0x2d, 0xea, 0x21, 0x00, 0x4f, 0x37, 0x1e, 0x61, 0xfe, 0xcb,
+	0x88, 0xa2, 0xce, 0x1b, 0x64, 0x0d, 0x20, 0xb8, 0x41, 0xe3, 0x77, 0xe5, 0x49, 0xa5, 0x90, 0xe2,
+	0x5b, 0xf1, 0x85, 0xef, 0xeb, 0x82, 0x67, 0x2e, 0xb2, 0x57, 0x7e, 0x45, 0x39, 0xfd, 0x1a, 0xaf,
+	0xea, 0x44, 0x9b, 0x1e, 0xd6, 0x71, 0xb9, 0xeb, 0x69, 0x07, 0x4f, 0x28, 0x44, 0x6a, 0xcc, 0xc1,
+	0x2f, 0x04, 0x34, 0xfa, 0xa0, 0xa3, 0x78, 0xee, 0x07, 0x1d, 0x58, 0x1b, 0x8d, 0xea, 0x9a, 0xd5,
+	0x46, 0xa5, 0x2a, 0x13, 0x6b, 0xa3, 0x51, 0x6a, 0x0c, 0xf8, 0xc3, 0x96, 0xf6, 0x2f, 0x69, 0x19,
+	0x7f, 0x07, 0xcb, 0x12, 0x1e, 0xe1, 0xff, 0x5b, 0xc1, 0x33, 0x57, 0x30, 0x8c, 0xd4, 0x8b, 0xd1,
+	0x48, 0x5d, 0xab, 0x4b, 0x07, 0x76, 0x25, 0xb5, 0x6a, 0xbf, 0x51, 0xe4, 0xb9, 0xea, 0xb2, 0x53,
+	0x7d, 0xc1, 0x55, 0xf9, 0x30, 0x62, 0x59, 0xaa, 0xeb, 0x6a, 0x9c, 0x92, 0x73, 0x8c, 0xdc, 0xee,
+	0xae, 0x42, 0x26, 0xf2, 0x86, 0x71, 0x66, 0x3d, 0x05, 0xe9, 0x64, 0xe2, 0x9c, 0x39, 0x25, 0x71,
+	0xfe, 0x08, 0x8a, 0xe1, 0xc5, 0x75, 0xf6, 0x94, 0x02, 0x64, 0x41, 0xae, 0x59, 0xcc, 0x65, 0xe4,
+	0xce, 0x74, 0x62, 0x3f, 0x84, 0x6a, 0x7c, 0x81, 0x59, 0x2a, 0x74, 0xea, 0xfa, 0x56, 0x62, 0xeb,
+	0x1b, 0xa9, 0x77, 0x16, 0x62, 0xf5, 0xce, 0xf0, 0x15, 0x78, 0x71, 0xd6, 0x2b, 0x70, 0xed, 0x57,
+	0x29, 0xa8, 0xb5, 0x5c, 0xa3, 0x4b, 0x59, 0x25, 0x45, 0xac, 0xec, 0x4a, 0xc4, 0x5d, 0x4d, 0x3e,
+	0x60, 0x66, 0x18, 0xf2, 0x09, 0x14, 0x7b, 0xa6, 0x4b, 0xbb, 0xbe, 0x7c, 0x7b, 0x50, 0x0d, 0x43,
+	0x61, 0xc6, 0x6e, 0x5b, 0x62, 0xf5, 0x90, 0x90, 0xff, 0x64, 0xca, 0x11, 0x3f, 0x50, 0x53, 0x74,
+	0xde, 0xd0, 0xfe, 0x31, 0x05, 0x25, 0x64, 0xbd, 0x63, 0x5a, 0xd4, 0x18, 0x04, 0x8b, 0x71, 0x7a,
+	0x25, 0x38, 0x2c, 0x27, 0xa5, 0x67, 0x94, 0x93, 0xbe, 0x01, 0x39, 0x96, 0x39, 0x79, 0xe2, 0xf9,
+	0x7e, 0x7c, 0x1a, 0x02, 0x47, 0x1e, 0x40, 0x9e, 0xa7, 0x9c, 0xf2, 0x01, 0x7f, 0x9c, 0x4c, 0x22,
+	0x43, 0xd1, 0xb3, 0x51, 0xd1, 0x97, 0x60, 0x61, 0xa3, 0xeb, 0x9b, 0xc7, 0x86, 0x4f, 0x37, 0xc6,
+	0xfe, 0xa1, 0xd0, 0x9f, 0x76, 0x1d, 0x16, 0xe3, 0x60, 0xcf, 0xb1, 0x2d, 0x8f, 0x6a, 0xbf, 0x4d,
+	0xc1, 0x92, 0x4e, 0xad, 0x1e, 0x75, 0x5b, 0x74, 0xe4, 0x0c, 0x23, 0xd5, 0xef, 0x65, 0x28, 0xf8,
+	0x02, 0x24, 0xbc, 0x51, 0xd0, 0x26, 0x3f, 0x80, 0x8c, 0xe1, 0x0e, 0xe4, 0x93, 0xc8, 0x6f, 0x85,
+	0x99, 0x65, 0x02, 0xa3, 0xd5, 0x0d, 0x77, 0x20, 0x7e, 0x51, 0xc4, 0x3a, 0x2d, 0x7f, 0x0f, 0x8a,
+	0x01, 0xe8, 0x42, 0xe5, 0x0c, 0x03, 0xae, 0x4f, 0x8e, 0xc0, 0x67, 0x81, 0x5e, 0xf3, 0x2d, 0x6e,
+	0x38, 0xe1, 0x35, 0xf1, 0x9b, 0x3c, 0xc1, 0x94, 0x91, 0x76, 0xa5, 0x90, 0x77, 0xc2, 0x1f, 0x2f,
+	0x24, 0x14, 0x44, 0x74, 0x4e, 0xfb, 0xf8, 0x77, 0x29, 0xf6, 0x53, 0x06, 0xbe, 0x91, 0x97, 0x60,
+	0xfe, 0xd5, 0xde, 0x66, 0xbb, 0xd9, 0xda, 0x68, 0x45, 0x2f, 0xe5, 0xe6, 0xa0, 0x84, 0xe0, 0x2d,
+	0xbd, 0xbe, 0xd1, 0xaa, 0x6f, 0xd7, 0x52, 0xa4, 0x06, 0x65, 0x41, 0xa7, 0xb7, 0x1a, 0xbb, 0x2f,
+	0x6b, 0x69, 0x49, 0xa2, 0x1f, 0xec, 0xee, 0x22, 0x40, 0x91, 0x80, 0x17, 0x1b, 0x8d, 0x9d, 0x03,
+	0xbd, 0x5e, 0xcb, 0x48, 0x40, 0xf3, 0x60, 0x6b, 0xab, 0xde, 0x6c, 0xd6, 0xb2, 0xa4, 0x0a, 0x80,
+	0x80, 0xd7, 0x8d, 0x9d, 0x9d, 0xfa, 0x76, 0x2d, 0x47, 0xe6, 0xa1, 0x82, 0xed, 0xfa, 0x4b, 0xbd,
+	0xde, 0x6c, 0x22, 0x93, 0xbc, 0x04, 0xbd, 0x68, 0xec, 0x36, 0x9a, 0x9f, 0x23, 0xa8, 0x40, 0x08,
+	0x54, 0x11, 0x74, 0xb0, 0x8b, 0x43, 0x6d, 0x6c, 0xee, 0xd4, 0x6b, 0xc5, 0xc7, 0xff, 0x0f, 0x20,
+	0xfc, 0xc5, 0x00, 0x29, 0x41, 0x3e, 0x14, 0x1d, 0x20, 0x87, 0x22, 0x30, 0xa9, 0x4b, 0x90, 0x97,
+	0xa3, 0xa7, 0x59, 0xe3, 0x75, 0x63, 0x7f, 0xbf, 0xbe, 0x5d, 0x53, 0x48, 0x19, 0x0a, 0xc1, 0x5c,
+	0x32, 0xa4, 0x02, 0x45, 0xbd, 0xbe, 0xb5, 0xf7, 0x45, 0x5d, 0xaf, 0x6f, 0xd7, 0xb2, 0x8f, 0x7f,
+	0x0a, 0xa5, 0xc8, 0xab, 0x22, 0xa2, 0xc2, 0xe2, 0x97, 0x7b, 0xfa, 0xeb, 0xba, 0x9e, 0xa4, 0xa6,
+	0xfd, 0xbd, 0xed, 0x40, 0x07, 0x29, 0x09, 0x08, 0x07, 0xad, 0x02, 0x20, 0x40, 0x48, 0xa4, 0x3c,
+	0xfe, 0xe7, 0x54, 0x78, 0x2f, 0xc9, 0xb9, 0x2f, 0xc3, 0xf5, 0xe0, 0x26, 0x73, 0x92, 0xff, 0x12,
+	0xcc, 0x47, 0x71, 0x5c, 0xdc, 0x14, 0x59, 0x84, 0x5a, 0x00, 0x96, 0x63, 0xa7, 0x63, 0x77, 0xa5,
+	0x7a, 0x3d, 0x20, 0x57, 0x62, 0xe4, 0xe1, 0xea, 0x2c, 0xc0, 0x5c, 0x00, 0xdd, 0xdf, 0x38, 0x68,
+	0xe2, 0xcc, 0x63, 0xa4, 0xcd, 0xd6, 0xc6, 0xee, 0xf6, 0xe6, 0x4f, 0x6b, 0xb9, 0x98, 0x18, 0x5b,
+	0xfa, 0x06, 0x5f, 0x98, 0xfc, 0xe3, 0x13, 0xa8, 0x4d, 0x3a, 0x02, 0x72, 0x07, 0x6e, 0xee, 0xee,
+	0xb5, 0x1a, 0x2f, 0x1a, 0x5b, 0x1b, 0xad, 0xc6, 0xde, 0xee, 0xe4, 0x65, 0x6f, 0x6c, 0xbb, 0x6d,
+	0x7d, 0xbe, 0xb1, 0xfb, 0x92, 0xad, 0xd3, 0xb4, 0x0e, 0x24, 0x2e, 0x8d, 0x72, 0x6e, 0xed, 0xbd,
+	0x79, 0xd3, 0x68, 0x89, 0x4d, 0xc1, 0xd4, 0xb8, 0x0a, 0xd5, 0xb8, 0xbd, 0xc3, 0x05, 0x3d, 0xd8,
+	0x6f, 0xb6, 0xf4, 0xfa, 0xc6, 0x9b, 0xda, 0x35, 0x54, 0xfb, 0xf6, 0xde, 0x97, 0xbb, 0xa2, 0x9d,
+	0x5a, 0xff, 0x6b, 0x02, 0xca, 0xc6, 0x7e, 0x83, 0x3c, 0x03, 0x08, 0x6f, 0x42, 0xc9, 0xcd, 0xb0,
+	0xe4, 0x33, 0x71, 0x3b, 0xba, 0x3c, 0xf9, 0x04, 0x5a, 0xbb, 0x46, 0x36, 0xa1, 0x12, 0xbb, 0xe3,
+	0x25, 0xb7, 0xa7, 0xbb, 0x87, 0xd7, 0xb1, 0x09, 0x1c, 0x3e, 0x4e, 0xe1, 0x03, 0x27, 0x71, 0x4d,
+	0x4a, 0x02, 0xc3, 0x1d, 0xbf, 0x37, 0x4d, 0xee, 0xf7, 0x19, 0x40, 0x78, 0xe1, 0x1b, 0xca, 0x3d,
+	0x75, 0x09, 0xbc, 0x4c, 0xe2, 0xf7, 0xcb, 0x01, 0x83, 0x1f, 0x43, 0x39, 0x7a, 0xb9, 0x49, 0x6e,
+	0x05, 0xf1, 0xfa, 0xf4, 0x95, 0xe7, 0x69, 0x22, 0x14, 0x83, 0xfb, 0x4b, 0x12, 0x44, 0x02, 0x93,
+	0x57, 0x9a, 0xcb, 0xd7, 0xa7, 0xbc, 0x7e, 0x1d, 0x7f, 0x18, 0xa7, 0x5d, 0x23, 0x3f, 0x80, 0xbc,
+	0xb8, 0xcd, 0x0c, 0xe7, 0x1e, 0xbf, 0xde, 0x9c, 0xd1, 0xf9, 0xc7, 0x50, 0x8e, 0xd6, 0xec, 0x43,
+	0xf9, 0x13, 0x2a, 0xf9, 0xcb, 0xf3, 0xb1, 0x62, 0x98, 0x58, 0xbe, 0x1f, 0x42, 0x31, 0xa8, 0xdc,
+	0x87, 0xf2, 0x4f, 0x16, 0xf3, 0x13, 0xfb, 0x7e, 0x9c, 0x22, 0x75, 0xf6, 0xfe, 0x3f, 0xb8, 0x8c,
+	0x08, 0xc7, 0x4f, 0xb8, 0xa2, 0x98, 0x31, 0x8d, 0x06, 0x54, 0xe3, 0xb6, 0x99, 0xcc, 0xb6, 0xd9,
+	0x33, 0x59, 0xcd, 0x4d, 0x94, 0x76, 0xc8, 0xdd, 0x09, 0xa5, 0x4c, 0x32, 0x4b, 0x7c, 0xeb, 0xa0,
+	0x5d, 0xc3, 0xc9, 0x45, 0x4b, 0x38, 0xe1, 0xe4, 0x12, 0x0a, 0x3b, 0xa7, 0x31, 0xf9, 0x38, 0x85,
+	0x93, 0x8b, 0xd7, 0x5c, 0xc2, 0xc9, 0x25, 0xd6, 0x62, 0x66, 0x4c, 0xee, 0x25, 0x54, 0x62, 0x25,
+	0x93, 0xf0, 0xac, 0x25, 0x55, 0x52, 0x66, 0x30, 0xaa, 0x43, 0x39, 0x5a, 0x35, 0x89, 0xec, 0xfb,
+	0xe9, 0x5a, 0xca, 0x0c, 0x36, 0x5b, 0x50, 0x8a, 0x94, 0x4d, 0x48, 0xf0, 0x1b, 0xfb, 0xe9, 0x5a,
+	0xca, 0xec, 0x03, 0x20, 0xaa, 0x1c, 0xe1, 0x01, 0x88, 0x97, 0x3d, 0x66, 0x4f, 0x24, 0x5a, 0xe2,
+	0x08, 0x27, 0x92, 0x50, 0xf8, 0x98, 0xcd, 0x26, 0x5a, 0xfe, 0x08, 0xd9, 0x24, 0x14, 0x45, 0x66,
+	0x4e, 0x85, 0xd9, 0x23, 0xc1, 0xe4, 0x14, 0xba, 0xe5, 0x85, 0xe9, 0xa2, 0x80, 0xc7, 0x94, 0x59,
+	0x89, 0xd5, 0x50, 0xa6, 0x0c, 0x69, 0x5c, 0x8a, 0x84, 0xd2, 0x42, 0xf4, 0x24, 0x05, 0x65, 0x85,
+	0x89, 0x93, 0x34, 0x91, 0x37, 0xcd, 0x98, 0xcc, 0x67, 0x7c, 0xfb, 0x07, 0x8c, 0x4e, 0x9b, 0xce,
+	0x52, 0x52, 0x3a, 0xec, 0x71, 0x59, 0xe2, 0xb9, 0xda, 0xe4, 0xc6, 0x3f, 0xbf, 0x2c, 0x3f, 0x92,
+	0x56, 0x76, 0x63, 0x38, 0x3c, 0x55, 0x90, 0xd3, 0xbb, 0x7f, 0x0a, 0x79, 0xf1, 0x7e, 0x20, 0xdc,
+	0x62, 0xf1, 0x07, 0x05, 0xa1, 0x3a, 0xc3, 0xdb, 0x69, 0x76, 0x7a, 0x9f, 0x43, 0x31, 0xc8, 0x48,
+	0x42, 0xfb, 0x38, 0x99, 0xa4, 0x84, 0x6b, 0x1a, 0xc9, 0x1d, 0x58, 0xff, 0xd7, 0x50, 0x8e, 0x46,
+	0xdf, 0xe1, 0xce, 0x4a, 0x08, 0xd5, 0x97, 0x6f, 0x27, 0x23, 0x45, 0xc0, 0xce, 0x34, 0x1a, 0x7f,
+	0xaf, 0x12, 0x6a, 0x34, 0xf1, 0x1d, 0xcb, 0x0c, 0x95, 0x7c, 0xce, 0x8e, 0xee, 0x0e, 0xfe, 0x74,
+	0x8e, 0x85, 0xfc, 0x32, 0xd1, 0x88, 0x00, 0x25, 0x93, 0x5b, 0x89, 0xb8, 0x40, 0xa8, 0xd7, 0x40,
+	0x22, 0x88, 0x6d, 0xda, 0x37, 0xc6, 0xc3, 0xd3, 0x37, 0xff, 0x19, 0xcc, 0x7e, 0x02, 0xd5, 0x78,
+	0xa0, 0x1f, 0xce, 0x30, 0x31, 0xc5, 0x58, 0xbe, 0x7b, 0x1a, 0x3a, 0x60, 0xf9, 0x03, 0x28, 0xe0,
+	0x3e, 0xc6, 0x17, 0x7e, 0x44, 0x5d, 0xc5, 0xe7, 0x7f, 0x86, 0x63, 0xae, 0x4a, 0x50, 0xe8, 0xe0,
+	0x24, 0x06, 0xa1, 0xd2, 0x78, 0x6f, 0x7e, 0xef, 0xf7, 0xef, 0xef, 0xa6, 0xfe, 0xf0, 0xfe, 0x6e,
+	0xea, 0x3f, 0xde, 0xdf, 0x4d, 0xfd, 0xec, 0xd1, 0xc0, 0xf4, 0x0f, 0xc7, 0x9d, 0xd5, 0xae, 0x3d,
+	0x5a, 0x73, 0x8c, 0xee, 0xe1, 0x49, 0x8f, 0xba, 0xd1, 0xaf, 0xe3, 0xf5, 0x35, 0xcf, 0xed, 0xe2,
+	0x7f, 0xed, 0xd2, 0xc9, 0xb1, 0x79, 0x3f, 0xf9, 0xdf, 0x01, 0x00, 0x88, 0x72, 0x42, 0xbe, 0xec,
+	0x45, 0x00, 0x00,
 }
 
-func _API_ListJob_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListJobRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListJob(m, &aPIListJobServer{stream})
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-type API_ListJobServer interface {
-	Send(*JobInfo) error
-	grpc.ServerStream
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-type aPIListJobServer struct {
-	grpc.ServerStream
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error)
+	InspectJobSet(ctx context.Context, in *InspectJobSetRequest, opts ...grpc.CallOption) (API_InspectJobSetClient, error)
+	// ListJob returns information about current and past Pachyderm jobs.
+	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobClient, error)
+	ListJobSet(ctx context.Context, in *ListJobSetRequest, opts ...grpc.CallOption) (API_ListJobSetClient, error)
+	SubscribeJob(ctx context.Context, in *SubscribeJobRequest, opts ...grpc.CallOption) (API_SubscribeJobClient, error)
+	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error)
+	// ListDatum returns information about each datum fed to a Pachyderm job
+	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error)
+	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
+	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (API_ListPipelineClient, error)
+	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RunPipeline(ctx context.Context, in *RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RunCron(ctx context.Context, in *RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ListSecret(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SecretInfos, error)
+	InspectSecret(ctx context.Context, in *InspectSecretRequest, opts ...grpc.CallOption) (*SecretInfo, error)
+	// CreateNotifier registers an HTTP webhook that is notified of job,
+	// pipeline and commit events.
+	CreateNotifier(ctx context.Context, in *CreateNotifierRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ListNotifier returns every notifier. Notifier URLs may contain
+	// credentials, so it requires the same permission as CreateNotifier.
+	ListNotifier(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*NotifierInfos, error)
+	DeleteNotifier(ctx context.Context, in *DeleteNotifierRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// DeleteAll deletes everything
+	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
+	// TraceFile returns the file-level lineage of a file, by following the
+	// files that each datum read and wrote upstream or downstream through the
+	// DAG.
+	TraceFile(ctx context.Context, in *TraceFileRequest, opts ...grpc.CallOption) (API_TraceFileClient, error)
+	// An internal call that causes PPS to put itself into an auth-enabled state
+	// (all pipeline have tokens, correct permissions, etcd)
+	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
+	// An internal call used to move a job from one state to another
+	UpdateJobState(ctx context.Context, in *UpdateJobStateRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RunLoadTest runs a load test.
+	RunLoadTest(ctx context.Context, in *pfs.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error)
+	// RunLoadTestDefault runs the default load test.
+	RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error)
+	// RenderTemplate renders the provided template and arguments into a list of Pipeline specicifications
+	RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error)
+	// ListTask lists PPS tasks
+	ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error)
 }
 
-func (x *aPIListJobServer) Send(m *JobInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIClient struct {
+	cc *grpc.ClientConn
 }
 
-func _API_ListJobSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListJobSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error) {
+	out := new(JobInfo)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectJob", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListJobSet(m, &aPIListJobSetServer{stream})
+	return out, nil
 }
 
-type API_ListJobSetServer interface {
-	Send(*JobSetInfo) error
-	grpc.ServerStream
+func (c *aPIClient) InspectJobSet(ctx context.Context, in *InspectJobSetRequest, opts ...grpc.CallOption) (API_InspectJobSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pps_v2.API/InspectJobSet", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIInspectJobSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPIListJobSetServer struct {
-	grpc.ServerStream
+type API_InspectJobSetClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIListJobSetServer) Send(m *JobSetInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIInspectJobSetClient struct {
+	grpc.ClientStream
 }
 
-func _API_SubscribeJob_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeJobRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *aPIInspectJobSetClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).SubscribeJob(m, &aPISubscribeJobServer{stream})
+	return m, nil
 }
 
-type API_SubscribeJobServer interface {
-	Send(*JobInfo) error
-	grpc.ServerStream
+func (c *aPIClient) ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pps_v2.API/ListJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPISubscribeJobServer struct {
-	grpc.ServerStream
+type API_ListJobClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPISubscribeJobServer) Send(m *JobInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListJobClient struct {
+	grpc.ClientStream
 }
 
-func _API_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteJobRequest)
-	if err := dec(in); err != nil {
+func (x *aPIListJobClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/DeleteJob",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteJob(ctx, req.(*DeleteJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_StopJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopJobRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ListJobSet(ctx context.Context, in *ListJobSetRequest, opts ...grpc.CallOption) (API_ListJobSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pps_v2.API/ListJobSet", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).StopJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/StopJob",
+	x := &aPIListJobSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StopJob(ctx, req.(*StopJobRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_InspectDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectDatumRequest)
-	if err := dec(in); err != nil {
+type API_ListJobSetClient interface {
+	Recv() (*JobSetInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListJobSetClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListJobSetClient) Recv() (*JobSetInfo, error) {
+	m := new(JobSetInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectDatum(ctx, in)
+	return m, nil
+}
+
+func (c *aPIClient) SubscribeJob(ctx context.Context, in *SubscribeJobRequest, opts ...grpc.CallOption) (API_SubscribeJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pps_v2.API/SubscribeJob", opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/InspectDatum",
+	x := &aPISubscribeJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectDatum(ctx, req.(*InspectDatumRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_ListDatum_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListDatumRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListDatum(m, &aPIListDatumServer{stream})
+type API_SubscribeJobClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
 }
 
-type API_ListDatumServer interface {
-	Send(*DatumInfo) error
-	grpc.ServerStream
+type aPISubscribeJobClient struct {
+	grpc.ClientStream
 }
 
-type aPIListDatumServer struct {
-	grpc.ServerStream
+func (x *aPISubscribeJobClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *aPIListDatumServer) Send(m *DatumInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/DeleteJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestartDatumRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/StopJob", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RestartDatum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/RestartDatum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RestartDatum(ctx, req.(*RestartDatumRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePipelineRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error) {
+	out := new(DatumInfo)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectDatum", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).CreatePipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/CreatePipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreatePipeline(ctx, req.(*CreatePipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_InspectPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectPipelineRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pps_v2.API/ListDatum", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/InspectPipeline",
+	x := &aPIListDatumClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectPipeline(ctx, req.(*InspectPipelineRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_ListPipeline_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListPipelineRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListPipeline(m, &aPIListPipelineServer{stream})
+type API_ListDatumClient interface {
+	Recv() (*DatumInfo, error)
+	grpc.ClientStream
 }
 
-type API_ListPipelineServer interface {
-	Send(*PipelineInfo) error
-	grpc.ServerStream
-}
-
-type aPIListPipelineServer struct {
-	grpc.ServerStream
-}
-
-func (x *aPIListPipelineServer) Send(m *PipelineInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListDatumClient struct {
+	grpc.ClientStream
 }
 
-func _API_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePipelineRequest)
-	if err := dec(in); err != nil {
+func (x *aPIListDatumClient) Recv() (*DatumInfo, error) {
+	m := new(DatumInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeletePipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/DeletePipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeletePipeline(ctx, req.(*DeletePipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_StartPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartPipelineRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/RestartDatum", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).StartPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/StartPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StartPipeline(ctx, req.(*StartPipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_StopPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopPipelineRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/CreatePipeline", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).StopPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/StopPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StopPipeline(ctx, req.(*StopPipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_RunPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RunPipelineRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error) {
+	out := new(PipelineInfo)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectPipeline", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RunPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/RunPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RunPipeline(ctx, req.(*RunPipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_RunCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RunCronRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (API_ListPipelineClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pps_v2.API/ListPipeline", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RunCron(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/RunCron",
+	x := &aPIListPipelineClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RunCron(ctx, req.(*RunCronRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSecretRequest)
-	if err := dec(in); err != nil {
+type API_ListPipelineClient interface {
+	Recv() (*PipelineInfo, error)
+	grpc.ClientStream
+}
+
+type aPIListPipelineClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListPipelineClient) Recv() (*PipelineInfo, error) {
+	m := new(PipelineInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateSecret(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/CreateSecret",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateSecret(ctx, req.(*CreateSecretRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSecretRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/DeletePipeline", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteSecret(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/DeleteSecret",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_ListSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+func (c *aPIClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/StartPipeline", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ListSecret(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/ListSecret",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListSecret(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_InspectSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectSecretRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/StopPipeline", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectSecret(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/InspectSecret",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectSecret(ctx, req.(*InspectSecretRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+func (c *aPIClient) RunPipeline(ctx context.Context, in *RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/RunPipeline", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/DeleteAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_GetLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetLogsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) RunCron(ctx context.Context, in *RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/RunCron", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).GetLogs(m, &aPIGetLogsServer{stream})
+	return out, nil
 }
 
-type API_GetLogsServer interface {
-	Send(*LogMessage) error
-	grpc.ServerStream
+func (c *aPIClient) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/CreateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type aPIGetLogsServer struct {
-	grpc.ServerStream
+func (c *aPIClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPIGetLogsServer) Send(m *LogMessage) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) ListSecret(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SecretInfos, error) {
+	out := new(SecretInfos)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/ListSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateAuthRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) InspectSecret(ctx context.Context, in *InspectSecretRequest, opts ...grpc.CallOption) (*SecretInfo, error) {
+	out := new(SecretInfo)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectSecret", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ActivateAuth(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) CreateNotifier(ctx context.Context, in *CreateNotifierRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/CreateNotifier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/ActivateAuth",
+	return out, nil
+}
+
+func (c *aPIClient) ListNotifier(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*NotifierInfos, error) {
+	out := new(NotifierInfos)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/ListNotifier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
+	return out, nil
+}
+
+func (c *aPIClient) DeleteNotifier(ctx context.Context, in *DeleteNotifierRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/DeleteNotifier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_UpdateJobState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateJobStateRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/DeleteAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).UpdateJobState(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pps_v2.API/GetLogs", opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/UpdateJobState",
+	x := &aPIGetLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).UpdateJobState(ctx, req.(*UpdateJobStateRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_RunLoadTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pfs.RunLoadTestRequest)
-	if err := dec(in); err != nil {
+type API_GetLogsClient interface {
+	Recv() (*LogMessage, error)
+	grpc.ClientStream
+}
+
+type aPIGetLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGetLogsClient) Recv() (*LogMessage, error) {
+	m := new(LogMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RunLoadTest(ctx, in)
+	return m, nil
+}
+
+func (c *aPIClient) TraceFile(ctx context.Context, in *TraceFileRequest, opts ...grpc.CallOption) (API_TraceFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pps_v2.API/TraceFile", opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/RunLoadTest",
+	x := &aPITraceFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RunLoadTest(ctx, req.(*pfs.RunLoadTestRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_RunLoadTestDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+type API_TraceFileClient interface {
+	Recv() (*FileLineage, error)
+	grpc.ClientStream
+}
+
+type aPITraceFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPITraceFileClient) Recv() (*FileLineage, error) {
+	m := new(FileLineage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RunLoadTestDefault(ctx, in)
+	return m, nil
+}
+
+func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
+	out := new(ActivateAuthResponse)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/ActivateAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/RunLoadTestDefault",
+	return out, nil
+}
+
+func (c *aPIClient) UpdateJobState(ctx context.Context, in *UpdateJobStateRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/UpdateJobState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RunLoadTestDefault(ctx, req.(*types.Empty))
+	return out, nil
+}
+
+func (c *aPIClient) RunLoadTest(ctx context.Context, in *pfs.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error) {
+	out := new(pfs.RunLoadTestResponse)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/RunLoadTest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_RenderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenderTemplateRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*pfs.RunLoadTestResponse, error) {
+	out := new(pfs.RunLoadTestResponse)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/RunLoadTestDefault", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RenderTemplate(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error) {
+	out := new(RenderTemplateResponse)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/RenderTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps_v2.API/RenderTemplate",
+	return out, nil
+}
+
+func (c *aPIClient) ListTask(ctx context.Context, in *task.ListTaskRequest, opts ...grpc.CallOption) (API_ListTaskClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pps_v2.API/ListTask", opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RenderTemplate(ctx, req.(*RenderTemplateRequest))
+	x := &aPIListTaskClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func _API_ListTask_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(task.ListTaskRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListTask(m, &aPIListTaskServer{stream})
+type API_ListTaskClient interface {
+	Recv() (*task.TaskInfo, error)
+	grpc.ClientStream
 }
 
-type API_ListTaskServer interface {
-	Send(*task.TaskInfo) error
-	grpc.ServerStream
+type aPIListTaskClient struct {
+	grpc.ClientStream
 }
 
-type aPIListTaskServer struct {
-	grpc.ServerStream
+func (x *aPIListTaskClient) Recv() (*task.TaskInfo, error) {
+	m := new(task.TaskInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *aPIListTaskServer) Send(m *task.TaskInfo) error {
-	return x.ServerStream.SendMsg(m)
+// APIServer is the server API for API service.
+type APIServer interface {
+	InspectJob(context.Context, *InspectJobRequest) (*JobInfo, error)
+	InspectJobSet(*InspectJobSetRequest, API_InspectJobSetServer) error
+	// ListJob returns information about current and past Pachyderm jobs.
+	ListJob(*ListJobRequest, API_ListJobServer) error
+	ListJobSet(*ListJobSetRequest, API_ListJobSetServer) error
+	SubscribeJob(*SubscribeJobRequest, API_SubscribeJobServer) error
+	DeleteJob(context.Context, *DeleteJobRequest) (*types.Empty, error)
+	StopJob(context.Context, *StopJobRequest) (*types.Empty, error)
+	InspectDatum(context.Context, *InspectDatumRequest) (*DatumInfo, error)
+	// ListDatum returns information about each datum fed to a Pachyderm job
+	ListDatum(*ListDatumRequest, API_ListDatumServer) error
+	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
+	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
+	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
+	ListPipeline(*ListPipelineRequest, API_ListPipelineServer) error
+	DeletePipeline(context.Context, *DeletePipelineRequest) (*types.Empty, error)
+	StartPipeline(context.Context, *StartPipelineRequest) (*types.Empty, error)
+	StopPipeline(context.Context, *StopPipelineRequest) (*types.Empty, error)
+	RunPipeline(context.Context, *RunPipelineRequest) (*types.Empty, error)
+	RunCron(context.Context, *RunCronRequest) (*types.Empty, error)
+	CreateSecret(context.Context, *CreateSecretRequest) (*types.Empty, error)
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*types.Empty, error)
+	ListSecret(context.Context, *types.Empty) (*SecretInfos, error)
+	InspectSecret(context.Context, *InspectSecretRequest) (*SecretInfo, error)
+	// CreateNotifier registers an HTTP webhook that is notified of job,
+	// pipeline and commit events.
+	CreateNotifier(context.Context, *CreateNotifierRequest) (*types.Empty, error)
+	// ListNotifier returns every notifier. Notifier URLs may contain
+	// credentials, so it requires the same permission as CreateNotifier.
+	ListNotifier(context.Context, *types.Empty) (*NotifierInfos, error)
+	DeleteNotifier(context.Context, *DeleteNotifierRequest) (*types.Empty, error)
+	// DeleteAll deletes everything
+	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
+	GetLogs(*GetLogsRequest, API_GetLogsServer) error
+	// TraceFile returns the file-level lineage of a file, by following the
+	// files that each datum read and wrote upstream or downstream through the
+	// DAG.
+	TraceFile(*TraceFileRequest, API_TraceFileServer) error
+	// An internal call that causes PPS to put itself into an auth-enabled state
+	// (all pipeline have tokens, correct permissions, etcd)
+	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
+	// An internal call used to move a job from one state to another
+	UpdateJobState(context.Context, *UpdateJobStateRequest) (*types.Empty, error)
+	// RunLoadTest runs a load test.
+	RunLoadTest(context.Context, *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error)
+	// RunLoadTestDefault runs the default load test.
+	RunLoadTestDefault(context.Context, *types.Empty) (*pfs.RunLoadTestResponse, error)
+	// RenderTemplate renders the provided template and arguments into a list of Pipeline specicifications
+	RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error)
+	// ListTask lists PPS tasks
+	ListTask(*task.ListTaskRequest, API_ListTaskServer) error
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pps_v2.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InspectJob",
-			Handler:    _API_InspectJob_Handler,
-		},
-		{
-			MethodName: "DeleteJob",
-			Handler:    _API_DeleteJob_Handler,
-		},
-		{
-			MethodName: "StopJob",
-			Handler:    _API_StopJob_Handler,
-		},
-		{
-			MethodName: "InspectDatum",
-			Handler:    _API_InspectDatum_Handler,
-		},
-		{
-			MethodName: "RestartDatum",
-			Handler:    _API_RestartDatum_Handler,
-		},
-		{
-			MethodName: "CreatePipeline",
-			Handler:    _API_CreatePipeline_Handler,
-		},
-		{
-			MethodName: "InspectPipeline",
-			Handler:    _API_InspectPipeline_Handler,
-		},
-		{
-			MethodName: "DeletePipeline",
-			Handler:    _API_DeletePipeline_Handler,
-		},
-		{
-			MethodName: "StartPipeline",
-			Handler:    _API_StartPipeline_Handler,
-		},
-		{
-			MethodName: "StopPipeline",
-			Handler:    _API_StopPipeline_Handler,
-		},
-		{
-			MethodName: "RunPipeline",
-			Handler:    _API_RunPipeline_Handler,
-		},
-		{
-			MethodName: "RunCron",
-			Handler:    _API_RunCron_Handler,
-		},
-		{
-			MethodName: "CreateSecret",
-			Handler:    _API_CreateSecret_Handler,
-		},
-		{
-			MethodName: "DeleteSecret",
-			Handler:    _API_DeleteSecret_Handler,
-		},
-		{
-			MethodName: "ListSecret",
-			Handler:    _API_ListSecret_Handler,
-		},
-		{
-			MethodName: "InspectSecret",
-			Handler:    _API_InspectSecret_Handler,
-		},
-		{
-			MethodName: "DeleteAll",
-			Handler:    _API_DeleteAll_Handler,
-		},
-		{
-			MethodName: "ActivateAuth",
-			Handler:    _API_ActivateAuth_Handler,
-		},
-		{
-			MethodName: "UpdateJobState",
-			Handler:    _API_UpdateJobState_Handler,
-		},
-		{
-			MethodName: "RunLoadTest",
-			Handler:    _API_RunLoadTest_Handler,
-		},
-		{
-			MethodName: "RunLoadTestDefault",
-			Handler:    _API_RunLoadTestDefault_Handler,
-		},
-		{
-			MethodName: "RenderTemplate",
-			Handler:    _API_RenderTemplate_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "InspectJobSet",
-			Handler:       _API_InspectJobSet_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListJob",
-			Handler:       _API_ListJob_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListJobSet",
-			Handler:       _API_ListJobSet_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "SubscribeJob",
-			Handler:       _API_SubscribeJob_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListDatum",
-			Handler:       _API_ListDatum_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListPipeline",
-			Handler:       _API_ListPipeline_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetLogs",
-			Handler:       _API_GetLogs_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListTask",
-			Handler:       _API_ListTask_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pps/pps.proto",
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
 }
 
-func (m *SecretMount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAPIServer) InspectJob(ctx context.Context, req *InspectJobRequest) (*JobInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectJob not implemented")
+}
+func (*UnimplementedAPIServer) InspectJobSet(req *InspectJobSetRequest, srv API_InspectJobSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method InspectJobSet not implemented")
+}
+func (*UnimplementedAPIServer) ListJob(req *ListJobRequest, srv API_ListJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListJob not implemented")
+}
+func (*UnimplementedAPIServer) ListJobSet(req *ListJobSetRequest, srv API_ListJobSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListJobSet not implemented")
+}
+func (*UnimplementedAPIServer) SubscribeJob(req *SubscribeJobRequest, srv API_SubscribeJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeJob not implemented")
+}
+func (*UnimplementedAPIServer) DeleteJob(ctx context.Context, req *DeleteJobRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
+}
+func (*UnimplementedAPIServer) StopJob(ctx context.Context, req *StopJobRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopJob not implemented")
+}
+func (*UnimplementedAPIServer) InspectDatum(ctx context.Context, req *InspectDatumRequest) (*DatumInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectDatum not implemented")
+}
+func (*UnimplementedAPIServer) ListDatum(req *ListDatumRequest, srv API_ListDatumServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDatum not implemented")
+}
+func (*UnimplementedAPIServer) RestartDatum(ctx context.Context, req *RestartDatumRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartDatum not implemented")
+}
+func (*UnimplementedAPIServer) CreatePipeline(ctx context.Context, req *CreatePipelineRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
+}
+func (*UnimplementedAPIServer) InspectPipeline(ctx context.Context, req *InspectPipelineRequest) (*PipelineInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectPipeline not implemented")
+}
+func (*UnimplementedAPIServer) ListPipeline(req *ListPipelineRequest, srv API_ListPipelineServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPipeline not implemented")
+}
+func (*UnimplementedAPIServer) DeletePipeline(ctx context.Context, req *DeletePipelineRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePipeline not implemented")
+}
+func (*UnimplementedAPIServer) StartPipeline(ctx context.Context, req *StartPipelineRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPipeline not implemented")
+}
+func (*UnimplementedAPIServer) StopPipeline(ctx context.Context, req *StopPipelineRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopPipeline not implemented")
+}
+func (*UnimplementedAPIServer) RunPipeline(ctx context.Context, req *RunPipelineRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunPipeline not implemented")
+}
+func (*UnimplementedAPIServer) RunCron(ctx context.Context, req *RunCronRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunCron not implemented")
+}
+func (*UnimplementedAPIServer) CreateSecret(ctx context.Context, req *CreateSecretRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
+}
+func (*UnimplementedAPIServer) DeleteSecret(ctx context.Context, req *DeleteSecretRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (*UnimplementedAPIServer) ListSecret(ctx context.Context, req *types.Empty) (*SecretInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecret not implemented")
+}
+func (*UnimplementedAPIServer) InspectSecret(ctx context.Context, req *InspectSecretRequest) (*SecretInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectSecret not implemented")
+}
+func (*UnimplementedAPIServer) CreateNotifier(ctx context.Context, req *CreateNotifierRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotifier not implemented")
+}
+func (*UnimplementedAPIServer) ListNotifier(ctx context.Context, req *types.Empty) (*NotifierInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifier not implemented")
+}
+func (*UnimplementedAPIServer) DeleteNotifier(ctx context.Context, req *DeleteNotifierRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotifier not implemented")
+}
+func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+}
+func (*UnimplementedAPIServer) GetLogs(req *GetLogsRequest, srv API_GetLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
+func (*UnimplementedAPIServer) TraceFile(req *TraceFileRequest, srv API_TraceFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method TraceFile not implemented")
+}
+func (*UnimplementedAPIServer) ActivateAuth(ctx context.Context, req *ActivateAuthRequest) (*ActivateAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
+}
+func (*UnimplementedAPIServer) UpdateJobState(ctx context.Context, req *UpdateJobStateRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobState not implemented")
+}
+func (*UnimplementedAPIServer) RunLoadTest(ctx context.Context, req *pfs.RunLoadTestRequest) (*pfs.RunLoadTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTest not implemented")
+}
+func (*UnimplementedAPIServer) RunLoadTestDefault(ctx context.Context, req *types.Empty) (*pfs.RunLoadTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTestDefault not implemented")
+}
+func (*UnimplementedAPIServer) RenderTemplate(ctx context.Context, req *RenderTemplateRequest) (*RenderTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderTemplate not implemented")
+}
+func (*UnimplementedAPIServer) ListTask(req *task.ListTaskRequest, srv API_ListTaskServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTask not implemented")
 }
 
-func (m *SecretMount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
 }
 
-func (m *SecretMount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_InspectJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.EnvVar) > 0 {
-		i -= len(m.EnvVar)
-		copy(dAtA[i:], m.EnvVar)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.EnvVar)))
-		i--
-		dAtA[i] = 0x22
+	if interceptor == nil {
+		return srv.(APIServer).InspectJob(ctx, in)
 	}
-	if len(m.MountPath) > 0 {
-		i -= len(m.MountPath)
-		copy(dAtA[i:], m.MountPath)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.MountPath)))
-		i--
-		dAtA[i] = 0x1a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/InspectJob",
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectJob(ctx, req.(*InspectJobRequest))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Transform) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_InspectJobSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InspectJobSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).InspectJobSet(m, &aPIInspectJobSetServer{stream})
 }
 
-func (m *Transform) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_InspectJobSetServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
 }
 
-func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Dockerfile) > 0 {
-		i -= len(m.Dockerfile)
-		copy(dAtA[i:], m.Dockerfile)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Dockerfile)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.WorkingDir) > 0 {
-		i -= len(m.WorkingDir)
-		copy(dAtA[i:], m.WorkingDir)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkingDir)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Debug {
-		i--
-		if m.Debug {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.AcceptReturnCode) > 0 {
-		dAtA2 := make([]byte, len(m.AcceptReturnCode)*10)
-		var j1 int
-		for _, num1 := range m.AcceptReturnCode {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintPps(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ErrStdin) > 0 {
-		for iNdEx := len(m.ErrStdin) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ErrStdin[iNdEx])
-			copy(dAtA[i:], m.ErrStdin[iNdEx])
-			i = encodeVarintPps(dAtA, i, uint64(len(m.ErrStdin[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.Stdin) > 0 {
-		for iNdEx := len(m.Stdin) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Stdin[iNdEx])
-			copy(dAtA[i:], m.Stdin[iNdEx])
-			i = encodeVarintPps(dAtA, i, uint64(len(m.Stdin[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.ImagePullSecrets) > 0 {
-		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ImagePullSecrets[iNdEx])
-			copy(dAtA[i:], m.ImagePullSecrets[iNdEx])
-			i = encodeVarintPps(dAtA, i, uint64(len(m.ImagePullSecrets[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Secrets) > 0 {
-		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Env) > 0 {
-		for k := range m.Env {
-			v := m.Env[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintPps(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPps(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ErrCmd) > 0 {
-		for iNdEx := len(m.ErrCmd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ErrCmd[iNdEx])
-			copy(dAtA[i:], m.ErrCmd[iNdEx])
-			i = encodeVarintPps(dAtA, i, uint64(len(m.ErrCmd[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Cmd) > 0 {
-		for iNdEx := len(m.Cmd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Cmd[iNdEx])
-			copy(dAtA[i:], m.Cmd[iNdEx])
-			i = encodeVarintPps(dAtA, i, uint64(len(m.Cmd[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type aPIInspectJobSetServer struct {
+	grpc.ServerStream
 }
 
-func (m *TFJob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *aPIInspectJobSetServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ListJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListJob(m, &aPIListJobServer{stream})
 }
 
-func (m *TFJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListJobServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
 }
 
-func (m *TFJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TFJob) > 0 {
-		i -= len(m.TFJob)
-		copy(dAtA[i:], m.TFJob)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.TFJob)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type aPIListJobServer struct {
+	grpc.ServerStream
 }
 
-func (m *Egress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *aPIListJobServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ListJobSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListJobSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListJobSet(m, &aPIListJobSetServer{stream})
 }
 
-func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListJobSetServer interface {
+	Send(*JobSetInfo) error
+	grpc.ServerStream
 }
 
-func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIListJobSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListJobSetServer) Send(m *JobSetInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SubscribeJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.Target != nil {
-		{
-			size := m.Target.Size()
-			i -= size
-			if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return srv.(APIServer).SubscribeJob(m, &aPISubscribeJobServer{stream})
 }
 
-func (m *Egress_ObjectStorage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_SubscribeJobServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
 }
 
-func (m *Egress_ObjectStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ObjectStorage != nil {
-		{
-			size, err := m.ObjectStorage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-func (m *Egress_SqlDatabase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type aPISubscribeJobServer struct {
+	grpc.ServerStream
 }
 
-func (m *Egress_SqlDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SqlDatabase != nil {
-		{
-			size, err := m.SqlDatabase.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
+func (x *aPISubscribeJobServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (m *Job) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+
+func _API_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Job) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).DeleteJob(ctx, in)
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/DeleteJob",
 	}
-	if m.Pipeline != nil {
-		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteJob(ctx, req.(*DeleteJobRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_StopJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopJobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).StopJob(ctx, in)
 	}
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintPps(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPps(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/StopJob",
 	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintPps(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPps(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StopJob(ctx, req.(*StopJobRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Service) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_InspectDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectDatumRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Service) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IP) > 0 {
-		i -= len(m.IP)
-		copy(dAtA[i:], m.IP)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.IP)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).InspectDatum(ctx, in)
 	}
-	if m.ExternalPort != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.ExternalPort))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/InspectDatum",
 	}
-	if m.InternalPort != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.InternalPort))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectDatum(ctx, req.(*InspectDatumRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Spout) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ListDatum_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDatumRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListDatum(m, &aPIListDatumServer{stream})
 }
 
-func (m *Spout) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListDatumServer interface {
+	Send(*DatumInfo) error
+	grpc.ServerStream
 }
 
-func (m *Spout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PFSInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIListDatumServer struct {
+	grpc.ServerStream
 }
 
-func (m *PFSInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *aPIListDatumServer) Send(m *DatumInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RepoType) > 0 {
-		i -= len(m.RepoType)
-		copy(dAtA[i:], m.RepoType)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.RepoType)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.Trigger != nil {
-		{
-			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartDatumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.S3 {
-		i--
-		if m.S3 {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+	if interceptor == nil {
+		return srv.(APIServer).RestartDatum(ctx, in)
 	}
-	if m.EmptyFiles {
-		i--
-		if m.EmptyFiles {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/RestartDatum",
 	}
-	if m.Lazy {
-		i--
-		if m.Lazy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RestartDatum(ctx, req.(*RestartDatumRequest))
 	}
-	if len(m.GroupBy) > 0 {
-		i -= len(m.GroupBy)
-		copy(dAtA[i:], m.GroupBy)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.GroupBy)))
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OuterJoin {
-		i--
-		if m.OuterJoin {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(APIServer).CreatePipeline(ctx, in)
 	}
-	if len(m.JoinOn) > 0 {
-		i -= len(m.JoinOn)
-		copy(dAtA[i:], m.JoinOn)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JoinOn)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/CreatePipeline",
 	}
-	if len(m.Glob) > 0 {
-		i -= len(m.Glob)
-		copy(dAtA[i:], m.Glob)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreatePipeline(ctx, req.(*CreatePipelineRequest))
 	}
-	if len(m.Commit) > 0 {
-		i -= len(m.Commit)
-		copy(dAtA[i:], m.Commit)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).InspectPipeline(ctx, in)
 	}
-	if len(m.Repo) > 0 {
-		i -= len(m.Repo)
-		copy(dAtA[i:], m.Repo)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/InspectPipeline",
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectPipeline(ctx, req.(*InspectPipelineRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CronInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ListPipeline_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPipelineRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListPipeline(m, &aPIListPipelineServer{stream})
 }
 
-func (m *CronInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListPipelineServer interface {
+	Send(*PipelineInfo) error
+	grpc.ServerStream
 }
 
-func (m *CronInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIListPipelineServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListPipelineServer) Send(m *PipelineInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Start != nil {
-		{
-			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(APIServer).DeletePipeline(ctx, in)
 	}
-	if m.Overwrite {
-		i--
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/DeletePipeline",
 	}
-	if len(m.Spec) > 0 {
-		i -= len(m.Spec)
-		copy(dAtA[i:], m.Spec)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePipeline(ctx, req.(*DeletePipelineRequest))
 	}
-	if len(m.Commit) > 0 {
-		i -= len(m.Commit)
-		copy(dAtA[i:], m.Commit)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_StartPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Repo) > 0 {
-		i -= len(m.Repo)
-		copy(dAtA[i:], m.Repo)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(APIServer).StartPipeline(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/StartPipeline",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartPipeline(ctx, req.(*StartPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_StopPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPipelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).StopPipeline(ctx, in)
 	}
-	if m.Cron != nil {
-		{
-			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/StopPipeline",
 	}
-	if len(m.Union) > 0 {
-		for iNdEx := len(m.Union) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Union[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StopPipeline(ctx, req.(*StopPipelineRequest))
 	}
-	if len(m.Cross) > 0 {
-		for iNdEx := len(m.Cross) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Cross[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RunPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Group) > 0 {
-		for iNdEx := len(m.Group) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Group[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(APIServer).RunPipeline(ctx, in)
 	}
-	if len(m.Join) > 0 {
-		for iNdEx := len(m.Join) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Join[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/RunPipeline",
 	}
-	if m.Pfs != nil {
-		{
-			size, err := m.Pfs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunPipeline(ctx, req.(*RunPipelineRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RunCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunCronRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JobInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).RunCron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/RunCron",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunCron(ctx, req.(*RunCronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Lazy {
-		i--
-		if m.Lazy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func _API_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Glob) > 0 {
-		i -= len(m.Glob)
-		copy(dAtA[i:], m.Glob)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).CreateSecret(ctx, in)
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/CreateSecret",
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateSecret(ctx, req.(*CreateSecretRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParallelismSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/DeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParallelismSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ParallelismSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_ListSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Constant != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Constant))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(APIServer).ListSecret(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/ListSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListSecret(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InputFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_InspectSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectSecretRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InputFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InputFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).InspectSecret(ctx, in)
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/InspectSecret",
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectSecret(ctx, req.(*InspectSecretRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Datum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_CreateNotifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotifierRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Datum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Datum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).CreateNotifier(ctx, in)
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/CreateNotifier",
 	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateNotifier(ctx, req.(*CreateNotifierRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DatumInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_ListNotifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).ListNotifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/ListNotifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListNotifier(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_DeleteNotifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ImageId) > 0 {
-		i -= len(m.ImageId)
-		copy(dAtA[i:], m.ImageId)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ImageId)))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(APIServer).DeleteNotifier(ctx, in)
 	}
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/DeleteNotifier",
 	}
-	if m.PfsState != nil {
-		{
-			size, err := m.PfsState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteNotifier(ctx, req.(*DeleteNotifierRequest))
 	}
-	if m.Stats != nil {
-		{
-			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.State != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(APIServer).DeleteAll(ctx, in)
 	}
-	if m.Datum != nil {
-		{
-			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/DeleteAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Aggregate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_GetLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GetLogs(m, &aPIGetLogsServer{stream})
 }
 
-func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_GetLogsServer interface {
+	Send(*LogMessage) error
+	grpc.ServerStream
 }
 
-func (m *Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.NinetyFifthPercentile != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NinetyFifthPercentile))))
-		i--
-		dAtA[i] = 0x29
+type aPIGetLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetLogsServer) Send(m *LogMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_TraceFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TraceFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.FifthPercentile != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FifthPercentile))))
-		i--
-		dAtA[i] = 0x21
+	return srv.(APIServer).TraceFile(m, &aPITraceFileServer{stream})
+}
+
+type API_TraceFileServer interface {
+	Send(*FileLineage) error
+	grpc.ServerStream
+}
+
+type aPITraceFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPITraceFileServer) Send(m *FileLineage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Stddev != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Stddev))))
-		i--
-		dAtA[i] = 0x19
+	if interceptor == nil {
+		return srv.(APIServer).ActivateAuth(ctx, in)
 	}
-	if m.Mean != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mean))))
-		i--
-		dAtA[i] = 0x11
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/ActivateAuth",
 	}
-	if m.Count != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProcessStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_UpdateJobState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).UpdateJobState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/UpdateJobState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateJobState(ctx, req.(*UpdateJobStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_RunLoadTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pfs.RunLoadTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RunLoadTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/RunLoadTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunLoadTest(ctx, req.(*pfs.RunLoadTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_RunLoadTestDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UploadBytes != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
-		i--
-		dAtA[i] = 0x28
+	if interceptor == nil {
+		return srv.(APIServer).RunLoadTestDefault(ctx, in)
 	}
-	if m.DownloadBytes != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes))
-		i--
-		dAtA[i] = 0x20
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/RunLoadTestDefault",
 	}
-	if m.UploadTime != nil {
-		{
-			size, err := m.UploadTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunLoadTestDefault(ctx, req.(*types.Empty))
 	}
-	if m.ProcessTime != nil {
-		{
-			size, err := m.ProcessTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RenderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DownloadTime != nil {
-		{
-			size, err := m.DownloadTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(APIServer).RenderTemplate(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/RenderTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RenderTemplate(ctx, req.(*RenderTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AggregateProcessStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ListTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(task.ListTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListTask(m, &aPIListTaskServer{stream})
 }
 
-func (m *AggregateProcessStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListTaskServer interface {
+	Send(*task.TaskInfo) error
+	grpc.ServerStream
 }
 
-func (m *AggregateProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.UploadBytes != nil {
+type aPIListTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListTaskServer) Send(m *task.TaskInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pps_v2.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.UploadBytes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DownloadBytes != nil {
+			MethodName: "InspectJob",
+			Handler:    _API_InspectJob_Handler,
+		},
 		{
-			size, err := m.DownloadBytes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.UploadTime != nil {
+			MethodName: "DeleteJob",
+			Handler:    _API_DeleteJob_Handler,
+		},
 		{
-			size, err := m.UploadTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ProcessTime != nil {
+			MethodName: "StopJob",
+			Handler:    _API_StopJob_Handler,
+		},
 		{
-			size, err := m.ProcessTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DownloadTime != nil {
+			MethodName: "InspectDatum",
+			Handler:    _API_InspectDatum_Handler,
+		},
 		{
-			size, err := m.DownloadTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+			MethodName: "RestartDatum",
+			Handler:    _API_RestartDatum_Handler,
+		},
+		{
+			MethodName: "CreatePipeline",
+			Handler:    _API_CreatePipeline_Handler,
+		},
+		{
+			MethodName: "InspectPipeline",
+			Handler:    _API_InspectPipeline_Handler,
+		},
+		{
+			MethodName: "DeletePipeline",
+			Handler:    _API_DeletePipeline_Handler,
+		},
+		{
+			MethodName: "StartPipeline",
+			Handler:    _API_StartPipeline_Handler,
+		},
+		{
+			MethodName: "StopPipeline",
+			Handler:    _API_StopPipeline_Handler,
+		},
+		{
+			MethodName: "RunPipeline",
+			Handler:    _API_RunPipeline_Handler,
+		},
+		{
+			MethodName: "RunCron",
+			Handler:    _API_RunCron_Handler,
+		},
+		{
+			MethodName: "CreateSecret",
+			Handler:    _API_CreateSecret_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _API_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "ListSecret",
+			Handler:    _API_ListSecret_Handler,
+		},
+		{
+			MethodName: "InspectSecret",
+			Handler:    _API_InspectSecret_Handler,
+		},
+		{
+			MethodName: "CreateNotifier",
+			Handler:    _API_CreateNotifier_Handler,
+		},
+		{
+			MethodName: "ListNotifier",
+			Handler:    _API_ListNotifier_Handler,
+		},
+		{
+			MethodName: "DeleteNotifier",
+			Handler:    _API_DeleteNotifier_Handler,
+		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _API_DeleteAll_Handler,
+		},
+		{
+			MethodName: "ActivateAuth",
+			Handler:    _API_ActivateAuth_Handler,
+		},
+		{
+			MethodName: "UpdateJobState",
+			Handler:    _API_UpdateJobState_Handler,
+		},
+		{
+			MethodName: "RunLoadTest",
+			Handler:    _API_RunLoadTest_Handler,
+		},
+		{
+			MethodName: "RunLoadTestDefault",
+			Handler:    _API_RunLoadTestDefault_Handler,
+		},
+		{
+			MethodName: "RenderTemplate",
+			Handler:    _API_RenderTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InspectJobSet",
+			Handler:       _API_InspectJobSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListJob",
+			Handler:       _API_ListJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListJobSet",
+			Handler:       _API_ListJobSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeJob",
+			Handler:       _API_SubscribeJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDatum",
+			Handler:       _API_ListDatum_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListPipeline",
+			Handler:       _API_ListPipeline_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLogs",
+			Handler:       _API_GetLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TraceFile",
+			Handler:       _API_TraceFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTask",
+			Handler:       _API_ListTask_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pps/pps.proto",
 }
 
-func (m *WorkerStatus) Marshal() (dAtA []byte, err error) {
+func (m *SecretMount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8360,12 +8019,12 @@ func (m *WorkerStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretMount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretMount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8374,36 +8033,38 @@ func (m *WorkerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DatumStatus != nil {
-		{
-			size, err := m.DatumStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.EnvVar) > 0 {
+		i -= len(m.EnvVar)
+		copy(dAtA[i:], m.EnvVar)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.EnvVar)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MountPath) > 0 {
+		i -= len(m.MountPath)
+		copy(dAtA[i:], m.MountPath)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.MountPath)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.JobID) > 0 {
-		i -= len(m.JobID)
-		copy(dAtA[i:], m.JobID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.WorkerID) > 0 {
-		i -= len(m.WorkerID)
-		copy(dAtA[i:], m.WorkerID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DatumStatus) Marshal() (dAtA []byte, err error) {
+func (m *Transform) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8413,12 +8074,12 @@ func (m *DatumStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DatumStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Transform) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DatumStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8427,95 +8088,145 @@ func (m *DatumStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Dockerfile) > 0 {
+		i -= len(m.Dockerfile)
+		copy(dAtA[i:], m.Dockerfile)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Dockerfile)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.Started != nil {
-		{
-			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.WorkingDir) > 0 {
+		i -= len(m.WorkingDir)
+		copy(dAtA[i:], m.WorkingDir)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkingDir)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x62
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ResourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Disk) > 0 {
-		i -= len(m.Disk)
-		copy(dAtA[i:], m.Disk)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Disk)))
+	if m.Debug {
 		i--
-		dAtA[i] = 0x22
+		if m.Debug {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.Gpu != nil {
-		{
-			size, err := m.Gpu.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.AcceptReturnCode) > 0 {
+		dAtA2 := make([]byte, len(m.AcceptReturnCode)*10)
+		var j1 int
+		for _, num1 := range m.AcceptReturnCode {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
 			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+			dAtA2[j1] = uint8(num)
+			j1++
 		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPps(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x4a
 	}
-	if len(m.Memory) > 0 {
-		i -= len(m.Memory)
-		copy(dAtA[i:], m.Memory)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Memory)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.ErrStdin) > 0 {
+		for iNdEx := len(m.ErrStdin) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ErrStdin[iNdEx])
+			copy(dAtA[i:], m.ErrStdin[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.ErrStdin[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.Cpu != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Cpu))))
+	if len(m.Stdin) > 0 {
+		for iNdEx := len(m.Stdin) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Stdin[iNdEx])
+			copy(dAtA[i:], m.Stdin[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Stdin[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ImagePullSecrets[iNdEx])
+			copy(dAtA[i:], m.ImagePullSecrets[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.ImagePullSecrets[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Env) > 0 {
+		for k := range m.Env {
+			v := m.Env[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ErrCmd) > 0 {
+		for iNdEx := len(m.ErrCmd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ErrCmd[iNdEx])
+			copy(dAtA[i:], m.ErrCmd[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.ErrCmd[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Cmd) > 0 {
+		for iNdEx := len(m.Cmd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cmd[iNdEx])
+			copy(dAtA[i:], m.Cmd[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Cmd[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Image)))
 		i--
-		dAtA[i] = 0xd
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GPUSpec) Marshal() (dAtA []byte, err error) {
+func (m *TFJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8525,12 +8236,12 @@ func (m *GPUSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GPUSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *TFJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GPUSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TFJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8539,22 +8250,17 @@ func (m *GPUSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Number != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Number))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
+	if len(m.TFJob) > 0 {
+		i -= len(m.TFJob)
+		copy(dAtA[i:], m.TFJob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.TFJob)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+func (m *Egress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8564,12 +8270,12 @@ func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8578,23 +8284,35 @@ func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Jobs) > 0 {
-		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.Target != nil {
+		{
+			size := m.Target.Size()
+			i -= size
+			if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
 		}
 	}
-	if m.JobSet != nil {
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Egress_ObjectStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Egress_ObjectStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ObjectStorage != nil {
 		{
-			size, err := m.JobSet.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ObjectStorage.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8602,12 +8320,32 @@ func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Egress_SqlDatabase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+func (m *Egress_SqlDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SqlDatabase != nil {
+		{
+			size, err := m.SqlDatabase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Job) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8617,12 +8355,12 @@ func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8631,23 +8369,16 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x12
 	}
-	if m.Finished != nil {
+	if m.Pipeline != nil {
 		{
-			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8655,106 +8386,154 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0xa
 	}
-	if m.Started != nil {
-		{
-			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x62
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.State != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x58
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Stats != nil {
-		{
-			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	if m.DataRecovered != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
-		i--
-		dAtA[i] = 0x48
+	return len(dAtA) - i, nil
+}
+
+func (m *Service) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DataFailed != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
-		i--
-		dAtA[i] = 0x40
+	return dAtA[:n], nil
+}
+
+func (m *Service) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DataTotal != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x22
 	}
-	if m.DataSkipped != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+	if len(m.IP) > 0 {
+		i -= len(m.IP)
+		copy(dAtA[i:], m.IP)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.IP)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1a
 	}
-	if m.DataProcessed != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
+	if m.ExternalPort != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.ExternalPort))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x10
 	}
-	if m.Restart != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
+	if m.InternalPort != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.InternalPort))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x8
 	}
-	if m.OutputCommit != nil {
-		{
-			size, err := m.OutputCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *Spout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PipelineVersion != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.PipelineVersion))
-		i--
-		dAtA[i] = 0x10
+	return dAtA[:n], nil
+}
+
+func (m *Spout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Spout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Job != nil {
+	if m.Service != nil {
 		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8767,7 +8546,7 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobInfo_Details) Marshal() (dAtA []byte, err error) {
+func (m *PFSInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8777,12 +8556,12 @@ func (m *JobInfo_Details) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobInfo_Details) MarshalTo(dAtA []byte) (int, error) {
+func (m *PFSInput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8791,27 +8570,16 @@ func (m *JobInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PodPatch) > 0 {
-		i -= len(m.PodPatch)
-		copy(dAtA[i:], m.PodPatch)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.PodSpec) > 0 {
-		i -= len(m.PodSpec)
-		copy(dAtA[i:], m.PodSpec)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
-		i--
-		dAtA[i] = 0x1
+	if len(m.RepoType) > 0 {
+		i -= len(m.RepoType)
+		copy(dAtA[i:], m.RepoType)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.RepoType)))
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x6a
 	}
-	if m.SchedulingSpec != nil {
+	if m.Trigger != nil {
 		{
-			size, err := m.SchedulingSpec.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8819,184 +8587,101 @@ func (m *JobInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x62
 	}
-	if m.DatumTries != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	if m.S3 {
 		i--
-		dAtA[i] = 0x78
-	}
-	if m.JobTimeout != nil {
-		{
-			size, err := m.JobTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+		if m.S3 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x58
 	}
-	if m.DatumTimeout != nil {
-		{
-			size, err := m.DatumTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if m.EmptyFiles {
 		i--
-		dAtA[i] = 0x6a
-	}
-	if m.DatumSetSpec != nil {
-		{
-			size, err := m.DatumSetSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+		if m.EmptyFiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Salt) > 0 {
-		i -= len(m.Salt)
-		copy(dAtA[i:], m.Salt)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
-		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x50
 	}
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if m.Lazy {
 		i--
-		dAtA[i] = 0x52
-	}
-	if m.SidecarResourceLimits != nil {
-		{
-			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+		if m.Lazy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x48
 	}
-	if m.ResourceLimits != nil {
-		{
-			size, err := m.ResourceLimits.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.GroupBy)))
 		i--
 		dAtA[i] = 0x42
 	}
-	if m.ResourceRequests != nil {
-		{
-			size, err := m.ResourceRequests.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.OuterJoin {
+		i--
+		if m.OuterJoin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x38
 	}
-	if len(m.WorkerStatus) > 0 {
-		for iNdEx := len(m.WorkerStatus) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WorkerStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if len(m.JoinOn) > 0 {
+		i -= len(m.JoinOn)
+		copy(dAtA[i:], m.JoinOn)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JoinOn)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Spout != nil {
-		{
-			size, err := m.Spout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.Glob) > 0 {
+		i -= len(m.Glob)
+		copy(dAtA[i:], m.Glob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Egress != nil {
-		{
-			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ParallelismSpec != nil {
-		{
-			size, err := m.ParallelismSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Transform != nil {
-		{
-			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Worker) Marshal() (dAtA []byte, err error) {
+func (m *CronInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9006,12 +8691,12 @@ func (m *Worker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
+func (m *CronInput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CronInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9020,44 +8705,48 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.State != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x32
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+	if m.Overwrite {
 		i--
-		dAtA[i] = 0xa
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Pipeline) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Pipeline) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Pipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
@@ -9069,7 +8758,7 @@ func (m *Pipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
+func (m *Input) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9079,12 +8768,12 @@ func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9093,9 +8782,9 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Details != nil {
+	if m.Cron != nil {
 		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9103,72 +8792,67 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.AuthToken) > 0 {
-		i -= len(m.AuthToken)
-		copy(dAtA[i:], m.AuthToken)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.AuthToken)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Type != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Parallelism != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Parallelism))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.LastJobState != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.LastJobState))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i--
 		dAtA[i] = 0x32
 	}
-	if m.State != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x28
+	if len(m.Union) > 0 {
+		for iNdEx := len(m.Union) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Union[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Stopped {
-		i--
-		if m.Stopped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Cross) > 0 {
+		for iNdEx := len(m.Cross) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cross[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if m.SpecCommit != nil {
-		{
-			size, err := m.SpecCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Group) > 0 {
+		for iNdEx := len(m.Group) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Group[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.Version != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Join) > 0 {
+		for iNdEx := len(m.Join) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Join[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Pipeline != nil {
+	if m.Pfs != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pfs.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9181,7 +8865,7 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PipelineInfo_Details) Marshal() (dAtA []byte, err error) {
+func (m *JobInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9191,12 +8875,12 @@ func (m *PipelineInfo_Details) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInfo_Details) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobInput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9205,46 +8889,26 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Autoscaling {
+	if m.Lazy {
 		i--
-		if m.Autoscaling {
+		if m.Lazy {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x88
-	}
-	if len(m.WorkerRc) > 0 {
-		i -= len(m.WorkerRc)
-		copy(dAtA[i:], m.WorkerRc)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerRc)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.UnclaimedTasks != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.UnclaimedTasks))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf8
+		dAtA[i] = 0x20
 	}
-	if len(m.ReprocessSpec) > 0 {
-		i -= len(m.ReprocessSpec)
-		copy(dAtA[i:], m.ReprocessSpec)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
-		i--
-		dAtA[i] = 0x1
+	if len(m.Glob) > 0 {
+		i -= len(m.Glob)
+		copy(dAtA[i:], m.Glob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
 		i--
-		dAtA[i] = 0xf2
+		dAtA[i] = 0x1a
 	}
-	if m.Metadata != nil {
+	if m.Commit != nil {
 		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9252,78 +8916,125 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0x12
 	}
-	if m.S3Out {
-		i--
-		if m.S3Out {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0xe0
+		dAtA[i] = 0xa
 	}
-	if len(m.PodPatch) > 0 {
-		i -= len(m.PodPatch)
-		copy(dAtA[i:], m.PodPatch)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+	return len(dAtA) - i, nil
+}
+
+func (m *ParallelismSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PodSpec) > 0 {
-		i -= len(m.PodSpec)
-		copy(dAtA[i:], m.PodSpec)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+	return dAtA[:n], nil
+}
+
+func (m *ParallelismSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParallelismSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SchedulingSpec != nil {
-		{
-			size, err := m.SchedulingSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if m.Constant != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Constant))
 		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0x8
 	}
-	if m.DatumTries != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc0
+	return len(dAtA) - i, nil
+}
+
+func (m *InputFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.JobTimeout != nil {
-		{
-			size, err := m.JobTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+	return dAtA[:n], nil
+}
+
+func (m *InputFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DatumTimeout != nil {
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Datum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Datum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Datum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Job != nil {
 		{
-			size, err := m.DatumTimeout.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9331,13 +9042,59 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatumInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ImageId) > 0 {
+		i -= len(m.ImageId)
+		copy(dAtA[i:], m.ImageId)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ImageId)))
 		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0x32
 	}
-	if m.DatumSetSpec != nil {
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PfsState != nil {
 		{
-			size, err := m.DatumSetSpec.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PfsState.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9345,13 +9102,11 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0x22
 	}
-	if m.Spout != nil {
+	if m.Stats != nil {
 		{
-			size, err := m.Spout.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9359,13 +9114,16 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x1a
+	}
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x10
 	}
-	if m.Service != nil {
+	if m.Datum != nil {
 		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9373,38 +9131,104 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Aggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NinetyFifthPercentile != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NinetyFifthPercentile))))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x29
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+	if m.FifthPercentile != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FifthPercentile))))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x21
+	}
+	if m.Stddev != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Stddev))))
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x19
 	}
-	if len(m.Salt) > 0 {
-		i -= len(m.Salt)
-		copy(dAtA[i:], m.Salt)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+	if m.Mean != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mean))))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x11
+	}
+	if m.Count != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x8
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
+	return len(dAtA) - i, nil
+}
+
+func (m *ProcessStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UploadBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x28
 	}
-	if m.Input != nil {
+	if m.DownloadBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UploadTime != nil {
 		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UploadTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9412,11 +9236,11 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x1a
 	}
-	if m.SidecarResourceLimits != nil {
+	if m.ProcessTime != nil {
 		{
-			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ProcessTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9424,23 +9248,11 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
-	}
-	if m.ResourceLimits != nil {
-		{
-			size, err := m.ResourceLimits.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x12
 	}
-	if m.ResourceRequests != nil {
+	if m.DownloadTime != nil {
 		{
-			size, err := m.ResourceRequests.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DownloadTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9448,35 +9260,38 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.OutputBranch) > 0 {
-		i -= len(m.OutputBranch)
-		copy(dAtA[i:], m.OutputBranch)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.WorkersAvailable != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.WorkersAvailable))
-		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0xa
 	}
-	if m.WorkersRequested != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.WorkersRequested))
-		i--
-		dAtA[i] = 0x38
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateProcessStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RecentError) > 0 {
-		i -= len(m.RecentError)
-		copy(dAtA[i:], m.RecentError)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.RecentError)))
-		i--
-		dAtA[i] = 0x32
+	return dAtA[:n], nil
+}
+
+func (m *AggregateProcessStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreatedAt != nil {
+	if m.UploadBytes != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UploadBytes.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9486,9 +9301,9 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Egress != nil {
+	if m.DownloadBytes != nil {
 		{
-			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DownloadBytes.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9498,9 +9313,9 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.ParallelismSpec != nil {
+	if m.UploadTime != nil {
 		{
-			size, err := m.ParallelismSpec.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UploadTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9510,9 +9325,9 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.TFJob != nil {
+	if m.ProcessTime != nil {
 		{
-			size, err := m.TFJob.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ProcessTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9522,9 +9337,9 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Transform != nil {
+	if m.DownloadTime != nil {
 		{
-			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DownloadTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9537,7 +9352,7 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
+func (m *WorkerStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9547,12 +9362,12 @@ func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PipelineInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9561,24 +9376,36 @@ func (m *PipelineInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PipelineInfo) > 0 {
-		for iNdEx := len(m.PipelineInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PipelineInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.DatumStatus != nil {
+		{
+			size, err := m.DatumStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobID) > 0 {
+		i -= len(m.JobID)
+		copy(dAtA[i:], m.JobID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkerID) > 0 {
+		i -= len(m.WorkerID)
+		copy(dAtA[i:], m.WorkerID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobSet) Marshal() (dAtA []byte, err error) {
+func (m *DatumStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9588,12 +9415,12 @@ func (m *JobSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *DatumStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DatumStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9602,17 +9429,36 @@ func (m *JobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectJobSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9622,12 +9468,12 @@ func (m *InspectJobSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectJobSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectJobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9636,29 +9482,16 @@ func (m *InspectJobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Details {
-		i--
-		if m.Details {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Wait {
-		i--
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Disk) > 0 {
+		i -= len(m.Disk)
+		copy(dAtA[i:], m.Disk)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Disk)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x22
 	}
-	if m.JobSet != nil {
+	if m.Gpu != nil {
 		{
-			size, err := m.JobSet.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Gpu.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9666,12 +9499,25 @@ func (m *InspectJobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.Memory) > 0 {
+		i -= len(m.Memory)
+		copy(dAtA[i:], m.Memory)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Memory)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Cpu != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Cpu))))
+		i--
+		dAtA[i] = 0xd
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListJobSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *GPUSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9681,12 +9527,12 @@ func (m *ListJobSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListJobSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GPUSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListJobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GPUSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9695,20 +9541,22 @@ func (m *ListJobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Details {
+	if m.Number != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Number))
 		i--
-		if m.Details {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x10
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9718,12 +9566,12 @@ func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9732,29 +9580,23 @@ func (m *InspectJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Details {
-		i--
-		if m.Details {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.Wait {
-		i--
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Job != nil {
+	if m.JobSet != nil {
 		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9767,7 +9609,7 @@ func (m *InspectJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9777,12 +9619,12 @@ func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9791,45 +9633,23 @@ func (m *ListJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.JqFilter) > 0 {
-		i -= len(m.JqFilter)
-		copy(dAtA[i:], m.JqFilter)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JqFilter)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Details {
-		i--
-		if m.Details {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Egressed != nil {
+		{
+			size, err := m.Egressed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.History != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.History))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.InputCommit) > 0 {
-		for iNdEx := len(m.InputCommit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InputCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x8a
 	}
-	if m.Pipeline != nil {
+	if m.Details != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9837,48 +9657,13 @@ func (m *ListJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SubscribeJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SubscribeJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubscribeJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Details {
-		i--
-		if m.Details {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x82
 	}
-	if m.Pipeline != nil {
+	if m.Finished != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9886,38 +9671,11 @@ func (m *SubscribeJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DeleteJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x7a
 	}
-	if m.Job != nil {
+	if m.Started != nil {
 		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9925,45 +9683,11 @@ func (m *DeleteJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *StopJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *StopJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x72
 	}
-	if m.Job != nil {
+	if m.Created != nil {
 		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9971,34 +9695,19 @@ func (m *StopJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateJobStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x62
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateJobStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateJobStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x58
 	}
 	if m.Stats != nil {
 		{
@@ -10010,12 +9719,7 @@ func (m *UpdateJobStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
-	}
-	if m.DataTotal != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
-		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x52
 	}
 	if m.DataRecovered != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
@@ -10027,30 +9731,40 @@ func (m *UpdateJobStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x40
 	}
+	if m.DataTotal != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DataSkipped != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x30
 	}
 	if m.DataProcessed != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x28
 	}
 	if m.Restart != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x20
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+	if m.OutputCommit != nil {
+		{
+			size, err := m.OutputCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.State != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+	if m.PipelineVersion != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PipelineVersion))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -10069,7 +9783,7 @@ func (m *UpdateJobStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetLogsRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobInfo_Details) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10079,12 +9793,12 @@ func (m *GetLogsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobInfo_Details) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10093,9 +9807,27 @@ func (m *GetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Since != nil {
+	if len(m.PodPatch) > 0 {
+		i -= len(m.PodPatch)
+		copy(dAtA[i:], m.PodPatch)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.PodSpec) > 0 {
+		i -= len(m.PodSpec)
+		copy(dAtA[i:], m.PodSpec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SchedulingSpec != nil {
 		{
-			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SchedulingSpec.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10103,46 +9835,42 @@ func (m *GetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
-	}
-	if m.UseLokiBackend {
-		i--
-		if m.UseLokiBackend {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x82
 	}
-	if m.Tail != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Tail))
+	if m.DatumTries != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x78
 	}
-	if m.Follow {
-		i--
-		if m.Follow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.JobTimeout != nil {
+		{
+			size, err := m.JobTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x72
 	}
-	if m.Master {
-		i--
-		if m.Master {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.DatumTimeout != nil {
+		{
+			size, err := m.DatumTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x6a
 	}
-	if m.Datum != nil {
+	if m.DatumSetSpec != nil {
 		{
-			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DatumSetSpec.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10150,20 +9878,18 @@ func (m *GetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x62
 	}
-	if len(m.DataFilters) > 0 {
-		for iNdEx := len(m.DataFilters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataFilters[iNdEx])
-			copy(dAtA[i:], m.DataFilters[iNdEx])
-			i = encodeVarintPps(dAtA, i, uint64(len(m.DataFilters[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.Job != nil {
+	if m.Input != nil {
 		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10171,11 +9897,11 @@ func (m *GetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x52
 	}
-	if m.Pipeline != nil {
+	if m.SidecarResourceLimits != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10183,45 +9909,11 @@ func (m *GetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *LogMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LogMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
-		i--
 		dAtA[i] = 0x4a
 	}
-	if m.Ts != nil {
+	if m.ResourceLimits != nil {
 		{
-			size, err := m.Ts.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ResourceLimits.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10231,20 +9923,22 @@ func (m *LogMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	if m.User {
-		i--
-		if m.User {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.ResourceRequests != nil {
+		{
+			size, err := m.ResourceRequests.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x3a
 	}
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerStatus) > 0 {
+		for iNdEx := len(m.WorkerStatus) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10255,48 +9949,70 @@ func (m *LogMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x32
 		}
 	}
-	if m.Master {
-		i--
-		if m.Master {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Spout != nil {
+		{
+			size, err := m.Spout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if len(m.DatumID) > 0 {
-		i -= len(m.DatumID)
-		copy(dAtA[i:], m.DatumID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.WorkerID) > 0 {
-		i -= len(m.WorkerID)
-		copy(dAtA[i:], m.WorkerID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+	if m.Egress != nil {
+		{
+			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.JobID) > 0 {
-		i -= len(m.JobID)
-		copy(dAtA[i:], m.JobID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+	if m.ParallelismSpec != nil {
+		{
+			size, err := m.ParallelismSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.PipelineName) > 0 {
-		i -= len(m.PipelineName)
-		copy(dAtA[i:], m.PipelineName)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PipelineName)))
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestartDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *Worker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10306,12 +10022,12 @@ func (m *RestartDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestartDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10320,31 +10036,22 @@ func (m *RestartDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DataFilters) > 0 {
-		for iNdEx := len(m.DataFilters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataFilters[iNdEx])
-			copy(dAtA[i:], m.DataFilters[iNdEx])
-			i = encodeVarintPps(dAtA, i, uint64(len(m.DataFilters[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *Pipeline) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10354,12 +10061,12 @@ func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Pipeline) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Pipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10368,22 +10075,17 @@ func (m *InspectDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Datum != nil {
-		{
-			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10393,12 +10095,12 @@ func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10407,9 +10109,9 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Input != nil {
+	if m.Details != nil {
 		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10417,11 +10119,55 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x62
 	}
-	if m.Job != nil {
+	if len(m.AuthToken) > 0 {
+		i -= len(m.AuthToken)
+		copy(dAtA[i:], m.AuthToken)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.AuthToken)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Type != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Parallelism != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Parallelism))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastJobState != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.LastJobState))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Stopped {
+		i--
+		if m.Stopped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SpecCommit != nil {
 		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SpecCommit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10429,54 +10175,29 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DatumSetSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DatumSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PerWorker != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.PerWorker))
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+	if m.Version != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Number != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInfo_Details) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10486,12 +10207,12 @@ func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInfo_Details) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10500,58 +10221,17 @@ func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PriorityClassName) > 0 {
-		i -= len(m.PriorityClassName)
-		copy(dAtA[i:], m.PriorityClassName)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClassName)))
+	if m.DatumCache {
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NodeSelector) > 0 {
-		for k := range m.NodeSelector {
-			v := m.NodeSelector[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintPps(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPps(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+		if m.DatumCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
 	}
 	if m.Autoscaling {
 		i--
@@ -10561,9 +10241,25 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.WorkerRc) > 0 {
+		i -= len(m.WorkerRc)
+		copy(dAtA[i:], m.WorkerRc)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerRc)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.UnclaimedTasks != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.UnclaimedTasks))
+		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xf0
+		dAtA[i] = 0xf8
 	}
 	if len(m.ReprocessSpec) > 0 {
 		i -= len(m.ReprocessSpec)
@@ -10572,7 +10268,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0xf2
 	}
 	if m.Metadata != nil {
 		{
@@ -10586,21 +10282,19 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0xea
 	}
-	if m.SpecCommit != nil {
-		{
-			size, err := m.SpecCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.S3Out {
+		i--
+		if m.S3Out {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0xe0
 	}
 	if len(m.PodPatch) > 0 {
 		i -= len(m.PodPatch)
@@ -10609,7 +10303,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0xda
 	}
 	if len(m.PodSpec) > 0 {
 		i -= len(m.PodSpec)
@@ -10618,7 +10312,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0xd2
 	}
 	if m.SchedulingSpec != nil {
 		{
@@ -10632,23 +10326,14 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0xca
 	}
 	if m.DatumTries != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xb8
-	}
-	if len(m.Salt) > 0 {
-		i -= len(m.Salt)
-		copy(dAtA[i:], m.Salt)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0xc0
 	}
 	if m.JobTimeout != nil {
 		{
@@ -10662,7 +10347,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0xba
 	}
 	if m.DatumTimeout != nil {
 		{
@@ -10676,7 +10361,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0xb2
 	}
 	if m.DatumSetSpec != nil {
 		{
@@ -10690,7 +10375,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0xaa
 	}
 	if m.Spout != nil {
 		{
@@ -10704,7 +10389,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0xa2
 	}
 	if m.Service != nil {
 		{
@@ -10718,24 +10403,32 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1
+		i--
 		dAtA[i] = 0x8a
 	}
-	if m.Reprocess {
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
 		i--
-		if m.Reprocess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x82
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x72
 	}
 	if m.Input != nil {
 		{
@@ -10747,7 +10440,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x6a
 	}
 	if m.SidecarResourceLimits != nil {
 		{
@@ -10759,7 +10452,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x62
 	}
 	if m.ResourceLimits != nil {
 		{
@@ -10771,7 +10464,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x5a
 	}
 	if m.ResourceRequests != nil {
 		{
@@ -10783,38 +10476,35 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
-	}
-	if m.S3Out {
-		i--
-		if m.S3Out {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x52
 	}
 	if len(m.OutputBranch) > 0 {
 		i -= len(m.OutputBranch)
 		copy(dAtA[i:], m.OutputBranch)
 		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x4a
 	}
-	if m.Update {
+	if m.WorkersAvailable != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.WorkersAvailable))
 		i--
-		if m.Update {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x40
+	}
+	if m.WorkersRequested != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.WorkersRequested))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x38
 	}
-	if m.Egress != nil {
+	if len(m.RecentError) > 0 {
+		i -= len(m.RecentError)
+		copy(dAtA[i:], m.RecentError)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.RecentError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreatedAt != nil {
 		{
-			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10824,9 +10514,9 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.ParallelismSpec != nil {
+	if m.Egress != nil {
 		{
-			size, err := m.ParallelismSpec.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10836,9 +10526,9 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Transform != nil {
+	if m.ParallelismSpec != nil {
 		{
-			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParallelismSpec.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10860,9 +10550,9 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Pipeline != nil {
+	if m.Transform != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10875,7 +10565,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10885,12 +10575,12 @@ func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PipelineInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10899,32 +10589,24 @@ func (m *InspectPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Details {
-		i--
-		if m.Details {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pipeline != nil {
-		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.PipelineInfo) > 0 {
+		for iNdEx := len(m.PipelineInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PipelineInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10934,12 +10616,12 @@ func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10948,44 +10630,17 @@ func (m *ListPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.JqFilter) > 0 {
-		i -= len(m.JqFilter)
-		copy(dAtA[i:], m.JqFilter)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JqFilter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Details {
-		i--
-		if m.Details {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.History != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.History))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pipeline != nil {
-		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectJobSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10995,12 +10650,12 @@ func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectJobSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectJobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11009,19 +10664,9 @@ func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.KeepRepo {
-		i--
-		if m.KeepRepo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Force {
+	if m.Details {
 		i--
-		if m.Force {
+		if m.Details {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -11029,9 +10674,9 @@ func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.All {
+	if m.Wait {
 		i--
-		if m.All {
+		if m.Wait {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -11039,9 +10684,9 @@ func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Pipeline != nil {
+	if m.JobSet != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11054,7 +10699,7 @@ func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListJobSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11064,12 +10709,12 @@ func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListJobSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StartPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListJobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11078,22 +10723,20 @@ func (m *StartPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Pipeline != nil {
-		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11103,12 +10746,12 @@ func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StopPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11117,9 +10760,29 @@ func (m *StopPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Pipeline != nil {
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Wait {
+		i--
+		if m.Wait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Job != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11132,7 +10795,7 @@ func (m *StopPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RunPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11142,12 +10805,12 @@ func (m *RunPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RunPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11156,17 +10819,32 @@ func (m *RunPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.JobID) > 0 {
-		i -= len(m.JobID)
-		copy(dAtA[i:], m.JobID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.JqFilter) > 0 {
+		i -= len(m.JqFilter)
+		copy(dAtA[i:], m.JqFilter)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JqFilter)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Provenance) > 0 {
-		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.History != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.History))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.InputCommit) > 0 {
+		for iNdEx := len(m.InputCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InputCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -11192,7 +10870,7 @@ func (m *RunPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RunCronRequest) Marshal() (dAtA []byte, err error) {
+func (m *SubscribeJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11202,12 +10880,12 @@ func (m *RunCronRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RunCronRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscribeJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RunCronRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubscribeJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11216,6 +10894,16 @@ func (m *RunCronRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pipeline != nil {
 		{
 			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
@@ -11231,7 +10919,7 @@ func (m *RunCronRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateSecretRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11241,12 +10929,12 @@ func (m *CreateSecretRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11255,17 +10943,22 @@ func (m *CreateSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.File) > 0 {
-		i -= len(m.File)
-		copy(dAtA[i:], m.File)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.File)))
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteSecretRequest) Marshal() (dAtA []byte, err error) {
+func (m *StopJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11275,12 +10968,12 @@ func (m *DeleteSecretRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StopJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11289,9 +10982,16 @@ func (m *DeleteSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Secret != nil {
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Job != nil {
 		{
-			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11304,7 +11004,7 @@ func (m *DeleteSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectSecretRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateJobStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11314,12 +11014,12 @@ func (m *InspectSecretRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateJobStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateJobStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11328,9 +11028,9 @@ func (m *InspectSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Secret != nil {
+	if m.Egressed != nil {
 		{
-			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Egressed.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11338,46 +11038,78 @@ func (m *InspectSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x62
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Secret) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.DataTotal != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+	if m.DataRecovered != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DataFailed != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DataSkipped != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DataProcessed != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Restart != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.State != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SecretInfo) Marshal() (dAtA []byte, err error) {
+func (m *GetLogsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11387,12 +11119,12 @@ func (m *SecretInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SecretInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SecretInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11401,9 +11133,9 @@ func (m *SecretInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreationTimestamp != nil {
+	if m.To != nil {
 		{
-			size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11411,18 +11143,11 @@ func (m *SecretInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x62
 	}
-	if m.Secret != nil {
+	if m.From != nil {
 		{
-			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11430,53 +11155,114 @@ func (m *SecretInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SecretInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x5a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SecretInfos) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SecretInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.UsePfsBackend {
+		i--
+		if m.UsePfsBackend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.SecretInfo) > 0 {
-		for iNdEx := len(m.SecretInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SecretInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.Since != nil {
+		{
+			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
+	if m.UseLokiBackend {
+		i--
+		if m.UseLokiBackend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Tail != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Tail))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Follow {
+		i--
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Master {
+		i--
+		if m.Master {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Datum != nil {
+		{
+			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataFilters) > 0 {
+		for iNdEx := len(m.DataFilters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataFilters[iNdEx])
+			copy(dAtA[i:], m.DataFilters[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.DataFilters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11486,12 +11272,12 @@ func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LogMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11500,10 +11286,91 @@ func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Ts != nil {
+		{
+			size, err := m.Ts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.User {
+		i--
+		if m.User {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Master {
+		i--
+		if m.Master {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DatumID) > 0 {
+		i -= len(m.DatumID)
+		copy(dAtA[i:], m.DatumID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WorkerID) > 0 {
+		i -= len(m.WorkerID)
+		copy(dAtA[i:], m.WorkerID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobID) > 0 {
+		i -= len(m.JobID)
+		copy(dAtA[i:], m.JobID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PipelineName) > 0 {
+		i -= len(m.PipelineName)
+		copy(dAtA[i:], m.PipelineName)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PipelineName)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
+func (m *RestartDatumRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11513,12 +11380,12 @@ func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivateAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestartDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11527,10 +11394,31 @@ func (m *ActivateAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DataFilters) > 0 {
+		for iNdEx := len(m.DataFilters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataFilters[iNdEx])
+			copy(dAtA[i:], m.DataFilters[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.DataFilters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RenderTemplateRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11540,12 +11428,12 @@ func (m *RenderTemplateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RenderTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RenderTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11554,36 +11442,22 @@ func (m *RenderTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Args) > 0 {
-		for k := range m.Args {
-			v := m.Args[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintPps(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPps(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	if m.Datum != nil {
+		{
+			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Template) > 0 {
-		i -= len(m.Template)
-		copy(dAtA[i:], m.Template)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Template)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RenderTemplateResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11593,12 +11467,12 @@ func (m *RenderTemplateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RenderTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RenderTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11607,1122 +11481,1881 @@ func (m *RenderTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Specs) > 0 {
-		for iNdEx := len(m.Specs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Specs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
-		}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Json) > 0 {
-		i -= len(m.Json)
-		copy(dAtA[i:], m.Json)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Json)))
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPps(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SecretMount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DatumSetSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.MountPath)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.PerWorker != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PerWorker))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.EnvVar)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.SizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Number != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Transform) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if len(m.PriorityClassName) > 0 {
+		i -= len(m.PriorityClassName)
+		copy(dAtA[i:], m.PriorityClassName)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClassName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ErrCmd) > 0 {
-		for _, s := range m.ErrCmd {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Secrets) > 0 {
-		for _, e := range m.Secrets {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, s := range m.ImagePullSecrets {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+	if m.DatumCache {
+		i--
+		if m.DatumCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	if len(m.Stdin) > 0 {
-		for _, s := range m.Stdin {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+	if m.Autoscaling {
+		i--
+		if m.Autoscaling {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	if len(m.ErrStdin) > 0 {
-		for _, s := range m.ErrStdin {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if len(m.ReprocessSpec) > 0 {
+		i -= len(m.ReprocessSpec)
+		copy(dAtA[i:], m.ReprocessSpec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	if len(m.AcceptReturnCode) > 0 {
-		l = 0
-		for _, e := range m.AcceptReturnCode {
-			l += sovPps(uint64(e))
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
-		n += 1 + sovPps(uint64(l)) + l
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	if m.Debug {
-		n += 2
+	if m.SpecCommit != nil {
+		{
+			size, err := m.SpecCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.PodPatch) > 0 {
+		i -= len(m.PodPatch)
+		copy(dAtA[i:], m.PodPatch)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	l = len(m.WorkingDir)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.PodSpec) > 0 {
+		i -= len(m.PodSpec)
+		copy(dAtA[i:], m.PodSpec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	l = len(m.Dockerfile)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.SchedulingSpec != nil {
+		{
+			size, err := m.SchedulingSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DatumTries != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	return n
-}
-
-func (m *TFJob) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	var l int
-	_ = l
-	l = len(m.TFJob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.JobTimeout != nil {
+		{
+			size, err := m.JobTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DatumTimeout != nil {
+		{
+			size, err := m.DatumTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return n
-}
-
-func (m *Egress) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DatumSetSpec != nil {
+		{
+			size, err := m.DatumSetSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Spout != nil {
+		{
+			size, err := m.Spout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Target != nil {
-		n += m.Target.Size()
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Reprocess {
+		i--
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
 	}
-	return n
-}
-
-func (m *Egress_ObjectStorage) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	var l int
-	_ = l
-	if m.ObjectStorage != nil {
-		l = m.ObjectStorage.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
-}
-func (m *Egress_SqlDatabase) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SidecarResourceLimits != nil {
+		{
+			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	if m.SqlDatabase != nil {
-		l = m.SqlDatabase.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.ResourceLimits != nil {
+		{
+			size, err := m.ResourceLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
-}
-func (m *Job) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ResourceRequests != nil {
+		{
+			size, err := m.ResourceRequests.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.S3Out {
+		i--
+		if m.S3Out {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.OutputBranch) > 0 {
+		i -= len(m.OutputBranch)
+		copy(dAtA[i:], m.OutputBranch)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Update {
+		i--
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Egress != nil {
+		{
+			size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+	if m.ParallelismSpec != nil {
+		{
+			size, err := m.ParallelismSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TFJob != nil {
+		{
+			size, err := m.TFJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Service) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.InternalPort != 0 {
-		n += 1 + sovPps(uint64(m.InternalPort))
-	}
-	if m.ExternalPort != 0 {
-		n += 1 + sovPps(uint64(m.ExternalPort))
-	}
-	l = len(m.IP)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Spout) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.JqFilter) > 0 {
+		i -= len(m.JqFilter)
+		copy(dAtA[i:], m.JqFilter)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JqFilter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.History != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.History))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PFSInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.KeepRepo {
+		i--
+		if m.KeepRepo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.JoinOn)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.OuterJoin {
-		n += 2
-	}
-	l = len(m.GroupBy)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Lazy {
-		n += 2
-	}
-	if m.EmptyFiles {
-		n += 2
-	}
-	if m.S3 {
-		n += 2
-	}
-	if m.Trigger != nil {
-		l = m.Trigger.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.RepoType)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CronInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Overwrite {
-		n += 2
-	}
-	if m.Start != nil {
-		l = m.Start.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pfs != nil {
-		l = m.Pfs.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.Join) > 0 {
-		for _, e := range m.Join {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if len(m.Group) > 0 {
-		for _, e := range m.Group {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if len(m.Cross) > 0 {
-		for _, e := range m.Cross {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Union) > 0 {
-		for _, e := range m.Union {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Cron != nil {
-		l = m.Cron.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Lazy {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParallelismSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Constant != 0 {
-		n += 1 + sovPps(uint64(m.Constant))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InputFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StopPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Datum) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RunPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.JobID) > 0 {
+		i -= len(m.JobID)
+		copy(dAtA[i:], m.JobID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Provenance) > 0 {
+		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DatumInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RunCronRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RunCronRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunCronRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Datum != nil {
-		l = m.Datum.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.PfsState != nil {
-		l = m.PfsState.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ImageId)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Aggregate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateSecretRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		n += 1 + sovPps(uint64(m.Count))
-	}
-	if m.Mean != 0 {
-		n += 9
-	}
-	if m.Stddev != 0 {
-		n += 9
-	}
-	if m.FifthPercentile != 0 {
-		n += 9
-	}
-	if m.NinetyFifthPercentile != 0 {
-		n += 9
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.File)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProcessStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteSecretRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DownloadTime != nil {
-		l = m.DownloadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ProcessTime != nil {
-		l = m.ProcessTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.UploadTime != nil {
-		l = m.UploadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.DownloadBytes != 0 {
-		n += 1 + sovPps(uint64(m.DownloadBytes))
-	}
-	if m.UploadBytes != 0 {
-		n += 1 + sovPps(uint64(m.UploadBytes))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregateProcessStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectSecretRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectSecretRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectSecretRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DownloadTime != nil {
-		l = m.DownloadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ProcessTime != nil {
-		l = m.ProcessTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.UploadTime != nil {
-		l = m.UploadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.DownloadBytes != nil {
-		l = m.DownloadBytes.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.UploadBytes != nil {
-		l = m.UploadBytes.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WorkerStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Secret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WorkerID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.JobID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.DatumStatus != nil {
-		l = m.DatumStatus.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DatumStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SecretInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.CreationTimestamp != nil {
+		{
+			size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SecretInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretInfos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cpu != 0 {
-		n += 5
-	}
-	l = len(m.Memory)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Gpu != nil {
-		l = m.Gpu.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Disk)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.SecretInfo) > 0 {
+		for iNdEx := len(m.SecretInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SecretInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GPUSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Notifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Notifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Number != 0 {
-		n += 1 + sovPps(uint64(m.Number))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NotifierInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NotifierInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotifierInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JobSet != nil {
-		l = m.JobSet.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Jobs) > 0 {
-		for _, e := range m.Jobs {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CommitFinished {
+		i--
+		if m.CommitFinished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *JobInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PipelineStates) > 0 {
+		dAtA118 := make([]byte, len(m.PipelineStates)*10)
+		var j117 int
+		for _, num := range m.PipelineStates {
+			for num >= 1<<7 {
+				dAtA118[j117] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j117++
+			}
+			dAtA118[j117] = uint8(num)
+			j117++
+		}
+		i -= j117
+		copy(dAtA[i:], dAtA118[:j117])
+		i = encodeVarintPps(dAtA, i, uint64(j117))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.PipelineVersion != 0 {
-		n += 1 + sovPps(uint64(m.PipelineVersion))
-	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Restart != 0 {
-		n += 1 + sovPps(uint64(m.Restart))
-	}
-	if m.DataProcessed != 0 {
-		n += 1 + sovPps(uint64(m.DataProcessed))
-	}
-	if m.DataSkipped != 0 {
-		n += 1 + sovPps(uint64(m.DataSkipped))
-	}
-	if m.DataTotal != 0 {
-		n += 1 + sovPps(uint64(m.DataTotal))
-	}
-	if m.DataFailed != 0 {
-		n += 1 + sovPps(uint64(m.DataFailed))
-	}
-	if m.DataRecovered != 0 {
-		n += 1 + sovPps(uint64(m.DataRecovered))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.JobStates) > 0 {
+		dAtA120 := make([]byte, len(m.JobStates)*10)
+		var j119 int
+		for _, num := range m.JobStates {
+			for num >= 1<<7 {
+				dAtA120[j119] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j119++
+			}
+			dAtA120[j119] = uint8(num)
+			j119++
+		}
+		i -= j119
+		copy(dAtA[i:], dAtA120[:j119])
+		i = encodeVarintPps(dAtA, i, uint64(j119))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Repos[iNdEx])
+			copy(dAtA[i:], m.Repos[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Repos[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Pipelines) > 0 {
+		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pipelines[iNdEx])
+			copy(dAtA[i:], m.Pipelines[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Pipelines[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Notifier != nil {
+		{
+			size, err := m.Notifier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobInfo_Details) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NotifierInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NotifierInfos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotifierInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Spout != nil {
-		l = m.Spout.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.WorkerStatus) > 0 {
-		for _, e := range m.WorkerStatus {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if len(m.NotifierInfo) > 0 {
+		for iNdEx := len(m.NotifierInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NotifierInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.ResourceRequests != nil {
-		l = m.ResourceRequests.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ResourceLimits != nil {
-		l = m.ResourceLimits.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.SidecarResourceLimits != nil {
-		l = m.SidecarResourceLimits.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateNotifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *CreateNotifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateNotifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Update {
+		i--
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.DatumSetSpec != nil {
-		l = m.DatumSetSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.CommitFinished {
+		i--
+		if m.CommitFinished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.DatumTimeout != nil {
-		l = m.DatumTimeout.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.PipelineStates) > 0 {
+		dAtA123 := make([]byte, len(m.PipelineStates)*10)
+		var j122 int
+		for _, num := range m.PipelineStates {
+			for num >= 1<<7 {
+				dAtA123[j122] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j122++
+			}
+			dAtA123[j122] = uint8(num)
+			j122++
+		}
+		i -= j122
+		copy(dAtA[i:], dAtA123[:j122])
+		i = encodeVarintPps(dAtA, i, uint64(j122))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.JobTimeout != nil {
-		l = m.JobTimeout.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.JobStates) > 0 {
+		dAtA125 := make([]byte, len(m.JobStates)*10)
+		var j124 int
+		for _, num := range m.JobStates {
+			for num >= 1<<7 {
+				dAtA125[j124] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j124++
+			}
+			dAtA125[j124] = uint8(num)
+			j124++
+		}
+		i -= j124
+		copy(dAtA[i:], dAtA125[:j124])
+		i = encodeVarintPps(dAtA, i, uint64(j124))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.DatumTries != 0 {
-		n += 1 + sovPps(uint64(m.DatumTries))
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Repos[iNdEx])
+			copy(dAtA[i:], m.Repos[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Repos[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.SchedulingSpec != nil {
-		l = m.SchedulingSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Pipelines) > 0 {
+		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pipelines[iNdEx])
+			copy(dAtA[i:], m.Pipelines[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Pipelines[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.PodSpec)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PodPatch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Notifier != nil {
+		{
+			size, err := m.Notifier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Worker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+func (m *DeleteNotifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Pipeline) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleteNotifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteNotifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Notifier != nil {
+		{
+			size, err := m.Notifier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PipelineInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Notification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Notification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Version != 0 {
-		n += 1 + sovPps(uint64(m.Version))
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.SpecCommit != nil {
-		l = m.SpecCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Stopped {
-		n += 2
+	if m.PipelineState != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PipelineState))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.JobState != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.JobState))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.LastJobState != 0 {
-		n += 1 + sovPps(uint64(m.LastJobState))
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Parallelism != 0 {
-		n += 1 + sovPps(uint64(m.Parallelism))
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Type != 0 {
-		n += 1 + sovPps(uint64(m.Type))
-	}
-	l = len(m.AuthToken)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovPps(uint64(l))
+		i = encodeVarintPps(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Notifier != nil {
+		{
+			size, err := m.Notifier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PipelineInfo_Details) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TraceFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TFJob != nil {
-		l = m.TFJob.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Depth != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Direction != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *FileLineage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.RecentError)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *FileLineage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileLineage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkersRequested != 0 {
-		n += 1 + sovPps(uint64(m.WorkersRequested))
+	if m.Depth != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.WorkersAvailable != 0 {
-		n += 1 + sovPps(uint64(m.WorkersAvailable))
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.ResourceRequests != nil {
-		l = m.ResourceRequests.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ResourceLimits != nil {
-		l = m.ResourceLimits.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.SidecarResourceLimits != nil {
-		l = m.SidecarResourceLimits.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.DatumID) > 0 {
+		i -= len(m.DatumID)
+		copy(dAtA[i:], m.DatumID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ActivateAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ActivateAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Spout != nil {
-		l = m.Spout.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *RenderTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DatumSetSpec != nil {
-		l = m.DatumSetSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RenderTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenderTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DatumTimeout != nil {
-		l = m.DatumTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Args) > 0 {
+		for k := range m.Args {
+			v := m.Args[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.JobTimeout != nil {
-		l = m.JobTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.DatumTries != 0 {
-		n += 2 + sovPps(uint64(m.DatumTries))
+	return len(dAtA) - i, nil
+}
+
+func (m *RenderTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.SchedulingSpec != nil {
-		l = m.SchedulingSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RenderTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenderTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.PodSpec)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Specs) > 0 {
+		for iNdEx := len(m.Specs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Specs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.PodPatch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.S3Out {
-		n += 3
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPps(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 2 + l + sovPps(uint64(l))
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SecretMount) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.ReprocessSpec)
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.UnclaimedTasks != 0 {
-		n += 2 + sovPps(uint64(m.UnclaimedTasks))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.WorkerRc)
+	l = len(m.MountPath)
 	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Autoscaling {
-		n += 3
+	l = len(m.EnvVar)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12730,17 +13363,81 @@ func (m *PipelineInfo_Details) Size() (n int) {
 	return n
 }
 
-func (m *PipelineInfos) Size() (n int) {
+func (m *Transform) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PipelineInfo) > 0 {
-		for _, e := range m.PipelineInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.ErrCmd) > 0 {
+		for _, s := range m.ErrCmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for _, s := range m.ImagePullSecrets {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Stdin) > 0 {
+		for _, s := range m.Stdin {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.ErrStdin) > 0 {
+		for _, s := range m.ErrStdin {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.AcceptReturnCode) > 0 {
+		l = 0
+		for _, e := range m.AcceptReturnCode {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.Debug {
+		n += 2
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.WorkingDir)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Dockerfile)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12748,13 +13445,13 @@ func (m *PipelineInfos) Size() (n int) {
 	return n
 }
 
-func (m *JobSet) Size() (n int) {
+func (m *TFJob) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
+	l = len(m.TFJob)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
@@ -12764,21 +13461,18 @@ func (m *JobSet) Size() (n int) {
 	return n
 }
 
-func (m *InspectJobSetRequest) Size() (n int) {
+func (m *Egress) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.JobSet != nil {
-		l = m.JobSet.Size()
+	l = len(m.URL)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Wait {
-		n += 2
-	}
-	if m.Details {
-		n += 2
+	if m.Target != nil {
+		n += m.Target.Size()
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12786,44 +13480,31 @@ func (m *InspectJobSetRequest) Size() (n int) {
 	return n
 }
 
-func (m *ListJobSetRequest) Size() (n int) {
+func (m *Egress_ObjectStorage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Details {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ObjectStorage != nil {
+		l = m.ObjectStorage.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
-
-func (m *InspectJobRequest) Size() (n int) {
+func (m *Egress_SqlDatabase) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	if m.SqlDatabase != nil {
+		l = m.SqlDatabase.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Wait {
-		n += 2
-	}
-	if m.Details {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
 	return n
 }
-
-func (m *ListJobRequest) Size() (n int) {
+func (m *Job) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12833,19 +13514,7 @@ func (m *ListJobRequest) Size() (n int) {
 		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.InputCommit) > 0 {
-		for _, e := range m.InputCommit {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if m.History != 0 {
-		n += 1 + sovPps(uint64(m.History))
-	}
-	if m.Details {
-		n += 2
-	}
-	l = len(m.JqFilter)
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
@@ -12855,18 +13524,27 @@ func (m *ListJobRequest) Size() (n int) {
 	return n
 }
 
-func (m *SubscribeJobRequest) Size() (n int) {
+func (m *Metadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
 	}
-	if m.Details {
-		n += 2
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12874,14 +13552,24 @@ func (m *SubscribeJobRequest) Size() (n int) {
 	return n
 }
 
-func (m *DeleteJobRequest) Size() (n int) {
+func (m *Service) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	if m.InternalPort != 0 {
+		n += 1 + sovPps(uint64(m.InternalPort))
+	}
+	if m.ExternalPort != 0 {
+		n += 1 + sovPps(uint64(m.ExternalPort))
+	}
+	l = len(m.IP)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -12890,18 +13578,14 @@ func (m *DeleteJobRequest) Size() (n int) {
 	return n
 }
 
-func (m *StopJobRequest) Size() (n int) {
+func (m *Spout) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
+	if m.Service != nil {
+		l = m.Service.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -12910,43 +13594,58 @@ func (m *StopJobRequest) Size() (n int) {
 	return n
 }
 
-func (m *UpdateJobStateRequest) Size() (n int) {
+func (m *PFSInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.Reason)
+	l = len(m.Branch)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Restart != 0 {
-		n += 1 + sovPps(uint64(m.Restart))
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.DataProcessed != 0 {
-		n += 1 + sovPps(uint64(m.DataProcessed))
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.DataSkipped != 0 {
-		n += 1 + sovPps(uint64(m.DataSkipped))
+	l = len(m.JoinOn)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.DataFailed != 0 {
-		n += 1 + sovPps(uint64(m.DataFailed))
+	if m.OuterJoin {
+		n += 2
 	}
-	if m.DataRecovered != 0 {
-		n += 1 + sovPps(uint64(m.DataRecovered))
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.DataTotal != 0 {
-		n += 1 + sovPps(uint64(m.DataTotal))
+	if m.Lazy {
+		n += 2
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
+	if m.EmptyFiles {
+		n += 2
+	}
+	if m.S3 {
+		n += 2
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.RepoType)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -12955,44 +13654,33 @@ func (m *UpdateJobStateRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetLogsRequest) Size() (n int) {
+func (m *CronInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.Repo)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Datum != nil {
-		l = m.Datum.Size()
+	l = len(m.Spec)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Master {
+	if m.Overwrite {
 		n += 2
 	}
-	if m.Follow {
-		n += 2
-	}
-	if m.Tail != 0 {
-		n += 1 + sovPps(uint64(m.Tail))
-	}
-	if m.UseLokiBackend {
-		n += 2
-	}
-	if m.Since != nil {
-		l = m.Since.Size()
+	if m.Start != nil {
+		l = m.Start.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -13001,69 +13689,85 @@ func (m *GetLogsRequest) Size() (n int) {
 	return n
 }
 
-func (m *LogMessage) Size() (n int) {
+func (m *Input) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PipelineName)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.JobID)
-	if l > 0 {
+	if m.Pfs != nil {
+		l = m.Pfs.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.WorkerID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Join) > 0 {
+		for _, e := range m.Join {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	l = len(m.DatumID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Group) > 0 {
+		for _, e := range m.Group {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	if m.Master {
-		n += 2
+	if len(m.Cross) > 0 {
+		for _, e := range m.Cross {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
+	if len(m.Union) > 0 {
+		for _, e := range m.Union {
 			l = e.Size()
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	if m.User {
-		n += 2
+	if m.Cron != nil {
+		l = m.Cron.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Ts != nil {
-		l = m.Ts.Size()
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.Message)
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Lazy {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RestartDatumRequest) Size() (n int) {
+func (m *ParallelismSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.Constant != 0 {
+		n += 1 + sovPps(uint64(m.Constant))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13071,14 +13775,18 @@ func (m *RestartDatumRequest) Size() (n int) {
 	return n
 }
 
-func (m *InspectDatumRequest) Size() (n int) {
+func (m *InputFile) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Datum != nil {
-		l = m.Datum.Size()
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -13087,7 +13795,7 @@ func (m *InspectDatumRequest) Size() (n int) {
 	return n
 }
 
-func (m *ListDatumRequest) Size() (n int) {
+func (m *Datum) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -13097,8 +13805,8 @@ func (m *ListDatumRequest) Size() (n int) {
 		l = m.Job.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -13107,20 +13815,36 @@ func (m *ListDatumRequest) Size() (n int) {
 	return n
 }
 
-func (m *DatumSetSpec) Size() (n int) {
+func (m *DatumInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Number != 0 {
-		n += 1 + sovPps(uint64(m.Number))
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPps(uint64(m.SizeBytes))
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
 	}
-	if m.PerWorker != 0 {
-		n += 1 + sovPps(uint64(m.PerWorker))
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PfsState != nil {
+		l = m.PfsState.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.ImageId)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13128,23 +13852,26 @@ func (m *DatumSetSpec) Size() (n int) {
 	return n
 }
 
-func (m *SchedulingSpec) Size() (n int) {
+func (m *Aggregate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
-		}
+	if m.Count != 0 {
+		n += 1 + sovPps(uint64(m.Count))
 	}
-	l = len(m.PriorityClassName)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Mean != 0 {
+		n += 9
+	}
+	if m.Stddev != 0 {
+		n += 9
+	}
+	if m.FifthPercentile != 0 {
+		n += 9
+	}
+	if m.NinetyFifthPercentile != 0 {
+		n += 9
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13152,137 +13879,107 @@ func (m *SchedulingSpec) Size() (n int) {
 	return n
 }
 
-func (m *CreatePipelineRequest) Size() (n int) {
+func (m *ProcessStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.DownloadTime != nil {
+		l = m.DownloadTime.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.TFJob != nil {
-		l = m.TFJob.Size()
+	if m.ProcessTime != nil {
+		l = m.ProcessTime.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
+	if m.UploadTime != nil {
+		l = m.UploadTime.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.DownloadBytes != 0 {
+		n += 1 + sovPps(uint64(m.DownloadBytes))
 	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.UploadBytes != 0 {
+		n += 1 + sovPps(uint64(m.UploadBytes))
 	}
-	if m.Update {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return n
+}
+
+func (m *AggregateProcessStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.S3Out {
-		n += 2
+	var l int
+	_ = l
+	if m.DownloadTime != nil {
+		l = m.DownloadTime.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ResourceRequests != nil {
-		l = m.ResourceRequests.Size()
+	if m.ProcessTime != nil {
+		l = m.ProcessTime.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ResourceLimits != nil {
-		l = m.ResourceLimits.Size()
+	if m.UploadTime != nil {
+		l = m.UploadTime.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.SidecarResourceLimits != nil {
-		l = m.SidecarResourceLimits.Size()
+	if m.DownloadBytes != nil {
+		l = m.DownloadBytes.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
+	if m.UploadBytes != nil {
+		l = m.UploadBytes.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.Description)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkerID)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Reprocess {
-		n += 2
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if m.DatumStatus != nil {
+		l = m.DatumStatus.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Spout != nil {
-		l = m.Spout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DatumSetSpec != nil {
-		l = m.DatumSetSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DatumTimeout != nil {
-		l = m.DatumTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.JobTimeout != nil {
-		l = m.JobTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DatumTries != 0 {
-		n += 2 + sovPps(uint64(m.DatumTries))
-	}
-	if m.SchedulingSpec != nil {
-		l = m.SchedulingSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.PodSpec)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.PodPatch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.SpecCommit != nil {
-		l = m.SpecCommit.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.ReprocessSpec)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Autoscaling {
-		n += 3
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *InspectPipelineRequest) Size() (n int) {
+func (m *DatumStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.Started != nil {
+		l = m.Started.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Details {
-		n += 2
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13290,23 +13987,24 @@ func (m *InspectPipelineRequest) Size() (n int) {
 	return n
 }
 
-func (m *ListPipelineRequest) Size() (n int) {
+func (m *ResourceSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Cpu != 0 {
+		n += 5
 	}
-	if m.History != 0 {
-		n += 1 + sovPps(uint64(m.History))
+	l = len(m.Memory)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Details {
-		n += 2
+	if m.Gpu != nil {
+		l = m.Gpu.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.JqFilter)
+	l = len(m.Disk)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
@@ -13316,24 +14014,18 @@ func (m *ListPipelineRequest) Size() (n int) {
 	return n
 }
 
-func (m *DeletePipelineRequest) Size() (n int) {
+func (m *GPUSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Type)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.All {
-		n += 2
-	}
-	if m.Force {
-		n += 2
-	}
-	if m.KeepRepo {
-		n += 2
+	if m.Number != 0 {
+		n += 1 + sovPps(uint64(m.Number))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13341,87 +14033,211 @@ func (m *DeletePipelineRequest) Size() (n int) {
 	return n
 }
 
-func (m *StartPipelineRequest) Size() (n int) {
+func (m *JobSetInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.JobSet != nil {
+		l = m.JobSet.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *StopPipelineRequest) Size() (n int) {
+func (m *JobInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PipelineVersion != 0 {
+		n += 1 + sovPps(uint64(m.PipelineVersion))
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Restart != 0 {
+		n += 1 + sovPps(uint64(m.Restart))
+	}
+	if m.DataProcessed != 0 {
+		n += 1 + sovPps(uint64(m.DataProcessed))
+	}
+	if m.DataSkipped != 0 {
+		n += 1 + sovPps(uint64(m.DataSkipped))
+	}
+	if m.DataTotal != 0 {
+		n += 1 + sovPps(uint64(m.DataTotal))
+	}
+	if m.DataFailed != 0 {
+		n += 1 + sovPps(uint64(m.DataFailed))
+	}
+	if m.DataRecovered != 0 {
+		n += 1 + sovPps(uint64(m.DataRecovered))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Egressed != nil {
+		l = m.Egressed.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RunPipelineRequest) Size() (n int) {
+func (m *JobInfo_Details) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.Transform != nil {
+		l = m.Transform.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.WorkerStatus) > 0 {
+		for _, e := range m.WorkerStatus {
 			l = e.Size()
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	l = len(m.JobID)
+	if m.ResourceRequests != nil {
+		l = m.ResourceRequests.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.SidecarResourceLimits != nil {
+		l = m.SidecarResourceLimits.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Salt)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.DatumSetSpec != nil {
+		l = m.DatumSetSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeout != nil {
+		l = m.DatumTimeout.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeout != nil {
+		l = m.JobTimeout.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DatumTries != 0 {
+		n += 1 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SchedulingSpec != nil {
+		l = m.SchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodPatch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RunCronRequest) Size() (n int) {
+func (m *Worker) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *CreateSecretRequest) Size() (n int) {
+func (m *Pipeline) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.File)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
@@ -13431,14 +14247,48 @@ func (m *CreateSecretRequest) Size() (n int) {
 	return n
 }
 
-func (m *DeleteSecretRequest) Size() (n int) {
+func (m *PipelineInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.SpecCommit != nil {
+		l = m.SpecCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Stopped {
+		n += 2
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.LastJobState != 0 {
+		n += 1 + sovPps(uint64(m.LastJobState))
+	}
+	if m.Parallelism != 0 {
+		n += 1 + sovPps(uint64(m.Parallelism))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPps(uint64(m.Type))
+	}
+	l = len(m.AuthToken)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -13447,31 +14297,150 @@ func (m *DeleteSecretRequest) Size() (n int) {
 	return n
 }
 
-func (m *InspectSecretRequest) Size() (n int) {
+func (m *PipelineInfo_Details) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.TFJob != nil {
+		l = m.TFJob.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.RecentError)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.WorkersRequested != 0 {
+		n += 1 + sovPps(uint64(m.WorkersRequested))
+	}
+	if m.WorkersAvailable != 0 {
+		n += 1 + sovPps(uint64(m.WorkersAvailable))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceRequests != nil {
+		l = m.ResourceRequests.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.SidecarResourceLimits != nil {
+		l = m.SidecarResourceLimits.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumSetSpec != nil {
+		l = m.DatumSetSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeout != nil {
+		l = m.DatumTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeout != nil {
+		l = m.JobTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SchedulingSpec != nil {
+		l = m.SchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodPatch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.S3Out {
+		n += 3
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.ReprocessSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.UnclaimedTasks != 0 {
+		n += 2 + sovPps(uint64(m.UnclaimedTasks))
+	}
+	l = len(m.WorkerRc)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Autoscaling {
+		n += 3
+	}
+	if m.DatumCache {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Secret) Size() (n int) {
+func (m *PipelineInfos) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.PipelineInfo) > 0 {
+		for _, e := range m.PipelineInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13479,41 +14448,37 @@ func (m *Secret) Size() (n int) {
 	return n
 }
 
-func (m *SecretInfo) Size() (n int) {
+func (m *JobSet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Secret != nil {
-		l = m.Secret.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Type)
+	l = len(m.ID)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.CreationTimestamp != nil {
-		l = m.CreationTimestamp.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SecretInfos) Size() (n int) {
+func (m *InspectJobSetRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.SecretInfo) > 0 {
-		for _, e := range m.SecretInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.JobSet != nil {
+		l = m.JobSet.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Wait {
+		n += 2
+	}
+	if m.Details {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13521,83 +14486,2776 @@ func (m *SecretInfos) Size() (n int) {
 	return n
 }
 
-func (m *ActivateAuthRequest) Size() (n int) {
+func (m *ListJobSetRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Details {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ActivateAuthResponse) Size() (n int) {
+func (m *InspectJobRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Wait {
+		n += 2
+	}
+	if m.Details {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RenderTemplateRequest) Size() (n int) {
+func (m *ListJobRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Template)
-	if l > 0 {
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Args) > 0 {
-		for k, v := range m.Args {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+	if len(m.InputCommit) > 0 {
+		for _, e := range m.InputCommit {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	if m.History != 0 {
+		n += 1 + sovPps(uint64(m.History))
+	}
+	if m.Details {
+		n += 2
+	}
+	l = len(m.JqFilter)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RenderTemplateResponse) Size() (n int) {
+func (m *SubscribeJobRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Json)
-	if l > 0 {
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Specs) > 0 {
-		for _, e := range m.Specs {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Details {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateJobStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Restart != 0 {
+		n += 1 + sovPps(uint64(m.Restart))
+	}
+	if m.DataProcessed != 0 {
+		n += 1 + sovPps(uint64(m.DataProcessed))
+	}
+	if m.DataSkipped != 0 {
+		n += 1 + sovPps(uint64(m.DataSkipped))
+	}
+	if m.DataFailed != 0 {
+		n += 1 + sovPps(uint64(m.DataFailed))
+	}
+	if m.DataRecovered != 0 {
+		n += 1 + sovPps(uint64(m.DataRecovered))
+	}
+	if m.DataTotal != 0 {
+		n += 1 + sovPps(uint64(m.DataTotal))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egressed != nil {
+		l = m.Egressed.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Master {
+		n += 2
+	}
+	if m.Follow {
+		n += 2
+	}
+	if m.Tail != 0 {
+		n += 1 + sovPps(uint64(m.Tail))
+	}
+	if m.UseLokiBackend {
+		n += 2
+	}
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.UsePfsBackend {
+		n += 2
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PipelineName)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.DatumID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Master {
+		n += 2
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.User {
+		n += 2
+	}
+	if m.Ts != nil {
+		l = m.Ts.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestartDatumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectDatumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDatumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatumSetSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovPps(uint64(m.Number))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
+	}
+	if m.PerWorker != 0 {
+		n += 1 + sovPps(uint64(m.PerWorker))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchedulingSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PriorityClassName)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreatePipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.TFJob != nil {
+		l = m.TFJob.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.S3Out {
+		n += 2
+	}
+	if m.ResourceRequests != nil {
+		l = m.ResourceRequests.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.SidecarResourceLimits != nil {
+		l = m.SidecarResourceLimits.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Reprocess {
+		n += 2
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumSetSpec != nil {
+		l = m.DatumSetSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeout != nil {
+		l = m.DatumTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeout != nil {
+		l = m.JobTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SchedulingSpec != nil {
+		l = m.SchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodPatch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SpecCommit != nil {
+		l = m.SpecCommit.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.ReprocessSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Autoscaling {
+		n += 3
+	}
+	if m.DatumCache {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Details {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.History != 0 {
+		n += 1 + sovPps(uint64(m.History))
+	}
+	if m.Details {
+		n += 2
+	}
+	l = len(m.JqFilter)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.All {
+		n += 2
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.KeepRepo {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RunPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RunCronRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateSecretRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteSecretRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectSecretRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Secret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.CreationTimestamp != nil {
+		l = m.CreationTimestamp.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SecretInfo) > 0 {
+		for _, e := range m.SecretInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Notifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NotifierInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Notifier != nil {
+		l = m.Notifier.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.JobStates) > 0 {
+		l = 0
+		for _, e := range m.JobStates {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if len(m.PipelineStates) > 0 {
+		l = 0
+		for _, e := range m.PipelineStates {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.CommitFinished {
+		n += 2
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NotifierInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NotifierInfo) > 0 {
+		for _, e := range m.NotifierInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateNotifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Notifier != nil {
+		l = m.Notifier.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.JobStates) > 0 {
+		l = 0
+		for _, e := range m.JobStates {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if len(m.PipelineStates) > 0 {
+		l = 0
+		for _, e := range m.PipelineStates {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.CommitFinished {
+		n += 2
+	}
+	if m.Update {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteNotifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Notifier != nil {
+		l = m.Notifier.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Notification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Notifier != nil {
+		l = m.Notifier.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPps(uint64(m.Type))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.JobState != 0 {
+		n += 1 + sovPps(uint64(m.JobState))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PipelineState != 0 {
+		n += 1 + sovPps(uint64(m.PipelineState))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TraceFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovPps(uint64(m.Direction))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovPps(uint64(m.Depth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileLineage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.DatumID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Depth != 0 {
+		n += 1 + sovPps(uint64(m.Depth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateAuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateAuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for k, v := range m.Args {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Specs) > 0 {
+		for _, e := range m.Specs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPps(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPps(x uint64) (n int) {
+	return sovPps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SecretMount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretMount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretMount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transform) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrCmd = append(m.ErrCmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &SecretMount{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrStdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrStdin = append(m.ErrStdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AcceptReturnCode) == 0 {
+					m.AcceptReturnCode = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkingDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dockerfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dockerfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TFJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TFJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TFJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TFJob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Egress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &pfs.ObjectStorageEgress{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &Egress_ObjectStorage{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqlDatabase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &pfs.SQLDatabaseEgress{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &Egress_SqlDatabase{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			m.InternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovPps(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPps(x uint64) (n int) {
-	return sovPps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *SecretMount) Unmarshal(dAtA []byte) error {
+func (m *PFSInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13620,10 +17278,10 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretMount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretMount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13660,9 +17318,241 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterJoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OuterJoin = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13672,29 +17562,37 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			m.EmptyFiles = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.S3 = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13704,27 +17602,31 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MountPath = string(dAtA[iNdEx:postIndex])
+			if m.Trigger == nil {
+				m.Trigger = &pfs.Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13752,7 +17654,7 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvVar = string(dAtA[iNdEx:postIndex])
+			m.RepoType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13776,7 +17678,7 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transform) Unmarshal(dAtA []byte) error {
+func (m *CronInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13799,15 +17701,15 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13835,11 +17737,11 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13867,11 +17769,11 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrCmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13899,11 +17801,63 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrCmd = append(m.ErrCmd, string(dAtA[iNdEx:postIndex]))
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13930,107 +17884,67 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			m.Env[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14057,16 +17971,18 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secrets = append(m.Secrets, &SecretMount{})
-			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pfs == nil {
+				m.Pfs = &PFSInput{}
+			}
+			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14076,29 +17992,31 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			m.Join = append(m.Join, &Input{})
+			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14108,29 +18026,31 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			m.Group = append(m.Group, &Input{})
+			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrStdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14140,105 +18060,65 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrStdin = append(m.ErrStdin, string(dAtA[iNdEx:postIndex]))
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPps
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPps
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AcceptReturnCode) == 0 {
-					m.AcceptReturnCode = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14248,15 +18128,82 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Debug = bool(v != 0)
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
+			}
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14284,13 +18231,13 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14300,27 +18247,31 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkingDir = string(dAtA[iNdEx:postIndex])
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dockerfile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14348,8 +18299,28 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dockerfile = string(dAtA[iNdEx:postIndex])
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14372,7 +18343,7 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TFJob) Unmarshal(dAtA []byte) error {
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14395,17 +18366,17 @@ func (m *TFJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TFJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TFJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
 			}
-			var stringLen uint64
+			m.Constant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14415,24 +18386,11 @@ func (m *TFJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Constant |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TFJob = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14455,7 +18413,7 @@ func (m *TFJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Egress) Unmarshal(dAtA []byte) error {
+func (m *InputFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14478,15 +18436,15 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14514,48 +18472,13 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStorage", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &pfs.ObjectStorageEgress{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Target = &Egress_ObjectStorage{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SqlDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14565,26 +18488,25 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &pfs.SQLDatabaseEgress{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-			m.Target = &Egress_SqlDatabase{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14608,7 +18530,7 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Job) Unmarshal(dAtA []byte) error {
+func (m *Datum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14631,15 +18553,15 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14666,10 +18588,10 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14727,7 +18649,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14737,28 +18659,119 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowPps
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14785,107 +18798,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14912,103 +18834,42 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15032,7 +18893,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15055,17 +18916,17 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.InternalPort = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15075,16 +18936,111 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InternalPort |= int32(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
-			m.ExternalPort = 0
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FifthPercentile = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15094,16 +19050,33 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &types.Duration{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15113,29 +19086,33 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IP = string(dAtA[iNdEx:postIndex])
+			if m.ProcessTime == nil {
+				m.ProcessTime = &types.Duration{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15145,80 +19122,52 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.UploadTime == nil {
+				m.UploadTime = &types.Duration{}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Spout) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DownloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
-			var msglen int
+			m.UploadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15228,28 +19177,11 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UploadBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15272,7 +19204,7 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PFSInput) Unmarshal(dAtA []byte) error {
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15295,17 +19227,17 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15315,29 +19247,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15347,29 +19283,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15379,29 +19319,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15411,29 +19355,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
+			}
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15443,27 +19391,82 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
+			}
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15491,31 +19494,11 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JoinOn = string(dAtA[iNdEx:postIndex])
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OuterJoin", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.OuterJoin = bool(v != 0)
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15543,13 +19526,13 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumStatus", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15559,55 +19542,82 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumStatus == nil {
+				m.DatumStatus = &DatumStatus{}
+			}
+			if err := m.DatumStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EmptyFiles = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.S3 = bool(v != 0)
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15634,18 +19644,18 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &pfs.Trigger{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15655,23 +19665,25 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoType = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15695,7 +19707,7 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronInput) Unmarshal(dAtA []byte) error {
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15718,15 +19730,26 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15754,13 +19777,13 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15770,27 +19793,31 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.Gpu == nil {
+				m.Gpu = &GPUSpec{}
+			}
+			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15818,11 +19845,62 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Disk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GPUSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15850,13 +19928,83 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15866,15 +20014,31 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobSet == nil {
+				m.JobSet = &JobSet{}
+			}
+			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15901,10 +20065,8 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &types.Timestamp{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Jobs = append(m.Jobs, &JobInfo{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15930,7 +20092,7 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15953,15 +20115,15 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15988,18 +20150,18 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pfs == nil {
-				m.Pfs = &PFSInput{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
 			}
-			var msglen int
+			m.PipelineVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16009,29 +20171,14 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PipelineVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Join = append(m.Join, &Input{})
-			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16058,16 +20205,18 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = append(m.Group, &Input{})
-			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var msglen int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16077,31 +20226,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16111,31 +20245,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			var msglen int
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16145,84 +20264,35 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
-			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			var stringLen uint64
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16232,27 +20302,33 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DataRecovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRecovered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16279,18 +20355,18 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16300,29 +20376,16 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16332,68 +20395,29 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			m.Constant = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16403,67 +20427,33 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputFile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16473,29 +20463,33 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16505,80 +20499,31 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Datum) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16605,18 +20550,18 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Details == nil {
+				m.Details = &JobInfo_Details{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egressed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16626,23 +20571,27 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Egressed == nil {
+				m.Egressed = &types.Timestamp{}
+			}
+			if err := m.Egressed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16666,7 +20615,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16689,15 +20638,15 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16724,35 +20673,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16779,16 +20709,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16815,16 +20745,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16851,16 +20781,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16870,192 +20802,65 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
 				}
 				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Mean = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17082,16 +20887,16 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &types.Duration{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17118,16 +20923,16 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &types.Duration{}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17154,18 +20959,18 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &types.Duration{}
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			m.DownloadBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17175,16 +20980,33 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownloadBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.UploadBytes = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17194,65 +21016,27 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadBytes |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17279,16 +21063,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17315,16 +21099,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17351,16 +21135,35 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			}
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17387,18 +21190,18 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17408,27 +21211,55 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
-			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17452,7 +21283,7 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+func (m *Worker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17475,15 +21306,15 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17511,45 +21342,13 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumStatus", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17559,28 +21358,11 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= WorkerState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DatumStatus == nil {
-				m.DatumStatus = &DatumStatus{}
-			}
-			if err := m.DatumStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17603,7 +21385,7 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumStatus) Unmarshal(dAtA []byte) error {
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17626,53 +21408,17 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17682,25 +21428,23 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17724,7 +21468,7 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17747,28 +21491,17 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17778,27 +21511,50 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17825,18 +21581,18 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gpu == nil {
-				m.Gpu = &GPUSpec{}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
 			}
-			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17846,78 +21602,34 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Disk = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GPUSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Stopped = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PipelineState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17945,13 +21657,13 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
 			}
-			m.Number = 0
+			m.LastJobState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17961,67 +21673,54 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				m.LastJobState |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PipelineInfo_PipelineType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18031,31 +21730,27 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobSet == nil {
-				m.JobSet = &JobSet{}
-			}
-			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18082,8 +21777,10 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Jobs = append(m.Jobs, &JobInfo{})
-			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Details == nil {
+				m.Details = &PipelineInfo_Details{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18109,7 +21806,7 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18132,15 +21829,15 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18167,35 +21864,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
-			}
-			m.PipelineVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PipelineVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18222,18 +21900,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.TFJob == nil {
+				m.TFJob = &TFJob{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
-			m.Restart = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18243,54 +21921,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DataSkipped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			m.DataTotal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18300,16 +21957,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataFailed = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18319,16 +21993,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataRecovered = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18338,16 +22029,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersRequested", wireType)
+			}
+			m.WorkersRequested = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18357,33 +22061,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WorkersRequested |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
 			}
-			m.State = 0
+			m.WorkersAvailable = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18393,14 +22080,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
+				m.WorkersAvailable |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18428,11 +22115,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18459,16 +22146,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18495,16 +22182,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18531,16 +22218,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18567,69 +22254,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &JobInfo_Details{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18639,33 +22275,29 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18675,33 +22307,29 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18711,29 +22339,25 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 19:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
@@ -18769,7 +22393,7 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 20:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
@@ -18805,9 +22429,9 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18834,14 +22458,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
+			}
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18868,16 +22494,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18904,16 +22530,35 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			}
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18940,18 +22585,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18961,31 +22606,27 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19013,11 +22654,31 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.S3Out = bool(v != 0)
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19044,18 +22705,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
 			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19065,33 +22726,48 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedTasks", wireType)
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnclaimedTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnclaimedTasks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 14:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRc", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19101,33 +22777,29 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
-			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerRc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 33:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
 			}
-			m.DatumTries = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19137,16 +22809,17 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+			m.Autoscaling = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumCache", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19156,33 +22829,68 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			m.DatumCache = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 17:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19192,27 +22900,80 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
+			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 18:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19240,7 +23001,7 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19264,7 +23025,7 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Worker) Unmarshal(dAtA []byte) error {
+func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19287,17 +23048,17 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectJobSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19307,29 +23068,33 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.JobSet == nil {
+				m.JobSet = &JobSet{}
+			}
+			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19339,11 +23104,32 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= WorkerState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Details = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -19366,7 +23152,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
+func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19389,17 +23175,17 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19409,24 +23195,12 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Details = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -19449,7 +23223,7 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19472,15 +23246,15 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19507,18 +23281,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19528,16 +23302,17 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Wait = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19547,72 +23322,68 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			m.Details = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
-			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Stopped = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= PipelineState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19622,29 +23393,33 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
 			}
-			m.LastJobState = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19654,16 +23429,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastJobState |= JobState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
+			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			m.Parallelism = 0
+			m.History = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19673,16 +23463,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parallelism |= uint64(b&0x7F) << shift
+				m.History |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19692,14 +23482,15 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PipelineInfo_PipelineType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.Details = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19727,43 +23518,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &PipelineInfo_Details{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JqFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19787,7 +23542,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
+func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19810,15 +23565,15 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19845,18 +23600,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19866,31 +23621,66 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			m.Details = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TFJob == nil {
-				m.TFJob = &TFJob{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19917,52 +23707,67 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19989,16 +23794,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20026,51 +23831,64 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentError = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersRequested", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.WorkersRequested = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkersRequested |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.WorkersAvailable = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkersAvailable |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateJobStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateJobStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20080,29 +23898,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20112,33 +23934,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
-			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20148,33 +23953,29 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
-			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var msglen int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20184,33 +23985,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
-			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20220,33 +24004,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			var stringLen uint64
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20256,29 +24023,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			var stringLen uint64
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20288,29 +24042,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			var stringLen uint64
+			m.DataRecovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20320,29 +24061,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataRecovered |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			var msglen int
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20352,31 +24080,14 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20403,16 +24114,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egressed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20439,16 +24150,67 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
+			if m.Egressed == nil {
+				m.Egressed = &types.Timestamp{}
 			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egressed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20475,16 +24237,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20511,18 +24273,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			m.DatumTries = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20532,14 +24294,27 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20566,18 +24341,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20587,29 +24362,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			m.Master = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20619,29 +24382,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 28:
+			m.Follow = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
 			}
-			var v int
+			m.Tail = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20651,17 +24402,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Tail |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.S3Out = bool(v != 0)
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseLokiBackend", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20671,33 +24421,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 30:
+			m.UseLokiBackend = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20707,29 +24441,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			if m.Since == nil {
+				m.Since = &types.Duration{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 31:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsePfsBackend", wireType)
 			}
-			m.UnclaimedTasks = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20739,16 +24477,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnclaimedTasks |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 32:
+			m.UsePfsBackend = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20758,29 +24497,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerRc = string(dAtA[iNdEx:postIndex])
+			if m.From == nil {
+				m.From = &types.Timestamp{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 33:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20790,12 +24533,28 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Autoscaling = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &types.Timestamp{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20818,7 +24577,7 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20841,17 +24600,17 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20861,80 +24620,59 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
-			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20962,62 +24700,63 @@ func (m *JobSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DatumID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobSetRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Master = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21044,16 +24783,14 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobSet == nil {
-				m.JobSet = &JobSet{}
-			}
-			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21070,12 +24807,12 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			m.User = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21085,68 +24822,33 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Ts == nil {
+				m.Ts = &types.Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobSetRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21156,12 +24858,24 @@ func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21184,7 +24898,7 @@ func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21207,10 +24921,10 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21250,10 +24964,10 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21263,32 +24977,24 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Details = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21311,7 +25017,7 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21334,15 +25040,15 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21369,16 +25075,67 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21405,55 +25162,18 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
-			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
-			}
-			m.History = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.History |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Details = bool(v != 0)
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21463,23 +25183,27 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JqFilter = string(dAtA[iNdEx:postIndex])
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21503,7 +25227,7 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
+func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21526,17 +25250,17 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumSetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21546,33 +25270,35 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerWorker", wireType)
 			}
-			var v int
+			m.PerWorker = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21582,12 +25308,11 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PerWorker |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21610,7 +25335,7 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
+func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21633,15 +25358,15 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21668,12 +25393,135 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21697,7 +25545,7 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
+func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21720,15 +25568,15 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21755,18 +25603,18 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21776,78 +25624,103 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if m.TFJob == nil {
+				m.TFJob = &TFJob{}
+			}
+			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if iNdEx >= l {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateJobStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateJobStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21874,18 +25747,18 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21895,14 +25768,15 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Update = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21930,13 +25804,13 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
 			}
-			m.Restart = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21946,16 +25820,89 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			m.S3Out = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
+			}
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
-			m.DataProcessed = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21965,35 +25912,33 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataSkipped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DataFailed = 0
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
+			}
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22003,16 +25948,33 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataRecovered = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22022,16 +25984,29 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
-			m.DataTotal = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22041,14 +26016,15 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.Reprocess = bool(v != 0)
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22075,67 +26051,16 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22162,16 +26087,16 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22198,18 +26123,18 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22219,27 +26144,31 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
+			}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22266,58 +26195,18 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Master = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Follow = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			m.Tail = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22327,16 +26216,29 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tail |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseLokiBackend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			var v int
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22346,15 +26248,14 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DatumTries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseLokiBackend = bool(v != 0)
-		case 9:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22381,67 +26282,16 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Since == nil {
-				m.Since = &types.Duration{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22469,11 +26319,11 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22501,13 +26351,13 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22517,29 +26367,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
+			}
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22549,29 +26403,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumID = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22581,17 +26439,29 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22601,29 +26471,15 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.Autoscaling = bool(v != 0)
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumCache", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -22640,10 +26496,61 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.User = bool(v != 0)
-		case 8:
+			m.DatumCache = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22670,18 +26577,18 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ts == nil {
-				m.Ts = &types.Timestamp{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22691,24 +26598,12 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Details = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22731,7 +26626,7 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22754,15 +26649,15 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22789,16 +26684,55 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			m.History = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.History |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22826,7 +26760,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			m.JqFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22850,7 +26784,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22873,15 +26807,15 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22908,13 +26842,73 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepRepo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepRepo = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22937,7 +26931,7 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22960,51 +26954,15 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23031,10 +26989,10 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23060,7 +27018,7 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
+func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23083,36 +27041,17 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumSetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
-			}
-			m.Number = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23122,30 +27061,28 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerWorker", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.PerWorker = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PerWorker |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23168,7 +27105,7 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
+func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23191,15 +27128,15 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23226,107 +27163,50 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &pfs.Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23354,7 +27234,7 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23378,7 +27258,7 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23401,10 +27281,10 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunCronRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunCronRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23443,83 +27323,62 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TFJob == nil {
-				m.TFJob = &TFJob{}
-			}
-			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSecretRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSecretRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSecretRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23529,31 +27388,80 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			m.File = append(m.File[:0], dAtA[iNdEx:postIndex]...)
+			if m.File == nil {
+				m.File = []byte{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteSecretRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteSecretRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteSecretRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23580,88 +27488,67 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.Secret == nil {
+				m.Secret = &Secret{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectSecretRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.S3Out = bool(v != 0)
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectSecretRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectSecretRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23688,18 +27575,69 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.Secret == nil {
+				m.Secret = &Secret{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Secret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23709,67 +27647,78 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
-			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23796,16 +27745,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Secret == nil {
+				m.Secret = &Secret{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23833,31 +27782,11 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reprocess = bool(v != 0)
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23884,16 +27813,67 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.CreationTimestamp == nil {
+				m.CreationTimestamp = &types.Timestamp{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23920,54 +27900,67 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
-			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SecretInfo = append(m.SecretInfo, &SecretInfo{})
+			if err := m.SecretInfo[len(m.SecretInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Notifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 20:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Notifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Notifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23977,31 +27970,78 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
-			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 21:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotifierInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotifierInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotifierInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifier", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24028,16 +28068,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
+			if m.Notifier == nil {
+				m.Notifier = &Notifier{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Notifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24065,32 +28105,13 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
-			}
-			m.DatumTries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 24:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24100,31 +28121,27 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
-			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24152,11 +28169,11 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.Pipelines = append(m.Pipelines, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 26:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24184,115 +28201,149 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			m.Repos = append(m.Repos, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
+		case 6:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.JobStates = append(m.JobStates, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.JobStates) == 0 {
+					m.JobStates = make([]JobState, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.JobStates = append(m.JobStates, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobStates", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
-			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
+		case 7:
+			if wireType == 0 {
+				var v PipelineState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PipelineState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.PipelineStates = append(m.PipelineStates, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.PipelineStates) == 0 {
+					m.PipelineStates = make([]PipelineState, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v PipelineState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PipelineState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PipelineStates = append(m.PipelineStates, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineStates", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 30:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitFinished", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -24309,61 +28360,10 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Autoscaling = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CommitFinished = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24390,33 +28390,13 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Details = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24439,7 +28419,7 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *NotifierInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24462,15 +28442,15 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotifierInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotifierInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotifierInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24497,84 +28477,11 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NotifierInfo = append(m.NotifierInfo, &NotifierInfo{})
+			if err := m.NotifierInfo[len(m.NotifierInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
-			}
-			m.History = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.History |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Details = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JqFilter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24597,7 +28504,7 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateNotifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24620,15 +28527,15 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateNotifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateNotifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifier", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24655,18 +28562,18 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Notifier == nil {
+				m.Notifier = &Notifier{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Notifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24676,17 +28583,29 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24696,17 +28615,29 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeepRepo", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24716,68 +28647,29 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KeepRepo = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Pipelines = append(m.Pipelines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24787,28 +28679,202 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			m.Repos = append(m.Repos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.JobStates = append(m.JobStates, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.JobStates) == 0 {
+					m.JobStates = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.JobStates = append(m.JobStates, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobStates", wireType)
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 7:
+			if wireType == 0 {
+				var v PipelineState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PipelineState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PipelineStates = append(m.PipelineStates, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PipelineStates) == 0 {
+					m.PipelineStates = make([]PipelineState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PipelineState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PipelineState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PipelineStates = append(m.PipelineStates, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineStates", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitFinished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitFinished = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Update = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24831,7 +28897,7 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteNotifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24854,15 +28920,15 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteNotifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteNotifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifier", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24889,10 +28955,10 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Notifier == nil {
+				m.Notifier = &Notifier{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Notifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24918,7 +28984,7 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *Notification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24941,15 +29007,15 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Notification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Notification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifier", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24976,18 +29042,18 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Notifier == nil {
+				m.Notifier = &Notifier{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Notifier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24997,31 +29063,16 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= NotificationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &pfs.Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25031,78 +29082,31 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Time == nil {
+				m.Time = &types.Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RunCronRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunCronRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25129,152 +29133,35 @@ func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateSecretRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateSecretRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateSecretRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobState", wireType)
 			}
-			var byteLen int
+			m.JobState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.File = append(m.File[:0], dAtA[iNdEx:postIndex]...)
-			if m.File == nil {
-				m.File = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteSecretRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteSecretRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteSecretRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobState |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25301,67 +29188,67 @@ func (m *DeleteSecretRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secret == nil {
-				m.Secret = &Secret{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineState", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
+			m.PipelineState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineState |= PipelineState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectSecretRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectSecretRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectSecretRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25388,10 +29275,10 @@ func (m *InspectSecretRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secret == nil {
-				m.Secret = &Secret{}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
 			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25417,7 +29304,7 @@ func (m *InspectSecretRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Secret) Unmarshal(dAtA []byte) error {
+func (m *TraceFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25440,17 +29327,17 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25460,24 +29347,66 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.File == nil {
+				m.File = &pfs.File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= TraceDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25500,7 +29429,7 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretInfo) Unmarshal(dAtA []byte) error {
+func (m *FileLineage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25523,15 +29452,15 @@ func (m *SecretInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileLineage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileLineage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25558,16 +29487,16 @@ func (m *SecretInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secret == nil {
-				m.Secret = &Secret{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25595,11 +29524,11 @@ func (m *SecretInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.DatumID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25626,67 +29555,14 @@ func (m *SecretInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreationTimestamp == nil {
-				m.CreationTimestamp = &types.Timestamp{}
-			}
-			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Inputs = append(m.Inputs, &pfs.File{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SecretInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SecretInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25713,11 +29589,30 @@ func (m *SecretInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretInfo = append(m.SecretInfo, &SecretInfo{})
-			if err := m.SecretInfo[len(m.SecretInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outputs = append(m.Outputs, &pfs.File{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
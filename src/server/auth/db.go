@@ -22,3 +22,16 @@ ON auth.auth_tokens (subject);
 `)
 	return errors.EnsureStack(err)
 }
+
+// CreatePresignKeysTable sets up the postgres table which holds the key that
+// presigned URL credentials are derived from
+func CreatePresignKeysTable(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS auth.presign_keys (
+	id INT PRIMARY KEY,
+	key BYTEA NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+`)
+	return errors.EnsureStack(err)
+}
@@ -36,6 +36,9 @@ type APIServer interface {
 
 	// GetPipelineAuthTokenInTransaction is an internal API used by PPS to generate tokens for pipelines
 	GetPipelineAuthTokenInTransaction(*txncontext.TransactionContext, string) (string, error)
+	// ResolvePresignCredentials is an internal API used by the S3 gateway to get
+	// the auth token and secret key of the access key ID of a presigned URL.
+	ResolvePresignCredentials(context.Context, string) (string, string, error)
 	RevokeAuthTokenInTransaction(*txncontext.TransactionContext, *auth_client.RevokeAuthTokenRequest) (*auth_client.RevokeAuthTokenResponse, error)
 
 	GetPermissionsInTransaction(*txncontext.TransactionContext, *auth_client.GetPermissionsRequest) (*auth_client.GetPermissionsResponse, error)
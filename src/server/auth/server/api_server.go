@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -81,6 +82,10 @@ type apiServer struct {
 	// direct access to a repo anyways, so the cluster role bindings don't affect their access,
 	// and the OIDC server doesn't run in the sidecar so the config doesn't matter.
 	watchesEnabled bool
+
+	// presignKey caches the key that presign credentials are derived from.
+	presignKeyMu sync.Mutex
+	presignKey   []byte
 }
 
 // NewAuthServer returns an implementation of auth.APIServer.
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"golang.org/x/net/context"
+)
+
+const presignKeySize = 32
+
+// GetPresignCredentials implements the protobuf auth.GetPresignCredentials RPC
+func (a *apiServer) GetPresignCredentials(ctx context.Context, req *auth.GetPresignCredentialsRequest) (resp *auth.GetPresignCredentialsResponse, retErr error) {
+	if _, err := a.getAuthenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+	token, err := auth.GetAuthToken(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "presigned URLs must be signed with the credentials of an auth token")
+	}
+	key, err := a.getPresignKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accessKeyID, err := encryptPresignToken(key, token)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetPresignCredentialsResponse{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: presignSecret(key, token),
+	}, nil
+}
+
+// ResolvePresignCredentials is an internal API used by the S3 gateway to
+// verify presigned URLs. It returns the auth token that accessKeyID was
+// created for, and the secret key that URLs signed with it must be signed
+// with. The token must not be returned to the holder of the URL.
+// Not an RPC.
+func (a *apiServer) ResolvePresignCredentials(ctx context.Context, accessKeyID string) (string, string, error) {
+	if err := a.isActive(ctx); err != nil {
+		return "", "", err
+	}
+	key, err := a.getPresignKey(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	token, err := decryptPresignToken(key, accessKeyID)
+	if err != nil {
+		return "", "", err
+	}
+	// the URL stops working as soon as its token is revoked or expires
+	tokenInfo, err := a.lookupAuthTokenInfo(ctx, auth.HashToken(token))
+	if err != nil {
+		if col.IsErrNotFound(err) {
+			return "", "", auth.ErrBadPresignCredentials
+		}
+		return "", "", err
+	}
+	if tokenInfo.Expiration != nil && time.Now().After(*tokenInfo.Expiration) {
+		return "", "", auth.ErrBadPresignCredentials
+	}
+	return token, presignSecret(key, token), nil
+}
+
+// getPresignKey returns the key that presign credentials are derived from,
+// creating it if it doesn't exist yet.
+func (a *apiServer) getPresignKey(ctx context.Context) ([]byte, error) {
+	a.presignKeyMu.Lock()
+	defer a.presignKeyMu.Unlock()
+	if a.presignKey != nil {
+		return a.presignKey, nil
+	}
+	key := make([]byte, presignKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	// if another pachd created the key first, use that key instead
+	if _, err := a.env.DB.ExecContext(ctx, `INSERT INTO auth.presign_keys (id, key) VALUES (1, $1) ON CONFLICT DO NOTHING`, key); err != nil {
+		return nil, errors.Wrapf(err, "error storing presign key")
+	}
+	if err := a.env.DB.GetContext(ctx, &key, `SELECT key FROM auth.presign_keys WHERE id = 1`); err != nil {
+		return nil, errors.Wrapf(err, "error getting presign key")
+	}
+	a.presignKey = key
+	return key, nil
+}
+
+// derivePresignKey derives a key for one purpose from the presign key, so
+// that the same key isn't used for both encryption and signing.
+func derivePresignKey(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// presignSecret returns the secret key of the presign credentials of token.
+func presignSecret(key []byte, token string) string {
+	mac := hmac.New(sha256.New, derivePresignKey(key, "secret"))
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func presignCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(derivePresignKey(key, "access key id"))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	return gcm, errors.EnsureStack(err)
+}
+
+// encryptPresignToken returns the access key ID of the presign credentials of
+// token, which is the token encrypted with the presign key. It is URL safe
+// and doesn't contain '/', so it can be used in a SigV4 credential.
+func encryptPresignToken(key []byte, token string) (string, error) {
+	gcm, err := presignCipher(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(token), nil)), nil
+}
+
+// decryptPresignToken returns the token that accessKeyID was created from by
+// encryptPresignToken.
+func decryptPresignToken(key []byte, accessKeyID string) (string, error) {
+	gcm, err := presignCipher(key)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.RawURLEncoding.DecodeString(accessKeyID)
+	if err != nil || len(data) < gcm.NonceSize() {
+		return "", auth.ErrBadPresignCredentials
+	}
+	token, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", auth.ErrBadPresignCredentials
+	}
+	return string(token), nil
+}
@@ -68,14 +68,28 @@ func init() {
 		}),
 	})
 
+	// repoApprover has the ability to approve commits, so that they
+	// can become the head of a protected branch, plus all the
+	// permissions of repoReader.
+	registerRole(&auth.Role{
+		Name:          auth.RepoApproverRole,
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO},
+		Permissions: combinePermissions(repoReaderRole.Permissions, []auth.Permission{
+			auth.Permission_REPO_APPROVE_COMMIT,
+		}),
+	})
+
 	// repoOwner has the ability to modify the role bindings for
-	// a repo and delete it, plus all the permissions of repoWriter.
+	// a repo and delete it, approve commits and bypass branch
+	// protection, plus all the permissions of repoWriter.
 	repoOwnerRole := registerRole(&auth.Role{
 		Name:          auth.RepoOwnerRole,
 		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO},
 		Permissions: combinePermissions(repoWriterRole.Permissions, []auth.Permission{
 			auth.Permission_REPO_MODIFY_BINDINGS,
 			auth.Permission_REPO_DELETE,
+			auth.Permission_REPO_APPROVE_COMMIT,
+			auth.Permission_REPO_BYPASS_BRANCH_PROTECTION,
 		}),
 	})
 
@@ -207,6 +221,11 @@ func init() {
 				auth.Permission_CLUSTER_ENTERPRISE_DEACTIVATE,
 				auth.Permission_CLUSTER_DELETE_ALL,
 				auth.Permission_CLUSTER_ENTERPRISE_PAUSE,
+				auth.Permission_CLUSTER_PFS_MODIFY_QUOTAS,
+				auth.Permission_CLUSTER_PFS_MANAGE_STORAGE_KEYS,
+				auth.Permission_CLUSTER_PFS_GARBAGE_COLLECT,
+				auth.Permission_CLUSTER_PFS_APPLY_RETENTION,
+				auth.Permission_CLUSTER_PPS_MODIFY_NOTIFIERS,
 			}),
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"os"
 	"path"
 	"sort"
@@ -29,6 +30,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/license"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/s3"
 
 	minio "github.com/minio/minio-go/v6"
 )
@@ -1142,6 +1145,8 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_WRITE,
 			auth.Permission_REPO_MODIFY_BINDINGS,
 			auth.Permission_REPO_DELETE,
+			auth.Permission_REPO_APPROVE_COMMIT,
+			auth.Permission_REPO_BYPASS_BRANCH_PROTECTION,
 			auth.Permission_REPO_INSPECT_COMMIT,
 			auth.Permission_REPO_LIST_COMMIT,
 			auth.Permission_REPO_DELETE_COMMIT,
@@ -2372,6 +2377,98 @@ func TestS3GatewayAuthRequests(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestPresignedURLRevokedToken checks that presigned URLs are authorized as
+// the token they were signed with, and stop working when it is revoked.
+func TestPresignedURLRevokedToken(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	adminClient := tu.AuthenticateClient(t, c, auth.RootUser)
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient := tu.AuthenticateClient(t, c, alice)
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	file := client.NewFile(repo, "master", "", "file")
+	require.NoError(t, aliceClient.PutFile(file.Commit, file.Path, strings.NewReader("content")))
+
+	ip := os.Getenv("VM_IP")
+	if ip == "" {
+		ip = "127.0.0.1"
+	}
+	// Port set dynamically in src/internal/minikubetestenv/deploy.go
+	endpoint := "http://" + net.JoinHostPort(ip, fmt.Sprint(c.GetAddress().Port+3))
+	url, err := s3.PresignURL(aliceClient, endpoint, http.MethodGet, file, time.Hour)
+	require.NoError(t, err)
+	// the URL doesn't contain alice's token
+	require.False(t, strings.Contains(url, aliceClient.AuthToken()))
+	get := func() int {
+		resp, err := http.Get(url)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusOK, get())
+
+	_, err = adminClient.RevokeAuthToken(adminClient.Ctx(), &auth.RevokeAuthTokenRequest{Token: aliceClient.AuthToken()})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, get())
+}
+
+// TestApproveCommit tests that principals can't approve their own commits, or
+// drop an approved head of a protected branch without bypassing its
+// protection.
+func TestApproveCommit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, bob)
+
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoWriterRole, auth.RepoApproverRole}))
+	commit1, err := aliceClient.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, aliceClient.FinishCommit(repo, commit1.Branch.Name, commit1.ID))
+	require.NoError(t, aliceClient.CreateBranch(repo, "dev", "master", "", nil))
+	require.NoError(t, aliceClient.ProtectBranch(repo, "master", 1))
+
+	commit2, err := bobClient.StartCommit(repo, "dev")
+	require.NoError(t, err)
+	require.NoError(t, bobClient.PutFile(commit2, "file", strings.NewReader("foo")))
+	require.NoError(t, bobClient.FinishCommit(repo, commit2.Branch.Name, commit2.ID))
+	commitInfo, err := bobClient.InspectCommit(repo, commit2.Branch.Name, commit2.ID)
+	require.NoError(t, err)
+	require.Equal(t, bob, commitInfo.Author)
+
+	// bob can't approve a commit they started, but alice can
+	require.YesError(t, bobClient.ApproveCommit(repo, "dev", commit2.ID))
+	require.YesError(t, bobClient.CreateBranch(repo, "master", "dev", commit2.ID, nil))
+	require.NoError(t, aliceClient.ApproveCommit(repo, "dev", commit2.ID))
+	require.NoError(t, aliceClient.ApproveCommit(repo, "dev", commit2.ID))
+	commitInfo, err = bobClient.InspectCommit(repo, commit2.Branch.Name, commit2.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commitInfo.Approvals))
+	require.Equal(t, alice, commitInfo.Approvals[0].Principal)
+	require.NoError(t, bobClient.CreateBranch(repo, "master", "dev", commit2.ID, nil))
+
+	// bob can't move master back by dropping its head, but alice, who owns
+	// the repo, can
+	err = bobClient.DropCommitSet(commit2.ID)
+	require.YesError(t, err)
+	require.True(t, pfsserver.IsBranchProtectedErr(err))
+	require.NoError(t, aliceClient.DropCommitSet(commit2.ID))
+	branchInfo, err := aliceClient.InspectBranch(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, commit1.ID, branchInfo.Head.ID)
+}
+
 // TestDeleteFailedPipeline creates a pipeline with an invalid image and then
 // tries to delete it (which shouldn't be blocked by the auth system)
 func TestDeleteFailedPipeline(t *testing.T) {
@@ -2899,7 +2996,7 @@ func TestRolesForPermission(t *testing.T) {
 		names[i] = r.Name
 	}
 	sort.Strings(names)
-	require.Equal(t, []string{"clusterAdmin", "repoOwner", "repoReader", "repoWriter"}, names)
+	require.Equal(t, []string{"clusterAdmin", "repoApprover", "repoOwner", "repoReader", "repoWriter"}, names)
 }
 
 // TODO: This test mirrors TestLoad in src/server/pfs/server/testing/load_test.go.
@@ -2920,3 +3017,41 @@ func TestLoad(t *testing.T) {
 	require.NoError(t, cmdutil.Encoder("", buf).EncodeProto(resp))
 	require.Equal(t, "", resp.Error, buf.String())
 }
+
+// TestListNotifier tests that only cluster admins can list notifiers, since
+// their URLs may contain credentials
+func TestListNotifier(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	tu.ActivateAuthClient(t, c)
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient, adminClient := tu.AuthenticateClient(t, c, alice), tu.AuthenticateClient(t, c, auth.RootUser)
+
+	notifier := tu.UniqueString("notifier")
+	require.NoError(t, adminClient.CreateNotifier(&pps.CreateNotifierRequest{
+		Notifier:       &pps.Notifier{Name: notifier},
+		Url:            "https://example.com/hook?token=secret",
+		CommitFinished: true,
+	}))
+	defer func() {
+		require.NoError(t, adminClient.DeleteNotifier(notifier))
+	}()
+
+	_, err := aliceClient.ListNotifier()
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	notifierInfos, err := adminClient.ListNotifier()
+	require.NoError(t, err)
+	var found bool
+	for _, ni := range notifierInfos {
+		if ni.Notifier.Name == notifier {
+			found = true
+			require.Equal(t, "https://example.com/hook?token=secret", ni.Url)
+		}
+	}
+	require.True(t, found)
+}
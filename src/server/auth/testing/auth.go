@@ -120,6 +120,16 @@ func (a *InactiveAPIServer) GetRobotToken(context.Context, *auth.GetRobotTokenRe
 	return nil, auth.ErrNotActivated
 }
 
+// GetPresignCredentials implements the GetPresignCredentials RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetPresignCredentials(context.Context, *auth.GetPresignCredentialsRequest) (*auth.GetPresignCredentialsResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ResolvePresignCredentials returns NotActivatedError
+func (a *InactiveAPIServer) ResolvePresignCredentials(context.Context, string) (string, string, error) {
+	return "", "", auth.ErrNotActivated
+}
+
 // GetPipelineAuthTokenInTransaction is the same as GetAuthToken but for use inside a running transaction.
 func (a *InactiveAPIServer) GetPipelineAuthTokenInTransaction(*txncontext.TransactionContext, string) (string, error) {
 	return "", auth.ErrNotActivated
@@ -720,6 +720,30 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(squashDocs, "squash"))
 
+	mergeDocs := &cobra.Command{
+		Short: "Merge a Pachyderm resource into another.",
+		Long:  "Merge a Pachyderm resource into another.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(mergeDocs, "merge"))
+
+	protectDocs := &cobra.Command{
+		Short: "Protect a Pachyderm resource.",
+		Long:  "Protect a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(protectDocs, "protect"))
+
+	unprotectDocs := &cobra.Command{
+		Short: "Remove the protection from a Pachyderm resource.",
+		Long:  "Remove the protection from a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(unprotectDocs, "unprotect"))
+
+	approveDocs := &cobra.Command{
+		Short: "Approve a Pachyderm resource.",
+		Long:  "Approve a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(approveDocs, "approve"))
+
 	createDocs := &cobra.Command{
 		Short: "Create a new instance of a Pachyderm resource.",
 		Long:  "Create a new instance of a Pachyderm resource.",
@@ -792,6 +816,36 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(globDocs, "glob"))
 
+	presignDocs := &cobra.Command{
+		Short: "Create a presigned URL for a Pachyderm resource.",
+		Long:  "Create a presigned URL for a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(presignDocs, "presign"))
+
+	searchDocs := &cobra.Command{
+		Short: "Search for Pachyderm resources.",
+		Long:  "Search for Pachyderm resources.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(searchDocs, "search"))
+
+	traceDocs := &cobra.Command{
+		Short: "Trace the lineage of a Pachyderm resource.",
+		Long:  "Trace the lineage of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(traceDocs, "trace"))
+
+	exportDocs := &cobra.Command{
+		Short: "Export a Pachyderm resource to an archive.",
+		Long:  "Export a Pachyderm resource to an archive.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(exportDocs, "export"))
+
+	importDocs := &cobra.Command{
+		Short: "Import a Pachyderm resource from an archive.",
+		Long:  "Import a Pachyderm resource from an archive.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(importDocs, "import"))
+
 	diffDocs := &cobra.Command{
 		Short: "Show the differences between two Pachyderm resources.",
 		Long:  "Show the differences between two Pachyderm resources.",
@@ -871,27 +925,37 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"job",
 			"object",
 			"pipeline",
+			"quota",
 			"repo",
 			"tag":
 			// These are ignored - they will show up in the help topics section
 		case
+			"approve",
 			"copy",
 			"create",
 			"delete",
 			"diff",
 			"edit",
+			"export",
 			"finish",
 			"wait",
 			"get",
 			"glob",
+			"import",
 			"inspect",
 			"list",
+			"merge",
+			"presign",
+			"protect",
 			"put",
 			"restart",
+			"search",
 			"squash",
 			"start",
 			"stop",
 			"subscribe",
+			"trace",
+			"unprotect",
 			"update":
 			actions = append(actions, subcmd)
 		case
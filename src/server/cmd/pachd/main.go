@@ -31,6 +31,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
 	"github.com/pachyderm/pachyderm/v2/src/internal/profileutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tls"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
@@ -71,7 +72,7 @@ var mode string
 var readiness bool
 
 func init() {
-	flag.StringVar(&mode, "mode", "full", "Pachd currently supports four modes: full, enterprise, sidecar and paused. Full includes everything you need in a full pachd node. Enterprise runs the Enterprise Server. Sidecar runs only PFS, the Auth service, and a stripped-down version of PPS.  Paused runs all APIs other than PFS and PPS; it is intended to enable taking database backups.")
+	flag.StringVar(&mode, "mode", "full", "Pachd currently supports five modes: full, enterprise, sidecar, paused and init-kek. Full includes everything you need in a full pachd node. Enterprise runs the Enterprise Server. Sidecar runs only PFS, the Auth service, and a stripped-down version of PPS.  Paused runs all APIs other than PFS and PPS; it is intended to enable taking database backups. Init-kek creates the key file of the local key encryption key provider and exits.")
 	flag.BoolVar(&readiness, "readiness", false, "Run readiness check.")
 	flag.Parse()
 }
@@ -96,6 +97,8 @@ func main() {
 		cmdutil.Main(doSidecarMode, &serviceenv.PachdFullConfiguration{})
 	case mode == "paused":
 		cmdutil.Main(doPausedMode, &serviceenv.PachdFullConfiguration{})
+	case mode == "init-kek":
+		cmdutil.Main(doInitKEKMode, &serviceenv.PachdFullConfiguration{})
 	default:
 		fmt.Printf("unrecognized mode: %s\n", mode)
 	}
@@ -106,6 +109,25 @@ func doReadinessCheck(config interface{}) error {
 	return env.GetPachClient(context.Background()).Health()
 }
 
+// doInitKEKMode creates the key file of the local key encryption key
+// provider, if it doesn't exist, and exits.
+func doInitKEKMode(config interface{}) error {
+	path := config.(*serviceenv.PachdFullConfiguration).StorageKEKLocalPath
+	if path == "" {
+		return errors.Errorf("STORAGE_KEK_LOCAL_PATH must be set to initialize the key encryption key file")
+	}
+	created, err := chunk.InitLocalKEKFile(path)
+	if err != nil {
+		return err
+	}
+	if created {
+		log.Infof("created key encryption key file %s", path)
+	} else {
+		log.Infof("key encryption key file %s already exists", path)
+	}
+	return nil
+}
+
 func doEnterpriseMode(config interface{}) (retErr error) {
 	defer func() {
 		if retErr != nil {
@@ -578,7 +600,14 @@ func doFullMode(config interface{}) (retErr error) {
 	} else {
 		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
 	}
-	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
+	var env *serviceenv.NonblockingServiceEnv
+	if c := serviceenv.NewConfiguration(config); c.PPSInfraDriver == "local" {
+		// The local PPS infrastructure driver runs pachd and its workers
+		// outside of kubernetes, so there's no API server to connect to.
+		env = serviceenv.InitServiceEnv(c)
+	} else {
+		env = serviceenv.InitWithKube(c)
+	}
 	profileutil.StartCloudProfiler("pachyderm-pachd-full", env.Config())
 	debug.SetGCPercent(env.Config().GCPercent)
 	if env.Config().EtcdPrefix == "" {
@@ -950,7 +979,7 @@ func doFullMode(config interface{}) (retErr error) {
 		return internalServer.Wait()
 	})
 	go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
-		router := s3.Router(s3.NewMasterDriver(), env.GetPachClient)
+		router := s3.Router(s3.NewMasterDriver(), env.GetPachClient, env.AuthServer().ResolvePresignCredentials)
 		server := s3.Server(env.Config().S3GatewayPort, router)
 		certPath, keyPath, err := tls.GetCertPaths()
 		if err != nil {
@@ -1282,7 +1311,7 @@ func doPausedMode(config interface{}) (retErr error) {
 		return internalServer.Wait()
 	})
 	go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
-		router := s3.Router(s3.NewMasterDriver(), env.GetPachClient)
+		router := s3.Router(s3.NewMasterDriver(), env.GetPachClient, env.AuthServer().ResolvePresignCredentials)
 		server := s3.Server(env.Config().S3GatewayPort, router)
 		certPath, keyPath, err := tls.GetCertPaths()
 		if err != nil {
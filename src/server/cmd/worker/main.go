@@ -37,7 +37,14 @@ func main() {
 func do(config interface{}) error {
 	// must run InstallJaegerTracer before InitWithKube/pach client initialization
 	tracing.InstallJaegerTracerFromEnv()
-	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
+	// Workers run by the local driver don't have a kubernetes API to talk to.
+	workerConfig := serviceenv.NewConfiguration(config)
+	var env *serviceenv.NonblockingServiceEnv
+	if workerConfig.PPSWorkerLocal {
+		env = serviceenv.InitServiceEnv(workerConfig)
+	} else {
+		env = serviceenv.InitWithKube(workerConfig)
+	}
 
 	// Enable cloud profilers if the configuration allows.
 	profileutil.StartCloudProfiler("pachyderm-worker", env.Config())
@@ -57,7 +64,7 @@ func do(config interface{}) error {
 
 	// Construct worker API server.
 	workerRcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
-	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, "/")
+	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, env.Config().PPSWorkerRoot)
 	if err != nil {
 		return err
 	}
@@ -107,8 +114,13 @@ func do(config interface{}) error {
 		return errors.Wrapf(err, "error putting IP address")
 	}
 
-	// If server ever exits, return error
-	if _, err := server.ListenTCP("", env.Config().PPSWorkerPort); err != nil {
+	// If server ever exits, return error. Local workers share a host (and so the
+	// worker port), so each of them only listens on its own IP.
+	var host string
+	if env.Config().PPSWorkerLocal {
+		host = env.Config().PPSWorkerIP
+	}
+	if _, err := server.ListenTCP(host, env.Config().PPSWorkerPort); err != nil {
 		return err
 	}
 	return server.Wait()
@@ -29,6 +29,7 @@ import (
 	"github.com/wcharczuk/go-chart"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	describe "k8s.io/kubectl/pkg/describe"
 )
 
@@ -61,6 +62,19 @@ type collectWorkerFunc func(*tar.Writer, *v1.Pod, ...string) error
 type redirectFunc func(debug.DebugClient, *debug.Filter) (io.Reader, error)
 type collectFunc func(*tar.Writer, ...string) error
 
+// errNoKube is returned when collecting something from kubernetes, when pachd
+// isn't running in kubernetes.
+var errNoKube = errors.New("pachd is not running in kubernetes")
+
+// pods returns the client for the pods in pachd's namespace.
+func (s *debugServer) pods() (corev1.PodInterface, error) {
+	kubeClient := s.env.GetKubeClient()
+	if kubeClient == nil {
+		return nil, errNoKube
+	}
+	return kubeClient.CoreV1().Pods(s.env.Config().Namespace), nil
+}
+
 func (s *debugServer) handleRedirect(
 	pachClient *client.APIClient,
 	server grpcutil.StreamingBytesServer,
@@ -103,7 +117,11 @@ func (s *debugServer) handleRedirect(
 						return collectDebugStream(tw, r)
 
 					}
-					pod, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).Get(pachClient.Ctx(), f.Worker.Pod, metav1.GetOptions{})
+					pods, err := s.pods()
+					if err != nil {
+						return err
+					}
+					pod, err := pods.Get(pachClient.Ctx(), f.Worker.Pod, metav1.GetOptions{})
 					if err != nil {
 						return errors.EnsureStack(err)
 					}
@@ -130,7 +148,11 @@ func (s *debugServer) handleRedirect(
 }
 
 func (s *debugServer) appLogs(tw *tar.Writer) error {
-	pods, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).List(s.env.Context(), metav1.ListOptions{
+	podsClient, err := s.pods()
+	if err != nil {
+		return err
+	}
+	pods, err := podsClient.List(s.env.Context(), metav1.ListOptions{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ListOptions",
 			APIVersion: "v1",
@@ -211,7 +233,11 @@ func (s *debugServer) forEachWorker(pipelineInfo *pps.PipelineInfo, cb func(*v1.
 }
 
 func (s *debugServer) getWorkerPods(pipelineInfo *pps.PipelineInfo) ([]v1.Pod, error) {
-	podList, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).List(
+	pods, err := s.pods()
+	if err != nil {
+		return nil, err
+	}
+	podList, err := pods.List(
 		s.env.Context(),
 		metav1.ListOptions{
 			TypeMeta: metav1.TypeMeta{
@@ -571,8 +597,12 @@ func (s *debugServer) collectPachdVersion(tw *tar.Writer, pachClient *client.API
 
 func (s *debugServer) collectDescribe(tw *tar.Writer, pod string, prefix ...string) error {
 	return collectDebugFile(tw, "describe", "txt", func(w io.Writer) error {
+		kubeClient := s.env.GetKubeClient()
+		if kubeClient == nil {
+			return errNoKube
+		}
 		pd := describe.PodDescriber{
-			Interface: s.env.GetKubeClient(),
+			Interface: kubeClient,
 		}
 		output, err := pd.Describe(s.env.Config().Namespace, pod, describe.DescriberSettings{ShowEvents: true})
 		if err != nil {
@@ -585,7 +615,11 @@ func (s *debugServer) collectDescribe(tw *tar.Writer, pod string, prefix ...stri
 
 func (s *debugServer) collectLogs(tw *tar.Writer, pod, container string, prefix ...string) error {
 	if err := collectDebugFile(tw, "logs", "txt", func(w io.Writer) (retErr error) {
-		stream, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, &v1.PodLogOptions{Container: container}).Stream(s.env.Context())
+		pods, err := s.pods()
+		if err != nil {
+			return err
+		}
+		stream, err := pods.GetLogs(pod, &v1.PodLogOptions{Container: container}).Stream(s.env.Context())
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
@@ -600,7 +634,11 @@ func (s *debugServer) collectLogs(tw *tar.Writer, pod, container string, prefix
 		return err
 	}
 	return collectDebugFile(tw, "logs-previous", "txt", func(w io.Writer) (retErr error) {
-		stream, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, &v1.PodLogOptions{Container: container, Previous: true}).Stream(s.env.Context())
+		pods, err := s.pods()
+		if err != nil {
+			return err
+		}
+		stream, err := pods.GetLogs(pod, &v1.PodLogOptions{Container: container, Previous: true}).Stream(s.env.Context())
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
@@ -382,6 +382,9 @@ func (a *apiServer) rollPachd(ctx context.Context, paused bool) error {
 		kc        *kubernetes.Clientset = a.env.getKubeClient()
 		namespace                       = a.env.namespace
 	)
+	if kc == nil {
+		return errors.New("pausing is only supported when pachd runs in kubernetes")
+	}
 	cc := kc.CoreV1().ConfigMaps(namespace)
 	c, err := cc.Get(ctx, "pachd-config", metav1.GetOptions{})
 	if k8serrors.IsNotFound(err) {
@@ -466,6 +469,10 @@ func newPachdConfigMap(namespace, unpausedMode string) *v1.ConfigMap {
 }
 
 func scaleDownWorkers(ctx context.Context, kc *kubernetes.Clientset, namespace string) error {
+	if kc == nil {
+		// pachd isn't running in kubernetes, so neither are its workers
+		return nil
+	}
 	rc := kc.CoreV1().ReplicationControllers(namespace)
 	ww, err := rc.List(ctx, metav1.ListOptions{
 		LabelSelector: "suite=pachyderm,component=worker",
@@ -513,6 +520,11 @@ func (a *apiServer) Unpause(ctx context.Context, req *ec.UnpauseRequest) (resp *
 // in the indicated state.
 func (a *apiServer) PauseStatus(ctx context.Context, req *ec.PauseStatusRequest) (resp *ec.PauseStatusResponse, retErr error) {
 	kc := a.env.getKubeClient()
+	if kc == nil {
+		return &ec.PauseStatusResponse{
+			Status: ec.PauseStatusResponse_UNPAUSED,
+		}, nil
+	}
 	cc := kc.CoreV1().ConfigMaps(a.env.namespace)
 	c, err := cc.Get(ctx, "pachd-config", metav1.GetOptions{})
 	if k8serrors.IsNotFound(err) {
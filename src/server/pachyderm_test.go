@@ -4291,6 +4291,166 @@ func TestGetLogs(t *testing.T) {
 	}, backoff.NewTestingBackOff()))
 }
 
+func TestGetLogsPFS(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	dataRepo := tu.UniqueString("data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+			"echo datum-log-line",
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	// Run two jobs, so that the first job's datum is skipped by the second.
+	require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), "a", strings.NewReader("a")))
+	commitInfo, err := c.WaitCommit(pipelineName, "master", "")
+	require.NoError(t, err)
+	firstJob := commitInfo.Commit.ID
+	require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), "b", strings.NewReader("b")))
+	commitInfo, err = c.WaitCommit(pipelineName, "master", "")
+	require.NoError(t, err)
+	secondJob := commitInfo.Commit.ID
+
+	countLogs := func(iter *client.LogsIter, cb func(*pps.LogMessage)) int {
+		var n int
+		for iter.Next() {
+			if cb != nil {
+				cb(iter.Message())
+			}
+			n++
+		}
+		require.NoError(t, iter.Err())
+		return n
+	}
+	userLines := func(jobID string) int {
+		var n int
+		countLogs(c.GetLogsPFS(pipelineName, jobID, nil, "", false, time.Time{}, time.Time{}), func(msg *pps.LogMessage) {
+			require.Equal(t, jobID, msg.JobID)
+			if msg.User && msg.Message == "datum-log-line" {
+				n++
+			}
+		})
+		return n
+	}
+	// Each job only returns the logs of the datums it processed.
+	require.Equal(t, 1, userLines(firstJob))
+	require.Equal(t, 1, userLines(secondJob))
+
+	// The master's logs are kept per job.
+	require.True(t, countLogs(c.GetLogsPFS(pipelineName, firstJob, nil, "", true, time.Time{}, time.Time{}), func(msg *pps.LogMessage) {
+		require.True(t, msg.Master)
+		require.Equal(t, firstJob, msg.JobID)
+	}) > 0)
+
+	// Logs can be filtered by input file and by time.
+	require.Equal(t, 0, countLogs(c.GetLogsPFS(pipelineName, "", []string{"/a"}, "", false, time.Now(), time.Time{}), nil))
+	require.True(t, countLogs(c.GetLogsPFS(pipelineName, "", []string{"/a"}, "", false, time.Time{}, time.Now()), func(msg *pps.LogMessage) {
+		require.Equal(t, firstJob, msg.JobID)
+	}) > 0)
+
+	// Following logs is not supported by the PFS backend.
+	logsClient, err := c.PpsAPIClient.GetLogs(c.Ctx(), &pps.GetLogsRequest{
+		Pipeline:      client.NewPipeline(pipelineName),
+		UsePfsBackend: true,
+		Follow:        true,
+	})
+	require.NoError(t, err)
+	_, err = logsClient.Recv()
+	require.YesError(t, err)
+}
+
+func TestTraceFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+	dataRepo := tu.UniqueString("data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	copyPipeline := func(name, input string) {
+		require.NoError(t, c.CreatePipeline(
+			name,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", input)},
+			nil,
+			client.NewPFSInput(input, "/*"),
+			"",
+			false,
+		))
+	}
+	first := tu.UniqueString("first")
+	copyPipeline(first, dataRepo)
+	second := tu.UniqueString("second")
+	copyPipeline(second, first)
+
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "a", strings.NewReader("a")))
+	require.NoError(t, c.PutFile(commit, "b", strings.NewReader("b")))
+	require.NoError(t, c.FinishCommit(dataRepo, "master", commit.ID))
+	_, err = c.WaitCommit(second, "master", commit.ID)
+	require.NoError(t, err)
+
+	paths := func(files []*pfs.File) []string {
+		var result []string
+		for _, f := range files {
+			result = append(result, f.Commit.Branch.Repo.Name+":"+f.Path)
+		}
+		return result
+	}
+
+	// Upstream, each pipeline's datum for "a" is returned, ending at the input repo.
+	lineages, err := c.TraceFileAll(client.NewFile(second, "master", "", "/a"), pps.TraceDirection_UPSTREAM, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(lineages))
+	require.Equal(t, int64(1), lineages[0].Depth)
+	require.Equal(t, client.NewJob(second, commit.ID), lineages[0].Job)
+	require.Equal(t, []string{first + ":/a"}, paths(lineages[0].Inputs))
+	require.Equal(t, []string{second + ":/a"}, paths(lineages[0].Outputs))
+	require.Equal(t, int64(2), lineages[1].Depth)
+	require.Equal(t, client.NewJob(first, commit.ID), lineages[1].Job)
+	require.Equal(t, []string{dataRepo + ":/a"}, paths(lineages[1].Inputs))
+	require.Equal(t, []string{first + ":/a"}, paths(lineages[1].Outputs))
+
+	// Downstream, the trace can be limited to the next pipeline.
+	lineages, err = c.TraceFileAll(client.NewFile(dataRepo, "master", "", "/b"), pps.TraceDirection_DOWNSTREAM, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(lineages))
+	require.Equal(t, []string{dataRepo + ":/b"}, paths(lineages[0].Inputs))
+	require.Equal(t, []string{first + ":/b"}, paths(lineages[0].Outputs))
+	require.Equal(t, []string{first + ":/b"}, paths(lineages[1].Inputs))
+	require.Equal(t, []string{second + ":/b"}, paths(lineages[1].Outputs))
+	lineages, err = c.TraceFileAll(client.NewFile(dataRepo, "master", "", "/b"), pps.TraceDirection_DOWNSTREAM, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(lineages))
+	require.Equal(t, client.NewJob(first, commit.ID), lineages[0].Job)
+
+	// Datums skipped by later jobs keep their lineage.
+	require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), "c", strings.NewReader("c")))
+	_, err = c.WaitCommit(second, "master", "")
+	require.NoError(t, err)
+	lineages, err = c.TraceFileAll(client.NewFile(second, "master", "", "/a"), pps.TraceDirection_UPSTREAM, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(lineages))
+	require.Equal(t, client.NewJob(second, commit.ID), lineages[0].Job)
+}
+
 func TestManyLogs(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -7238,6 +7398,61 @@ func TestLongDatums(t *testing.T) {
 	}
 }
 
+func TestDatumCacheSharedBetweenPipelines(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+
+	dataRepo := tu.UniqueString("TestDatumCacheSharedBetweenPipelines_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit1, "file", strings.NewReader("foo"), client.WithAppendPutFile()))
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
+
+	// The output is different every time the datum is processed, so the
+	// pipelines only have the same output if the second pipeline restores the
+	// datum from the cache.
+	runPipeline := func() string {
+		pipeline := tu.UniqueString("pipeline")
+		_, err := c.PpsAPIClient.CreatePipeline(
+			c.Ctx(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipeline),
+				Transform: &pps.Transform{
+					Image: tu.DefaultTransformImage,
+					Cmd:   []string{"bash"},
+					Stdin: []string{"cat /proc/sys/kernel/random/uuid > /pfs/out/file"},
+				},
+				Input:      client.NewPFSInput(dataRepo, "/*"),
+				DatumCache: true,
+			},
+		)
+		require.NoError(t, err)
+		var jobInfos []*pps.JobInfo
+		require.NoErrorWithinTRetry(t, time.Minute, func() error {
+			jobInfos, err = c.ListJob(pipeline, nil, -1, false)
+			require.NoError(t, err)
+			if len(jobInfos) != 1 {
+				return errors.Errorf("expected 1 jobs, got %d", len(jobInfos))
+			}
+			return nil
+		})
+		jobInfo, err := c.WaitJob(pipeline, jobInfos[0].Job.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(jobInfo.OutputCommit, "file", &buf))
+		return buf.String()
+	}
+	output := runPipeline()
+	require.NotEqual(t, "", output)
+	require.Equal(t, output, runPipeline())
+}
+
 func TestPipelineWithDatumTimeout(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
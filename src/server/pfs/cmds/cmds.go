@@ -13,16 +13,20 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	prompt "github.com/c-bata/go-prompt"
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/clientsdk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/config"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
@@ -35,6 +39,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/cmd/pachctl/shell"
 	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/s3"
 	txncmds "github.com/pachyderm/pachyderm/v2/src/server/transaction/cmds"
 )
 
@@ -67,6 +72,10 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateDocsAlias(repoDocs, "repo", " repo$"))
 
 	var description string
+	var chunkAverageBits uint32
+	var chunkMinSize, chunkMaxSize string
+	var retention retentionFlagValues
+	var searchIndex searchIndexFlagValues
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
@@ -77,13 +86,28 @@ or type (e.g. csv, binary, images, etc).`,
 				return err
 			}
 			defer c.Close()
+			chunkingParams, err := parseChunkingParams(chunkAverageBits, chunkMinSize, chunkMaxSize)
+			if err != nil {
+				return err
+			}
+			retentionPolicy, err := retention.parse()
+			if err != nil {
+				return err
+			}
+			searchIndexOptions, err := searchIndex.parse()
+			if err != nil {
+				return err
+			}
 
 			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
 				_, err = c.PfsAPIClient.CreateRepo(
 					c.Ctx(),
 					&pfs.CreateRepoRequest{
-						Repo:        client.NewRepo(args[0]),
-						Description: description,
+						Repo:            client.NewRepo(args[0]),
+						Description:     description,
+						ChunkingParams:  chunkingParams,
+						RetentionPolicy: retentionPolicy,
+						SearchIndex:     searchIndexOptions,
 					},
 				)
 				return errors.EnsureStack(err)
@@ -92,6 +116,9 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().AddFlagSet(chunkingFlags(&chunkAverageBits, &chunkMinSize, &chunkMaxSize))
+	createRepo.Flags().AddFlagSet(retentionFlags(&retention))
+	createRepo.Flags().AddFlagSet(searchIndexFlags(&searchIndex))
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
 	updateRepo := &cobra.Command{
@@ -104,14 +131,29 @@ or type (e.g. csv, binary, images, etc).`,
 				return err
 			}
 			defer c.Close()
+			chunkingParams, err := parseChunkingParams(chunkAverageBits, chunkMinSize, chunkMaxSize)
+			if err != nil {
+				return err
+			}
+			retentionPolicy, err := retention.parse()
+			if err != nil {
+				return err
+			}
+			searchIndexOptions, err := searchIndex.parse()
+			if err != nil {
+				return err
+			}
 
 			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
 				_, err = c.PfsAPIClient.CreateRepo(
 					c.Ctx(),
 					&pfs.CreateRepoRequest{
-						Repo:        cmdutil.ParseRepo(args[0]),
-						Description: description,
-						Update:      true,
+						Repo:            cmdutil.ParseRepo(args[0]),
+						Description:     description,
+						Update:          true,
+						ChunkingParams:  chunkingParams,
+						RetentionPolicy: retentionPolicy,
+						SearchIndex:     searchIndexOptions,
 					},
 				)
 				return errors.EnsureStack(err)
@@ -120,6 +162,9 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().AddFlagSet(chunkingFlags(&chunkAverageBits, &chunkMinSize, &chunkMaxSize))
+	updateRepo.Flags().AddFlagSet(retentionFlags(&retention))
+	updateRepo.Flags().AddFlagSet(searchIndexFlags(&searchIndex))
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
@@ -248,6 +293,180 @@ or type (e.g. csv, binary, images, etc).`,
 	shell.RegisterCompletionFunc(deleteRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteRepo, "delete repo"))
 
+	var exportPath string
+	exportRepo := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Export a repo to an archive.",
+		Long: `Export a repo to an archive.
+
+The archive contains the repo's finished commits, its branches and tags, and
+all of the data they refer to, so that it can be imported into another cluster
+with 'pachctl import repo'.`,
+		Example: `
+# export repo "foo" to the file "foo.tar"
+$ {{alias}} foo -o foo.tar`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			w := io.Writer(os.Stdout)
+			if exportPath != "" {
+				f, err := os.Create(exportPath)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = errors.EnsureStack(err)
+					}
+				}()
+				w = f
+			}
+			return c.ExportRepo(args[0], w)
+		}),
+	}
+	exportRepo.Flags().StringVarP(&exportPath, "output", "o", "", "The file to write the archive to, instead of stdout.")
+	shell.RegisterCompletionFunc(exportRepo, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(exportRepo, "export repo"))
+
+	var importPath, importFrom, importTo string
+	importRepo := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Import a repo from an archive.",
+		Long: `Import a repo from an archive written by 'pachctl export repo'.
+
+The repo is created with the name of the exported repo, unless a name is given.
+The imported commits get new IDs. Use --from and --to with the IDs of exported
+commits to only import the commits created between them.`,
+		Example: `
+# import the repo in "foo.tar"
+$ {{alias}} -f foo.tar
+
+# import the repo in "foo.tar" as "bar"
+$ {{alias}} bar -f foo.tar
+
+# import the commits created after commit "XXX" in "foo.tar"
+$ {{alias}} -f foo.tar --from XXX`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			r := io.Reader(os.Stdin)
+			if importPath != "-" {
+				f, err := os.Open(importPath)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer f.Close()
+				r = f
+			}
+			var repoName string
+			if len(args) > 0 {
+				repoName = args[0]
+			}
+			response, err := c.ImportRepo(repoName, r, importFrom, importTo)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Imported %d commits into repo %v\n", response.CommitCount, response.Repo)
+			return nil
+		}),
+	}
+	importRepo.Flags().StringVarP(&importPath, "file", "f", "-", "The archive to import.")
+	importRepo.Flags().StringVar(&importFrom, "from", "", "The ID of the first exported commit to import.")
+	importRepo.Flags().StringVar(&importTo, "to", "", "The ID of the last exported commit to import.")
+	commands = append(commands, cmdutil.CreateAlias(importRepo, "import repo"))
+
+	quotaDocs := &cobra.Command{
+		Short: "Docs for quotas.",
+		Long: `Quotas limit the storage used by a repo, or by all of the repos owned by a user.
+
+The usage of a repo is the size and number of files of the most recent
+successful commit on each of its branches. A commit which would put a repo, or
+one of its owners, over quota finishes with an error, and is not counted
+towards the usage. Commits which delete data reduce the usage, so they always
+succeed. A user is charged for the commits which finish while they own the
+repo, until the commits are deleted.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(quotaDocs, "quota", " quota$"))
+
+	var principal string
+	var quotaMaxSize string
+	var quotaMaxFiles int64
+	createQuota := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Set the storage quota of a repo or a user.",
+		Long:  "Set the storage quota of a repo, or of the repos owned by a user if --principal is set. Setting a quota without any limits removes it.",
+		Example: `
+# Limit repo "test" to 10GB
+$ {{alias}} test --max-size 10GB
+
+# Limit the repos owned by user "alice" to 1TB and a million files
+$ {{alias}} --principal user:alice --max-size 1TB --max-files 1000000
+
+# Remove the quota of repo "test"
+$ {{alias}} test`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			repo, err := quotaTarget(args, principal)
+			if err != nil {
+				return err
+			}
+			quota := &pfs.Quota{MaxFileCount: quotaMaxFiles}
+			if quotaMaxSize != "" {
+				quota.MaxSizeBytes, err = units.FromHumanSize(quotaMaxSize)
+				if err != nil {
+					return errors.Wrapf(err, "could not parse --max-size")
+				}
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.CreateQuota(repo, principal, quota)
+		}),
+	}
+	createQuota.Flags().StringVar(&principal, "principal", "", "Set the quota of the repos owned by this principal (e.g. user:alice) rather than of a single repo.")
+	createQuota.Flags().StringVar(&quotaMaxSize, "max-size", "", "The maximum size, e.g. 10GB.")
+	createQuota.Flags().Int64Var(&quotaMaxFiles, "max-files", 0, "The maximum number of files.")
+	shell.RegisterCompletionFunc(createQuota, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(createQuota, "create quota"))
+
+	inspectQuota := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Return the storage quota and usage of a repo or a user.",
+		Long:  "Return the storage quota and usage of a repo, or of the repos owned by a user if --principal is set.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			repo, err := quotaTarget(args, principal)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			quotaInfo, err := c.InspectQuota(repo, principal)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(quotaInfo))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			return pretty.PrintDetailedQuotaInfo(quotaInfo)
+		}),
+	}
+	inspectQuota.Flags().StringVar(&principal, "principal", "", "Return the quota of the repos owned by this principal (e.g. user:alice) rather than of a single repo.")
+	inspectQuota.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(inspectQuota, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(inspectQuota, "inspect quota"))
+
 	commitDocs := &cobra.Command{
 		Short: "Docs for commits.",
 		Long: `Commits are atomic transactions on the content of a repo.
@@ -265,6 +484,7 @@ Commits can be created with another commit as a parent.`,
 	commands = append(commands, cmdutil.CreateDocsAlias(commitDocs, "commit", " commit$"))
 
 	var parent string
+	var commitMetadata map[string]string
 	startCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch>",
 		Short: "Start a new commit.",
@@ -277,7 +497,10 @@ $ {{alias}} test@master
 $ {{alias}} test@patch -p master
 
 # Start a commit with XXX as the parent in repo "test" on the branch "fork"
-$ {{alias}} test@fork -p XXX`,
+$ {{alias}} test@fork -p XXX
+
+# Start a commit in repo "test" on branch "master" with metadata
+$ {{alias}} test@master --metadata experiment=42 --metadata git-sha=0a1b2c3`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			branch, err := cmdutil.ParseBranch(args[0])
 			if err != nil {
@@ -308,6 +531,7 @@ $ {{alias}} test@fork -p XXX`,
 						Branch:      branch,
 						Parent:      parentCommit,
 						Description: description,
+						Metadata:    commitMetadata,
 					},
 				)
 				return errors.EnsureStack(err)
@@ -322,6 +546,7 @@ $ {{alias}} test@fork -p XXX`,
 	startCommit.MarkFlagCustom("parent", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	startCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents")
 	startCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	startCommit.Flags().StringToStringVar(&commitMetadata, "metadata", nil, "A key=value pair of metadata to set on the commit, may be specified multiple times.")
 	shell.RegisterCompletionFunc(startCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(startCommit, "start commit"))
 
@@ -360,6 +585,28 @@ $ {{alias}} test@fork -p XXX`,
 	shell.RegisterCompletionFunc(finishCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(finishCommit, "finish commit"))
 
+	approveCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Approve a commit.",
+		Long:  "Approve a finished commit, so that it can become the head of a protected branch. You can't approve a commit that you started.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := newClient("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.ApproveCommit(c.Ctx(), &pfs.ApproveCommitRequest{Commit: commit})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	shell.RegisterCompletionFunc(approveCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(approveCommit, "approve commit"))
+
 	inspectCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Return info about a commit.",
@@ -771,6 +1018,43 @@ The squash will fail if it includes a commit with no children`,
 	shell.RegisterCompletionFunc(squashCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(squashCommit, "squash commit"))
 
+	var dryRun bool
+	squashExpired := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Squash the commits which have expired under retention policies.",
+		Long: `Squash the commits which have expired under the retention policies of their branches, or of their repos if the branch has none.
+A commit is only squashed if no other commit with the same ID is kept by a retention policy. The PFS master does this periodically, this command does it immediately.
+If a repo is given, only commits with an ID that has a commit in the repo are squashed.`,
+		Example: `
+# Show which commits would be squashed
+$ {{alias}} --dry-run
+
+# Squash the expired commits in repo "test"
+$ {{alias}} test`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			var repo string
+			if len(args) > 0 {
+				repo = args[0]
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			commitSets, err := c.ApplyRetention(repo, dryRun)
+			if err != nil {
+				return err
+			}
+			for _, commitSet := range commitSets {
+				fmt.Println(commitSet.ID)
+			}
+			return nil
+		}),
+	}
+	squashExpired.Flags().BoolVar(&dryRun, "dry-run", false, "Print the IDs of the commits which would be squashed without squashing them.")
+	shell.RegisterCompletionFunc(squashExpired, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(squashExpired, "squash expired"))
+
 	deleteCommit := &cobra.Command{
 		Use:   "{{alias}} <commit-id>",
 		Short: "Delete the sub-commits of a commit.",
@@ -829,6 +1113,10 @@ Any pachctl command that can take a commit, can take a branch name instead.`,
 			if proto.Equal(trigger, &pfs.Trigger{}) {
 				trigger = nil
 			}
+			retentionPolicy, err := retention.parse()
+			if err != nil {
+				return err
+			}
 			var headCommit *pfs.Commit
 			if head != "" {
 				if strings.Contains(head, "@") {
@@ -852,10 +1140,11 @@ Any pachctl command that can take a commit, can take a branch name instead.`,
 				_, err := c.PfsAPIClient.CreateBranch(
 					c.Ctx(),
 					&pfs.CreateBranchRequest{
-						Head:       headCommit,
-						Branch:     branch,
-						Provenance: provenance,
-						Trigger:    trigger,
+						Head:            headCommit,
+						Branch:          branch,
+						Provenance:      provenance,
+						Trigger:         trigger,
+						RetentionPolicy: retentionPolicy,
 					})
 				return grpcutil.ScrubGRPC(err)
 			})
@@ -870,6 +1159,7 @@ Any pachctl command that can take a commit, can take a branch name instead.`,
 	createBranch.Flags().StringVar(&trigger.Size_, "trigger-size", "", "The data size to use in triggering.")
 	createBranch.Flags().Int64Var(&trigger.Commits, "trigger-commits", 0, "The number of commits to use in triggering.")
 	createBranch.Flags().BoolVar(&trigger.All, "trigger-all", false, "Only trigger when all conditions are met, rather than when any are met.")
+	createBranch.Flags().AddFlagSet(retentionFlags(&retention))
 	commands = append(commands, cmdutil.CreateAlias(createBranch, "create branch"))
 
 	inspectBranch := &cobra.Command{
@@ -972,6 +1262,285 @@ Any pachctl command that can take a commit, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteBranch, "delete branch"))
 
+	var mergeStrategy string
+	mergeBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<source-branch> <repo>@<target-branch>",
+		Short: "Merge a branch into another branch.",
+		Long: `Merge the changes made on a branch, since its most recent common ancestor with
+another branch in the same repo, into the other branch as a new commit.
+
+A path which was changed differently on both branches is a conflict. By default
+conflicts are reported and nothing is committed. With --strategy ours the target
+branch's version of each conflicting path is kept, and with --strategy theirs
+the source branch's version is taken.`,
+		Example: `
+# merge branch "dev" of repo "foo" into master
+$ {{alias}} foo@dev foo@master
+
+# merge branch "dev" into master, taking dev's version of conflicting paths
+$ {{alias}} foo@dev foo@master --strategy theirs`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			source, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			target, err := cmdutil.ParseBranch(args[1])
+			if err != nil {
+				return err
+			}
+			strategy, ok := pfs.MergeStrategy_value[strings.ToUpper(mergeStrategy)]
+			if !ok {
+				return errors.Errorf("unrecognized merge strategy %q, must be one of fail, ours or theirs", mergeStrategy)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.PfsAPIClient.MergeBranch(c.Ctx(), &pfs.MergeBranchRequest{
+				Source:      source,
+				Target:      target,
+				Strategy:    pfs.MergeStrategy(strategy),
+				Description: description,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if len(resp.Conflicts) > 0 {
+				writer := tabwriter.NewWriter(os.Stdout, pretty.MergeConflictHeader)
+				for _, conflict := range resp.Conflicts {
+					pretty.PrintMergeConflict(writer, conflict)
+				}
+				if err := writer.Flush(); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
+			if resp.Commit == nil {
+				if len(resp.Conflicts) > 0 {
+					return errors.Errorf("merge failed with %d conflicts, rerun with --strategy ours or --strategy theirs to resolve them", len(resp.Conflicts))
+				}
+				fmt.Println("Already up to date.")
+				return nil
+			}
+			fmt.Println(resp.Commit.ID)
+			return nil
+		}),
+	}
+	mergeBranch.Flags().StringVar(&mergeStrategy, "strategy", "fail", "How to resolve conflicts: fail, ours or theirs.")
+	mergeBranch.Flags().StringVarP(&description, "message", "m", "", "A description of the merge commit.")
+	shell.RegisterCompletionFunc(mergeBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(mergeBranch, "merge branch"))
+
+	var requiredApprovals int64
+	protectBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch>",
+		Short: "Protect a branch.",
+		Long: `Protect a branch. Commits can't be started on a protected branch directly,
+and its head can only be moved to a finished commit from another branch which
+has at least --required-approvals approvals, and commits can't be dropped or
+squashed from its head. Repo owners can bypass the protection.`,
+		Example: `
+# protect master so that its head can only be moved to a commit approved by two principals
+$ {{alias}} foo@master --required-approvals 2`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.ProtectBranch(c.Ctx(), &pfs.ProtectBranchRequest{
+				Branch:     branch,
+				Protection: &pfs.BranchProtection{RequiredApprovals: requiredApprovals},
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	protectBranch.Flags().Int64Var(&requiredApprovals, "required-approvals", 0, "The number of approvals a commit needs before it can become the head of the branch.")
+	shell.RegisterCompletionFunc(protectBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(protectBranch, "protect branch"))
+
+	unprotectBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch>",
+		Short: "Remove the protection from a branch.",
+		Long:  "Remove the protection from a branch.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.ProtectBranch(c.Ctx(), &pfs.ProtectBranchRequest{Branch: branch})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	shell.RegisterCompletionFunc(unprotectBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(unprotectBranch, "unprotect branch"))
+
+	tagDocs := &cobra.Command{
+		Short: "Docs for tags.",
+		Long: `Tags are immutable, human-readable names for finished commits.
+
+Unlike a branch, a tag can never be moved to another commit, so <repo>@<tag>
+always refers to the same data. Tags and branches share a namespace, so a tag
+can't have the same name as a branch in its repo. A tagged commit can't be
+squashed or removed by a retention policy until the tag is deleted.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(tagDocs, "tag", " tag$"))
+
+	var tagCommit string
+	createTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Create a new tag.",
+		Long:  "Create a new tag which refers to a finished commit. A tag can't be moved once it's created.",
+		Example: `
+# tag the head of master in repo "foo" as "v1.0"
+$ {{alias}} foo@v1.0 --commit master
+
+# tag a specific commit in repo "foo" as "v1.0"
+$ {{alias}} foo@v1.0 --commit 0001a0100b1c10d01111e001fg00h00i`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			if tagCommit == "" {
+				return errors.New("a commit to tag must be specified with --commit")
+			}
+			tag := branch.Repo.NewTag(branch.Name)
+			var commit *pfs.Commit
+			if strings.Contains(tagCommit, "@") {
+				commit, err = cmdutil.ParseCommit(tagCommit)
+				if err != nil {
+					return err
+				}
+			} else {
+				// treat the commit as a commit ID or branch name
+				commit = tag.Repo.NewCommit("", tagCommit)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.CreateTag(c.Ctx(), &pfs.CreateTagRequest{
+				Tag:         tag,
+				Commit:      commit,
+				Description: description,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createTag.Flags().StringVar(&tagCommit, "commit", "", "The commit or branch whose head to tag.")
+	createTag.Flags().StringVarP(&description, "description", "d", "", "A description of the tag.")
+	shell.RegisterCompletionFunc(createTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(createTag, "create tag"))
+
+	inspectTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Return info about a tag.",
+		Long:  "Return info about a tag.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			tagInfo, err := c.PfsAPIClient.InspectTag(c.Ctx(), &pfs.InspectTagRequest{Tag: branch.Repo.NewTag(branch.Name)})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(tagInfo))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.TagHeader)
+			pretty.PrintTagInfo(writer, tagInfo, fullTimestamps)
+			return writer.Flush()
+		}),
+	}
+	inspectTag.Flags().AddFlagSet(outputFlags)
+	inspectTag.Flags().AddFlagSet(timestampFlags)
+	commands = append(commands, cmdutil.CreateAlias(inspectTag, "inspect tag"))
+
+	listTag := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Return all tags in a repo.",
+		Long:  "Return all tags in a repo.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tagClient, err := c.PfsAPIClient.ListTag(c.Ctx(), &pfs.ListTagRequest{Repo: cmdutil.ParseRepo(args[0])})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				err := clientsdk.ForEachTagInfo(tagClient, func(tagInfo *pfs.TagInfo) error {
+					return errors.EnsureStack(encoder.EncodeProto(tagInfo))
+				})
+				return grpcutil.ScrubGRPC(err)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, pretty.TagHeader)
+			if err := clientsdk.ForEachTagInfo(tagClient, func(tagInfo *pfs.TagInfo) error {
+				pretty.PrintTagInfo(writer, tagInfo, fullTimestamps)
+				return nil
+			}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return writer.Flush()
+		}),
+	}
+	listTag.Flags().AddFlagSet(outputFlags)
+	listTag.Flags().AddFlagSet(timestampFlags)
+	shell.RegisterCompletionFunc(listTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(listTag, "list tag"))
+
+	deleteTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Delete a tag.",
+		Long:  "Delete a tag, while leaving the commit it refers to intact.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.DeleteTag(c.Ctx(), &pfs.DeleteTagRequest{Tag: branch.Repo.NewTag(branch.Name)})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteTag, "delete tag"))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -990,6 +1559,11 @@ from commits with 'get file'.`,
 	var compress bool
 	var enableProgress bool
 	var fullPath bool
+	var split string
+	var targetFileDatums int64
+	var targetFileBytes int64
+	var headerRecords int64
+	var fileMetadata map[string]string
 	putFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/to/file>]",
 		Short: "Put a file into the filesystem.",
@@ -1026,7 +1600,14 @@ $ {{alias}} repo@branch -i file
 # Put several files or URLs that are listed at URL.
 # NOTE this URL can reference local files, so it could cause you to put sensitive
 # files into your Pachyderm cluster.
-$ {{alias}} repo@branch -i http://host/path`,
+$ {{alias}} repo@branch -i http://host/path
+
+# Split a CSV file into a directory of files at repo@branch:/data, with 1000
+# records in each file and the CSV header at the start of every file.
+$ {{alias}} repo@branch:/data -f data.csv --split csv --target-file-datums 1000 --header-records 1
+
+# Put a file at repo@branch:/file with metadata
+$ {{alias}} repo@branch:/file -f file --metadata labeler=alice --metadata license=cc-by`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			if !enableProgress {
 				progress.Disable()
@@ -1035,6 +1616,27 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if err != nil {
 				return err
 			}
+			var putFileOpts []client.PutFileOption
+			if appendFile {
+				putFileOpts = append(putFileOpts, client.WithAppendPutFile())
+			}
+			if len(fileMetadata) > 0 {
+				putFileOpts = append(putFileOpts, client.WithMetadataPutFile(fileMetadata))
+			}
+			if split != "" {
+				delimiter, ok := pfs.Delimiter_value[strings.ToUpper(split)]
+				if !ok || pfs.Delimiter(delimiter) == pfs.Delimiter_NONE {
+					return errors.Errorf("unrecognized split format %q, must be one of line, json, sql, csv or parquet", split)
+				}
+				if appendFile {
+					return errors.Errorf("cannot set --append with --split")
+				}
+				putFileOpts = append(putFileOpts,
+					client.WithSplitPutFile(pfs.Delimiter(delimiter), targetFileDatums, targetFileBytes),
+					client.WithHeaderRecordsPutFile(headerRecords))
+			} else if targetFileDatums != 0 || targetFileBytes != 0 || headerRecords != 0 {
+				return errors.Errorf("--target-file-datums, --target-file-bytes and --header-records can only be set with --split")
+			}
 			opts := []client.Option{client.WithMaxConcurrentStreams(parallelism)}
 			if compress {
 				opts = append(opts, client.WithGZIPCompression())
@@ -1110,13 +1712,13 @@ $ {{alias}} repo@branch -i http://host/path`,
 						if !fullPath {
 							target = filepath.Base(source)
 						}
-						if err := putFileHelper(mf, joinPaths("", target), source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, joinPaths("", target), source, recursive, putFileOpts); err != nil {
 							return err
 						}
 					} else if len(sources) == 1 {
 						// We have a single source and the user has specified a path,
 						// we use the path and ignore source (in terms of naming the file).
-						if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, file.Path, source, recursive, putFileOpts); err != nil {
 							return err
 						}
 					} else {
@@ -1126,7 +1728,7 @@ $ {{alias}} repo@branch -i http://host/path`,
 						if !fullPath {
 							target = filepath.Base(source)
 						}
-						if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, putFileOpts); err != nil {
 							return err
 						}
 					}
@@ -1143,6 +1745,11 @@ $ {{alias}} repo@branch -i http://host/path`,
 	putFile.Flags().BoolVarP(&appendFile, "append", "a", false, "Append to the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
 	putFile.Flags().BoolVar(&enableProgress, "progress", isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()), "Print progress bars.")
 	putFile.Flags().BoolVar(&fullPath, "full-path", false, "If true, use the entire path provided to -f as the target filename in PFS. By default only the base of the path is used.")
+	putFile.Flags().StringVar(&split, "split", "", "Split the input into a directory of files which each contain whole records. The format of the records is one of 'line', 'json', 'sql', 'csv' or 'parquet'.")
+	putFile.Flags().Int64Var(&targetFileDatums, "target-file-datums", 0, "The number of records in each file when splitting. If neither this nor --target-file-bytes is set, each record is put in its own file.")
+	putFile.Flags().Int64Var(&targetFileBytes, "target-file-bytes", 0, "The target size of each file when splitting. Files end at the first record boundary after the target is reached.")
+	putFile.Flags().StringToStringVar(&fileMetadata, "metadata", nil, "A key=value pair of metadata to set on the files, may be specified multiple times. It is merged into the existing metadata of the files when appending.")
+	putFile.Flags().Int64Var(&headerRecords, "header-records", 0, "The number of records at the start of the input which are written to the start of every file when splitting, such as the header of a CSV file. Only supported for 'line' and 'csv'.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 			if flag == "-f" || flag == "--file" || flag == "-i" || flag == "input-file" {
@@ -1290,6 +1897,83 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 	shell.RegisterCompletionFunc(getFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(getFile, "get file"))
 
+	var presignMethod string
+	var presignExpiry time.Duration
+	var presignEndpoint string
+	presignFile := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
+		Short: "Create a presigned S3 gateway URL for a file.",
+		Long: `Create a presigned S3 gateway URL for a file, which can be used to get or put the file without credentials until it expires.
+
+The URL is signed with S3 (SigV4) credentials derived from your auth token, but doesn't contain the token. Requests made with the URL are made as your token, but only for the given method and file, and the URL stops working as soon as your token is revoked or expires. Anyone who has the URL can use it, so share it with care.`,
+		Example: `
+# create a URL for getting file "XXX" on branch "master" in repo "foo", which expires in 15 minutes
+$ {{alias}} foo@master:XXX
+
+# create a URL for putting file "XXX" on branch "master" in repo "foo", which expires in an hour
+$ {{alias}} foo@master:XXX --method PUT --expires 1h
+
+# use an S3 gateway that is not port forwarded
+$ {{alias}} foo@master:XXX --endpoint https://s3.example.com`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			file, err := cmdutil.ParseFile(args[0])
+			if err != nil {
+				return err
+			}
+			method := strings.ToUpper(presignMethod)
+			if method != http.MethodGet && method != http.MethodPut {
+				return errors.Errorf("--method must be GET or PUT")
+			}
+			endpoint := presignEndpoint
+			if endpoint == "" {
+				cfg, err := config.Read(false, false)
+				if err != nil {
+					return errors.Wrapf(err, "error reading Pachyderm config")
+				}
+				_, context, err := cfg.ActiveContext(true)
+				if err != nil {
+					return errors.Wrapf(err, "error getting the active context")
+				}
+				port, ok := context.PortForwarders["s3g"]
+				if !ok {
+					return errors.Errorf("the S3 gateway is not port forwarded, so --endpoint must be set")
+				}
+				endpoint = fmt.Sprintf("http://localhost:%d", port)
+			}
+			c, err := newClient("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if method == http.MethodPut {
+				if file.Commit.ID != "" {
+					return errors.Errorf("presigned PUT URLs must refer to a branch, not a commit")
+				}
+			} else {
+				// Resolve the commit, so the bucket name refers to a branch,
+				// and check that the file can be read before signing for it.
+				fileInfo, err := c.InspectFile(file.Commit, file.Path)
+				if err != nil {
+					return err
+				}
+				if file.Commit.ID != "" {
+					file.Commit = fileInfo.File.Commit
+				}
+			}
+			url, err := s3.PresignURL(c, endpoint, method, file, presignExpiry)
+			if err != nil {
+				return err
+			}
+			fmt.Println(url)
+			return nil
+		}),
+	}
+	presignFile.Flags().StringVar(&presignMethod, "method", http.MethodGet, "The HTTP method the URL can be used with, either GET or PUT.")
+	presignFile.Flags().DurationVar(&presignExpiry, "expires", 15*time.Minute, "How long the URL is valid for, at most 7 days.")
+	presignFile.Flags().StringVar(&presignEndpoint, "endpoint", "", "The URL of the S3 gateway, defaults to the port forwarded S3 gateway.")
+	shell.RegisterCompletionFunc(presignFile, shell.FileCompletion)
+	commands = append(commands, cmdutil.CreateAlias(presignFile, "presign file"))
+
 	inspectFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return info about a file.",
@@ -1428,6 +2112,53 @@ $ {{alias}} "foo@master:data/*"`,
 	shell.RegisterCompletionFunc(globFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(globFile, "glob file"))
 
+	var searchContent, searchName string
+	searchFile := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Search for files in a repo's search index.",
+		Long: `Search for files in a repo's search index, which is enabled with 'create repo --search-index' or 'update repo --search-index'.
+
+Each result is a file added or modified by a commit, so a search for a word
+returns the commits which introduced it. Commits are indexed in the background,
+so a commit's files may not be returned until shortly after it finishes.`,
+		Example: `
+# Return the files in repo "foo" which contain both "hello" and "world"
+$ {{alias}} foo --content "hello world"
+
+# Return the files in repo "foo" named "readme.md"
+$ {{alias}} foo --name readme.md`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			repo := cmdutil.ParseRepo(args[0])
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				return c.SearchFile(repo.Name, searchContent, searchName, func(fileInfo *pfs.FileInfo) error {
+					return errors.EnsureStack(encoder.EncodeProto(fileInfo))
+				})
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeaderWithCommit)
+			if err := c.SearchFile(repo.Name, searchContent, searchName, func(fileInfo *pfs.FileInfo) error {
+				pretty.PrintFileInfo(writer, fileInfo, fullTimestamps, true)
+				return nil
+			}); err != nil {
+				return err
+			}
+			return writer.Flush()
+		}),
+	}
+	searchFile.Flags().StringVar(&searchContent, "content", "", "Only return files which contain all of the given words.")
+	searchFile.Flags().StringVar(&searchName, "name", "", "Only return files with the given base name.")
+	searchFile.Flags().AddFlagSet(outputFlags)
+	searchFile.Flags().AddFlagSet(timestampFlags)
+	shell.RegisterCompletionFunc(searchFile, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(searchFile, "search file"))
+
 	var shallow bool
 	var nameOnly bool
 	var diffCmdArg string
@@ -1598,6 +2329,99 @@ Objects are a low-level resource and should not be accessed directly by most use
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
+	storageDocs := &cobra.Command{
+		Short: "Docs for storage.",
+		Long: `Storage commands operate on the chunk storage layer which backs pfs.
+
+They are low-level and should not be needed by most users.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(storageDocs, "storage", " storage "))
+
+	listStorageKeyVersions := &cobra.Command{
+		Short: "List the versions of the storage key encryption key.",
+		Long: `List the versions of the key encryption key which wrap the data encryption keys of chunks,
+along with the number of chunks which still reference each version.
+A version which is no longer referenced can be retired in the key provider.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.ListStorageKeyVersions(c.Ctx(), &pfs.ListStorageKeyVersionsRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.StorageKeyVersionHeader)
+			for _, version := range resp.Versions {
+				pretty.PrintStorageKeyVersion(writer, version)
+			}
+			return writer.Flush()
+		}),
+	}
+	listStorageKeyVersions.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(listStorageKeyVersions, "storage key-versions"))
+
+	rotateStorageKey := &cobra.Command{
+		Short: "Rotate the storage key encryption key.",
+		Long: `Create a new version of the key encryption key, and re-wrap the data encryption keys of all chunks with it.
+The chunk data is not rewritten.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.RotateStorageKey(c.Ctx(), &pfs.RotateStorageKeyRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Printf("Rotated to key version %d, re-wrapped %d data encryption keys.\n", resp.Version, resp.RewrappedCount)
+			return nil
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(rotateStorageKey, "storage rotate-key"))
+
+	garbageCollectStorage := &cobra.Command{
+		Short: "Garbage collect unreferenced storage.",
+		Long: `Garbage collect the file sets and chunks which are no longer referenced, and report what was reclaimed,
+broken down by tracker ID prefix. With --dry-run, only report what would be reclaimed.
+Use --raw to output a report which can be compared between runs.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.GarbageCollectStorage(c.Ctx(), &pfs.GarbageCollectStorageRequest{DryRun: dryRun})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(resp))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.GarbageCollectStorageHeader)
+			for _, prefix := range resp.Prefixes {
+				pretty.PrintGarbageCollectStoragePrefix(writer, prefix)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			fmt.Printf("Chunk objects pending deletion: %d (%s)\n", resp.PendingChunkCount, units.BytesSize(float64(resp.PendingChunkBytes)))
+			return nil
+		}),
+	}
+	garbageCollectStorage.Flags().BoolVar(&dryRun, "dry-run", false, "Only report what would be reclaimed, without deleting anything.")
+	garbageCollectStorage.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(garbageCollectStorage, "storage gc"))
+
 	var branchStr string
 	var seed int64
 	runLoadTest := &cobra.Command{
@@ -1674,13 +2498,9 @@ Objects are a low-level resource and should not be accessed directly by most use
 	return commands
 }
 
-func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendFile bool) (retErr error) {
+func putFileHelper(mf client.ModifyFile, path, source string, recursive bool, opts []client.PutFileOption) (retErr error) {
 	// Resolve the path and convert to unix path in case we're on windows.
 	path = filepath.ToSlash(filepath.Clean(path))
-	var opts []client.PutFileOption
-	if appendFile {
-		opts = append(opts, client.WithAppendPutFile())
-	}
 	// try parsing the filename as a url, if it is one do a PutFileURL
 	if url, err := url.Parse(source); err == nil && url.Scheme != "" {
 		return errors.EnsureStack(mf.PutFileURL(path, url.String(), recursive, opts...))
@@ -1708,7 +2528,7 @@ func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendF
 			// don't do a second recursive 'put file', just put the one file at
 			// filePath into childDest, and then this walk loop will go on to the
 			// next one
-			return putFileHelper(mf, childDest, filePath, false, appendFile)
+			return putFileHelper(mf, childDest, filePath, false, opts)
 		})
 		return errors.EnsureStack(err)
 	}
@@ -1832,3 +2652,125 @@ func parseOriginKind(input string) (pfs.OriginKind, error) {
 
 	return result, nil
 }
+
+func chunkingFlags(averageBits *uint32, minSize, maxSize *string) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("", pflag.ContinueOnError)
+	flags.Uint32Var(averageBits, "chunk-average-bits", 0, "Split file content into chunks of 2^N bytes on average (defaults to 23, i.e. 8MB).")
+	flags.StringVar(minSize, "chunk-min-size", "", "The minimum size of a chunk, e.g. 1MB.")
+	flags.StringVar(maxSize, "chunk-max-size", "", "The maximum size of a chunk, e.g. 20MB.")
+	return flags
+}
+
+// quotaTarget returns the repo named by args, after checking that exactly one
+// of a repo and principal was given.
+func quotaTarget(args []string, principal string) (string, error) {
+	if (len(args) == 0) == (principal == "") {
+		return "", errors.Errorf("exactly one of a repo and --principal must be given")
+	}
+	if len(args) == 0 {
+		return "", nil
+	}
+	return args[0], nil
+}
+
+type retentionFlagValues struct {
+	keepLast      int64
+	keepNewerThan time.Duration
+	keepDaily     bool
+	none          bool
+}
+
+func retentionFlags(values *retentionFlagValues) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("", pflag.ContinueOnError)
+	flags.Int64Var(&values.keepLast, "retain-last", 0, "Keep the given number of most recent commits on each branch, older commits are squashed unless another retention rule keeps them.")
+	flags.DurationVar(&values.keepNewerThan, "retain-newer-than", 0, "Keep the commits which finished within the given duration, e.g. 720h.")
+	flags.BoolVar(&values.keepDaily, "retain-daily", false, "Keep the most recent commit which finished on each day.")
+	flags.BoolVar(&values.none, "no-retention", false, "Remove the retention policy, so that no commits are squashed.")
+	return flags
+}
+
+// parse returns the retention policy set by the retention flags, or nil if
+// none of them were set.
+func (values retentionFlagValues) parse() (*pfs.RetentionPolicy, error) {
+	policy := &pfs.RetentionPolicy{
+		KeepLast:  values.keepLast,
+		KeepDaily: values.keepDaily,
+	}
+	if values.keepNewerThan != 0 {
+		policy.KeepNewerThan = types.DurationProto(values.keepNewerThan)
+	}
+	if values.none {
+		if !proto.Equal(policy, &pfs.RetentionPolicy{}) {
+			return nil, errors.Errorf("cannot set --no-retention with other retention flags")
+		}
+		return policy, nil
+	}
+	if proto.Equal(policy, &pfs.RetentionPolicy{}) {
+		return nil, nil
+	}
+	return policy, nil
+}
+
+type searchIndexFlagValues struct {
+	enabled     bool
+	disabled    bool
+	maxFileSize string
+}
+
+func searchIndexFlags(values *searchIndexFlagValues) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("", pflag.ContinueOnError)
+	flags.BoolVar(&values.enabled, "search-index", false, "Index the files added or modified by each commit, so that they can be found with 'search file'.")
+	flags.BoolVar(&values.disabled, "no-search-index", false, "Stop indexing the repo, and discard its search index.")
+	flags.StringVar(&values.maxFileSize, "search-index-max-file-size", "", "Only index the names of files larger than the given size, e.g. 10MB. Defaults to 1MiB.")
+	return flags
+}
+
+// parse returns the search index options set by the search index flags, or nil
+// if none of them were set.
+func (values searchIndexFlagValues) parse() (*pfs.SearchIndexOptions, error) {
+	if values.disabled {
+		if values.enabled || values.maxFileSize != "" {
+			return nil, errors.Errorf("cannot set --no-search-index with other search index flags")
+		}
+		return &pfs.SearchIndexOptions{}, nil
+	}
+	if values.maxFileSize != "" && !values.enabled {
+		return nil, errors.Errorf("cannot set --search-index-max-file-size without --search-index")
+	}
+	if !values.enabled {
+		return nil, nil
+	}
+	opts := &pfs.SearchIndexOptions{Enabled: true}
+	if values.maxFileSize != "" {
+		size, err := units.FromHumanSize(values.maxFileSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse --search-index-max-file-size")
+		}
+		opts.MaxFileSizeBytes = size
+	}
+	return opts, nil
+}
+
+// parseChunkingParams returns the chunking parameters set by the chunking flags,
+// or nil if none of them were set.
+func parseChunkingParams(averageBits uint32, minSize, maxSize string) (*pfs.ChunkingParams, error) {
+	if averageBits == 0 && minSize == "" && maxSize == "" {
+		return nil, nil
+	}
+	params := &pfs.ChunkingParams{AverageBits: averageBits}
+	if minSize != "" {
+		size, err := units.FromHumanSize(minSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse --chunk-min-size")
+		}
+		params.MinChunkSizeBytes = size
+	}
+	if maxSize != "" {
+		size, err := units.FromHumanSize(maxSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse --chunk-max-size")
+		}
+		params.MaxChunkSizeBytes = size
+	}
+	return params, nil
+}
@@ -0,0 +1,105 @@
+package fuse
+
+import (
+	"sync"
+	"syscall"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// fetchBlockSize is the size of the blocks that are fetched from PFS when a
+// file which is only open for reading is read.
+const fetchBlockSize = 4 * 1024 * 1024
+
+// blockFetcher fills in the blocks of a sparse local copy of a PFS file as
+// they are read, so that reading part of a file doesn't download all of it.
+type blockFetcher struct {
+	c    *client.APIClient
+	file *pfs.File
+	size int64
+	// done returns true once the local copy doesn't need to be fetched
+	// anymore, because it has been downloaded in full or written to.
+	done func() bool
+
+	// mu protects fetched and fetching. It isn't held while blocks are
+	// fetched, so reads of different blocks don't wait for each other.
+	mu      sync.Mutex
+	fetched map[int64]bool
+	// fetching has a channel for each block that is being fetched, which is
+	// closed once the fetch finishes.
+	fetching map[int64]chan struct{}
+}
+
+func newBlockFetcher(c *client.APIClient, file *pfs.File, size int64, done func() bool) *blockFetcher {
+	return &blockFetcher{
+		c:        c,
+		file:     file,
+		size:     size,
+		done:     done,
+		fetched:  make(map[int64]bool),
+		fetching: make(map[int64]chan struct{}),
+	}
+}
+
+// fetch writes the blocks which overlap [off, off+size) to fd, if they
+// haven't been written already.
+func (bf *blockFetcher) fetch(fd int, off, size int64) error {
+	if bf.done() {
+		return nil
+	}
+	end := off + size
+	if end > bf.size {
+		end = bf.size
+	}
+	for block := off / fetchBlockSize; block*fetchBlockSize < end; block++ {
+		if err := bf.fetchBlock(fd, block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bf *blockFetcher) fetchBlock(fd int, block int64) error {
+	// wait for any fetch of the block that is already in progress
+	bf.mu.Lock()
+	for !bf.fetched[block] && bf.fetching[block] != nil {
+		ch := bf.fetching[block]
+		bf.mu.Unlock()
+		<-ch
+		bf.mu.Lock()
+	}
+	if bf.fetched[block] {
+		bf.mu.Unlock()
+		return nil
+	}
+	ch := make(chan struct{})
+	bf.fetching[block] = ch
+	bf.mu.Unlock()
+
+	w := &offsetWriter{fd: fd, offset: block * fetchBlockSize}
+	err := bf.c.GetFile(bf.file.Commit, bf.file.Path, w, client.WithOffset(w.offset), client.WithLength(fetchBlockSize))
+
+	bf.mu.Lock()
+	defer bf.mu.Unlock()
+	delete(bf.fetching, block)
+	close(ch)
+	if err != nil {
+		return err
+	}
+	bf.fetched[block] = true
+	return nil
+}
+
+// offsetWriter writes to a file descriptor, starting at offset.
+type offsetWriter struct {
+	fd     int
+	offset int64
+}
+
+func (w *offsetWriter) Write(data []byte) (int, error) {
+	n, err := syscall.Pwrite(w.fd, data, w.offset)
+	w.offset += int64(n)
+	return n, errors.EnsureStack(err)
+}
@@ -23,6 +23,8 @@ func NewLoopbackFile(fd int) fs.FileHandle {
 type loopbackFile struct {
 	mu sync.Mutex
 	fd int
+	// fetcher, if set, fetches the parts of the file that are read.
+	fetcher *blockFetcher
 }
 
 var _ = (fs.FileHandle)((*loopbackFile)(nil))
@@ -40,6 +42,16 @@ var _ = (fs.FileSetattrer)((*loopbackFile)(nil))
 var _ = (fs.FileAllocater)((*loopbackFile)(nil))
 
 func (f *loopbackFile) Read(ctx context.Context, buf []byte, off int64) (res fuse.ReadResult, errno syscall.Errno) {
+	// The fetcher synchronizes fetches itself, so f.mu isn't held while the
+	// blocks being read are fetched from PFS.
+	if f.fetcher != nil {
+		f.mu.Lock()
+		fd := f.fd
+		f.mu.Unlock()
+		if err := f.fetcher.fetch(fd, off, int64(len(buf))); err != nil {
+			return nil, fs.ToErrno(err)
+		}
+	}
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	r := fuse.ReadResultFd(uintptr(f.fd), off, len(buf))
@@ -395,6 +395,19 @@ func (n *loopbackNode) Open(ctx context.Context, flags uint32) (fh fs.FileHandle
 		}
 		state = dirty
 	}
+	// Files which are only being read are fetched a block at a time as they
+	// are read, rather than being downloaded in full up front.
+	var file *pfs.File
+	if state == full && n.getFileState(p) < full {
+		var err error
+		file, err = n.pfsFile(p)
+		if err != nil {
+			return nil, 0, fs.ToErrno(err)
+		}
+		if file != nil {
+			state = meta
+		}
+	}
 	if err := n.download(p, state); err != nil {
 		return nil, 0, fs.ToErrno(err)
 	}
@@ -409,8 +422,18 @@ func (n *loopbackNode) Open(ctx context.Context, flags uint32) (fh fs.FileHandle
 	if err != nil {
 		return nil, 0, fs.ToErrno(err)
 	}
-	lf := NewLoopbackFile(f)
-	return lf, 0, 0
+	if file == nil {
+		return NewLoopbackFile(f), 0, 0
+	}
+	var st syscall.Stat_t
+	if err := syscall.Fstat(f, &st); err != nil {
+		syscall.Close(f)
+		return nil, 0, fs.ToErrno(err)
+	}
+	bf := newBlockFetcher(n.c(), file, st.Size, func() bool {
+		return n.getFileState(p) >= full
+	})
+	return &loopbackFile{fd: f, fetcher: bf}, 0, 0
 }
 
 func (n *loopbackNode) Opendir(ctx context.Context) syscall.Errno {
@@ -661,6 +684,28 @@ func (n *loopbackNode) download(origPath string, state fileState) (retErr error)
 	return nil
 }
 
+// pfsFile returns the PFS file that origPath refers to, or nil if it doesn't
+// refer to a file in a mounted commit.
+func (n *loopbackNode) pfsFile(origPath string) (*pfs.File, error) {
+	parts := strings.Split(n.trimPath(origPath), "/")
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, nil
+	}
+	name := parts[0]
+	ro, ok := n.root().repoOpts[name]
+	if !ok {
+		return nil, nil
+	}
+	commit, err := n.commit(name)
+	if err != nil {
+		return nil, err
+	}
+	if commit == "" {
+		return nil, nil
+	}
+	return client.NewCommit(ro.Repo, n.root().branch(name), commit).NewFile(pathpkg.Join(parts[1:]...)), nil
+}
+
 func (n *loopbackNode) trimPath(path string) string {
 	path = strings.TrimPrefix(path, n.root().rootPath)
 	return strings.TrimPrefix(path, "/")
@@ -127,6 +127,45 @@ type ErrSquashWithoutChildren struct {
 	Commit *pfs.Commit
 }
 
+// ErrBranchProtected represents an error where a protected branch would be
+// modified in a way that its protection doesn't allow.
+type ErrBranchProtected struct {
+	Branch *pfs.Branch
+	Reason string
+}
+
+// ErrTagNotFound represents a tag-not-found error.
+type ErrTagNotFound struct {
+	Tag *pfs.Tag
+}
+
+// ErrTagExists represents a tag-exists error.
+type ErrTagExists struct {
+	Tag *pfs.Tag
+}
+
+// ErrCommitTagged represents an error where a tagged commit would be squashed
+// or dropped.
+type ErrCommitTagged struct {
+	Commit *pfs.Commit
+	Tag    *pfs.Tag
+}
+
+// ErrQuotaExceeded represents an error where finishing a commit would put a
+// repo, or the repos owned by an auth principal, over their storage quota.
+type ErrQuotaExceeded struct {
+	Commit *pfs.Commit
+	// Target is the repo or principal whose quota is exceeded.
+	Target string
+	Reason string
+}
+
+// ErrCacheNotFound represents an error where a key is not in the cache, or
+// its entry has expired.
+type ErrCacheNotFound struct {
+	Key string
+}
+
 // ErrDropWithChildren represents an error when attempting to drop a commit that
 // has children.  Because proper datum removal semantics have not been
 // implemented in the middle of a commit chain, this operation is unsupported.
@@ -248,6 +287,54 @@ func (e ErrSquashWithoutChildren) Error() string {
 	return fmt.Sprintf("cannot squash a commit that has no children as that would cause data loss, use the drop operation instead: %s", e.Commit)
 }
 
+func (e ErrBranchProtected) Error() string {
+	return fmt.Sprintf("branch %v is protected: %s", e.Branch, e.Reason)
+}
+
+func (e ErrBranchProtected) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+func (e ErrTagNotFound) Error() string {
+	return fmt.Sprintf("tag %q not found in repo %v", e.Tag.Name, e.Tag.Repo)
+}
+
+func (e ErrTagNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+func (e ErrTagExists) Error() string {
+	return fmt.Sprintf("tag %q already exists in repo %v", e.Tag.Name, e.Tag.Repo)
+}
+
+func (e ErrTagExists) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
+
+func (e ErrCommitTagged) Error() string {
+	return fmt.Sprintf("commit %v is tagged as %q, delete the tag first", e.Commit, e.Tag.Name)
+}
+
+func (e ErrCommitTagged) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("commit %v exceeds the storage quota of %s: %s", e.Commit, e.Target, e.Reason)
+}
+
+func (e ErrQuotaExceeded) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, e.Error())
+}
+
+func (e ErrCacheNotFound) Error() string {
+	return fmt.Sprintf("cache key %q not found", e.Key)
+}
+
+func (e ErrCacheNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
 func (e ErrDropWithChildren) Error() string {
 	return fmt.Sprintf("cannot drop a commit that has children: %s", e.Commit)
 }
@@ -270,6 +357,12 @@ var (
 	commitOnOutputBranchRe    = regexp.MustCompile("cannot start a commit on an output branch")
 	squashWithoutChildrenRe   = regexp.MustCompile("cannot squash a commit that has no children")
 	dropWithChildrenRe        = regexp.MustCompile("cannot drop a commit that has children")
+	quotaExceededRe           = regexp.MustCompile("commit [^ ]+ exceeds the storage quota")
+	branchProtectedRe         = regexp.MustCompile("branch [^ ]+ is protected")
+	tagNotFoundRe             = regexp.MustCompile(`tag [^ ]+ not found in repo [^ ]+`)
+	tagExistsRe               = regexp.MustCompile(`tag [^ ]+ already exists in repo [^ ]+`)
+	commitTaggedRe            = regexp.MustCompile("commit [^ ]+ is tagged as")
+	cacheNotFoundRe           = regexp.MustCompile(`cache key ".*" not found`)
 )
 
 // IsCommitNotFoundErr returns true if 'err' has an error message that matches
@@ -426,6 +519,61 @@ func IsDropWithChildrenErr(err error) bool {
 	return dropWithChildrenRe.MatchString(err.Error())
 }
 
+// IsBranchProtectedErr returns true if 'err' has an error message that matches
+// ErrBranchProtected
+func IsBranchProtectedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return branchProtectedRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
+// IsTagNotFoundErr returns true if 'err' has an error message that matches
+// ErrTagNotFound
+func IsTagNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return tagNotFoundRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
+// IsTagExistsErr returns true if 'err' has an error message that matches
+// ErrTagExists
+func IsTagExistsErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return tagExistsRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
+// IsCommitTaggedErr returns true if 'err' has an error message that matches
+// ErrCommitTagged
+func IsCommitTaggedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return commitTaggedRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
+// IsQuotaExceededErr returns true if 'err' has an error message that matches
+// ErrQuotaExceeded. The error of a commit which exceeded a quota can be checked
+// with errors.New(commitInfo.Error).
+func IsQuotaExceededErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return quotaExceededRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
+// IsCacheNotFoundErr returns true if 'err' has an error message that matches
+// ErrCacheNotFound
+func IsCacheNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return cacheNotFoundRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
 func ValidateSQLDatabaseEgress(sql *pfs.SQLDatabaseEgress) error {
 	if sql == nil {
 		return nil
@@ -437,5 +585,8 @@ func ValidateSQLDatabaseEgress(sql *pfs.SQLDatabaseEgress) error {
 	if secret.Name == "" || secret.Key == "" {
 		return errors.Errorf("egress.sql_database.secret.name and egress.sql_database.secret.key are required")
 	}
+	if sql.Mode == pfs.SQLDatabaseEgress_INCREMENTAL && len(sql.PrimaryKey) == 0 {
+		return errors.Errorf("egress.sql_database.primary_key is required in INCREMENTAL mode")
+	}
 	return nil
 }
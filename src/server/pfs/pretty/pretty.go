@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	units "github.com/docker/go-units"
@@ -32,6 +33,14 @@ const (
 	FileHeaderWithCommit = "COMMIT\tNAME\tTYPE\tCOMMITTED\tSIZE\t\n"
 	// DiffFileHeader is the header for files produced by diff file.
 	DiffFileHeader = "OP\t" + FileHeader
+	// StorageKeyVersionHeader is the header for storage key versions.
+	StorageKeyVersionHeader = "VERSION\tCHUNKS\tCURRENT\t\n"
+	// GarbageCollectStorageHeader is the header for storage garbage collection reports.
+	GarbageCollectStorageHeader = "PREFIX\tOBJECTS\tSIZE\t\n"
+	// MergeConflictHeader is the header for merge conflicts.
+	MergeConflictHeader = "PATH\tOURS\tTHEIRS\t\n"
+	// TagHeader is the header for tags.
+	TagHeader = "TAG\tCOMMIT\tCREATED\tDESCRIPTION\t\n"
 )
 
 // PrintRepoInfo pretty-prints repo info.
@@ -75,7 +84,10 @@ func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 Description: {{.Description}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}{{if .Details}}
-Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .AuthInfo}}
+Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .ChunkingParams}}
+Chunking Params: {{printChunkingParams .ChunkingParams}}{{end}}{{if .RetentionPolicy}}
+Retention Policy: {{printRetentionPolicy .RetentionPolicy}}{{end}}{{if .SearchIndex}}
+Search Index: {{printSearchIndexOptions .SearchIndex}}{{end}}{{if .AuthInfo}}
 Roles: {{ .AuthInfo.Roles | commafy }}
 Permissions: {{ .AuthInfo.Permissions | commafy }}{{end}}
 `)
@@ -85,6 +97,74 @@ Permissions: {{ .AuthInfo.Permissions | commafy }}{{end}}
 	return errors.EnsureStack(template.Execute(os.Stdout, repoInfo))
 }
 
+func printChunkingParams(params *pfs.ChunkingParams) string {
+	var parts []string
+	if params.AverageBits != 0 {
+		parts = append(parts, fmt.Sprintf("average 2^%d bytes", params.AverageBits))
+	}
+	if params.MinChunkSizeBytes != 0 {
+		parts = append(parts, fmt.Sprintf("min %s", pretty.Size(params.MinChunkSizeBytes)))
+	}
+	if params.MaxChunkSizeBytes != 0 {
+		parts = append(parts, fmt.Sprintf("max %s", pretty.Size(params.MaxChunkSizeBytes)))
+	}
+	if len(parts) == 0 {
+		return "default"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// PrintDetailedQuotaInfo pretty-prints a quota and its usage.
+func PrintDetailedQuotaInfo(quotaInfo *pfs.QuotaInfo) error {
+	template, err := template.New("QuotaInfo").Funcs(funcMap).Parse(
+		`{{if .Repo}}Repo: {{.Repo.Name}}{{else}}Principal: {{.Principal}}{{end}}
+Size: {{prettySize .SizeBytes}}{{if .Quota}}{{if .Quota.MaxSizeBytes}} of {{prettySize .Quota.MaxSizeBytes}}{{end}}{{end}}
+Files: {{.FileCount}}{{if .Quota}}{{if .Quota.MaxFileCount}} of {{.Quota.MaxFileCount}}{{end}}{{end}}
+`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(template.Execute(os.Stdout, quotaInfo))
+}
+
+func printApprovals(approvals []*pfs.Approval) string {
+	var principals []string
+	for _, approval := range approvals {
+		if approval.Principal == "" {
+			principals = append(principals, "-")
+			continue
+		}
+		principals = append(principals, approval.Principal)
+	}
+	return strings.Join(principals, ", ")
+}
+
+func printRetentionPolicy(policy *pfs.RetentionPolicy) string {
+	var parts []string
+	if policy.KeepLast != 0 {
+		parts = append(parts, fmt.Sprintf("keep last %d", policy.KeepLast))
+	}
+	if policy.KeepNewerThan != nil {
+		if d, err := types.DurationFromProto(policy.KeepNewerThan); err == nil {
+			parts = append(parts, fmt.Sprintf("keep newer than %s", d))
+		}
+	}
+	if policy.KeepDaily {
+		parts = append(parts, "keep daily")
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func printSearchIndexOptions(opts *pfs.SearchIndexOptions) string {
+	if opts.MaxFileSizeBytes != 0 {
+		return fmt.Sprintf("enabled, contents of files up to %s", pretty.Size(opts.MaxFileSizeBytes))
+	}
+	return "enabled"
+}
+
 func printTrigger(trigger *pfs.Trigger) string {
 	var conds []string
 	if trigger.CronSpec != "" {
@@ -121,13 +201,64 @@ func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintln(w)
 }
 
+// PrintTagInfo pretty-prints a tag.
+func PrintTagInfo(w io.Writer, tagInfo *pfs.TagInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t%s\t", tagInfo.Tag.Name, tagInfo.Commit.ID)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", tagInfo.Created.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(tagInfo.Created))
+	}
+	fmt.Fprintf(w, "%s\t\n", tagInfo.Description)
+}
+
+// PrintStorageKeyVersion pretty-prints a storage key version.
+func PrintStorageKeyVersion(w io.Writer, version *pfs.StorageKeyVersion) {
+	fmt.Fprintf(w, "%d\t%d\t", version.Version, version.ChunkCount)
+	if version.Current {
+		fmt.Fprintf(w, "*\t")
+	} else {
+		fmt.Fprintf(w, "\t")
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintGarbageCollectStoragePrefix pretty-prints the reclaimable objects with a tracker ID prefix.
+func PrintGarbageCollectStoragePrefix(w io.Writer, prefix *pfs.GarbageCollectStoragePrefix) {
+	fmt.Fprintf(w, "%s\t%d\t", prefix.Prefix, prefix.ObjectCount)
+	if prefix.SizeBytes > 0 {
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(prefix.SizeBytes)))
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintMergeConflict pretty-prints how each branch changed a conflicting path.
+func PrintMergeConflict(w io.Writer, conflict *pfs.MergeConflict) {
+	fmt.Fprintf(w, "%s\t%s\t%s\t\n", conflict.Path, mergeChange(conflict.Base, conflict.Ours), mergeChange(conflict.Base, conflict.Theirs))
+}
+
+func mergeChange(base, fileInfo *pfs.FileInfo) string {
+	switch {
+	case fileInfo == nil:
+		return "deleted"
+	case base == nil:
+		return "added"
+	default:
+		return "modified"
+	}
+}
+
 // PrintDetailedBranchInfo pretty-prints detailed branch info.
 func PrintDetailedBranchInfo(branchInfo *pfs.BranchInfo) error {
 	template, err := template.New("BranchInfo").Funcs(funcMap).Parse(
 		`Name: {{.Branch.Repo.Name}}@{{.Branch.Name}}{{if .Head}}
 Head Commit: {{ .Head.Branch.Repo.Name}}@{{.Head.ID}} {{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}@{{.Name}} {{end}} {{end}}{{if .Trigger}}
-Trigger: {{printTrigger .Trigger}} {{end}}
+Trigger: {{printTrigger .Trigger}} {{end}}{{if .RetentionPolicy}}
+Retention Policy: {{printRetentionPolicy .RetentionPolicy}}{{end}}{{if .Protection}}
+Protection: {{.Protection.RequiredApprovals}} required approvals{{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -232,12 +363,15 @@ func PrintDetailedCommitInfo(w io.Writer, commitInfo *PrintableCommitInfo) error
 		`Commit: {{.Commit.Branch.Repo.Name}}@{{.Commit.ID}}
 Original Branch: {{.Commit.Branch.Name}}{{if .Description}}
 Description: {{.Description}}{{end}}{{if .ParentCommit}}
-Parent: {{.ParentCommit.ID}}{{end}}{{if .FullTimestamps}}
+Parent: {{.ParentCommit.ID}}{{end}}{{if .Merged}}
+Merged: {{.Merged.Branch.Name}}@{{.Merged.ID}}{{end}}{{if .FullTimestamps}}
 Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
 Finished: {{.Finished}}{{else}}
 Finished: {{prettyAgo .Finished}}{{end}}{{end}}{{if .Details}}
-Size: {{prettySize .Details.SizeBytes}}{{end}}
+Size: {{prettySize .Details.SizeBytes}}{{end}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}}{{end}}{{if .Approvals}}
+Approvals: {{printApprovals .Approvals}}{{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -287,7 +421,8 @@ func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 		`Path: {{.File.Path}}
 Datum: {{.File.Datum}}
 Type: {{fileType .FileType}}
-Size: {{prettySize .SizeBytes}}
+Size: {{prettySize .SizeBytes}}{{if .Metadata}}
+Metadata: {{printMetadata .Metadata}}{{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -302,12 +437,26 @@ func fileType(fileType pfs.FileType) string {
 	return "dir"
 }
 
+func printMetadata(metadata map[string]string) string {
+	var pairs []string
+	for k, v := range metadata {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
 var funcMap = template.FuncMap{
-	"prettyAgo":    pretty.Ago,
-	"prettySize":   pretty.Size,
-	"fileType":     fileType,
-	"printTrigger": printTrigger,
-	"commafy":      pretty.Commafy,
+	"prettyAgo":               pretty.Ago,
+	"prettySize":              pretty.Size,
+	"fileType":                fileType,
+	"printTrigger":            printTrigger,
+	"printChunkingParams":     printChunkingParams,
+	"printRetentionPolicy":    printRetentionPolicy,
+	"printSearchIndexOptions": printSearchIndexOptions,
+	"printApprovals":          printApprovals,
+	"commafy":                 pretty.Commafy,
+	"printMetadata":           printMetadata,
 }
 
 // CompactPrintCommit renders 'c' as a compact string, e.g.
@@ -5,10 +5,12 @@ package s3
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/s2"
 )
 
 func (c *controller) SecretKey(r *http.Request, accessKey string, region *string) (*string, error) {
@@ -40,6 +42,38 @@ func (c *controller) SecretKey(r *http.Request, accessKey string, region *string
 
 func (c *controller) CustomAuth(r *http.Request) (bool, error) {
 	c.logger.Debug("CustomAuth")
+
+	// The s2 library only handles header based auth, so presigned URLs are
+	// verified here. They are signed with credentials derived from an auth
+	// token, and requests made with them are authorized as that token.
+	if isPresigned(r) {
+		if c.resolvePresign == nil {
+			return false, s2.NotImplementedError(r)
+		}
+		var token string
+		_, err := verifyPresigned(r, time.Now(), func(accessKey string) (string, error) {
+			var secret string
+			var err error
+			token, secret, err = c.resolvePresign(r.Context(), accessKey)
+			return secret, err
+		})
+		if err != nil {
+			if auth.IsErrNotActivated(err) {
+				return true, nil
+			}
+			if auth.IsErrBadPresignCredentials(err) {
+				return false, s2.NewError(r, http.StatusForbidden, "AccessDenied", "Presigned URL is invalid, or its token has expired or been revoked")
+			}
+			if _, ok := err.(*s2.Error); ok {
+				return false, err
+			}
+			return false, s2.InternalError(r, err)
+		}
+		vars := mux.Vars(r)
+		vars["authAccessKey"] = token
+		return true, nil
+	}
+
 	pc := c.clientFactory(r.Context())
 	active, err := pc.IsAuthActive()
 	if err != nil {
@@ -4,13 +4,19 @@
 package s3
 
 import (
+	"encoding/xml"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/gorilla/mux"
 	glob "github.com/pachyderm/ohmyglob"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -247,12 +253,342 @@ func (c *controller) DeleteBucket(r *http.Request, bucketName string) error {
 }
 
 func (c *controller) ListObjectVersions(r *http.Request, bucketName, prefix, keyMarker, versionIDMarker string, delimiter string, maxKeys int) (*s2.ListObjectVersionsResult, error) {
-	// NOTE: because this endpoint isn't implemented, conformance tests will
-	// fail on teardown. It's nevertheless unimplemented because it's too
-	// expensive to pull off with PFS until this is implemented:
-	// https://github.com/pachyderm/pachyderm/v2/issues/3896
+	result, err := c.listObjectVersions(r, bucketName, prefix, keyMarker, versionIDMarker, delimiter, maxKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &s2.ListObjectVersionsResult{
+		Versions:      result.Versions,
+		DeleteMarkers: result.DeleteMarkers,
+		IsTruncated:   result.IsTruncated,
+	}, nil
+}
+
+// listVersionsResult is a page of object versions. Unlike
+// s2.ListObjectVersionsResult, it has the common prefixes and the markers to
+// resume listing from, which the s2 library doesn't support, so it is
+// written by listVersionsMiddleware.
+type listVersionsResult struct {
+	XMLName             xml.Name             `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListVersionsResult"`
+	Name                string               `xml:"Name"`
+	Prefix              string               `xml:"Prefix"`
+	Delimiter           string               `xml:"Delimiter,omitempty"`
+	KeyMarker           string               `xml:"KeyMarker"`
+	VersionIDMarker     string               `xml:"VersionIdMarker"`
+	NextKeyMarker       string               `xml:"NextKeyMarker,omitempty"`
+	NextVersionIDMarker string               `xml:"NextVersionIdMarker,omitempty"`
+	MaxKeys             int                  `xml:"MaxKeys"`
+	IsTruncated         bool                 `xml:"IsTruncated"`
+	Versions            []*s2.Version        `xml:"Version"`
+	DeleteMarkers       []*s2.DeleteMarker   `xml:"DeleteMarker"`
+	CommonPrefixes      []*s2.CommonPrefixes `xml:"CommonPrefixes"`
+}
+
+// listVersionsMiddleware serves ListObjectVersions requests with
+// listObjectVersions, rather than with the s2 library's handler. It runs after
+// the s2 library's middleware, so requests are authenticated.
+func (c *controller) listVersionsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		_, hasKey := vars["key"]
+		if _, ok := r.URL.Query()["versions"]; !ok || r.Method != http.MethodGet || vars["bucket"] == "" || hasKey {
+			next.ServeHTTP(w, r)
+			return
+		}
+		maxKeys := defaultMaxKeys
+		if v := r.FormValue("max-keys"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				s2.WriteError(c.logger, w, r, s2.InvalidArgumentError(r))
+				return
+			}
+			if n < maxKeys {
+				maxKeys = n
+			}
+		}
+		result, err := c.listObjectVersions(r, vars["bucket"], r.FormValue("prefix"), r.FormValue("key-marker"), r.FormValue("version-id-marker"), r.FormValue("delimiter"), maxKeys)
+		if err != nil {
+			s2.WriteError(c.logger, w, r, err)
+			return
+		}
+		// some clients (e.g. minio-python) can't handle sub-seconds in
+		// datetime output
+		for _, v := range result.Versions {
+			v.LastModified = v.LastModified.UTC().Round(time.Second)
+			if !strings.HasPrefix(v.ETag, "\"") {
+				v.ETag = fmt.Sprintf("%q", v.ETag)
+			}
+		}
+		for _, dm := range result.DeleteMarkers {
+			dm.LastModified = dm.LastModified.UTC().Round(time.Second)
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.Header().Set("x-amz-id-2", vars["requestID"])
+		w.Header().Set("x-amz-request-id", vars["requestID"])
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, xml.Header)
+		if err := xml.NewEncoder(w).Encode(result); err != nil {
+			c.logger.Errorf("could not encode xml response: %v", err)
+		}
+	})
+}
+
+// listObjectVersions lists a page of the versions of the objects in a bucket.
+// If historic versions are enabled, versions are listed newest commit first,
+// and by key within a commit, so that listing can resume from the commit of
+// versionIDMarker, after keyMarker, without walking the newer commits again.
+// Otherwise only the current version of each object is listed, by key, after
+// keyMarker. If delimiter is set, the keys which contain it after prefix are
+// grouped into common prefixes.
+func (c *controller) listObjectVersions(r *http.Request, bucketName, prefix, keyMarker, versionIDMarker string, delimiter string, maxKeys int) (*listVersionsResult, error) {
 	c.logger.Debugf("ListObjectVersions: bucketName=%+v, prefix=%+v, keyMarker=%+v, versionIDMarker=%+v, delimiter=%+v, maxKeys=%+v", bucketName, prefix, keyMarker, versionIDMarker, delimiter, maxKeys)
-	return nil, s2.NotImplementedError(r)
+
+	result := &listVersionsResult{
+		Name:            bucketName,
+		Prefix:          prefix,
+		Delimiter:       delimiter,
+		KeyMarker:       keyMarker,
+		VersionIDMarker: versionIDMarker,
+		MaxKeys:         maxKeys,
+		Versions:        []*s2.Version{},
+		DeleteMarkers:   []*s2.DeleteMarker{},
+		CommonPrefixes:  []*s2.CommonPrefixes{},
+	}
+
+	// Strip / from prefix to normalize: "/" means "all objects" and "/foo"
+	// means the same as "foo"
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	pc := c.requestClient(r)
+	if delimiter != "" && delimiter != "/" {
+		return nil, invalidDelimiterError(r)
+	}
+
+	bucket, err := c.driver.bucket(pc, r, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	bucketCaps, err := c.driver.bucketCapabilities(pc, r, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bucketCaps.readable {
+		// serve empty results if we can't read the bucket; this helps with s3
+		// conformance
+		return result, nil
+	}
+
+	page := &versionPage{
+		result:    result,
+		prefix:    prefix,
+		delimiter: delimiter,
+		maxKeys:   maxKeys,
+		seen:      make(map[string]bool),
+	}
+	if !bucketCaps.historicVersions {
+		err = listCurrentVersions(pc, bucket, keyMarker, page)
+	} else {
+		err = listHistoricVersions(pc, bucket, keyMarker, versionIDMarker, page)
+	}
+	if err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return nil, err
+	}
+	return result, nil
+}
+
+// objectVersion is a version or a delete marker of an object.
+type objectVersion struct {
+	version      *s2.Version
+	deleteMarker *s2.DeleteMarker
+}
+
+func (v *objectVersion) id() string {
+	if v.version != nil {
+		return v.version.Version
+	}
+	return v.deleteMarker.Version
+}
+
+// versionPage accumulates a page of ListObjectVersions results.
+type versionPage struct {
+	result            *listVersionsResult
+	prefix, delimiter string
+	maxKeys, n        int
+	// seen are the keys and common prefixes which were listed before the
+	// current point of the listing, possibly on earlier pages.
+	seen map[string]bool
+}
+
+// listingKey is the key that an object is listed under: its common prefix if
+// it has one, and its own key otherwise.
+func (p *versionPage) listingKey(key string) string {
+	if p.delimiter != "" {
+		if i := strings.Index(key[len(p.prefix):], p.delimiter); i >= 0 {
+			return key[:len(p.prefix)+i+len(p.delimiter)]
+		}
+	}
+	return key
+}
+
+// skip records that key was listed on an earlier page.
+func (p *versionPage) skip(key string) {
+	p.seen[key] = true
+	p.seen[p.listingKey(key)] = true
+}
+
+// add adds a version or delete marker of key to the page. Only the first
+// entry of a key is the latest one, and common prefixes are only listed once.
+// It returns errutil.ErrBreak once the page is full.
+func (p *versionPage) add(key string, entry *objectVersion) error {
+	listingKey := p.listingKey(key)
+	latest := !p.seen[key]
+	if listingKey != key && p.seen[listingKey] {
+		p.seen[key] = true
+		return nil
+	}
+	if p.n >= p.maxKeys {
+		p.result.IsTruncated = true
+		return errutil.ErrBreak
+	}
+	p.n++
+	p.skip(key)
+	p.result.NextKeyMarker = listingKey
+	p.result.NextVersionIDMarker = entry.id()
+	if listingKey != key {
+		p.result.CommonPrefixes = append(p.result.CommonPrefixes, &s2.CommonPrefixes{
+			Prefix: listingKey,
+			Owner:  defaultUser,
+		})
+		return nil
+	}
+	if entry.version != nil {
+		entry.version.IsLatest = latest
+		p.result.Versions = append(p.result.Versions, entry.version)
+	} else {
+		entry.deleteMarker.IsLatest = latest
+		p.result.DeleteMarkers = append(p.result.DeleteMarkers, entry.deleteMarker)
+	}
+	return nil
+}
+
+// listCurrentVersions adds the current version of the objects after keyMarker
+// to page.
+func listCurrentVersions(pc *client.APIClient, bucket *Bucket, keyMarker string, page *versionPage) error {
+	var opts []client.ListFileOption
+	if keyMarker != "" {
+		token := pfsClient.NewFilePageToken(&pfsClient.FileInfo{File: bucket.Commit.NewFile("/" + keyMarker)})
+		opts = append(opts, client.WithPageListFile(0, token))
+	}
+	return pc.GlobFile(bucket.Commit, "**", func(fileInfo *pfsClient.FileInfo) error {
+		key := strings.TrimPrefix(fileInfo.File.Path, "/")
+		if fileInfo.FileType != pfsClient.FileType_FILE || !strings.HasPrefix(key, page.prefix) || page.listingKey(key) <= keyMarker {
+			return nil
+		}
+		v, err := newVersion(fileInfo, "")
+		if err != nil {
+			return err
+		}
+		return page.add(key, &objectVersion{version: v})
+	}, opts...)
+}
+
+// listHistoricVersions adds the versions of the objects to page, walking the
+// history of the bucket's branch from the commit of versionIDMarker, or from
+// the head if it isn't set. A version is emitted for every commit in which an
+// object changed, and a delete marker for every commit in which it was
+// deleted. Keys up to keyMarker are skipped in the marker's commit, or in
+// every commit if only keyMarker is set.
+func listHistoricVersions(pc *client.APIClient, bucket *Bucket, keyMarker, versionIDMarker string, page *versionPage) error {
+	start := bucket.Commit
+	if versionIDMarker != "" {
+		start = bucket.Commit.Branch.NewCommit(versionIDMarker)
+		// The objects which changed after the marker's commit were listed on
+		// earlier pages, so their versions in older commits aren't the
+		// latest. Changes which were later reverted don't show up in the
+		// diff, so their older versions may also be marked as the latest.
+		head, err := pc.InspectCommit(bucket.Commit.Branch.Repo.Name, bucket.Commit.Branch.Name, bucket.Commit.ID)
+		if err != nil {
+			return err
+		}
+		for head.Finished == nil && head.ParentCommit != nil {
+			if head, err = pc.InspectCommit(head.ParentCommit.Branch.Repo.Name, head.ParentCommit.Branch.Name, head.ParentCommit.ID); err != nil {
+				return err
+			}
+		}
+		if head.Commit.ID != versionIDMarker {
+			if err := pc.DiffFile(head.Commit, "", start, "", false, func(newFileInfo, oldFileInfo *pfsClient.FileInfo) error {
+				for _, fi := range []*pfsClient.FileInfo{newFileInfo, oldFileInfo} {
+					if fi != nil && fi.FileType == pfsClient.FileType_FILE {
+						page.skip(strings.TrimPrefix(fi.File.Path, "/"))
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return pc.ListCommitF(bucket.Commit.Branch.Repo, start, nil, 0, false, func(commitInfo *pfsClient.CommitInfo) error {
+		if commitInfo.Finished == nil {
+			// skip the open head commit; it is not a version yet
+			return nil
+		}
+		finished, err := types.TimestampFromProto(commitInfo.Finished)
+		if err != nil {
+			return err
+		}
+		// Keys are diffed in order, so the keys up to the marker were listed
+		// on the previous page.
+		markerCommit := versionIDMarker == "" || commitInfo.Commit.ID == versionIDMarker
+		return pc.DiffFile(commitInfo.Commit, "", nil, "", false, func(newFileInfo, oldFileInfo *pfsClient.FileInfo) error {
+			var key string
+			var entry *objectVersion
+			switch {
+			case newFileInfo != nil && newFileInfo.FileType == pfsClient.FileType_FILE:
+				key = strings.TrimPrefix(newFileInfo.File.Path, "/")
+				v, err := newVersion(newFileInfo, commitInfo.Commit.ID)
+				if err != nil {
+					return err
+				}
+				entry = &objectVersion{version: v}
+			case newFileInfo == nil && oldFileInfo != nil && oldFileInfo.FileType == pfsClient.FileType_FILE:
+				key = strings.TrimPrefix(oldFileInfo.File.Path, "/")
+				entry = &objectVersion{deleteMarker: &s2.DeleteMarker{
+					Key:          key,
+					Version:      commitInfo.Commit.ID,
+					LastModified: finished,
+					Owner:        defaultUser,
+				}}
+			default:
+				return nil
+			}
+			if !strings.HasPrefix(key, page.prefix) {
+				return nil
+			}
+			if markerCommit && keyMarker != "" && page.listingKey(key) <= keyMarker {
+				page.skip(key)
+				return nil
+			}
+			return page.add(key, entry)
+		})
+	})
+}
+
+func newVersion(fileInfo *pfsClient.FileInfo, version string) (*s2.Version, error) {
+	t, err := types.TimestampFromProto(fileInfo.Committed)
+	if err != nil {
+		return nil, err
+	}
+	return &s2.Version{
+		Key:          strings.TrimPrefix(fileInfo.File.Path, "/"),
+		Version:      version,
+		LastModified: t,
+		ETag:         fmt.Sprintf("%x", fileInfo.Hash),
+		Size:         uint64(fileInfo.SizeBytes),
+		StorageClass: globalStorageClass,
+		Owner:        defaultUser,
+	}, nil
 }
 
 func (c *controller) GetBucketVersioning(r *http.Request, bucketName string) (string, error) {
@@ -0,0 +1,39 @@
+package s3
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/s2"
+)
+
+func TestVersionPage(t *testing.T) {
+	version := func(key, id string) *objectVersion {
+		return &objectVersion{version: &s2.Version{Key: key, Version: id}}
+	}
+	page := &versionPage{
+		result:    &listVersionsResult{},
+		delimiter: "/",
+		maxKeys:   3,
+		seen:      make(map[string]bool),
+	}
+	// "old" was listed on an earlier page.
+	page.skip("old")
+	require.NoError(t, page.add("dir/a", version("dir/a", "2")))
+	require.NoError(t, page.add("dir/b", version("dir/b", "2")))
+	require.NoError(t, page.add("file", version("file", "2")))
+	require.NoError(t, page.add("old", version("old", "1")))
+	require.True(t, errors.Is(page.add("file", version("file", "1")), errutil.ErrBreak))
+
+	result := page.result
+	require.Equal(t, 1, len(result.CommonPrefixes))
+	require.Equal(t, "dir/", result.CommonPrefixes[0].Prefix)
+	require.Equal(t, 2, len(result.Versions))
+	require.True(t, result.Versions[0].IsLatest)
+	require.False(t, result.Versions[1].IsLatest)
+	require.True(t, result.IsTruncated)
+	require.Equal(t, "old", result.NextKeyMarker)
+	require.Equal(t, "1", result.NextVersionIDMarker)
+}
@@ -1,15 +1,19 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
 	"time"
 
 	minio "github.com/minio/minio-go/v6"
+	miniov7 "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -79,6 +83,34 @@ func masterGetObject(t *testing.T, pachClient *client.APIClient, minioClient *mi
 	require.Equal(t, "content", fetchedContent)
 }
 
+func masterPresignedObject(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testpresignedobject")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	endpoint := minioClient.EndpointURL().String()
+	file := client.NewFile(repo, "master", "", "file")
+
+	url, err := PresignURL(pachClient, endpoint, http.MethodPut, file, time.Minute)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader("content"))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	url, err = PresignURL(pachClient, endpoint, http.MethodGet, file, time.Minute)
+	require.NoError(t, err)
+	resp, err = http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	content, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "content", string(content))
+	// auth isn't active, so the URL isn't signed
+	require.False(t, strings.Contains(url, "X-Amz-Signature"))
+}
+
 func masterGetObjectInBranch(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 	repo := tu.UniqueString("testgetobjectinbranch")
 	require.NoError(t, pachClient.CreateRepo(repo))
@@ -446,6 +478,72 @@ func masterListObjectsRecursive(t *testing.T, pachClient *client.APIClient, mini
 	checkListObjects(t, ch, &startTime, &endTime, expectedFiles, []string{})
 }
 
+func masterListObjectVersions(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testlistobjectversions")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("1")))
+	require.NoError(t, pachClient.PutFile(commit, "other", strings.NewReader("1")))
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("2")))
+	require.NoError(t, pachClient.DeleteFile(commit, "other"))
+	require.NoError(t, pachClient.PutFile(commit, "dir/file", strings.NewReader("1")))
+
+	// minio-go v6 can't list object versions, so a v7 client is used instead
+	minioClientV7, err := miniov7.New(minioClient.EndpointURL().Host, &miniov7.Options{
+		Creds: credentials.NewStaticV4("", "", ""),
+	})
+	require.NoError(t, err)
+	bucket := fmt.Sprintf("master.%s", repo)
+	listVersions := func(prefix string, recursive bool) []miniov7.ObjectInfo {
+		var objs []miniov7.ObjectInfo
+		for obj := range minioClientV7.ListObjects(context.Background(), bucket, miniov7.ListObjectsOptions{
+			Prefix:       prefix,
+			Recursive:    recursive,
+			WithVersions: true,
+			MaxKeys:      1,
+		}) {
+			require.NoError(t, obj.Err)
+			objs = append(objs, obj)
+		}
+		return objs
+	}
+
+	// Pages hold a single version, so listing resumes from version markers.
+	// Versions are listed newest commit first.
+	objs := listVersions("", true)
+	require.Equal(t, 5, len(objs))
+	require.Equal(t, "dir/file", objs[0].Key)
+	require.True(t, objs[0].IsLatest)
+	require.Equal(t, "other", objs[1].Key)
+	require.True(t, objs[1].IsDeleteMarker)
+	require.True(t, objs[1].IsLatest)
+	require.Equal(t, "file", objs[2].Key)
+	require.True(t, objs[2].IsLatest)
+	require.Equal(t, "other", objs[3].Key)
+	require.False(t, objs[3].IsDeleteMarker)
+	require.False(t, objs[3].IsLatest)
+	require.Equal(t, "file", objs[4].Key)
+	require.False(t, objs[4].IsLatest)
+
+	// every version can be fetched by its version ID
+	for i, expected := range map[int]string{2: "2", 4: "1"} {
+		obj, err := minioClientV7.GetObject(context.Background(), bucket, "file", miniov7.GetObjectOptions{VersionID: objs[i].VersionID})
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(obj)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(content))
+	}
+
+	objs = listVersions("fi", true)
+	require.Equal(t, 2, len(objs))
+
+	// Without recursion, the versions under dir/ are grouped into a common
+	// prefix.
+	objs = listVersions("", false)
+	require.Equal(t, 5, len(objs))
+	require.Equal(t, "dir/", objs[0].Key)
+}
+
 func masterListSystemRepoBuckets(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 	repo := tu.UniqueString("listsystemrepo")
 	require.NoError(t, pachClient.CreateRepo(repo))
@@ -542,6 +640,9 @@ func TestMasterDriver(t *testing.T) {
 		t.Run("GetObject", func(t *testing.T) {
 			masterGetObject(t, pachClient, minioClient)
 		})
+		t.Run("PresignedObject", func(t *testing.T) {
+			masterPresignedObject(t, pachClient, minioClient)
+		})
 		t.Run("GetObjectInBranch", func(t *testing.T) {
 			masterGetObjectInBranch(t, pachClient, minioClient)
 		})
@@ -599,6 +700,9 @@ func TestMasterDriver(t *testing.T) {
 		t.Run("ListObjectsRecursive", func(t *testing.T) {
 			masterListObjectsRecursive(t, pachClient, minioClient)
 		})
+		t.Run("ListObjectVersions", func(t *testing.T) {
+			masterListObjectVersions(t, pachClient, minioClient)
+		})
 		t.Run("ListSystemRepoBucket", func(t *testing.T) {
 			masterListSystemRepoBuckets(t, pachClient, minioClient)
 		})
@@ -35,15 +35,15 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, s2.NoSuchKeyError(r)
 	}
 
-	commitID := bucket.Commit.ID
+	commit := bucket.Commit
 	if version != "" {
 		if !bucketCaps.historicVersions {
 			return nil, s2.NotImplementedError(r)
 		}
-		commitID = version
+		commit = commit.Branch.NewCommit(version)
 	}
 
-	fileInfo, err := pc.InspectFile(bucket.Commit, file)
+	fileInfo, err := pc.InspectFile(commit, file)
 	if err != nil {
 		return nil, maybeNotFoundError(r, err)
 	}
@@ -53,7 +53,7 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, err
 	}
 
-	content, err := pc.GetFileReadSeeker(bucket.Commit, file)
+	content, err := pc.GetFileReadSeeker(commit, file)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +62,7 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		ModTime:      modTime,
 		Content:      content,
 		ETag:         fmt.Sprintf("%x", fileInfo.Hash),
-		Version:      commitID,
+		Version:      commit.ID,
 		DeleteMarker: false,
 	}
 
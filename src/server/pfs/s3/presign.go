@@ -0,0 +1,199 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/s2"
+)
+
+const (
+	presignAlgorithm   = "AWS4-HMAC-SHA256"
+	presignTimeFormat  = "20060102T150405Z"
+	presignDateFormat  = "20060102"
+	presignMaxSkew     = 15 * time.Minute
+	presignPayloadHash = "UNSIGNED-PAYLOAD"
+	// MaxPresignExpiry is the longest a presigned URL can be valid for, which
+	// is the same limit as S3's.
+	MaxPresignExpiry = 7 * 24 * time.Hour
+)
+
+// BucketName returns the name of the bucket which serves commit in the
+// s3gateway instance running on pachd.
+func BucketName(commit *pfs.Commit) string {
+	var name string
+	if commit.Branch.Repo.Type == pfs.UserRepoType {
+		name = fmt.Sprintf("%s.%s", commit.Branch.Name, commit.Branch.Repo.Name)
+	} else {
+		name = fmt.Sprintf("%s.%s.%s", commit.Branch.Name, commit.Branch.Repo.Type, commit.Branch.Repo.Name)
+	}
+	if commit.ID != "" {
+		name = fmt.Sprintf("%s.%s", commit.ID, name)
+	}
+	return name
+}
+
+// PresignURL creates a URL for the s3gateway at endpoint, which allows
+// requests with the given HTTP method to be made on file until the URL
+// expires. The URL is signed with SigV4 query string auth, using the presign
+// credentials of the auth token pc is authenticated with, so requests made
+// with the URL are authorized as that token. The URL never contains the
+// token. If auth is not active, the URL isn't signed.
+func PresignURL(pc *client.APIClient, endpoint, method string, file *pfs.File, expires time.Duration) (string, error) {
+	if expires < time.Second || expires > MaxPresignExpiry {
+		return "", errors.Errorf("presigned URL expiry must be between 1s and %v", MaxPresignExpiry)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not parse s3gateway endpoint %q", endpoint)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", errors.Errorf("s3gateway endpoint %q must include a scheme and host", endpoint)
+	}
+	u.Path = "/" + BucketName(file.Commit) + "/" + strings.TrimPrefix(file.Path, "/")
+	u.RawPath = escapePath(u.Path)
+	resp, err := pc.GetPresignCredentials(pc.Ctx(), &auth.GetPresignCredentialsRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return u.String(), nil
+		}
+		return "", errors.EnsureStack(err)
+	}
+	req, err := http.NewRequest(method, u.String(), nil)
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	signer := v4.NewSigner(credentials.NewStaticCredentials(resp.AccessKeyID, resp.SecretAccessKey, ""), func(s *v4.Signer) {
+		// the path is already escaped the way S3 expects
+		s.DisableURIPathEscaping = true
+	})
+	if _, err := signer.Presign(req, nil, "s3", globalLocation, expires, time.Now()); err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return req.URL.String(), nil
+}
+
+// PresignResolver returns the auth token that the access key ID of a
+// presigned URL was created for, and the secret key that the URL must be
+// signed with. The token is only used within pachd.
+type PresignResolver func(ctx context.Context, accessKeyID string) (token, secret string, err error)
+
+// isPresigned returns whether the request uses SigV4 query string auth.
+func isPresigned(r *http.Request) bool {
+	return r.URL.Query().Get("X-Amz-Algorithm") != ""
+}
+
+// verifyPresigned checks the SigV4 query string signature and expiry of a
+// presigned request. secretKey is called with the access key in the
+// signature, and its errors are returned as is. It returns the access key of
+// a valid request.
+func verifyPresigned(r *http.Request, now time.Time, secretKey func(accessKey string) (string, error)) (string, error) {
+	query := r.URL.Query()
+	if query.Get("X-Amz-Algorithm") != presignAlgorithm {
+		return "", s2.InvalidRequestError(r, fmt.Sprintf("X-Amz-Algorithm must be %s", presignAlgorithm))
+	}
+	// the credential is <access key>/<date>/<region>/s3/aws4_request
+	credential := strings.Split(query.Get("X-Amz-Credential"), "/")
+	if len(credential) != 5 || credential[3] != "s3" || credential[4] != "aws4_request" {
+		return "", s2.AuthorizationHeaderMalformedError(r)
+	}
+	accessKey, date, region := credential[0], credential[1], credential[2]
+	timestamp, err := time.Parse(presignTimeFormat, query.Get("X-Amz-Date"))
+	if err != nil || timestamp.Format(presignDateFormat) != date {
+		return "", s2.AuthorizationHeaderMalformedError(r)
+	}
+	expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+	if err != nil || expires <= 0 || time.Duration(expires)*time.Second > MaxPresignExpiry {
+		return "", s2.InvalidRequestError(r, fmt.Sprintf("X-Amz-Expires must be between 1 and %d seconds", int(MaxPresignExpiry.Seconds())))
+	}
+	if timestamp.After(now.Add(presignMaxSkew)) {
+		return "", s2.RequestTimeTooSkewedError(r)
+	}
+	if now.After(timestamp.Add(time.Duration(expires) * time.Second)) {
+		return "", s2.NewError(r, http.StatusForbidden, "AccessDenied", "Request has expired")
+	}
+	signedHeaderKeys := strings.Split(query.Get("X-Amz-SignedHeaders"), ";")
+	sort.Strings(signedHeaderKeys)
+	var signedHeaders strings.Builder
+	for _, key := range signedHeaderKeys {
+		signedHeaders.WriteString(key)
+		signedHeaders.WriteString(":")
+		if key == "host" {
+			signedHeaders.WriteString(r.Host)
+		} else {
+			signedHeaders.WriteString(strings.TrimSpace(r.Header.Get(key)))
+		}
+		signedHeaders.WriteString("\n")
+	}
+	expectedSignature := query.Get("X-Amz-Signature")
+	query.Del("X-Amz-Signature")
+	payloadHash := presignPayloadHash
+	if h := r.Header.Get("X-Amz-Content-Sha256"); h != "" {
+		payloadHash = h
+	}
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		escapePath(r.URL.Path),
+		strings.Replace(query.Encode(), "+", "%20", -1),
+		signedHeaders.String(),
+		strings.Join(signedHeaderKeys, ";"),
+		payloadHash,
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		presignAlgorithm,
+		query.Get("X-Amz-Date"),
+		fmt.Sprintf("%s/%s/s3/aws4_request", date, region),
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+	secret, err := secretKey(accessKey)
+	if err != nil {
+		return "", err
+	}
+	signingKey := hmacSHA256([]byte("AWS4"+secret), date)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+	if !hmac.Equal([]byte(signature), []byte(expectedSignature)) {
+		return "", s2.SignatureDoesNotMatchError(r)
+	}
+	return accessKey, nil
+}
+
+func hmacSHA256(key []byte, content string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(content))
+	return mac.Sum(nil)
+}
+
+// escapePath escapes every byte of a URL path except for the unreserved
+// characters and '/', which is how S3 escapes paths in canonical requests.
+func escapePath(path string) string {
+	var sb strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,72 @@
+package s3
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestBucketName(t *testing.T) {
+	require.Equal(t, "master.repo", BucketName(client.NewCommit("repo", "master", "")))
+	require.Equal(t, "0123456789abcdef0123456789abcdef.master.repo", BucketName(client.NewCommit("repo", "master", "0123456789abcdef0123456789abcdef")))
+	require.Equal(t, "master.meta.repo", BucketName(client.NewSystemRepo("repo", pfs.MetaRepoType).NewCommit("master", "")))
+}
+
+func TestVerifyPresigned(t *testing.T) {
+	now := time.Now()
+	presign := func(method, path, secret string, expires time.Duration) *http.Request {
+		req, err := http.NewRequest(method, "http://localhost:30600"+escapePath(path), nil)
+		require.NoError(t, err)
+		req.URL.RawPath = escapePath(path)
+		signer := v4.NewSigner(credentials.NewStaticCredentials("access", secret, ""), func(s *v4.Signer) {
+			s.DisableURIPathEscaping = true
+		})
+		_, err = signer.Presign(req, nil, "s3", globalLocation, expires, now)
+		require.NoError(t, err)
+		// the gateway sees the request the way a client sends it
+		r := httptest.NewRequest(method, req.URL.String(), nil)
+		require.True(t, isPresigned(r))
+		return r
+	}
+	secretKey := func(accessKey string) (string, error) {
+		if accessKey != "access" {
+			return "", auth.ErrBadPresignCredentials
+		}
+		return "secret", nil
+	}
+
+	r := presign(http.MethodPut, "/master.repo/dir/some file (1).txt", "secret", time.Hour)
+	accessKey, err := verifyPresigned(r, now, secretKey)
+	require.NoError(t, err)
+	require.Equal(t, "access", accessKey)
+
+	// a URL signed with another secret, or for another method, doesn't verify
+	r = presign(http.MethodPut, "/master.repo/file", "other", time.Hour)
+	_, err = verifyPresigned(r, now, secretKey)
+	require.YesError(t, err)
+	r = presign(http.MethodGet, "/master.repo/file", "secret", time.Hour)
+	r.Method = http.MethodDelete
+	_, err = verifyPresigned(r, now, secretKey)
+	require.YesError(t, err)
+
+	// expired URLs are rejected
+	r = presign(http.MethodGet, "/master.repo/file", "secret", time.Minute)
+	_, err = verifyPresigned(r, now.Add(2*time.Minute), secretKey)
+	require.YesError(t, err)
+
+	// errors from secretKey are returned as is
+	_, err = verifyPresigned(presign(http.MethodGet, "/master.repo/file", "secret", time.Hour), now, func(string) (string, error) {
+		return "", auth.ErrBadPresignCredentials
+	})
+	require.True(t, auth.IsErrBadPresignCredentials(err))
+
+	require.False(t, isPresigned(httptest.NewRequest(http.MethodGet, "/master.repo/file", nil)))
+}
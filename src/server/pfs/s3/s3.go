@@ -29,6 +29,9 @@ const (
 	maxRequestBodyLength = 128 * 1024 * 1024 //128mb
 	requestTimeout       = 10 * time.Second
 	readBodyTimeout      = 5 * time.Second
+	// defaultMaxKeys is the default and largest number of keys listed in a
+	// page, which matches S3.
+	defaultMaxKeys = 1000
 
 	// The S3 storage class that all PFS content will be reported to be stored in
 	globalStorageClass = "STANDARD"
@@ -53,6 +56,9 @@ type controller struct {
 	driver Driver
 
 	clientFactory ClientFactory
+
+	// resolvePresign is nil if presigned URLs aren't supported
+	resolvePresign PresignResolver
 }
 
 // requestPachClient uses the clientFactory to construct a request-scoped
@@ -92,7 +98,10 @@ func (c *controller) requestClient(r *http.Request) *client.APIClient {
 // Note: In `s3cmd`, you must set the access key and secret key, even though
 // this API will ignore them - otherwise, you'll get an opaque config error:
 // https://github.com/s3tools/s3cmd/issues/845#issuecomment-464885959
-func Router(driver Driver, clientFactory ClientFactory) *mux.Router {
+//
+// `resolvePresign` is used to authorize requests made with presigned URLs. If
+// it's nil, presigned URLs aren't supported.
+func Router(driver Driver, clientFactory ClientFactory, resolvePresign PresignResolver) *mux.Router {
 	logger := logrus.WithFields(logrus.Fields{
 		"source": "s3gateway",
 	})
@@ -103,6 +112,7 @@ func Router(driver Driver, clientFactory ClientFactory) *mux.Router {
 		maxAllowedParts: maxAllowedParts,
 		driver:          driver,
 		clientFactory:   clientFactory,
+		resolvePresign:  resolvePresign,
 	}
 
 	s3Server := s2.NewS2(logger, maxRequestBodyLength, readBodyTimeout)
@@ -111,7 +121,9 @@ func Router(driver Driver, clientFactory ClientFactory) *mux.Router {
 	s3Server.Bucket = c
 	s3Server.Object = c
 	s3Server.Multipart = c
-	return s3Server.Router()
+	router := s3Server.Router()
+	router.Use(c.listVersionsMiddleware)
+	return router
 }
 
 // S3Server wraps an HTTP server with an S3-like API for PFS. This allows you to
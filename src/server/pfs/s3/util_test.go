@@ -132,7 +132,7 @@ func fileHash(t *testing.T, name string) (int64, []byte) {
 func testRunner(t *testing.T, pachClient *client.APIClient, group string, driver Driver, runner func(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client)) {
 	router := Router(driver, func(_ctx context.Context) *client.APIClient {
 		return pachClient.WithCtx(context.Background())
-	})
+	}, nil)
 	server := Server(0, router)
 	listener, err := net.Listen("tcp", ":0")
 	require.NoError(t, err)
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -84,7 +85,7 @@ func (a *apiServer) CreateRepoInTransaction(txnCtx *txncontext.TransactionContex
 	if repo := request.GetRepo(); repo != nil && repo.Name == fileSetsRepo {
 		return errors.Errorf("%s is a reserved name", fileSetsRepo)
 	}
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update, request.ChunkingParams, request.RetentionPolicy, request.SearchIndex)
 }
 
 // CreateRepo implements the protobuf pfs.CreateRepo RPC
@@ -147,10 +148,55 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+// ExportRepo implements the protobuf pfs.ExportRepo RPC
+func (a *apiServer) ExportRepo(request *pfs.ExportRepoRequest, server pfs.API_ExportRepoServer) (retErr error) {
+	return grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
+		return a.driver.exportRepo(server.Context(), request.Repo, w)
+	})
+}
+
+// ImportRepo implements the protobuf pfs.ImportRepo RPC
+func (a *apiServer) ImportRepo(server pfs.API_ImportRepoServer) (retErr error) {
+	request, err := server.Recv()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	response, err := a.driver.importRepo(server.Context(), request.Repo, request.From, request.To, &importRepoReader{
+		server: server,
+		data:   request.Data,
+	})
+	if err != nil {
+		return err
+	}
+	return errors.EnsureStack(server.SendAndClose(response))
+}
+
+// importRepoReader reads the archive data sent to ImportRepo.
+type importRepoReader struct {
+	server pfs.API_ImportRepoServer
+	data   []byte
+}
+
+func (r *importRepoReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		request, err := r.server.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, io.EOF
+			}
+			return 0, errors.EnsureStack(err)
+		}
+		r.data = request.Data
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
 // StartCommitInTransaction is identical to StartCommit except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) StartCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.StartCommitRequest) (*pfs.Commit, error) {
-	return a.driver.startCommit(txnCtx, request.Parent, request.Branch, request.Description)
+	return a.driver.startCommit(txnCtx, request.Parent, request.Branch, request.Description, request.Metadata)
 }
 
 // StartCommit implements the protobuf pfs.StartCommit RPC
@@ -198,7 +244,11 @@ func (a *apiServer) InspectCommit(ctx context.Context, request *pfs.InspectCommi
 
 // ListCommit implements the protobuf pfs.ListCommit RPC
 func (a *apiServer) ListCommit(request *pfs.ListCommitRequest, respServer pfs.API_ListCommitServer) (retErr error) {
-	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, request.All, request.OriginKind, func(ci *pfs.CommitInfo) error {
+	page, err := newCommitPage(request.Filter, request.PageSize, request.PageToken)
+	if err != nil {
+		return err
+	}
+	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, request.All, request.OriginKind, page, func(ci *pfs.CommitInfo) error {
 		return errors.EnsureStack(respServer.Send(ci))
 	})
 }
@@ -248,6 +298,38 @@ func (a *apiServer) DropCommitSet(ctx context.Context, request *pfs.DropCommitSe
 	return &types.Empty{}, nil
 }
 
+// ApplyRetention implements the protobuf pfs.ApplyRetention RPC
+func (a *apiServer) ApplyRetention(ctx context.Context, request *pfs.ApplyRetentionRequest) (response *pfs.ApplyRetentionResponse, retErr error) {
+	commitSets, err := a.driver.applyRetention(ctx, request.Repo, request.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.ApplyRetentionResponse{CommitSets: commitSets}, nil
+}
+
+// CreateQuota implements the protobuf pfs.CreateQuota RPC
+func (a *apiServer) CreateQuota(ctx context.Context, request *pfs.CreateQuotaRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.createQuota(txnCtx, request.Repo, request.Principal, request.Quota)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectQuota implements the protobuf pfs.InspectQuota RPC
+func (a *apiServer) InspectQuota(ctx context.Context, request *pfs.InspectQuotaRequest) (response *pfs.QuotaInfo, retErr error) {
+	var quotaInfo *pfs.QuotaInfo
+	if err := a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		quotaInfo, err = a.driver.inspectQuota(txnCtx, request.Repo, request.Principal)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return quotaInfo, nil
+}
+
 // SubscribeCommit implements the protobuf pfs.SubscribeCommit RPC
 func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream pfs.API_SubscribeCommitServer) (retErr error) {
 	return a.driver.subscribeCommit(stream.Context(), request.Repo, request.Branch, request.From, request.State, request.All, request.OriginKind, stream.Send)
@@ -261,7 +343,7 @@ func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitReq
 // CreateBranchInTransaction is identical to CreateBranch except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) CreateBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.CreateBranchRequest) error {
-	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger)
+	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger, request.RetentionPolicy)
 }
 
 // CreateBranch implements the protobuf pfs.CreateBranch RPC
@@ -323,6 +405,9 @@ func (a *apiServer) ListBranch(request *pfs.ListBranchRequest, srv pfs.API_ListB
 // DeleteBranchInTransaction is identical to DeleteBranch except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) DeleteBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.DeleteBranchRequest) error {
+	if err := a.driver.checkProtectedDelete(txnCtx, request.Branch); err != nil {
+		return err
+	}
 	return a.driver.deleteBranch(txnCtx, request.Branch, request.Force)
 }
 
@@ -336,6 +421,70 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+// ProtectBranch implements the protobuf pfs.ProtectBranch RPC
+func (a *apiServer) ProtectBranch(ctx context.Context, request *pfs.ProtectBranchRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.protectBranch(txnCtx, request.Branch, request.Protection)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ApproveCommit implements the protobuf pfs.ApproveCommit RPC
+func (a *apiServer) ApproveCommit(ctx context.Context, request *pfs.ApproveCommitRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.approveCommit(txnCtx, request.Commit)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// MergeBranch implements the protobuf pfs.MergeBranch RPC
+func (a *apiServer) MergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (response *pfs.MergeBranchResponse, retErr error) {
+	return a.driver.mergeBranch(ctx, request.Source, request.Target, request.Strategy, request.Description)
+}
+
+// CreateTag implements the protobuf pfs.CreateTag RPC
+func (a *apiServer) CreateTag(ctx context.Context, request *pfs.CreateTagRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.createTag(txnCtx, request.Tag, request.Commit, request.Description)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectTag implements the protobuf pfs.InspectTag RPC
+func (a *apiServer) InspectTag(ctx context.Context, request *pfs.InspectTagRequest) (response *pfs.TagInfo, retErr error) {
+	return a.driver.inspectTag(ctx, request.Tag)
+}
+
+// ListTag implements the protobuf pfs.ListTag RPC
+func (a *apiServer) ListTag(request *pfs.ListTagRequest, srv pfs.API_ListTagServer) (retErr error) {
+	return a.driver.listTag(srv.Context(), request.Repo, func(tagInfo *pfs.TagInfo) error {
+		return errors.EnsureStack(srv.Send(tagInfo))
+	})
+}
+
+// DeleteTag implements the protobuf pfs.DeleteTag RPC
+func (a *apiServer) DeleteTag(ctx context.Context, request *pfs.DeleteTagRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.deleteTag(txnCtx, request.Tag)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// SearchFile implements the protobuf pfs.SearchFile RPC
+func (a *apiServer) SearchFile(request *pfs.SearchFileRequest, srv pfs.API_SearchFileServer) (retErr error) {
+	return a.driver.searchFile(srv.Context(), request, func(fi *pfs.FileInfo) error {
+		return errors.EnsureStack(srv.Send(fi))
+	})
+}
+
 func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	commit, err := readCommit(server)
 	if err != nil {
@@ -361,6 +510,26 @@ type modifyFileSource interface {
 	Recv() (*pfs.ModifyFileRequest, error)
 }
 
+// peekedModifyFileSource returns a message that was already received from a
+// modifyFileSource, or the error that ended it, before the rest of the source.
+type peekedModifyFileSource struct {
+	first *pfs.ModifyFileRequest
+	err   error
+	src   modifyFileSource
+}
+
+func (s *peekedModifyFileSource) Recv() (*pfs.ModifyFileRequest, error) {
+	if s.first != nil {
+		first := s.first
+		s.first = nil
+		return first, nil
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.src.Recv()
+}
+
 // modifyFile reads from a modifyFileSource until io.EOF and writes changes to an UnorderedWriter.
 // SetCommit messages will result in an error.
 func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter, server modifyFileSource) (int64, error) {
@@ -379,14 +548,15 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 			var n int64
 			p := mod.AddFile.Path
 			t := mod.AddFile.Datum
+			md := mod.AddFile.Metadata
 			switch src := mod.AddFile.Source.(type) {
 			case *pfs.AddFile_Raw:
-				n, err = putFileRaw(uw, p, t, src.Raw)
+				n, err = putFileRaw(uw, p, t, md, src.Raw)
 			case *pfs.AddFile_Url:
-				n, err = putFileURL(ctx, uw, p, t, src.Url)
+				n, err = putFileURL(ctx, uw, p, t, md, src.Url)
 			default:
 				// need to write empty data to path
-				n, err = putFileRaw(uw, p, t, &types.BytesValue{})
+				n, err = putFileRaw(uw, p, t, md, &types.BytesValue{})
 			}
 			if err != nil {
 				return bytesRead, err
@@ -410,14 +580,23 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 	return bytesRead, nil
 }
 
-func putFileRaw(uw *fileset.UnorderedWriter, path, tag string, src *types.BytesValue) (int64, error) {
-	if err := uw.Put(path, tag, true, bytes.NewReader(src.Value)); err != nil {
+// putFile appends the content of r to a file, and merges metadata into the
+// file's metadata.
+func putFile(uw *fileset.UnorderedWriter, path, tag string, metadata map[string]string, r io.Reader) error {
+	if err := uw.SetMetadata(path, tag, metadata); err != nil {
+		return err
+	}
+	return uw.Put(path, tag, true, r)
+}
+
+func putFileRaw(uw *fileset.UnorderedWriter, path, tag string, metadata map[string]string, src *types.BytesValue) (int64, error) {
+	if err := putFile(uw, path, tag, metadata, bytes.NewReader(src.Value)); err != nil {
 		return 0, err
 	}
 	return int64(len(src.Value)), nil
 }
 
-func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag string, src *pfs.AddFile_URLSource) (n int64, retErr error) {
+func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag string, metadata map[string]string, src *pfs.AddFile_URLSource) (n int64, retErr error) {
 	url, err := url.Parse(src.URL)
 	if err != nil {
 		return 0, errors.EnsureStack(err)
@@ -437,7 +616,7 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 				retErr = err
 			}
 		}()
-		return 0, uw.Put(dstPath, tag, true, resp.Body)
+		return 0, putFile(uw, dstPath, tag, metadata, resp.Body)
 	default:
 		url, err := obj.ParseURL(src.URL)
 		if err != nil {
@@ -453,7 +632,7 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 				return miscutil.WithPipe(func(w io.Writer) error {
 					return errors.EnsureStack(objClient.Get(ctx, name, w))
 				}, func(r io.Reader) error {
-					return uw.Put(filepath.Join(dstPath, strings.TrimPrefix(name, path)), tag, true, r)
+					return putFile(uw, filepath.Join(dstPath, strings.TrimPrefix(name, path)), tag, metadata, r)
 				})
 			})
 			return 0, errors.EnsureStack(err)
@@ -461,7 +640,7 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, dstPath, tag s
 		return 0, miscutil.WithPipe(func(w io.Writer) error {
 			return errors.EnsureStack(objClient.Get(ctx, url.Object, w))
 		}, func(r io.Reader) error {
-			return uw.Put(dstPath, tag, true, r)
+			return putFile(uw, dstPath, tag, metadata, r)
 		})
 	}
 }
@@ -498,6 +677,9 @@ func (a *apiServer) GetFileTAR(request *pfs.GetFileRequest, server pfs.API_GetFi
 func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileServer) (retErr error) {
 	return metrics.ReportRequestWithThroughput(func() (int64, error) {
 		ctx := server.Context()
+		if request.Offset < 0 || request.Length < 0 {
+			return 0, errors.Errorf("offset and length cannot be negative")
+		}
 		src, err := a.driver.getFile(ctx, request.File)
 		if err != nil {
 			return 0, err
@@ -510,9 +692,15 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 		}
 		var n int64
 		if err := src.Iterate(ctx, func(fi *pfs.FileInfo, file fileset.File) error {
-			n = fileset.SizeFromIndex(file.Index())
+			n = fileset.SizeFromIndex(file.Index()) - request.Offset
+			if n < 0 {
+				n = 0
+			}
+			if request.Length > 0 && request.Length < n {
+				n = request.Length
+			}
 			return grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
-				return errors.EnsureStack(file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset)))
+				return errors.EnsureStack(file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset), chunk.WithSizeBytes(request.Length)))
 			})
 		}); err != nil {
 			return 0, errors.EnsureStack(err)
@@ -590,21 +778,33 @@ func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileReq
 
 // ListFile implements the protobuf pfs.ListFile RPC
 func (a *apiServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFileServer) (retErr error) {
-	return a.driver.listFile(server.Context(), request.File, func(fi *pfs.FileInfo) error {
+	page, err := newFilePage(request.Filter, request.PageSize, request.PageToken)
+	if err != nil {
+		return err
+	}
+	return a.driver.listFile(server.Context(), request.File, page, func(fi *pfs.FileInfo) error {
 		return errors.EnsureStack(server.Send(fi))
 	})
 }
 
 // WalkFile implements the protobuf pfs.WalkFile RPC
 func (a *apiServer) WalkFile(request *pfs.WalkFileRequest, server pfs.API_WalkFileServer) (retErr error) {
-	return a.driver.walkFile(server.Context(), request.File, func(fi *pfs.FileInfo) error {
+	page, err := newFilePage(request.Filter, request.PageSize, request.PageToken)
+	if err != nil {
+		return err
+	}
+	return a.driver.walkFile(server.Context(), request.File, page, func(fi *pfs.FileInfo) error {
 		return errors.EnsureStack(server.Send(fi))
 	})
 }
 
 // GlobFile implements the protobuf pfs.GlobFile RPC
 func (a *apiServer) GlobFile(request *pfs.GlobFileRequest, respServer pfs.API_GlobFileServer) (retErr error) {
-	return a.driver.globFile(respServer.Context(), request.Commit, request.Pattern, func(fi *pfs.FileInfo) error {
+	page, err := newFilePage(request.Filter, request.PageSize, request.PageToken)
+	if err != nil {
+		return err
+	}
+	return a.driver.globFile(respServer.Context(), request.Commit, request.Pattern, page, func(fi *pfs.FileInfo) error {
 		return errors.EnsureStack(respServer.Send(fi))
 	})
 }
@@ -638,11 +838,34 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 }
 
 // CreateFileSet implements the pfs.CreateFileset RPC
+// The stream may start with a SetCommit message, in which case the file set is
+// written with the chunking parameters of the commit's repo. The file set is
+// not added to the commit.
 func (a *apiServer) CreateFileSet(server pfs.API_CreateFileSetServer) (retErr error) {
+	var src modifyFileSource = server
+	var opts []fileset.UnorderedWriterOption
+	msg, err := server.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return errors.EnsureStack(err)
+	}
+	if err == nil {
+		if setCommit, ok := msg.Body.(*pfs.ModifyFileRequest_SetCommit); ok {
+			if setCommit.SetCommit.GetBranch().GetRepo() == nil {
+				return errors.Errorf("commit must have a repo")
+			}
+			if opts, err = a.driver.chunkingOptions(server.Context(), setCommit.SetCommit.Branch.Repo); err != nil {
+				return err
+			}
+		} else {
+			src = &peekedModifyFileSource{first: msg, src: server}
+		}
+	} else {
+		src = &peekedModifyFileSource{err: err}
+	}
 	fsID, err := a.driver.createFileSet(server.Context(), func(uw *fileset.UnorderedWriter) error {
-		_, err := a.modifyFile(server.Context(), uw, server)
+		_, err := a.modifyFile(server.Context(), uw, src)
 		return err
-	})
+	}, opts...)
 	if err != nil {
 		return err
 	}
@@ -723,6 +946,90 @@ func (a *apiServer) CheckStorage(ctx context.Context, req *pfs.CheckStorageReque
 	}, nil
 }
 
+// ListStorageKeyVersions implements the protobuf pfs.ListStorageKeyVersions RPC
+func (a *apiServer) ListStorageKeyVersions(ctx context.Context, req *pfs.ListStorageKeyVersionsRequest) (*pfs.ListStorageKeyVersionsResponse, error) {
+	deks, err := a.storageDEKs()
+	if err != nil {
+		return nil, err
+	}
+	current, err := deks.CurrentVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := deks.KeyVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := counts[current]; !ok {
+		counts[current] = 0
+	}
+	resp := &pfs.ListStorageKeyVersionsResponse{}
+	for version, count := range counts {
+		resp.Versions = append(resp.Versions, &pfs.StorageKeyVersion{
+			Version:    int64(version),
+			ChunkCount: count,
+			Current:    version == current,
+		})
+	}
+	sort.Slice(resp.Versions, func(i, j int) bool {
+		return resp.Versions[i].Version < resp.Versions[j].Version
+	})
+	return resp, nil
+}
+
+// RotateStorageKey implements the protobuf pfs.RotateStorageKey RPC
+func (a *apiServer) RotateStorageKey(ctx context.Context, req *pfs.RotateStorageKeyRequest) (*pfs.RotateStorageKeyResponse, error) {
+	deks, err := a.storageDEKs()
+	if err != nil {
+		return nil, err
+	}
+	version, n, err := deks.Rotate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.RotateStorageKeyResponse{
+		Version:        int64(version),
+		RewrappedCount: n,
+	}, nil
+}
+
+// GarbageCollectStorage implements the protobuf pfs.GarbageCollectStorage RPC
+func (a *apiServer) GarbageCollectStorage(ctx context.Context, req *pfs.GarbageCollectStorageRequest) (*pfs.GarbageCollectStorageResponse, error) {
+	report, err := a.driver.storage.GCReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pfs.GarbageCollectStorageResponse{
+		PendingChunkCount: report.PendingChunks,
+		PendingChunkBytes: report.PendingChunkBytes,
+	}
+	for prefix, pr := range report.Prefixes {
+		resp.Prefixes = append(resp.Prefixes, &pfs.GarbageCollectStoragePrefix{
+			Prefix:      prefix,
+			ObjectCount: pr.Objects,
+			SizeBytes:   pr.Bytes,
+		})
+	}
+	sort.Slice(resp.Prefixes, func(i, j int) bool {
+		return resp.Prefixes[i].Prefix < resp.Prefixes[j].Prefix
+	})
+	if req.DryRun {
+		return resp, nil
+	}
+	if err := a.driver.garbageCollect(ctx); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *apiServer) storageDEKs() (*chunk.DEKStore, error) {
+	deks := a.driver.storage.ChunkStorage().DEKs()
+	if deks == nil {
+		return nil, errors.Errorf("no key encryption key provider is configured")
+	}
+	return deks, nil
+}
+
 func (a *apiServer) PutCache(ctx context.Context, req *pfs.PutCacheRequest) (resp *types.Empty, retErr error) {
 	var fsids []fileset.ID
 	for _, id := range req.FileSetIds {
@@ -732,7 +1039,15 @@ func (a *apiServer) PutCache(ctx context.Context, req *pfs.PutCacheRequest) (res
 		}
 		fsids = append(fsids, *fsid)
 	}
-	if err := a.driver.putCache(ctx, req.Key, req.Value, fsids, req.Tag); err != nil {
+	var ttl time.Duration
+	if req.TTL != nil {
+		var err error
+		ttl, err = types.DurationFromProto(req.TTL)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	if err := a.driver.putCache(ctx, req.Key, req.Value, fsids, req.Tag, ttl); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -881,6 +1196,24 @@ func (a *apiServer) ListTask(req *taskapi.ListTaskRequest, server pfs.API_ListTa
 	return task.List(server.Context(), a.env.TaskService, req, server.Send)
 }
 
+// egressDiffToSQLDB egresses only the rows which changed between base and commit.
+// Unlike a full egress, an empty commit is not an error since all of its rows have to be deleted.
+func (a *apiServer) egressDiffToSQLDB(ctx context.Context, base, commit *pfs.Commit, egress *pfs.SQLDatabaseEgress) (*pfs.EgressResponse, error) {
+	baseInfo, baseFs, err := a.driver.openCommit(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	commitInfo, fs, err := a.driver.openCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	result, err := copyDiffToSQLDB(ctx, NewSource(baseInfo, baseFs), NewSource(commitInfo, fs), egress.Url, egress.FileFormat, egress.PrimaryKey)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &pfs.EgressResponse{Result: &pfs.EgressResponse_SqlDatabase{SqlDatabase: result}}, nil
+}
+
 func readCommit(srv pfs.API_ModifyFileServer) (*pfs.Commit, error) {
 	msg, err := srv.Recv()
 	if err != nil {
@@ -908,6 +1241,9 @@ func (a *apiServer) Egress(ctx context.Context, req *pfs.EgressRequest) (*pfs.Eg
 		return &pfs.EgressResponse{Result: &pfs.EgressResponse_ObjectStorage{ObjectStorage: result}}, nil
 
 	case *pfs.EgressRequest_SqlDatabase:
+		if target.SqlDatabase.Mode == pfs.SQLDatabaseEgress_INCREMENTAL && req.BaseCommit != nil {
+			return a.egressDiffToSQLDB(ctx, req.BaseCommit, req.Commit, target.SqlDatabase)
+		}
 		result, err := copyToSQLDB(ctx, src, target.SqlDatabase.Url, target.SqlDatabase.FileFormat)
 		if err != nil {
 			return nil, errors.EnsureStack(err)
@@ -0,0 +1,603 @@
+package server
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// A repo archive is a tar stream with the following entries, in order:
+//
+//	version         the version of the archive format, in decimal
+//	repo            the RepoInfo of the repo
+//	chunks/<hash>   the data with the hash, for each distinct piece of data
+//	                referenced by the commits
+//	commits/<n>     the CommitInfo of the nth finished commit, followed by the
+//	                index of each file the commit deleted, an empty index, and
+//	                the index of each file the commit added, all length
+//	                delimited
+//	branches/<name> the BranchInfo of each branch
+//	tags/<name>     the TagInfo of each tag
+//
+// Each commit holds its changes to its parent, the most recent finished commit
+// without an error that it descends from. A commit without such a parent holds
+// all of its files. The file indexes only keep the hashes and sizes of their
+// data references, so an archive doesn't depend on the chunks or keys of the
+// cluster it came from.
+const (
+	archiveVersionEntry   = "version"
+	archiveRepoEntry      = "repo"
+	archiveChunksPrefix   = "chunks/"
+	archiveCommitsPrefix  = "commits/"
+	archiveBranchesPrefix = "branches/"
+	archiveTagsPrefix     = "tags/"
+)
+
+// archiveVersion is the version of the archive format written by exportRepo.
+const archiveVersion = 1
+
+// exportRepo writes an archive of repo's finished commits, branches and tags
+// to w.
+func (d *driver) exportRepo(ctx context.Context, repo *pfs.Repo, w io.Writer) error {
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_READ); err != nil {
+		return errors.EnsureStack(err)
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return errors.EnsureStack(err)
+	}
+	var commitInfos []*pfs.CommitInfo
+	// parents maps the key of each commit to its parent, and exported contains
+	// the keys of the commits which are exported.
+	parents := make(map[string]*pfs.Commit)
+	exported := make(map[string]bool)
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadOnly(ctx).GetByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo), commitInfo, &col.Options{Target: col.SortByCreateRevision, Order: col.SortAscend}, func(string) error {
+		key := pfsdb.CommitKey(commitInfo.Commit)
+		parents[key] = commitInfo.ParentCommit
+		if commitInfo.Finished != nil && commitInfo.Error == "" {
+			exported[key] = true
+			commitInfos = append(commitInfos, proto.Clone(commitInfo).(*pfs.CommitInfo))
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	tw := tar.NewWriter(w)
+	if err := writeArchiveEntry(tw, archiveVersionEntry, []byte(strconv.Itoa(archiveVersion))); err != nil {
+		return err
+	}
+	if err := writeArchiveProto(tw, archiveRepoEntry, repoInfo); err != nil {
+		return err
+	}
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+		fileSets := make([]fileset.FileSet, len(commitInfos))
+		for i, ci := range commitInfos {
+			// Errored commits are skipped, like they are when a commit's files
+			// are read.
+			parent := parents[pfsdb.CommitKey(ci.Commit)]
+			for parent != nil && !exported[pfsdb.CommitKey(parent)] {
+				parent = parents[pfsdb.CommitKey(parent)]
+			}
+			ci.ParentCommit = parent
+			var id *fileset.ID
+			var err error
+			if parent != nil {
+				id, err = d.commitStore.GetDiffFileSet(ctx, ci.Commit)
+			} else {
+				id, err = d.getFileSet(ctx, ci.Commit)
+			}
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := renewer.Add(ctx, *id); err != nil {
+				return err
+			}
+			if fileSets[i], err = d.storage.Open(ctx, []fileset.ID{*id}); err != nil {
+				return err
+			}
+		}
+		// The data comes first, so that it is available when the commits which
+		// reference it are imported.
+		exportedData := make(map[string]bool)
+		for _, fs := range fileSets {
+			if err := fs.Iterate(ctx, func(f fileset.File) error {
+				for _, dataRef := range f.Index().File.DataRefs {
+					key := hex.EncodeToString(dataRef.Hash)
+					if exportedData[key] {
+						continue
+					}
+					exportedData[key] = true
+					if err := tw.WriteHeader(tarutil.NewHeader(archiveChunksPrefix+key, dataRef.SizeBytes)); err != nil {
+						return errors.EnsureStack(err)
+					}
+					if err := d.storage.ChunkStorage().NewReader(ctx, []*chunk.DataRef{dataRef}).Get(tw); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+				return nil
+			}); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		for i, ci := range commitInfos {
+			if err := writeArchiveCommit(ctx, tw, fmt.Sprintf("%s%d", archiveCommitsPrefix, i), ci, fileSets[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadOnly(ctx).GetByIndex(pfsdb.BranchesRepoIndex, pfsdb.RepoKey(repo), branchInfo, col.DefaultOptions(), func(string) error {
+		return writeArchiveProto(tw, archiveBranchesPrefix+branchInfo.Branch.Name, branchInfo)
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags.ReadOnly(ctx).GetByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo), tagInfo, col.DefaultOptions(), func(string) error {
+		return writeArchiveProto(tw, archiveTagsPrefix+tagInfo.Tag.Name, tagInfo)
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(tw.Close())
+}
+
+// writeArchiveCommit writes the commits/<n> entry of commitInfo, whose changes
+// are in fs. The entry is written twice, once to find its size and once to the
+// archive, so that the commit's files aren't held in memory.
+func writeArchiveCommit(ctx context.Context, tw *tar.Writer, name string, commitInfo *pfs.CommitInfo, fs fileset.FileSet) error {
+	write := func(w io.Writer) (int64, error) {
+		pw := pbutil.NewWriter(w)
+		size, err := pw.Write(commitInfo)
+		if err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		writeIndex := func(idx *index.Index) error {
+			n, err := pw.Write(idx)
+			size += n
+			return errors.EnsureStack(err)
+		}
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			idx := f.Index()
+			return writeIndex(&index.Index{Path: idx.Path, File: &index.File{Datum: idx.File.Datum}})
+		}, true); err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		if err := writeIndex(&index.Index{}); err != nil {
+			return 0, err
+		}
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			return writeIndex(exportIndex(f.Index()))
+		}); err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		return size, nil
+	}
+	size, err := write(io.Discard)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(tarutil.NewHeader(name, size)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = write(tw)
+	return err
+}
+
+// exportIndex returns a copy of idx which only refers to its data by hash.
+func exportIndex(idx *index.Index) *index.Index {
+	file := &index.File{
+		Datum:    idx.File.Datum,
+		Metadata: idx.File.Metadata,
+	}
+	for _, dataRef := range idx.File.DataRefs {
+		file.DataRefs = append(file.DataRefs, &chunk.DataRef{
+			Hash:      dataRef.Hash,
+			SizeBytes: dataRef.SizeBytes,
+		})
+	}
+	return &index.Index{
+		Path: idx.Path,
+		File: file,
+	}
+}
+
+func writeArchiveProto(tw *tar.Writer, name string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return writeArchiveEntry(tw, name, data)
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(tarutil.NewHeader(name, int64(len(data)))); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err := tw.Write(data)
+	return errors.EnsureStack(err)
+}
+
+func readArchiveProto(r io.Reader, msg proto.Message) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(proto.Unmarshal(data, msg))
+}
+
+// importRepo creates a repo from an archive written by exportRepo. If from or
+// to are set, only the commits created between the commits with those IDs are
+// imported. Imported commits get new IDs.
+func (d *driver) importRepo(ctx context.Context, repo *pfs.Repo, from, to string, r io.Reader) (_ *pfs.ImportRepoResponse, retErr error) {
+	tr := tar.NewReader(r)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read archive")
+	}
+	if hdr.Name != archiveVersionEntry {
+		return nil, errors.Errorf("archive must start with a %q entry, not %q", archiveVersionEntry, hdr.Name)
+	}
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if version, err := strconv.Atoi(string(data)); err != nil || version != archiveVersion {
+		return nil, errors.Errorf("unsupported archive version %q, only version %d is supported", data, archiveVersion)
+	}
+	if hdr, err = tr.Next(); err != nil {
+		return nil, errors.Wrapf(err, "could not read archive")
+	}
+	if hdr.Name != archiveRepoEntry {
+		return nil, errors.Errorf("archive must have a %q entry after its version, not %q", archiveRepoEntry, hdr.Name)
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := readArchiveProto(tr, repoInfo); err != nil {
+		return nil, err
+	}
+	if repo == nil || repo.Name == "" {
+		repo = repoInfo.Repo
+	}
+	if repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return d.createRepo(txnCtx, repo, repoInfo.Description, false, repoInfo.ChunkingParams, repoInfo.RetentionPolicy, repoInfo.SearchIndex)
+	}); err != nil {
+		return nil, err
+	}
+	// A partially imported repo is deleted, so that the import can be retried.
+	defer func() {
+		if retErr != nil {
+			if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+				return d.deleteRepo(txnCtx, repo, true)
+			}); err != nil {
+				log.Errorf("could not delete partially imported repo %v: %v", repo, err)
+			}
+		}
+	}()
+	response := &pfs.ImportRepoResponse{Repo: repo}
+	// imported maps the keys of the archived commits to the imported commits,
+	// and totals maps them to the filesets with all of their files.
+	imported := make(map[string]*pfs.Commit)
+	totals := make(map[string]fileset.ID)
+	var importedCommits []*pfs.Commit
+	var branchInfos []*pfs.BranchInfo
+	var tagInfos []*pfs.TagInfo
+	inRange, sawTo := from == "", false
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+		dataRefs, hdr, err := d.importData(ctx, renewer, repo, tr)
+		if err != nil {
+			return err
+		}
+		for ; hdr != nil; hdr, err = nextArchiveEntry(tr) {
+			switch {
+			case strings.HasPrefix(hdr.Name, archiveCommitsPrefix):
+				pr := pbutil.NewReader(tr)
+				commitInfo := &pfs.CommitInfo{}
+				if err := pr.Read(commitInfo); err != nil {
+					return err
+				}
+				diff, err := d.importCommitDiff(ctx, renewer, repo, pr, dataRefs)
+				if err != nil {
+					return errors.Wrapf(err, "could not import commit %v", commitInfo.Commit)
+				}
+				total := *diff
+				if commitInfo.ParentCommit != nil {
+					if parentTotal, ok := totals[pfsdb.CommitKey(commitInfo.ParentCommit)]; ok {
+						id, err := d.storage.Compose(ctx, []fileset.ID{parentTotal, *diff}, defaultTTL)
+						if err != nil {
+							return err
+						}
+						if err := renewer.Add(ctx, *id); err != nil {
+							return err
+						}
+						total = *id
+					}
+				}
+				totals[pfsdb.CommitKey(commitInfo.Commit)] = total
+				if commitInfo.Commit.ID == from {
+					inRange = true
+				}
+				if !inRange || sawTo {
+					continue
+				}
+				commit, err := d.importCommit(ctx, renewer, repo, commitInfo, *diff, total, imported)
+				if err != nil {
+					return errors.Wrapf(err, "could not import commit %v", commitInfo.Commit)
+				}
+				imported[pfsdb.CommitKey(commitInfo.Commit)] = commit
+				importedCommits = append(importedCommits, commit)
+				response.CommitCount++
+				sawTo = commitInfo.Commit.ID == to
+			case strings.HasPrefix(hdr.Name, archiveBranchesPrefix):
+				branchInfo := &pfs.BranchInfo{}
+				if err := readArchiveProto(tr, branchInfo); err != nil {
+					return err
+				}
+				branchInfos = append(branchInfos, branchInfo)
+			case strings.HasPrefix(hdr.Name, archiveTagsPrefix):
+				tagInfo := &pfs.TagInfo{}
+				if err := readArchiveProto(tr, tagInfo); err != nil {
+					return err
+				}
+				tagInfos = append(tagInfos, tagInfo)
+			default:
+				return errors.Errorf("unexpected archive entry %q", hdr.Name)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		// The imported commits reference the filesets built from the archive,
+		// so they must finish before the renewer stops keeping them alive.
+		for _, commit := range importedCommits {
+			if _, err := d.inspectCommit(ctx, commit, pfs.CommitState_FINISHED); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if !inRange {
+		return nil, errors.Errorf("commit %q not found in archive", from)
+	}
+	if to != "" && !sawTo {
+		return nil, errors.Errorf("commit %q not found in archive", to)
+	}
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		// Triggers can refer to any branch, so they are set once all of the
+		// branches exist.
+		for _, branchInfo := range branchInfos {
+			var head *pfs.Commit
+			if branchInfo.Head != nil {
+				head = imported[pfsdb.CommitKey(branchInfo.Head)]
+			}
+			if err := d.createBranch(txnCtx, repo.NewBranch(branchInfo.Branch.Name), head, nil, nil, branchInfo.RetentionPolicy); err != nil {
+				return err
+			}
+		}
+		for _, branchInfo := range branchInfos {
+			branch := repo.NewBranch(branchInfo.Branch.Name)
+			if branchInfo.Trigger != nil {
+				if err := d.createBranch(txnCtx, branch, nil, nil, branchInfo.Trigger, nil); err != nil {
+					return err
+				}
+			}
+			if branchInfo.Protection != nil {
+				if err := d.protectBranch(txnCtx, branch, branchInfo.Protection); err != nil {
+					return err
+				}
+			}
+		}
+		for _, tagInfo := range tagInfos {
+			commit, ok := imported[pfsdb.CommitKey(tagInfo.Commit)]
+			if !ok {
+				continue
+			}
+			if err := d.createTag(txnCtx, repo.NewTag(tagInfo.Tag.Name), commit, tagInfo.Description); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func nextArchiveEntry(tr *tar.Reader) (*tar.Header, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return hdr, nil
+}
+
+// importData writes the data in an archive to a fileset, and returns the data
+// references of each piece of data by hash, along with the first entry after
+// the data.
+func (d *driver) importData(ctx context.Context, renewer *fileset.Renewer, repo *pfs.Repo, tr *tar.Reader) (map[string][]*chunk.DataRef, *tar.Header, error) {
+	opts, err := d.chunkingOptions(ctx, repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hdr *tar.Header
+	id, err := d.withUnorderedWriter(ctx, renewer, func(uw *fileset.UnorderedWriter) error {
+		for {
+			var err error
+			if hdr, err = nextArchiveEntry(tr); err != nil {
+				return err
+			}
+			if hdr == nil || !strings.HasPrefix(hdr.Name, archiveChunksPrefix) {
+				return nil
+			}
+			if err := uw.Put("/"+strings.TrimPrefix(hdr.Name, archiveChunksPrefix), "", false, tr); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+	}, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	fs, err := d.storage.Open(ctx, []fileset.ID{*id})
+	if err != nil {
+		return nil, nil, err
+	}
+	dataRefs := make(map[string][]*chunk.DataRef)
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		idx := f.Index()
+		dataRefs[strings.TrimPrefix(idx.Path, "/")] = idx.File.DataRefs
+		return nil
+	}); err != nil {
+		return nil, nil, errors.EnsureStack(err)
+	}
+	return dataRefs, hdr, nil
+}
+
+// importCommitDiff writes a fileset with the changes of an archived commit,
+// read from pr. The files reference the imported data, so it is only copied
+// when its data references can't be reused.
+func (d *driver) importCommitDiff(ctx context.Context, renewer *fileset.Renewer, repo *pfs.Repo, pr pbutil.Reader, dataRefs map[string][]*chunk.DataRef) (*fileset.ID, error) {
+	params, err := d.repoChunkingParams(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	opts := []fileset.WriterOption{fileset.WithTTL(defaultTTL)}
+	if params != nil {
+		opts = append(opts, fileset.WithWriterChunkingParams(toChunkingParams(params)))
+	}
+	w := d.storage.NewWriter(ctx, opts...)
+	// The deleted files come first, up to an empty index.
+	for {
+		idx := &index.Index{}
+		if err := pr.Read(idx); err != nil {
+			return nil, err
+		}
+		if idx.Path == "" {
+			break
+		}
+		if err := w.Delete(idx.Path, idx.File.Datum); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	for {
+		idx := &index.Index{}
+		if err := pr.Read(idx); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		var refs []*chunk.DataRef
+		for _, dataRef := range idx.File.DataRefs {
+			key := hex.EncodeToString(dataRef.Hash)
+			r, ok := dataRefs[key]
+			if !ok {
+				return nil, errors.Errorf("archive is missing data %v of file %v", key, idx.Path)
+			}
+			refs = append(refs, r...)
+		}
+		idx.File.DataRefs = refs
+		if err := w.Copy(d.storage.NewFile(idx), idx.File.Datum); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	id, err := w.Close()
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := renewer.Add(ctx, *id); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// importCommit creates a commit with the changes in diff, and finishes it. Its
+// parent is the imported parent of the archived commit, or else the head of
+// its branch, in which case the commit replaces the head's files with total.
+func (d *driver) importCommit(ctx context.Context, renewer *fileset.Renewer, repo *pfs.Repo, commitInfo *pfs.CommitInfo, diff, total fileset.ID, imported map[string]*pfs.Commit) (*pfs.Commit, error) {
+	branch := repo.NewBranch(commitInfo.Commit.Branch.Name)
+	var parent *pfs.Commit
+	if commitInfo.ParentCommit != nil {
+		parent = imported[pfsdb.CommitKey(commitInfo.ParentCommit)]
+	}
+	ids := []fileset.ID{diff}
+	if parent == nil {
+		ids = []fileset.ID{total}
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches.ReadOnly(ctx).Get(branch, branchInfo); err != nil && !col.IsErrNotFound(err) {
+			return nil, errors.EnsureStack(err)
+		}
+		parent = branchInfo.Head
+		if parent != nil {
+			// The head's files are deleted first.
+			if _, err := d.inspectCommit(ctx, parent, pfs.CommitState_FINISHED); err != nil {
+				return nil, err
+			}
+			id, err := d.withUnorderedWriter(ctx, renewer, func(uw *fileset.UnorderedWriter) error {
+				_, fs, err := d.openCommit(ctx, parent)
+				if err != nil {
+					return err
+				}
+				return errors.EnsureStack(fs.Iterate(ctx, func(f fileset.File) error {
+					idx := f.Index()
+					return errors.EnsureStack(uw.Delete(idx.Path, idx.File.Datum))
+				}))
+			})
+			if err != nil {
+				return nil, err
+			}
+			ids = append([]fileset.ID{*id}, ids...)
+		}
+	}
+	var commit *pfs.Commit
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		commit, err = d.startCommit(txnCtx, parent, branch, commitInfo.Description, commitInfo.Metadata)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, commit, id); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return d.finishCommit(txnCtx, commit, "", "", false)
+	}); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
@@ -29,13 +31,15 @@ func newCompactor(storage *fileset.Storage, maxFanIn int) *compactor {
 	}
 }
 
-func (c *compactor) Compact(ctx context.Context, taskDoer task.Doer, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
+// Compact compacts ids. Content that has to be rewritten is chunked with
+// params, or the default parameters if params is nil.
+func (c *compactor) Compact(ctx context.Context, taskDoer task.Doer, ids []fileset.ID, ttl time.Duration, params *pfs.ChunkingParams) (*fileset.ID, error) {
 	return c.storage.CompactLevelBased(ctx, ids, defaultTTL, func(ctx context.Context, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
-		return c.compact(ctx, taskDoer, ids, ttl)
+		return c.compact(ctx, taskDoer, ids, ttl, params)
 	})
 }
 
-func (c *compactor) compact(ctx context.Context, taskDoer task.Doer, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
+func (c *compactor) compact(ctx context.Context, taskDoer task.Doer, ids []fileset.ID, ttl time.Duration, params *pfs.ChunkingParams) (*fileset.ID, error) {
 	ids, err := c.storage.Flatten(ctx, ids)
 	if err != nil {
 		return nil, err
@@ -54,7 +58,7 @@ func (c *compactor) compact(ctx context.Context, taskDoer task.Doer, ids []files
 		var compactResults []fileset.ID
 		if err := miscutil.LogStep(fmt.Sprintf("compacting %v tasks", len(tasks)), func() error {
 			var err error
-			compactResults, err = c.processCompactTasks(ctx, taskDoer, renewer, tasks)
+			compactResults, err = c.processCompactTasks(ctx, taskDoer, renewer, tasks, params)
 			return err
 		}); err != nil {
 			return err
@@ -71,7 +75,7 @@ func (c *compactor) compact(ctx context.Context, taskDoer task.Doer, ids []files
 			id = &concatResults[0]
 			return nil
 		}
-		id, err = c.compact(ctx, taskDoer, concatResults, ttl)
+		id, err = c.compact(ctx, taskDoer, concatResults, ttl, params)
 		return err
 	}); err != nil {
 		return nil, err
@@ -119,10 +123,11 @@ func (c *compactor) createCompactTasks(ctx context.Context, taskDoer task.Doer,
 	return tasks, taskLens, nil
 }
 
-func (c *compactor) processCompactTasks(ctx context.Context, taskDoer task.Doer, renewer *fileset.Renewer, tasks []*CompactTask) ([]fileset.ID, error) {
+func (c *compactor) processCompactTasks(ctx context.Context, taskDoer task.Doer, renewer *fileset.Renewer, tasks []*CompactTask, params *pfs.ChunkingParams) ([]fileset.ID, error) {
 	inputs := make([]*types.Any, len(tasks))
 	for i, task := range tasks {
 		task := proto.Clone(task).(*CompactTask)
+		task.ChunkingParams = params
 		input, err := serializeCompactTask(task)
 		if err != nil {
 			return nil, err
@@ -193,22 +198,18 @@ func (c *compactor) concat(ctx context.Context, taskDoer task.Doer, renewer *fil
 	return results, nil
 }
 
-func (c *compactor) Validate(ctx context.Context, taskDoer task.Doer, id fileset.ID) (int64, string, error) {
+func (c *compactor) Validate(ctx context.Context, taskDoer task.Doer, id fileset.ID) (*ValidateTaskResult, error) {
 	input, err := serializeValidateTask(&ValidateTask{
 		Id: id.HexString(),
 	})
 	if err != nil {
-		return 0, "", err
+		return nil, err
 	}
 	output, err := task.DoOne(ctx, taskDoer, input)
 	if err != nil {
-		return 0, "", err
-	}
-	result, err := deserializeValidateTaskResult(output)
-	if err != nil {
-		return 0, "", err
+		return nil, err
 	}
-	return result.SizeBytes, result.Error, nil
+	return deserializeValidateTaskResult(output)
 }
 
 func compactionWorker(ctx context.Context, taskSource task.Source, storage *fileset.Storage) error {
@@ -288,7 +289,12 @@ func processCompactTask(ctx context.Context, storage *fileset.Storage, task *Com
 			Upper:      task.PathRange.Upper,
 			UpperDatum: task.PathRange.UpperDatum,
 		}
-		id, err := storage.Compact(ctx, ids, defaultTTL, index.WithRange(pathRange))
+		var params *chunk.ChunkingParams
+		if task.ChunkingParams != nil {
+			p := toChunkingParams(task.ChunkingParams)
+			params = &p
+		}
+		id, err := storage.Compact(ctx, ids, defaultTTL, params, index.WithRange(pathRange))
 		if err != nil {
 			return err
 		}
@@ -333,7 +339,7 @@ func processValidateTask(ctx context.Context, storage *fileset.Storage, task *Va
 			return err
 		}
 		var prev *index.Index
-		var size int64
+		var size, fileCount int64
 		var validationError string
 		if err := fs.Iterate(ctx, func(f fileset.File) error {
 			idx := f.Index()
@@ -346,11 +352,13 @@ func processValidateTask(ctx context.Context, storage *fileset.Storage, task *Va
 			}
 			prev = idx
 			size += index.SizeBytes(idx)
+			fileCount++
 			return nil
 		}); err != nil {
 			return errors.EnsureStack(err)
 		}
 		result.SizeBytes = size
+		result.FileCount = fileCount
 		result.Error = validationError
 		return nil
 	}); err != nil {
@@ -55,7 +55,7 @@ func (c *cache) Put(ctx context.Context, key string, output *types.Any) error {
 		}
 		fsids = append(fsids, *fsid)
 	}
-	return c.driver.putCache(ctx, key, output, fsids, c.tag)
+	return c.driver.putCache(ctx, key, output, fsids, c.tag, 0)
 }
 
 func (c *cache) clear(ctx context.Context) error {
@@ -20,23 +20,38 @@ func NewDiffer(a, b Source) *Differ {
 	return &Differ{a: a, b: b}
 }
 
+// diffEntry is a FileInfo paired with the File it was computed from.
+type diffEntry struct {
+	fi *pfs.FileInfo
+	f  fileset.File
+}
+
 // Iterate compares the entries from `a` and `b` path wise.
 // If one side is missing a path, cb is called with the info for the side that has the path
 // If both sides have a path, but the content is different, cb is called with the info for both sides at once.
 // If both sides have a path, and the content is the same, cb is not called. The info is not part of the diff.
 func (d *Differ) Iterate(ctx context.Context, cb func(aFi, bFi *pfs.FileInfo) error) error {
+	return d.IterateFiles(ctx, func(aFi *pfs.FileInfo, _ fileset.File, bFi *pfs.FileInfo, _ fileset.File) error {
+		return cb(aFi, bFi)
+	})
+}
+
+// IterateFiles is like Iterate, but it also passes the Files the FileInfos were computed from, so
+// that the content of the differing entries can be read.
+// The Files are nil whenever the corresponding FileInfos are nil.
+func (d *Differ) IterateFiles(ctx context.Context, cb func(aFi *pfs.FileInfo, aF fileset.File, bFi *pfs.FileInfo, bF fileset.File) error) error {
 	ctx, cf := context.WithCancel(ctx)
 	defer cf()
-	aInfos := make(chan *pfs.FileInfo)
-	bInfos := make(chan *pfs.FileInfo)
+	aInfos := make(chan diffEntry)
+	bInfos := make(chan diffEntry)
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
 		defer close(aInfos)
-		err := d.a.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
+		err := d.a.Iterate(ctx, func(fi *pfs.FileInfo, f fileset.File) error {
 			select {
 			case <-ctx.Done():
 				return errors.EnsureStack(ctx.Err())
-			case aInfos <- fi:
+			case aInfos <- diffEntry{fi: fi, f: f}:
 				return nil
 			}
 		})
@@ -44,48 +59,48 @@ func (d *Differ) Iterate(ctx context.Context, cb func(aFi, bFi *pfs.FileInfo) er
 	})
 	eg.Go(func() error {
 		defer close(bInfos)
-		err := d.b.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
+		err := d.b.Iterate(ctx, func(fi *pfs.FileInfo, f fileset.File) error {
 			select {
 			case <-ctx.Done():
 				return errors.EnsureStack(ctx.Err())
-			case bInfos <- fi:
+			case bInfos <- diffEntry{fi: fi, f: f}:
 				return nil
 			}
 		})
 		return errors.EnsureStack(err)
 	})
 	eg.Go(func() error {
-		aFi, aOpen := <-aInfos
-		bFi, bOpen := <-bInfos
+		a, aOpen := <-aInfos
+		b, bOpen := <-bInfos
 		for aOpen && bOpen {
 			switch {
-			case aFi.File.Path < bFi.File.Path:
-				if err := cb(aFi, nil); err != nil {
+			case a.fi.File.Path < b.fi.File.Path:
+				if err := cb(a.fi, a.f, nil, nil); err != nil {
 					return err
 				}
-				aFi, aOpen = <-aInfos
-			case bFi.File.Path < aFi.File.Path:
-				if err := cb(nil, bFi); err != nil {
+				a, aOpen = <-aInfos
+			case b.fi.File.Path < a.fi.File.Path:
+				if err := cb(nil, nil, b.fi, b.f); err != nil {
 					return err
 				}
-				bFi, bOpen = <-bInfos
+				b, bOpen = <-bInfos
 			default:
-				if !equalFileInfos(aFi, bFi) {
-					if err := cb(aFi, bFi); err != nil {
+				if !equalFileInfos(a.fi, b.fi) {
+					if err := cb(a.fi, a.f, b.fi, b.f); err != nil {
 						return err
 					}
 				}
-				aFi, aOpen = <-aInfos
-				bFi, bOpen = <-bInfos
+				a, aOpen = <-aInfos
+				b, bOpen = <-bInfos
 			}
 		}
-		for ; aOpen; aFi, aOpen = <-aInfos {
-			if err := cb(aFi, nil); err != nil {
+		for ; aOpen; a, aOpen = <-aInfos {
+			if err := cb(a.fi, a.f, nil, nil); err != nil {
 				return err
 			}
 		}
-		for ; bOpen; bFi, bOpen = <-bInfos {
-			if err := cb(nil, bFi); err != nil {
+		for ; bOpen; b, bOpen = <-bInfos {
+			if err := cb(nil, nil, b.fi, b.f); err != nil {
 				return err
 			}
 		}
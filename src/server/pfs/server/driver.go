@@ -45,9 +45,12 @@ const (
 
 const (
 	storageTaskNamespace = "storage"
-	fileSetsRepo         = client.FileSetsRepoName
-	defaultTTL           = client.DefaultTTL
-	maxTTL               = 30 * time.Minute
+	// masterTaskNamespace is the namespace of the tasks which are processed
+	// by the PFS master.
+	masterTaskNamespace = "pfs-master"
+	fileSetsRepo        = client.FileSetsRepoName
+	defaultTTL          = client.DefaultTTL
+	maxTTL              = 30 * time.Minute
 )
 
 // IsPermissionError returns true if a given error is a permission error.
@@ -79,9 +82,12 @@ type driver struct {
 	repos    col.PostgresCollection
 	commits  col.PostgresCollection
 	branches col.PostgresCollection
+	quotas   col.PostgresCollection
+	tags     col.PostgresCollection
 
-	storage     *fileset.Storage
-	commitStore commitStore
+	storage       *fileset.Storage
+	commitStore   commitStore
+	searchIndexes *searchIndexStore
 
 	cache *fileset.Cache
 }
@@ -100,6 +106,8 @@ func newDriver(env Env) (*driver, error) {
 	repos := pfsdb.Repos(env.DB, env.Listener)
 	commits := pfsdb.Commits(env.DB, env.Listener)
 	branches := pfsdb.Branches(env.DB, env.Listener)
+	quotas := pfsdb.Quotas(env.DB, env.Listener)
+	tags := pfsdb.Tags(env.DB, env.Listener)
 
 	// Setup driver struct.
 	d := &driver{
@@ -110,6 +118,8 @@ func newDriver(env Env) (*driver, error) {
 		repos:      repos,
 		commits:    commits,
 		branches:   branches,
+		quotas:     quotas,
+		tags:       tags,
 		log:        env.Logger,
 	}
 	// Setup tracker and chunk / fileset storage.
@@ -119,28 +129,42 @@ func newDriver(env Env) (*driver, error) {
 		return nil, err
 	}
 	memCache := storageConfig.ChunkMemoryCache()
-	keyStore := chunk.NewPostgresKeyStore(env.DB)
-	secret, err := getOrCreateKey(context.TODO(), keyStore, "default")
-	if err != nil {
-		return nil, err
+	if storageConfig.StorageChunkKeySecret {
+		keyStore := chunk.NewPostgresKeyStore(env.DB)
+		secret, err := getOrCreateKey(context.TODO(), keyStore, "default")
+		if err != nil {
+			return nil, err
+		}
+		chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret))
 	}
-	chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret))
 	chunkStorage := chunk.NewStorage(objClient, memCache, env.DB, tracker, chunkStorageOpts...)
 	d.storage = fileset.NewStorage(fileset.NewPostgresStore(env.DB), tracker, chunkStorage, fileset.StorageOptions(&storageConfig)...)
 	// Set up compaction worker.
 	taskSource := env.TaskService.NewSource(storageTaskNamespace)
 	go compactionWorker(env.BackgroundContext, taskSource, d.storage)
 	d.commitStore = newPostgresCommitStore(env.DB, tracker, d.storage)
+	d.searchIndexes = newSearchIndexStore(env.DB, tracker)
 	// TODO: Make the cache max size configurable.
 	d.cache = fileset.NewCache(env.DB, tracker, 10000)
 	return d, nil
 }
 
-func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, description string, update bool) error {
+func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, description string, update bool, chunkingParams *pfs.ChunkingParams, retentionPolicy *pfs.RetentionPolicy, searchIndex *pfs.SearchIndexOptions) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
 	}
+	if chunkingParams != nil {
+		if err := toChunkingParams(chunkingParams).Validate(); err != nil {
+			return errors.Wrapf(err, "invalid chunking params")
+		}
+	}
+	if err := validateRetentionPolicy(retentionPolicy); err != nil {
+		return err
+	}
+	if err := validateSearchIndexOptions(searchIndex); err != nil {
+		return err
+	}
 
 	// Check that the user is logged in (user doesn't need any access level to
 	// create a repo, but they must be authenticated if auth is active)
@@ -175,7 +199,10 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			}
 		}
 
-		if existingRepoInfo.Description == description {
+		if existingRepoInfo.Description == description &&
+			(chunkingParams == nil || proto.Equal(existingRepoInfo.ChunkingParams, chunkingParams)) &&
+			(retentionPolicy == nil || proto.Equal(existingRepoInfo.RetentionPolicy, cleanRetentionPolicy(retentionPolicy))) &&
+			(searchIndex == nil || proto.Equal(existingRepoInfo.SearchIndex, cleanSearchIndexOptions(searchIndex))) {
 			// Don't overwrite the stored proto with an identical value. This
 			// optimization is impactful because pps will frequently update the spec
 			// repo to make sure it exists.
@@ -192,6 +219,18 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			return errors.Wrapf(err, "could not update description of %q", repo)
 		}
 		existingRepoInfo.Description = description
+		if chunkingParams != nil {
+			existingRepoInfo.ChunkingParams = chunkingParams
+		}
+		if retentionPolicy != nil {
+			existingRepoInfo.RetentionPolicy = cleanRetentionPolicy(retentionPolicy)
+		}
+		if searchIndex != nil {
+			existingRepoInfo.SearchIndex = cleanSearchIndexOptions(searchIndex)
+			if err := d.setSearchIndex(txnCtx, repo, searchIndex); err != nil {
+				return err
+			}
+		}
 		return errors.EnsureStack(repos.Put(repo, &existingRepoInfo))
 	} else {
 		// if this is a system repo, make sure the corresponding user repo already exists
@@ -220,10 +259,16 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 				return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not create role binding for new repo %q", repo)
 			}
 		}
+		if err := d.setSearchIndex(txnCtx, repo, searchIndex); err != nil {
+			return err
+		}
 		return errors.EnsureStack(repos.Create(repo, &pfs.RepoInfo{
-			Repo:        repo,
-			Created:     txnCtx.Timestamp,
-			Description: description,
+			Repo:            repo,
+			Created:         txnCtx.Timestamp,
+			Description:     description,
+			ChunkingParams:  chunkingParams,
+			RetentionPolicy: cleanRetentionPolicy(retentionPolicy),
+			SearchIndex:     cleanSearchIndexOptions(searchIndex),
 		}))
 	}
 }
@@ -408,9 +453,24 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 	if err := d.commits.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
 		return errors.EnsureStack(err)
 	}
+	// and tags
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// and the search index
+	if err := d.searchIndexes.DisableTx(txnCtx.SqlTx, repo); err != nil {
+		return err
+	}
+	// and its usage, before the owners' role binding is deleted
+	if err := deleteRepoUsage(txnCtx.SqlTx, repo); err != nil {
+		return err
+	}
 	if err := repos.Delete(repo); err != nil && !col.IsErrNotFound(err) {
 		return errors.Wrapf(err, "repos.Delete")
 	}
+	if err := d.quotas.ReadWrite(txnCtx.SqlTx).Delete(pfsdb.QuotaKey(repo, "")); err != nil && !col.IsErrNotFound(err) {
+		return errors.Wrapf(err, "quotas.Delete")
+	}
 
 	// since system repos share a role binding, only delete it if this is the user repo, in which case the other repos will be deleted anyway
 	if repo.Type == pfs.UserRepoType {
@@ -431,6 +491,7 @@ func (d *driver) startCommit(
 	parent *pfs.Commit,
 	branch *pfs.Branch,
 	description string,
+	metadata map[string]string,
 ) (*pfs.Commit, error) {
 	// Validate arguments:
 	if branch == nil || branch.Name == "" {
@@ -451,6 +512,13 @@ func (d *driver) startCommit(
 		Origin:      &pfs.CommitOrigin{Kind: pfs.OriginKind_USER},
 		Description: description,
 		Started:     txnCtx.Timestamp,
+		Metadata:    metadata,
+	}
+	whoAmI, err := txnCtx.WhoAmI()
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "error authenticating")
+	} else if err == nil {
+		newCommitInfo.Author = whoAmI.Username
 	}
 	if err := ancestry.ValidateName(branch.Name); err != nil {
 		return nil, err
@@ -469,8 +537,14 @@ func (d *driver) startCommit(
 	// was not set)
 	branchInfo := &pfs.BranchInfo{}
 	if err := d.branches.ReadWrite(txnCtx.SqlTx).Upsert(branch, branchInfo, func() error {
+		if err := d.checkProtectedCommit(txnCtx, branchInfo); err != nil {
+			return err
+		}
 		if branchInfo.Branch == nil {
 			// New branch, update the RepoInfo
+			if err := d.checkTagName(txnCtx, branch.Repo, branch.Name); err != nil {
+				return err
+			}
 			add(&repoInfo.Branches, branch)
 			if err := d.repos.ReadWrite(txnCtx.SqlTx).Put(repoInfo.Repo, repoInfo); err != nil {
 				return errors.EnsureStack(err)
@@ -994,21 +1068,43 @@ func (d *driver) resolveCommit(sqlTx *pachsql.Tx, userCommit *pfs.Commit) (*pfs.
 	}
 
 	// Now that ancestry has been parsed out, check if the ID is a branch name
+	// or a tag
 	if commit.ID != "" && !uuid.IsUUIDWithoutDashes(commit.ID) {
 		if commit.Branch.Name != "" {
-			return nil, errors.Errorf("invalid commit ID given with a branch (%s): %s\n", commit.Branch, commit.ID)
+			// A tag refers to the same commit whichever branch is given
+			tagInfo := &pfs.TagInfo{}
+			if err := d.tags.ReadWrite(sqlTx).Get(commit.Branch.Repo.NewTag(commit.ID), tagInfo); err != nil {
+				if col.IsErrNotFound(err) {
+					return nil, errors.Errorf("invalid commit ID given with a branch (%s): %s\n", commit.Branch, commit.ID)
+				}
+				return nil, errors.EnsureStack(err)
+			}
+			commit = proto.Clone(tagInfo.Commit).(*pfs.Commit)
+		} else {
+			commit.Branch.Name = commit.ID
+			commit.ID = ""
 		}
-		commit.Branch.Name = commit.ID
-		commit.ID = ""
 	}
 
 	if commit.ID == "" {
-		// If commit.ID is unspecified, get it from the branch head
+		// If commit.ID is unspecified, get it from the branch head, or from the
+		// tag with the branch's name
 		branchInfo := &pfs.BranchInfo{}
 		if err := d.branches.ReadWrite(sqlTx).Get(commit.Branch, branchInfo); err != nil {
-			return nil, errors.EnsureStack(err)
+			if !col.IsErrNotFound(err) {
+				return nil, errors.EnsureStack(err)
+			}
+			tagInfo := &pfs.TagInfo{}
+			if tagErr := d.tags.ReadWrite(sqlTx).Get(commit.Branch.Repo.NewTag(commit.Branch.Name), tagInfo); tagErr != nil {
+				if col.IsErrNotFound(tagErr) {
+					return nil, errors.EnsureStack(err)
+				}
+				return nil, errors.EnsureStack(tagErr)
+			}
+			commit = proto.Clone(tagInfo.Commit).(*pfs.Commit)
+		} else {
+			commit.ID = branchInfo.Head.ID
 		}
-		commit.ID = branchInfo.Head.ID
 	} else if commit.Branch.Name == "" {
 		// If the branch is unspecified, make sure the ID is unique (a repo may have
 		// one commit on each branch with the same ID) and load the branch name.
@@ -1117,6 +1213,7 @@ func (d *driver) listCommit(
 	reverse bool,
 	all bool,
 	originKind pfs.OriginKind,
+	page *commitPage,
 	cb func(*pfs.CommitInfo) error,
 ) error {
 	// Validate arguments
@@ -1153,7 +1250,24 @@ func (d *driver) listCommit(
 		}
 	}
 
+	// Make sure that the page token's commit still exists, since the listing
+	// resumes from it
+	var afterInfo *pfs.CommitInfo
+	if page.after != nil {
+		if repo.Name != "" && !proto.Equal(page.after.Branch.Repo, repo) {
+			return errors.Errorf("page token's commit %s is not in repo %s", page.after, repo)
+		}
+		afterInfo = &pfs.CommitInfo{}
+		if err := d.commits.ReadOnly(ctx).Get(page.after, afterInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("page token's commit %s no longer exists", page.after)
+			}
+			return errors.EnsureStack(err)
+		}
+	}
+
 	// if number is 0, we return all commits that match the criteria
+	number = page.limit(number)
 	if number == 0 {
 		number = math.MaxInt64
 	}
@@ -1171,11 +1285,14 @@ func (d *driver) listCommit(
 				if number == 0 {
 					return errutil.ErrBreak
 				}
-				number--
-
 				if reverse {
 					ci = cis[len(cis)-1-i]
 				}
+				if page.skip(ci) || !page.match(ci) {
+					continue
+				}
+				number--
+
 				var err error
 				ci.SizeBytesUpperBound, err = d.commitSizeUpperBound(ctx, ci.Commit)
 				if err != nil && !pfsserver.IsBaseCommitNotFinishedErr(err) {
@@ -1212,6 +1329,11 @@ func (d *driver) listCommit(
 		if reverse {
 			opts.Order = col.SortAscend
 		}
+		// seek to the page token's commit, so that only the commits created
+		// at the same time as it are skipped
+		if page.after != nil {
+			opts.Start = pfsdb.CommitKey(page.after)
+		}
 
 		if repo.Name == "" {
 			if err := d.commits.ReadOnly(ctx).ListRev(ci, opts, listCallback); err != nil {
@@ -1232,12 +1354,16 @@ func (d *driver) listCommit(
 			return errors.Errorf("cannot use 'Reverse' while also using 'From' or 'To'")
 		}
 		cursor := to
+		// resume from the parent of the page token's commit
+		if afterInfo != nil {
+			cursor = afterInfo.ParentCommit
+		}
 		for number != 0 && cursor != nil && (from == nil || cursor.ID != from.ID) {
 			commitInfo := &pfs.CommitInfo{}
 			if err := d.commits.ReadOnly(ctx).Get(cursor, commitInfo); err != nil {
 				return errors.EnsureStack(err)
 			}
-			if passesCommitOriginFilter(commitInfo, all, originKind) {
+			if passesCommitOriginFilter(commitInfo, all, originKind) && page.match(commitInfo) {
 				if err := cb(commitInfo); err != nil {
 					if errors.Is(err, errutil.ErrBreak) {
 						return nil
@@ -1378,6 +1504,13 @@ func (d *driver) listCommitSet(ctx context.Context, cb func(*pfs.CommitSetInfo)
 }
 
 func (d *driver) squashCommitSetInternal(txnCtx *txncontext.TransactionContext, commitInfos []*pfs.CommitInfo) error {
+	// Tagged commits are kept, along with the data they reference
+	if err := d.checkNotTagged(txnCtx, commitInfos); err != nil {
+		return err
+	}
+	if err := d.checkProtectedSquash(txnCtx, commitInfos); err != nil {
+		return err
+	}
 	deleted := make(map[string]*pfs.CommitInfo) // deleted commits
 
 	// 1) Delete each commit in the CommitSet
@@ -1387,6 +1520,9 @@ func (d *driver) squashCommitSetInternal(txnCtx *txncontext.TransactionContext,
 		if err := d.commits.ReadWrite(txnCtx.SqlTx).Delete(commitInfo.Commit); err != nil {
 			return errors.EnsureStack(err)
 		}
+		if err := d.searchIndexes.PruneTx(txnCtx.SqlTx, commitInfo.Commit); err != nil {
+			return err
+		}
 
 		// make sure all children are finished, so we don't lose data
 		for _, child := range commitInfo.ChildCommits {
@@ -1438,6 +1574,25 @@ func (d *driver) squashCommitSetInternal(txnCtx *txncontext.TransactionContext,
 		}
 	}
 
+	// Move the quota usage of each deleted commit to its first remaining
+	// descendant, which still holds the commit's data, or release it if the
+	// commit's data is gone
+	for _, commitInfo := range commitInfos {
+		var descendant *pfs.Commit
+		for ci := commitInfo; ci != nil && len(ci.ChildCommits) > 0; ci = deleted[pfsdb.CommitKey(descendant)] {
+			descendant = ci.ChildCommits[0]
+		}
+		if descendant == nil || deleted[pfsdb.CommitKey(descendant)] != nil {
+			if err := releaseUsage(txnCtx.SqlTx, commitInfo.Commit); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := moveUsage(txnCtx.SqlTx, commitInfo.Commit, descendant); err != nil {
+			return err
+		}
+	}
+
 	// 2) Rewrite ParentCommit of deleted commits' children, and
 	// ChildCommits of deleted commits' parents
 	visited := make(map[string]struct{}) // visited child/parent commits
@@ -1656,7 +1811,7 @@ func (d *driver) clearCommit(ctx context.Context, commit *pfs.Commit) error {
 //
 // This invariant is assumed to hold for all branches upstream of 'branch', but not
 // for 'branch' itself once 'b.Provenance' has been set.
-func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch, trigger *pfs.Trigger) error {
+func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch, trigger *pfs.Trigger, retentionPolicy *pfs.RetentionPolicy) error {
 	// Validate arguments
 	if branch == nil {
 		return errors.New("branch cannot be nil")
@@ -1670,6 +1825,9 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	if len(provenance) > 0 && trigger != nil {
 		return errors.New("a branch cannot have both provenance and a trigger")
 	}
+	if err := validateRetentionPolicy(retentionPolicy); err != nil {
+		return err
+	}
 
 	var err error
 	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_CREATE_BRANCH); err != nil {
@@ -1683,6 +1841,11 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	// Retrieve (and create, if necessary) the current version of this branch
 	branchInfo := &pfs.BranchInfo{}
 	if err := d.branches.ReadWrite(txnCtx.SqlTx).Upsert(branch, branchInfo, func() error {
+		if branchInfo.Branch == nil {
+			if err := d.checkTagName(txnCtx, branch.Repo, branch.Name); err != nil {
+				return err
+			}
+		}
 		branchInfo.Branch = branch
 		branchInfo.DirectProvenance = nil
 		for _, provBranch := range provenance {
@@ -1692,8 +1855,14 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 			add(&branchInfo.DirectProvenance, provBranch)
 		}
 		if trigger != nil && trigger.Branch != "" {
+			if branchInfo.Protection != nil {
+				return errors.Errorf("a branch cannot have both a trigger and protection")
+			}
 			branchInfo.Trigger = trigger
 		}
+		if retentionPolicy != nil {
+			branchInfo.RetentionPolicy = cleanRetentionPolicy(retentionPolicy)
+		}
 		return nil
 	}); err != nil {
 		return errors.EnsureStack(err)
@@ -1706,6 +1875,11 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 		if err != nil {
 			return errors.Wrapf(err, "unable to inspect %s", commit)
 		}
+		if branchInfo.Head == nil || branchInfo.Head.ID != ci.Commit.ID {
+			if err := d.checkProtectedHeadMove(txnCtx, branchInfo, ci); err != nil {
+				return err
+			}
+		}
 
 		// Verify the provenance of the new branch head and lock in its upstream commits
 		for _, provBranch := range provenance {
@@ -1967,7 +2141,7 @@ func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 				return errors.EnsureStack(err)
 			}
 			del(&subvBranchInfo.DirectProvenance, branch)
-			if err := d.createBranch(txnCtx, subvBranch, nil, subvBranchInfo.DirectProvenance, nil); err != nil {
+			if err := d.createBranch(txnCtx, subvBranch, nil, subvBranchInfo.DirectProvenance, nil, nil); err != nil {
 				return err
 			}
 		}
@@ -2034,7 +2208,10 @@ func (d *driver) deleteAll(ctx context.Context) error {
 				return err
 			}
 		}
-		return nil
+		if _, err := txnCtx.SqlTx.Exec(`DELETE FROM pfs.quota_usage; DELETE FROM pfs.quota_charges`); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return errors.EnsureStack(d.quotas.ReadWrite(txnCtx.SqlTx).DeleteAll())
 	})
 }
 
@@ -2075,12 +2252,19 @@ func (d *driver) makeEmptyCommit(txnCtx *txncontext.TransactionContext, branchIn
 	return commit, nil
 }
 
-func (d *driver) putCache(ctx context.Context, key string, value *types.Any, fileSetIds []fileset.ID, tag string) error {
-	return d.cache.Put(ctx, key, value, fileSetIds, tag)
+func (d *driver) putCache(ctx context.Context, key string, value *types.Any, fileSetIds []fileset.ID, tag string, ttl time.Duration) error {
+	return d.cache.Put(ctx, key, value, fileSetIds, tag, ttl)
 }
 
 func (d *driver) getCache(ctx context.Context, key string) (*types.Any, error) {
-	return d.cache.Get(ctx, key)
+	value, err := d.cache.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pfsserver.ErrCacheNotFound{Key: key}
+		}
+		return nil, err
+	}
+	return value, nil
 }
 
 func (d *driver) clearCache(ctx context.Context, tagPrefix string) error {
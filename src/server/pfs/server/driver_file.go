@@ -8,9 +8,11 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -29,12 +31,16 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 			branch.Name = commitID
 			commitID = ""
 		}
+		opts, err := d.chunkingOptions(ctx, branch.Repo)
+		if err != nil {
+			return err
+		}
 		commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
 		if err != nil {
 			if !errutil.IsNotFoundError(err) || branch.Name == "" {
 				return err
 			}
-			return d.oneOffModifyFile(ctx, renewer, branch, cb)
+			return d.oneOffModifyFile(ctx, renewer, branch, cb, opts...)
 		}
 		if commitInfo.Finishing != nil {
 			// The commit is already finished - if the commit was explicitly specified,
@@ -42,7 +48,7 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 			if commitID != "" {
 				return pfsserver.ErrCommitFinished{Commit: commitInfo.Commit}
 			}
-			return d.oneOffModifyFile(ctx, renewer, branch, cb, fileset.WithParentID(func() (*fileset.ID, error) {
+			opts = append(opts, fileset.WithParentID(func() (*fileset.ID, error) {
 				parentID, err := d.getFileSet(ctx, commitInfo.Commit)
 				if err != nil {
 					return nil, err
@@ -52,18 +58,57 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 				}
 				return parentID, nil
 			}))
+			return d.oneOffModifyFile(ctx, renewer, branch, cb, opts...)
 		}
-		return d.withCommitUnorderedWriter(ctx, renewer, commitInfo.Commit, cb)
+		return d.withCommitUnorderedWriter(ctx, renewer, commitInfo.Commit, cb, opts...)
 	})
 }
 
+// chunkingOptions returns the unordered writer options for the chunking parameters of a repo.
+func (d *driver) chunkingOptions(ctx context.Context, repo *pfs.Repo) ([]fileset.UnorderedWriterOption, error) {
+	params, err := d.repoChunkingParams(ctx, repo)
+	if err != nil || params == nil {
+		return nil, err
+	}
+	return []fileset.UnorderedWriterOption{fileset.WithChunkingParams(toChunkingParams(params))}, nil
+}
+
+// repoChunkingParams returns the chunking parameters of a repo, or nil if the
+// repo uses the defaults.
+func (d *driver) repoChunkingParams(ctx context.Context, repo *pfs.Repo) (*pfs.ChunkingParams, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return repoInfo.ChunkingParams, nil
+}
+
+// toChunkingParams converts the chunking parameters of a repo to the parameters used by
+// the chunk storage layer. Unset fields are replaced by the defaults.
+func toChunkingParams(params *pfs.ChunkingParams) chunk.ChunkingParams {
+	result := chunk.DefaultChunkingParams()
+	if params.AverageBits > 0 {
+		result.AverageBits = int(params.AverageBits)
+	}
+	if params.MinChunkSizeBytes > 0 {
+		result.MinChunkSize = int(params.MinChunkSizeBytes)
+	}
+	if params.MaxChunkSizeBytes > 0 {
+		result.MaxChunkSize = int(params.MaxChunkSizeBytes)
+	}
+	return result
+}
+
 func (d *driver) oneOffModifyFile(ctx context.Context, renewer *fileset.Renewer, branch *pfs.Branch, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) error {
 	id, err := d.withUnorderedWriter(ctx, renewer, cb, opts...)
 	if err != nil {
 		return err
 	}
 	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		commit, err := d.startCommit(txnCtx, nil, branch, "")
+		commit, err := d.startCommit(txnCtx, nil, branch, "", nil)
 		if err != nil {
 			return err
 		}
@@ -75,8 +120,8 @@ func (d *driver) oneOffModifyFile(ctx context.Context, renewer *fileset.Renewer,
 }
 
 // withCommitWriter calls cb with an unordered writer. All data written to cb is added to the commit, or an error is returned.
-func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset.Renewer, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
-	id, err := d.withUnorderedWriter(ctx, renewer, cb, fileset.WithParentID(func() (*fileset.ID, error) {
+func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset.Renewer, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) error {
+	opts = append(opts, fileset.WithParentID(func() (*fileset.ID, error) {
 		parentID, err := d.getFileSet(ctx, commit)
 		if err != nil {
 			return nil, err
@@ -86,6 +131,7 @@ func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset
 		}
 		return parentID, nil
 	}))
+	id, err := d.withUnorderedWriter(ctx, renewer, cb, opts...)
 	if err != nil {
 		return err
 	}
@@ -230,15 +276,15 @@ func (d *driver) inspectFile(ctx context.Context, file *pfs.File) (*pfs.FileInfo
 	return ret, nil
 }
 
-func (d *driver) listFile(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) error {
+func (d *driver) listFile(ctx context.Context, file *pfs.File, page *filePage, cb func(*pfs.FileInfo) error) error {
 	name := cleanPath(file.Path)
-	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(name), index.WithDatum(file.Datum))
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, page.indexOptions(index.WithPrefix(name), index.WithDatum(file.Datum))...)
 	if err != nil {
 		return err
 	}
 	opts := []SourceOption{
 		WithFilter(func(fs fileset.FileSet) fileset.FileSet {
-			return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+			return page.filterFileSet(fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
 				// Check for directory match (don't return directory in list)
 				if idx.Path == fileset.Clean(name, true) {
 					return false
@@ -249,9 +295,10 @@ func (d *driver) listFile(ctx context.Context, file *pfs.File, cb func(*pfs.File
 				}
 				// Check for sub directory / file match.
 				return strings.HasPrefix(idx.Path, fileset.Clean(name, true))
-			})
+			}))
 		}),
 	}
+	cb = page.callback(cb)
 	s := NewSource(commitInfo, fs, opts...)
 	err = s.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
 		if pathIsChild(name, cleanPath(fi.File.Path)) {
@@ -259,39 +306,46 @@ func (d *driver) listFile(ctx context.Context, file *pfs.File, cb func(*pfs.File
 		}
 		return nil
 	})
+	if errors.Is(err, errutil.ErrBreak) {
+		err = nil
+	}
 	return errors.EnsureStack(err)
 }
 
-func (d *driver) walkFile(ctx context.Context, file *pfs.File, cb func(*pfs.FileInfo) error) (retErr error) {
+func (d *driver) walkFile(ctx context.Context, file *pfs.File, page *filePage, cb func(*pfs.FileInfo) error) (retErr error) {
 	p := cleanPath(file.Path)
 	if p == "/" {
 		p = ""
 	}
-	commitInfo, fs, err := d.openCommit(ctx, file.Commit, index.WithPrefix(p), index.WithDatum(file.Datum))
+	commitInfo, fs, err := d.openCommit(ctx, file.Commit, page.indexOptions(index.WithPrefix(p), index.WithDatum(file.Datum))...)
 	if err != nil {
 		return err
 	}
 	opts := []SourceOption{
 		WithFilter(func(fs fileset.FileSet) fileset.FileSet {
-			return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+			return page.filterFileSet(fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
 				return idx.Path == p || strings.HasPrefix(idx.Path, p+"/")
-			})
+			}))
 		}),
 	}
+	cb = page.callback(cb)
 	s := NewSource(commitInfo, fs, opts...)
-	s = NewErrOnEmpty(s, newFileNotFound(commitInfo.Commit.ID, p))
+	// A page after the first one may be empty even though the file exists.
+	if page.after == "" {
+		s = NewErrOnEmpty(s, newFileNotFound(commitInfo.Commit.ID, p))
+	}
 	err = s.Iterate(ctx, func(fi *pfs.FileInfo, f fileset.File) error {
 		return cb(fi)
 	})
-	if p == "" && pacherr.IsNotExist(err) {
+	if p == "" && pacherr.IsNotExist(err) || errors.Is(err, errutil.ErrBreak) {
 		err = nil
 	}
 	return err
 }
 
-func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string, cb func(*pfs.FileInfo) error) error {
+func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string, page *filePage, cb func(*pfs.FileInfo) error) error {
 	glob = cleanPath(glob)
-	commitInfo, fs, err := d.openCommit(ctx, commit, index.WithPrefix(globLiteralPrefix(glob)))
+	commitInfo, fs, err := d.openCommit(ctx, commit, page.indexOptions(index.WithPrefix(globLiteralPrefix(glob)))...)
 	if err != nil {
 		return err
 	}
@@ -301,11 +355,12 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string,
 	}
 	opts := []SourceOption{
 		WithFilter(func(fs fileset.FileSet) fileset.FileSet {
-			return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+			return page.filterFileSet(fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
 				return mf(idx.Path)
-			}, true)
+			}, true))
 		}),
 	}
+	cb = page.callback(cb)
 	s := NewSource(commitInfo, fs, opts...)
 	err = s.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
 		if mf(fi.File.Path) {
@@ -313,6 +368,9 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, glob string,
 		}
 		return nil
 	})
+	if errors.Is(err, errutil.ErrBreak) {
+		err = nil
+	}
 	return errors.EnsureStack(err)
 }
 
@@ -394,11 +452,12 @@ func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb fu
 }
 
 // createFileSet creates a new temporary fileset and returns it.
-func (d *driver) createFileSet(ctx context.Context, cb func(*fileset.UnorderedWriter) error) (*fileset.ID, error) {
+func (d *driver) createFileSet(ctx context.Context, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) (*fileset.ID, error) {
 	var id *fileset.ID
 	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
 		var err error
-		id, err = d.withUnorderedWriter(ctx, renewer, cb, fileset.WithCompact(d.env.StorageConfig.StorageCompactionMaxFanIn))
+		opts := append([]fileset.UnorderedWriterOption{fileset.WithCompact(d.env.StorageConfig.StorageCompactionMaxFanIn)}, opts...)
+		id, err = d.withUnorderedWriter(ctx, renewer, cb, opts...)
 		return err
 	}); err != nil {
 		return nil, err
@@ -1,9 +1,11 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"strings"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -88,12 +90,9 @@ func copyToSQLDB(ctx context.Context, src Source, destURL string, fileFormat *pf
 				return errors.EnsureStack(file.Content(ctx, w))
 			},
 			func(r io.Reader) error {
-				var tr sdata.TupleReader
-				switch fileFormat.Type {
-				case pfs.SQLDatabaseEgress_FileFormat_CSV:
-					tr = sdata.NewCSVParser(r)
-				case pfs.SQLDatabaseEgress_FileFormat_JSON:
-					tr = sdata.NewJSONParser(r, fileFormat.Columns)
+				tr, err := newTupleReader(r, fileFormat)
+				if err != nil {
+					return err
 				}
 				tw := sdata.NewSQLTupleWriter(tx, tableInfo)
 				tuple, err := sdata.NewTupleFromTableInfo(tableInfo)
@@ -113,3 +112,226 @@ func copyToSQLDB(ctx context.Context, src Source, destURL string, fileFormat *pf
 	}
 	return result, errors.EnsureStack(tx.Commit())
 }
+
+// copyDiffToSQLDB applies the rows which differ between the files in base and src to the tables of a SQL database.
+// Rows are matched on the primary key columns, so that rows which were removed are deleted
+// and rows which were added or modified are upserted. Unchanged files are not read.
+func copyDiffToSQLDB(ctx context.Context, base, src Source, destURL string, fileFormat *pfs.SQLDatabaseEgress_FileFormat, primaryKey []string) (*pfs.EgressResponse_SQLDatabaseResult, error) {
+	url, err := pachsql.ParseURL(destURL)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	password, err := getEgressPassword()
+	if err != nil {
+		return nil, err
+	}
+	db, err := pachsql.OpenURL(*url, password)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer db.Close()
+
+	// all table are written through a single transaction
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer tx.Rollback()
+
+	tables := make(map[string]*tableDiff)
+	err = NewDiffer(base, src).IterateFiles(ctx, func(oldFi *pfs.FileInfo, oldFile fileset.File, newFi *pfs.FileInfo, newFile fileset.File) error {
+		if oldFi != nil && oldFi.FileType != pfs.FileType_FILE {
+			oldFi, oldFile = nil, nil
+		}
+		if newFi != nil && newFi.FileType != pfs.FileType_FILE {
+			newFi, newFile = nil, nil
+		}
+		var p string
+		switch {
+		case newFi != nil:
+			p = newFi.File.Path
+		case oldFi != nil:
+			p = oldFi.File.Path
+		default:
+			return nil
+		}
+		tableName := strings.Split(p, "/")[1]
+		td, ok := tables[tableName]
+		if !ok {
+			tableInfo, err := pachsql.GetTableInfoTx(tx, tableName)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			td, err = newTableDiff(tableInfo, primaryKey)
+			if err != nil {
+				return err
+			}
+			tables[tableName] = td
+		}
+		oldRows, err := td.readRows(ctx, oldFile, fileFormat)
+		if err != nil {
+			return err
+		}
+		newRows, err := td.readRows(ctx, newFile, fileFormat)
+		if err != nil {
+			return err
+		}
+		for key, row := range oldRows {
+			if _, ok := newRows[key]; !ok {
+				td.deletes[key] = row
+			}
+		}
+		for key, row := range newRows {
+			if oldRow, ok := oldRows[key]; !ok || !reflect.DeepEqual(oldRow, row) {
+				td.upserts[key] = row
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+
+	result := new(pfs.EgressResponse_SQLDatabaseResult)
+	result.RowsWritten = make(map[string]int64)
+	result.RowsDeleted = make(map[string]int64)
+	for tableName, td := range tables {
+		deleted, written, err := td.apply(tx)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsDeleted[tableName] = deleted
+		result.RowsWritten[tableName] = written
+	}
+	return result, errors.EnsureStack(tx.Commit())
+}
+
+// tableDiff accumulates the row changes for a single table, keyed by primary key.
+type tableDiff struct {
+	tableInfo  *pachsql.TableInfo
+	primaryKey []string
+	keyIndices []int
+	deletes    map[string]sdata.Tuple
+	upserts    map[string]sdata.Tuple
+}
+
+func newTableDiff(tableInfo *pachsql.TableInfo, primaryKey []string) (*tableDiff, error) {
+	td := &tableDiff{
+		tableInfo: tableInfo,
+		deletes:   make(map[string]sdata.Tuple),
+		upserts:   make(map[string]sdata.Tuple),
+	}
+	for _, col := range primaryKey {
+		i := -1
+		for j, ci := range tableInfo.Columns {
+			if strings.EqualFold(ci.Name, col) {
+				i = j
+				break
+			}
+		}
+		if i < 0 {
+			return nil, errors.Errorf("primary key column %q not found in table %s.%s", col, tableInfo.Schema, tableInfo.Name)
+		}
+		td.primaryKey = append(td.primaryKey, tableInfo.Columns[i].Name)
+		td.keyIndices = append(td.keyIndices, i)
+	}
+	return td, nil
+}
+
+// readRows reads all of the rows in file and indexes them by primary key.
+// A nil file has no rows.
+func (td *tableDiff) readRows(ctx context.Context, file fileset.File, fileFormat *pfs.SQLDatabaseEgress_FileFormat) (map[string]sdata.Tuple, error) {
+	rows := make(map[string]sdata.Tuple)
+	if file == nil {
+		return rows, nil
+	}
+	if err := miscutil.WithPipe(
+		func(w io.Writer) error {
+			return errors.EnsureStack(file.Content(ctx, w))
+		},
+		func(r io.Reader) error {
+			tr, err := newTupleReader(r, fileFormat)
+			if err != nil {
+				return err
+			}
+			for {
+				row, err := sdata.NewTupleFromTableInfo(td.tableInfo)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				if err := tr.Next(row); err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					return errors.EnsureStack(err)
+				}
+				key, err := td.key(row)
+				if err != nil {
+					return err
+				}
+				rows[key] = row
+			}
+		}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return rows, nil
+}
+
+// key encodes the primary key columns of row as a string which can be used as a map key.
+func (td *tableDiff) key(row sdata.Tuple) (string, error) {
+	data, err := json.Marshal(td.keyTuple(row))
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return string(data), nil
+}
+
+func (td *tableDiff) keyTuple(row sdata.Tuple) sdata.Tuple {
+	key := make(sdata.Tuple, len(td.keyIndices))
+	for i, j := range td.keyIndices {
+		key[i] = row[j]
+	}
+	return key
+}
+
+// apply deletes the removed rows and then upserts the added and modified rows.
+// Rows which moved between files are only upserted.
+func (td *tableDiff) apply(tx *pachsql.Tx) (deleted, written int64, _ error) {
+	dw := sdata.NewSQLDeleteWriter(tx, td.tableInfo, td.primaryKey)
+	for key, row := range td.deletes {
+		if _, ok := td.upserts[key]; ok {
+			continue
+		}
+		if err := dw.WriteTuple(td.keyTuple(row)); err != nil {
+			return 0, 0, errors.EnsureStack(err)
+		}
+		deleted++
+	}
+	if err := dw.Flush(); err != nil {
+		return 0, 0, errors.EnsureStack(err)
+	}
+	uw, err := sdata.NewSQLUpsertWriter(tx, td.tableInfo, td.primaryKey)
+	if err != nil {
+		return 0, 0, errors.EnsureStack(err)
+	}
+	for _, row := range td.upserts {
+		if err := uw.WriteTuple(row); err != nil {
+			return 0, 0, errors.EnsureStack(err)
+		}
+		written++
+	}
+	return deleted, written, errors.EnsureStack(uw.Flush())
+}
+
+func newTupleReader(r io.Reader, fileFormat *pfs.SQLDatabaseEgress_FileFormat) (sdata.TupleReader, error) {
+	switch fileFormat.Type {
+	case pfs.SQLDatabaseEgress_FileFormat_CSV:
+		return sdata.NewCSVParser(r), nil
+	case pfs.SQLDatabaseEgress_FileFormat_JSON:
+		return sdata.NewJSONParser(r, fileFormat.Columns), nil
+	case pfs.SQLDatabaseEgress_FileFormat_PARQUET:
+		return sdata.NewParquetParser(r), nil
+	default:
+		return nil, errors.Errorf("unrecognized file format %v", fileFormat.Type)
+	}
+}
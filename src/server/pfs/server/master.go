@@ -16,7 +16,9 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
@@ -59,9 +61,24 @@ func (d *driver) master(ctx context.Context) {
 				return gc.RunForever(ctx)
 			})
 		}
+		retentionPeriod := time.Second * time.Duration(d.env.StorageConfig.StorageRetentionPeriod)
+		if retentionPeriod <= 0 {
+			d.log.Info("Skipping retention policies")
+		} else {
+			d.log.Infof("Applying retention policies with period=%v", retentionPeriod)
+			eg.Go(func() error {
+				return d.applyRetentionForever(ctx, retentionPeriod)
+			})
+		}
 		eg.Go(func() error {
 			return d.finishCommits(ctx)
 		})
+		eg.Go(func() error {
+			return d.indexCommits(ctx)
+		})
+		eg.Go(func() error {
+			return d.masterTaskWorker(ctx)
+		})
 		return errors.EnsureStack(eg.Wait())
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
@@ -69,14 +86,55 @@ func (d *driver) master(ctx context.Context) {
 	})
 }
 
+// masterTaskWorker processes the tasks which must run in the PFS master, so
+// they don't race with the work the master does in the background.
+func (d *driver) masterTaskWorker(ctx context.Context) error {
+	taskSource := d.env.TaskService.NewSource(masterTaskNamespace)
+	return errors.EnsureStack(taskSource.Iterate(ctx, func(ctx context.Context, input *types.Any) (*types.Any, error) {
+		switch {
+		case types.Is(input, &GarbageCollectTask{}):
+			if err := d.storage.NewGC(0).RunUntilEmpty(ctx); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			if err := chunk.NewGC(d.storage.ChunkStorage(), 0, d.log).RunOnce(ctx); err != nil {
+				return nil, err
+			}
+			output, err := types.MarshalAny(&GarbageCollectTaskResult{})
+			return output, errors.EnsureStack(err)
+		default:
+			return nil, errors.Errorf("unrecognized any type (%v) in pfs master", input.TypeUrl)
+		}
+	}))
+}
+
+// garbageCollect runs storage garbage collection in the PFS master, and
+// waits for it to finish.
+func (d *driver) garbageCollect(ctx context.Context) error {
+	input, err := types.MarshalAny(&GarbageCollectTask{Id: uuid.NewWithoutDashes()})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	doer := d.env.TaskService.NewDoer(masterTaskNamespace, uuid.NewWithoutDashes(), nil)
+	_, err = task.DoOne(ctx, doer, input)
+	return errors.EnsureStack(err)
+}
+
 func (d *driver) finishCommits(ctx context.Context) error {
+	compactor := newCompactor(d.storage, d.env.StorageConfig.StorageCompactionMaxFanIn)
+	return d.forEachRepo(ctx, "finishing commits", func(ctx context.Context, repoKey string) error {
+		return d.finishRepoCommits(ctx, compactor, repoKey)
+	})
+}
+
+// forEachRepo runs f in the background for each repo, until the repo is
+// deleted, retrying it whenever it errors. op describes what f does in logs.
+func (d *driver) forEachRepo(ctx context.Context, op string, f func(ctx context.Context, repoKey string) error) error {
 	repos := make(map[string]context.CancelFunc)
 	defer func() {
 		for _, cancel := range repos {
 			cancel()
 		}
 	}()
-	compactor := newCompactor(d.storage, d.env.StorageConfig.StorageCompactionMaxFanIn)
 	err := d.repos.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
 		if ev.Type == watch.EventError {
 			return ev.Err
@@ -96,9 +154,9 @@ func (d *driver) finishCommits(ctx context.Context) error {
 		repos[key] = cancel
 		go func() {
 			backoff.RetryUntilCancel(ctx, func() error {
-				return d.finishRepoCommits(ctx, compactor, key)
+				return f(ctx, key)
 			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-				log.Errorf("error finishing commits for repo %v: %v, retrying in %v", key, err, d)
+				log.Errorf("error %s for repo %v: %v, retrying in %v", op, key, err, d)
 				return nil
 			})
 		}()
@@ -141,6 +199,13 @@ func (d *driver) finishRepoCommits(ctx context.Context, compactor *compactor, re
 					}
 					return err
 				}
+				params, err := d.repoChunkingParams(ctx, commit.Branch.Repo)
+				if err != nil {
+					if pfsserver.IsRepoNotFoundErr(err) {
+						return nil
+					}
+					return err
+				}
 				details := &pfs.CommitInfo_Details{}
 				// Compact the commit.
 				taskDoer := d.env.TaskService.NewDoer(storageTaskNamespace, commit.ID, cache)
@@ -148,7 +213,7 @@ func (d *driver) finishRepoCommits(ctx context.Context, compactor *compactor, re
 				start := time.Now()
 				if err := miscutil.LogStep(fmt.Sprintf("compacting commit %v", commit), func() error {
 					var err error
-					totalId, err = compactor.Compact(ctx, taskDoer, []fileset.ID{*id}, defaultTTL)
+					totalId, err = compactor.Compact(ctx, taskDoer, []fileset.ID{*id}, defaultTTL, params)
 					if err != nil {
 						return err
 					}
@@ -161,9 +226,12 @@ func (d *driver) finishRepoCommits(ctx context.Context, compactor *compactor, re
 				start = time.Now()
 				var validationError string
 				if err := miscutil.LogStep(fmt.Sprintf("validating commit %v", commit), func() error {
-					var err error
-					details.SizeBytes, validationError, err = compactor.Validate(ctx, taskDoer, *totalId)
-					return err
+					result, err := compactor.Validate(ctx, taskDoer, *totalId)
+					if err != nil {
+						return err
+					}
+					details.SizeBytes, details.FileCount, validationError = result.SizeBytes, result.FileCount, result.Error
+					return nil
 				}); err != nil {
 					return err
 				}
@@ -196,6 +264,26 @@ func (d *driver) finalizeCommit(ctx context.Context, commit *pfs.Commit, validat
 			}); err != nil {
 				return errors.EnsureStack(err)
 			}
+			if commitInfo.Error == "" && details != nil {
+				// The usage is computed from the commit's details, so the
+				// quotas are charged after it is updated.
+				if err := d.chargeQuotas(txnCtx, commitInfo); err != nil {
+					if !pfsserver.IsQuotaExceededErr(err) {
+						return err
+					}
+					commitInfo.Error = err.Error()
+					if err := d.commits.ReadWrite(txnCtx.SqlTx).Put(pfsdb.CommitKey(commit), commitInfo); err != nil {
+						return errors.EnsureStack(err)
+					}
+				}
+			}
+			if commitInfo.Error == "" {
+				// The commit is added to its repo's search index in the
+				// background, so indexing doesn't hold up finishing it.
+				if err := d.searchIndexes.AddCommitTx(txnCtx.SqlTx, commitInfo.Commit); err != nil {
+					return err
+				}
+			}
 			if commitInfo.Commit.Branch.Repo.Type == pfs.UserRepoType {
 				txnCtx.FinishJob(commitInfo)
 			}
@@ -0,0 +1,273 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// mergeChange is a path which was changed on the target branch since the
+// merge base.
+type mergeChange struct {
+	base, ours *pfs.FileInfo
+}
+
+// mergeBranch merges the changes made on source since its most recent common
+// ancestor with target into target, as a new commit whose parent is target's
+// head. Only files are merged; directories follow from the files they contain.
+func (d *driver) mergeBranch(ctx context.Context, source, target *pfs.Branch, strategy pfs.MergeStrategy, description string) (*pfs.MergeBranchResponse, error) {
+	if source == nil || source.Repo == nil || target == nil || target.Repo == nil {
+		return nil, errors.New("source and target branches must be set")
+	}
+	if !proto.Equal(source.Repo, target.Repo) {
+		return nil, errors.Errorf("cannot merge branch %v into branch %v in a different repo", source, target)
+	}
+	if source.Name == target.Name {
+		return nil, errors.Errorf("cannot merge branch %v into itself", source)
+	}
+	theirsInfo, err := d.inspectCommit(ctx, source.NewCommit(""), pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	oursInfo, err := d.inspectCommit(ctx, target.NewCommit(""), pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	for _, ci := range []*pfs.CommitInfo{theirsInfo, oursInfo} {
+		if ci.Finished == nil {
+			return nil, pfsserver.ErrCommitNotFinished{Commit: ci.Commit}
+		}
+		if ci.Error != "" {
+			return nil, pfsserver.ErrCommitError{Commit: ci.Commit}
+		}
+	}
+	base, err := d.mergeBase(ctx, oursInfo.Commit, theirsInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	response := &pfs.MergeBranchResponse{Base: base}
+	if base != nil && base.ID == theirsInfo.Commit.ID {
+		// target already contains everything on source.
+		return response, nil
+	}
+	var baseSource Source = emptySource{}
+	if base != nil {
+		baseInfo, fs, err := d.openCommit(ctx, base)
+		if err != nil {
+			return nil, err
+		}
+		baseSource = NewSource(baseInfo, fs)
+	}
+	_, oursFs, err := d.openCommit(ctx, oursInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	_, theirsFs, err := d.openCommit(ctx, theirsInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+
+	ours := make(map[string]*mergeChange)
+	if err := NewDiffer(baseSource, NewSource(oursInfo, oursFs)).Iterate(ctx, func(baseFi, oursFi *pfs.FileInfo) error {
+		baseFi, oursFi = mergeFile(baseFi), mergeFile(oursFi)
+		if p := mergePath(baseFi, oursFi); p != "" {
+			ours[p] = &mergeChange{base: baseFi, ours: oursFi}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	copies := make(map[string]bool)
+	var deletes []string
+	if err := NewDiffer(baseSource, NewSource(theirsInfo, theirsFs)).Iterate(ctx, func(baseFi, theirsFi *pfs.FileInfo) error {
+		baseFi, theirsFi = mergeFile(baseFi), mergeFile(theirsFi)
+		p := mergePath(baseFi, theirsFi)
+		if p == "" {
+			return nil
+		}
+		if change, ok := ours[p]; ok {
+			if equalMergeFiles(change.ours, theirsFi) {
+				// Both branches made the same change.
+				return nil
+			}
+			response.Conflicts = append(response.Conflicts, &pfs.MergeConflict{
+				Path:   p,
+				Base:   baseFi,
+				Ours:   change.ours,
+				Theirs: theirsFi,
+			})
+			if strategy != pfs.MergeStrategy_THEIRS {
+				return nil
+			}
+		}
+		if theirsFi == nil {
+			deletes = append(deletes, p)
+		} else {
+			copies[p] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(response.Conflicts) > 0 && strategy == pfs.MergeStrategy_FAIL {
+		return response, nil
+	}
+
+	if description == "" {
+		description = fmt.Sprintf("Merge branch %s into %s", theirsInfo.Commit.Branch.Name, oursInfo.Commit.Branch.Name)
+	}
+	opts, err := d.chunkingOptions(ctx, target.Repo)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+		opts = append(opts, fileset.WithParentID(func() (*fileset.ID, error) {
+			parentID, err := d.getFileSet(ctx, oursInfo.Commit)
+			if err != nil {
+				return nil, err
+			}
+			if err := renewer.Add(ctx, *parentID); err != nil {
+				return nil, err
+			}
+			return parentID, nil
+		}))
+		id, err := d.withUnorderedWriter(ctx, renewer, func(uw *fileset.UnorderedWriter) error {
+			for _, p := range deletes {
+				if err := uw.Delete(p, ""); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
+			if len(copies) == 0 {
+				return nil
+			}
+			fs := fileset.NewIndexFilter(theirsFs, func(idx *index.Index) bool {
+				return copies[idx.Path]
+			})
+			return errors.EnsureStack(uw.Copy(ctx, fs, "", false))
+		}, opts...)
+		if err != nil {
+			return err
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			branchInfo := &pfs.BranchInfo{}
+			if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(oursInfo.Commit.Branch, branchInfo); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if branchInfo.Head.ID != oursInfo.Commit.ID {
+				return errors.Errorf("branch %v moved while it was being merged into", oursInfo.Commit.Branch)
+			}
+			commit, err := d.startCommit(txnCtx, nil, oursInfo.Commit.Branch, description, nil)
+			if err != nil {
+				return err
+			}
+			commitInfo := &pfs.CommitInfo{}
+			if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(pfsdb.CommitKey(commit), commitInfo, func() error {
+				commitInfo.Merged = theirsInfo.Commit
+				return nil
+			}); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, commit, *id); err != nil {
+				return errors.EnsureStack(err)
+			}
+			response.Commit = commit
+			return d.finishCommit(txnCtx, commit, "", "", false)
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// mergeBase returns the most recent common ancestor of the commits, or nil if
+// they have none.
+func (d *driver) mergeBase(ctx context.Context, ours, theirs *pfs.Commit) (*pfs.Commit, error) {
+	ancestors := make(map[string]bool)
+	if err := d.walkAncestors(ctx, ours, func(ci *pfs.CommitInfo) error {
+		ancestors[ci.Commit.ID] = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var base *pfs.Commit
+	if err := d.walkAncestors(ctx, theirs, func(ci *pfs.CommitInfo) error {
+		if ancestors[ci.Commit.ID] {
+			base = ci.Commit
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return nil, err
+	}
+	return base, nil
+}
+
+// walkAncestors calls cb with commit and each of its ancestors, breadth first.
+// The ancestors of a merge commit include the commit merged into it.
+func (d *driver) walkAncestors(ctx context.Context, commit *pfs.Commit, cb func(*pfs.CommitInfo) error) error {
+	queue := []*pfs.Commit{commit}
+	visited := make(map[string]bool)
+	for len(queue) > 0 {
+		key := pfsdb.CommitKey(queue[0])
+		queue = queue[1:]
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+		ci := &pfs.CommitInfo{}
+		if err := d.commits.ReadOnly(ctx).Get(key, ci); err != nil {
+			if col.IsErrNotFound(err) {
+				// The commit was squashed.
+				continue
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := cb(ci); err != nil {
+			return err
+		}
+		if ci.ParentCommit != nil {
+			queue = append(queue, ci.ParentCommit)
+		}
+		if ci.Merged != nil {
+			queue = append(queue, ci.Merged)
+		}
+	}
+	return nil
+}
+
+// mergeFile returns fi if it is a file, and nil otherwise.
+func mergeFile(fi *pfs.FileInfo) *pfs.FileInfo {
+	if fi == nil || fi.FileType != pfs.FileType_FILE {
+		return nil
+	}
+	return fi
+}
+
+func mergePath(a, b *pfs.FileInfo) string {
+	switch {
+	case a != nil:
+		return a.File.Path
+	case b != nil:
+		return b.File.Path
+	default:
+		return ""
+	}
+}
+
+func equalMergeFiles(a, b *pfs.FileInfo) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalFileInfos(a, b)
+}
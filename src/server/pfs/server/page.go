@@ -0,0 +1,198 @@
+package server
+
+import (
+	"regexp"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// filePage applies the filter and paging parameters of a ListFile, WalkFile
+// or GlobFile request to a file listing.
+type filePage struct {
+	filter   *pfs.FileFilter
+	regex    *regexp.Regexp
+	after    string
+	pageSize int64
+}
+
+func newFilePage(filter *pfs.FileFilter, pageSize int64, pageToken string) (*filePage, error) {
+	if pageSize < 0 {
+		return nil, errors.Errorf("page size cannot be negative")
+	}
+	p := &filePage{
+		filter:   filter,
+		pageSize: pageSize,
+	}
+	if filter == nil {
+		p.filter = &pfs.FileFilter{}
+	}
+	if p.filter.PathRegex != "" {
+		var err error
+		p.regex, err = regexp.Compile(p.filter.PathRegex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid path regex")
+		}
+	}
+	if pageToken != "" {
+		var err error
+		p.after, err = pfs.ParseFilePageToken(pageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// indexOptions adds an index option which starts the read at the page
+// token's file, so that the files before it are not read at all.
+func (p *filePage) indexOptions(opts ...index.Option) []index.Option {
+	if p.after == "" {
+		return opts
+	}
+	return append(opts, index.WithRange(&index.PathRange{Lower: p.after}))
+}
+
+// filterFileSet removes the page token's file, and the directories
+// containing it, from fs.
+func (p *filePage) filterFileSet(fs fileset.FileSet) fileset.FileSet {
+	if p.after == "" {
+		return fs
+	}
+	return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+		return idx.Path > p.after
+	})
+}
+
+func (p *filePage) match(fi *pfs.FileInfo) bool {
+	f := p.filter
+	if fi.SizeBytes < f.MinSizeBytes || f.MaxSizeBytes > 0 && fi.SizeBytes > f.MaxSizeBytes {
+		return false
+	}
+	if !matchTimestamp(fi.Committed, f.CommittedAfter, f.CommittedBefore) {
+		return false
+	}
+	if !matchMetadata(fi.Metadata, f.Metadata) {
+		return false
+	}
+	return p.regex == nil || p.regex.MatchString(fi.File.Path)
+}
+
+// callback wraps cb so that it is only called with the files which match the
+// filter, and returns errutil.ErrBreak once the page is full.
+func (p *filePage) callback(cb func(*pfs.FileInfo) error) func(*pfs.FileInfo) error {
+	var n int64
+	return func(fi *pfs.FileInfo) error {
+		if !p.match(fi) {
+			return nil
+		}
+		if err := cb(fi); err != nil {
+			return err
+		}
+		n++
+		if p.pageSize > 0 && n >= p.pageSize {
+			return errutil.ErrBreak
+		}
+		return nil
+	}
+}
+
+// commitPage applies the filter and paging parameters of a ListCommit
+// request to a commit listing.
+type commitPage struct {
+	filter   *pfs.CommitFilter
+	after    *pfs.Commit
+	pageSize int64
+}
+
+func newCommitPage(filter *pfs.CommitFilter, pageSize int64, pageToken string) (*commitPage, error) {
+	if pageSize < 0 {
+		return nil, errors.Errorf("page size cannot be negative")
+	}
+	p := &commitPage{
+		filter:   filter,
+		pageSize: pageSize,
+	}
+	if filter == nil {
+		p.filter = &pfs.CommitFilter{}
+	}
+	if pageToken != "" {
+		var err error
+		p.after, err = pfs.ParseCommitPageToken(pageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// limit returns the number of commits to list, given the number requested
+// (0 meaning all of them).
+func (p *commitPage) limit(number int64) int64 {
+	if p.pageSize > 0 && (number == 0 || p.pageSize < number) {
+		return p.pageSize
+	}
+	return number
+}
+
+// skip returns true for the commits which precede the page token's commit
+// in the listing, and for the page token's commit itself. The listing is
+// expected to start close to the page token's commit, at the commits created
+// at the same time as it.
+func (p *commitPage) skip(ci *pfs.CommitInfo) bool {
+	if p.after == nil {
+		return false
+	}
+	if ci.Commit.ID == p.after.ID && ci.Commit.Branch.String() == p.after.Branch.String() {
+		p.after = nil
+	}
+	return true
+}
+
+func (p *commitPage) match(ci *pfs.CommitInfo) bool {
+	f := p.filter
+	if f.MinSizeBytes > 0 || f.MaxSizeBytes > 0 {
+		if ci.Details == nil {
+			return false
+		}
+		size := ci.Details.SizeBytes
+		if size < f.MinSizeBytes || f.MaxSizeBytes > 0 && size > f.MaxSizeBytes {
+			return false
+		}
+	}
+	if !matchMetadata(ci.Metadata, f.Metadata) {
+		return false
+	}
+	return matchTimestamp(ci.Finished, f.FinishedAfter, f.FinishedBefore)
+}
+
+// matchTimestamp returns whether t is within [after, before), where a nil
+// bound is unbounded. A nil t only matches when both bounds are nil.
+func matchTimestamp(t, after, before *types.Timestamp) bool {
+	if after == nil && before == nil {
+		return true
+	}
+	if t == nil {
+		return false
+	}
+	if after != nil && t.Compare(after) < 0 {
+		return false
+	}
+	return before == nil || t.Compare(before) < 0
+}
+
+// matchMetadata returns whether metadata contains all of the key/value pairs in
+// filter.
+func matchMetadata(metadata, filter map[string]string) bool {
+	for k, v := range filter {
+		if value, ok := metadata[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
@@ -6,6 +6,7 @@ package server
 import (
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	pfs "github.com/pachyderm/pachyderm/v2/src/pfs"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -188,11 +189,14 @@ func (m *PathRange) GetUpperDatum() string {
 }
 
 type CompactTask struct {
-	Inputs               []string   `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	PathRange            *PathRange `protobuf:"bytes,2,opt,name=path_range,json=pathRange,proto3" json:"path_range,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Inputs    []string   `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	PathRange *PathRange `protobuf:"bytes,2,opt,name=path_range,json=pathRange,proto3" json:"path_range,omitempty"`
+	// chunking_params are the chunking parameters of the repo being compacted,
+	// used for content that has to be rewritten.
+	ChunkingParams       *pfs.ChunkingParams `protobuf:"bytes,3,opt,name=chunking_params,json=chunkingParams,proto3" json:"chunking_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CompactTask) Reset()         { *m = CompactTask{} }
@@ -242,6 +246,13 @@ func (m *CompactTask) GetPathRange() *PathRange {
 	return nil
 }
 
+func (m *CompactTask) GetChunkingParams() *pfs.ChunkingParams {
+	if m != nil {
+		return m.ChunkingParams
+	}
+	return nil
+}
+
 type CompactTaskResult struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -430,9 +441,99 @@ func (m *ValidateTask) GetId() string {
 	return ""
 }
 
+// GarbageCollectTask runs storage garbage collection until nothing is left to
+// collect. It is processed by the PFS master.
+type GarbageCollectTask struct {
+	// id distinguishes the garbage collection requests.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GarbageCollectTask) Reset()         { *m = GarbageCollectTask{} }
+func (m *GarbageCollectTask) String() string { return proto.CompactTextString(m) }
+func (*GarbageCollectTask) ProtoMessage()    {}
+func (*GarbageCollectTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a92e512e703e9c, []int{8}
+}
+func (m *GarbageCollectTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GarbageCollectTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GarbageCollectTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GarbageCollectTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GarbageCollectTask.Merge(m, src)
+}
+func (m *GarbageCollectTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *GarbageCollectTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_GarbageCollectTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GarbageCollectTask proto.InternalMessageInfo
+
+func (m *GarbageCollectTask) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GarbageCollectTaskResult struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GarbageCollectTaskResult) Reset()         { *m = GarbageCollectTaskResult{} }
+func (m *GarbageCollectTaskResult) String() string { return proto.CompactTextString(m) }
+func (*GarbageCollectTaskResult) ProtoMessage()    {}
+func (*GarbageCollectTaskResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a92e512e703e9c, []int{9}
+}
+func (m *GarbageCollectTaskResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GarbageCollectTaskResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GarbageCollectTaskResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GarbageCollectTaskResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GarbageCollectTaskResult.Merge(m, src)
+}
+func (m *GarbageCollectTaskResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *GarbageCollectTaskResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_GarbageCollectTaskResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GarbageCollectTaskResult proto.InternalMessageInfo
+
 type ValidateTaskResult struct {
 	SizeBytes            int64    `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	FileCount            int64    `protobuf:"varint,3,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -442,7 +543,7 @@ func (m *ValidateTaskResult) Reset()         { *m = ValidateTaskResult{} }
 func (m *ValidateTaskResult) String() string { return proto.CompactTextString(m) }
 func (*ValidateTaskResult) ProtoMessage()    {}
 func (*ValidateTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5a92e512e703e9c, []int{8}
+	return fileDescriptor_a5a92e512e703e9c, []int{10}
 }
 func (m *ValidateTaskResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,6 +586,13 @@ func (m *ValidateTaskResult) GetError() string {
 	return ""
 }
 
+func (m *ValidateTaskResult) GetFileCount() int64 {
+	if m != nil {
+		return m.FileCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ShardTask)(nil), "pfsserver.ShardTask")
 	proto.RegisterType((*ShardTaskResult)(nil), "pfsserver.ShardTaskResult")
@@ -494,37 +602,43 @@ func init() {
 	proto.RegisterType((*ConcatTask)(nil), "pfsserver.ConcatTask")
 	proto.RegisterType((*ConcatTaskResult)(nil), "pfsserver.ConcatTaskResult")
 	proto.RegisterType((*ValidateTask)(nil), "pfsserver.ValidateTask")
+	proto.RegisterType((*GarbageCollectTask)(nil), "pfsserver.GarbageCollectTask")
+	proto.RegisterType((*GarbageCollectTaskResult)(nil), "pfsserver.GarbageCollectTaskResult")
 	proto.RegisterType((*ValidateTaskResult)(nil), "pfsserver.ValidateTaskResult")
 }
 
 func init() { proto.RegisterFile("server/pfs/server/pfsserver.proto", fileDescriptor_a5a92e512e703e9c) }
 
 var fileDescriptor_a5a92e512e703e9c = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xbd, 0x4e, 0xeb, 0x30,
-	0x14, 0x56, 0xda, 0x7b, 0x2b, 0xe5, 0xa4, 0xf7, 0x02, 0x51, 0x55, 0x65, 0x21, 0x14, 0x97, 0xa1,
-	0x53, 0x23, 0xb5, 0x03, 0x03, 0x5b, 0x0b, 0x03, 0x0b, 0x42, 0x01, 0x31, 0x74, 0x89, 0xdc, 0xc4,
-	0x34, 0x51, 0x7f, 0x6c, 0xd9, 0x4e, 0x51, 0x79, 0x42, 0x46, 0x1e, 0x01, 0xf5, 0x49, 0x90, 0xed,
-	0x90, 0x44, 0xa0, 0xb2, 0x9d, 0xef, 0xc7, 0xc7, 0xe7, 0x3b, 0x3a, 0x70, 0x2e, 0x08, 0xdf, 0x12,
-	0x1e, 0xb0, 0x67, 0x11, 0x54, 0xa5, 0xa9, 0x86, 0x8c, 0x53, 0x49, 0x5d, 0xbb, 0x24, 0x50, 0x1f,
-	0xec, 0x87, 0x14, 0xf3, 0xe4, 0x11, 0x8b, 0xa5, 0xdb, 0x85, 0x56, 0xb6, 0x61, 0xb9, 0x14, 0x9e,
-	0xd5, 0x6b, 0x0e, 0xec, 0xb0, 0x40, 0xe8, 0x0e, 0x8e, 0x4a, 0x53, 0x48, 0x44, 0xbe, 0x92, 0xee,
-	0x15, 0xfc, 0x8b, 0xe9, 0x9a, 0xe1, 0x58, 0x46, 0x12, 0x8b, 0xa5, 0x79, 0xe1, 0x8c, 0xba, 0xc3,
-	0xea, 0xaf, 0xa9, 0xd1, 0xf5, 0xa3, 0x76, 0x5c, 0x01, 0x81, 0x76, 0x60, 0xdf, 0x63, 0x99, 0x86,
-	0x78, 0xb3, 0x20, 0x6e, 0x07, 0xfe, 0xae, 0xe8, 0x0b, 0xe1, 0x9e, 0xd5, 0xb3, 0x06, 0x76, 0x68,
-	0x80, 0x62, 0x73, 0xc6, 0x08, 0xf7, 0x1a, 0x86, 0xd5, 0xc0, 0x3d, 0x03, 0x47, 0xcb, 0x51, 0x82,
-	0x65, 0xbe, 0xf6, 0x9a, 0x5a, 0x03, 0x4d, 0x5d, 0x2b, 0x46, 0x19, 0xb4, 0xb3, 0x30, 0xfc, 0x31,
-	0x06, 0x4d, 0x69, 0x03, 0x9a, 0x81, 0x53, 0x9b, 0xeb, 0x50, 0x62, 0x77, 0x0c, 0xc0, 0xb0, 0x4c,
-	0x23, 0xae, 0x46, 0xd4, 0x33, 0x38, 0xa3, 0x4e, 0x2d, 0x5b, 0x39, 0x7e, 0x68, 0xb3, 0xaf, 0x12,
-	0xf5, 0xe1, 0xa4, 0x9e, 0xd9, 0x2c, 0xea, 0x3f, 0x34, 0xb2, 0xa4, 0xc8, 0xd6, 0xc8, 0x12, 0x74,
-	0x01, 0x30, 0xa5, 0x9b, 0x18, 0xff, 0xfa, 0x3f, 0x42, 0x70, 0x5c, 0xb9, 0x0e, 0x74, 0xf2, 0xa1,
-	0xfd, 0x84, 0x57, 0x59, 0x82, 0x25, 0xd1, 0xbd, 0xbe, 0xeb, 0xb7, 0xe0, 0xd6, 0xf5, 0xa2, 0xcb,
-	0x29, 0x80, 0xc8, 0x5e, 0x49, 0x34, 0xdf, 0x49, 0x22, 0xb4, 0xbb, 0x19, 0xda, 0x8a, 0x99, 0x28,
-	0x42, 0xed, 0x9d, 0x70, 0x4e, 0xcb, 0xbd, 0x6b, 0x30, 0xb9, 0x79, 0xdb, 0xfb, 0xd6, 0xfb, 0xde,
-	0xb7, 0x3e, 0xf6, 0xbe, 0x35, 0xbb, 0x5c, 0x64, 0x32, 0xcd, 0xe7, 0xc3, 0x98, 0xae, 0x03, 0x86,
-	0xe3, 0x74, 0x97, 0x10, 0x5e, 0xaf, 0xb6, 0xa3, 0x40, 0xf0, 0x38, 0xf8, 0x71, 0x87, 0xf3, 0x96,
-	0x3e, 0xbf, 0xf1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x74, 0x04, 0x8f, 0xa3, 0x02, 0x00,
-	0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x8e, 0xd3, 0x30,
+	0x10, 0x56, 0x5a, 0x58, 0x29, 0xd3, 0xfd, 0x81, 0x68, 0xb5, 0x8a, 0x56, 0xa2, 0x2c, 0x5e, 0x0e,
+	0x7b, 0x6a, 0xa4, 0xee, 0x81, 0x03, 0x07, 0xa4, 0x0d, 0x88, 0x1b, 0x5a, 0x05, 0xc4, 0x81, 0x4b,
+	0xe4, 0x3a, 0x6e, 0x63, 0x35, 0x8d, 0x2d, 0xdb, 0x29, 0x2a, 0xaf, 0xc2, 0x0b, 0x71, 0xe4, 0x11,
+	0x50, 0x9f, 0x04, 0x79, 0x1c, 0x25, 0x41, 0x65, 0xf7, 0xe6, 0xef, 0xc7, 0x33, 0xdf, 0x8c, 0x65,
+	0x78, 0x65, 0xb8, 0xde, 0x72, 0x9d, 0xa8, 0xa5, 0x49, 0xfa, 0xa3, 0x3f, 0xcd, 0x94, 0x96, 0x56,
+	0x46, 0x61, 0x47, 0x5c, 0x9e, 0x38, 0x9b, 0x5a, 0x1a, 0xaf, 0x90, 0x6b, 0x08, 0x3f, 0x97, 0x54,
+	0x17, 0x5f, 0xa8, 0x59, 0x47, 0x17, 0x70, 0x24, 0x6a, 0xd5, 0x58, 0x13, 0x07, 0x57, 0xe3, 0x9b,
+	0x30, 0x6b, 0x11, 0xf9, 0x04, 0x67, 0x9d, 0x29, 0xe3, 0xa6, 0xa9, 0x6c, 0xf4, 0x16, 0x4e, 0x98,
+	0xdc, 0x28, 0xca, 0x6c, 0x6e, 0xa9, 0x59, 0xfb, 0x1b, 0x93, 0xf9, 0xc5, 0xac, 0x6f, 0x9d, 0x7a,
+	0x1d, 0x2f, 0x1d, 0xb3, 0x1e, 0x18, 0xb2, 0x83, 0xf0, 0x9e, 0xda, 0x32, 0xa3, 0xf5, 0x8a, 0x47,
+	0xe7, 0xf0, 0xb4, 0x92, 0xdf, 0xb9, 0x8e, 0x83, 0xab, 0xe0, 0x26, 0xcc, 0x3c, 0x70, 0x6c, 0xa3,
+	0x14, 0xd7, 0xf1, 0xc8, 0xb3, 0x08, 0xa2, 0x97, 0x30, 0x41, 0x39, 0x2f, 0xa8, 0x6d, 0x36, 0xf1,
+	0x18, 0x35, 0x40, 0xea, 0xbd, 0x63, 0x9c, 0x01, 0x9d, 0xad, 0xe1, 0x89, 0x37, 0x20, 0x85, 0x06,
+	0xf2, 0x33, 0x80, 0xc9, 0x20, 0xd8, 0x43, 0x23, 0x47, 0xb7, 0x00, 0x8a, 0xda, 0x32, 0xd7, 0x2e,
+	0x23, 0x86, 0x98, 0xcc, 0xcf, 0x07, 0xc3, 0x75, 0xf9, 0xb3, 0x50, 0x75, 0xa3, 0xbc, 0x83, 0x33,
+	0x56, 0x36, 0xf5, 0x5a, 0xd4, 0xab, 0x5c, 0x51, 0x4d, 0x37, 0x06, 0x23, 0xb6, 0x6b, 0xc9, 0xb7,
+	0xf3, 0x59, 0xda, 0xca, 0xf7, 0xa8, 0x66, 0xa7, 0xec, 0x1f, 0x4c, 0xae, 0xe1, 0xf9, 0x70, 0x6b,
+	0x7e, 0xd5, 0xa7, 0x30, 0x12, 0x45, 0xbb, 0x9d, 0x91, 0x28, 0xc8, 0x6b, 0x80, 0x54, 0xd6, 0x8c,
+	0x3e, 0x3a, 0x00, 0x21, 0xf0, 0xac, 0x77, 0x3d, 0x50, 0x69, 0x0a, 0xc7, 0x5f, 0x69, 0x25, 0x0a,
+	0x6a, 0x39, 0xd6, 0x3a, 0xec, 0x14, 0x7d, 0xa4, 0x7a, 0x41, 0x57, 0x3c, 0x95, 0x55, 0xc5, 0x99,
+	0xfd, 0xaf, 0xeb, 0x12, 0xe2, 0x43, 0x97, 0xef, 0x48, 0x4a, 0x88, 0x86, 0x1d, 0xda, 0x1c, 0x2f,
+	0x00, 0x8c, 0xf8, 0xc1, 0xf3, 0xc5, 0xce, 0x72, 0x83, 0x95, 0xc6, 0x59, 0xe8, 0x98, 0x3b, 0x47,
+	0xb8, 0xb7, 0xe7, 0x5a, 0xcb, 0xee, 0xed, 0x11, 0xb8, 0x4b, 0x4b, 0x51, 0xf1, 0x9c, 0xc9, 0xa6,
+	0xb6, 0xb8, 0xd7, 0x71, 0x16, 0x3a, 0x26, 0x75, 0xc4, 0xdd, 0x87, 0x5f, 0xfb, 0x69, 0xf0, 0x7b,
+	0x3f, 0x0d, 0xfe, 0xec, 0xa7, 0xc1, 0xb7, 0x37, 0x2b, 0x61, 0xcb, 0x66, 0x31, 0x63, 0x72, 0x93,
+	0x28, 0xca, 0xca, 0x5d, 0xc1, 0xf5, 0xf0, 0xb4, 0x9d, 0x27, 0x46, 0xb3, 0xe4, 0xe0, 0xe7, 0x2c,
+	0x8e, 0xf0, 0x5b, 0xdc, 0xfe, 0x1d, 0x00, 0x92, 0x63, 0x67, 0x20, 0x55, 0x03, 0x00, 0x00,
 }
 
 func (m *ShardTask) Marshal() (dAtA []byte, err error) {
@@ -683,6 +797,18 @@ func (m *CompactTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ChunkingParams != nil {
+		{
+			size, err := m.ChunkingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfsserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PathRange != nil {
 		{
 			size, err := m.PathRange.MarshalToSizedBuffer(dAtA[:i])
@@ -845,6 +971,67 @@ func (m *ValidateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GarbageCollectTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollectTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GarbageCollectTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPfsserver(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GarbageCollectTaskResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollectTaskResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GarbageCollectTaskResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidateTaskResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -869,6 +1056,11 @@ func (m *ValidateTaskResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.FileCount != 0 {
+		i = encodeVarintPfsserver(dAtA, i, uint64(m.FileCount))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -975,6 +1167,10 @@ func (m *CompactTask) Size() (n int) {
 		l = m.PathRange.Size()
 		n += 1 + l + sovPfsserver(uint64(l))
 	}
+	if m.ChunkingParams != nil {
+		l = m.ChunkingParams.Size()
+		n += 1 + l + sovPfsserver(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1047,6 +1243,34 @@ func (m *ValidateTask) Size() (n int) {
 	return n
 }
 
+func (m *GarbageCollectTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPfsserver(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GarbageCollectTaskResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ValidateTaskResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1060,6 +1284,9 @@ func (m *ValidateTaskResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPfsserver(uint64(l))
 	}
+	if m.FileCount != 0 {
+		n += 1 + sovPfsserver(uint64(m.FileCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1516,6 +1743,42 @@ func (m *CompactTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChunkingParams == nil {
+				m.ChunkingParams = &pfs.ChunkingParams{}
+			}
+			if err := m.ChunkingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfsserver(dAtA[iNdEx:])
@@ -1870,6 +2133,140 @@ func (m *ValidateTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GarbageCollectTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfsserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GarbageCollectTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GarbageCollectTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfsserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GarbageCollectTaskResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfsserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GarbageCollectTaskResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GarbageCollectTaskResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfsserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidateTaskResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1950,6 +2347,25 @@ func (m *ValidateTaskResult) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			}
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfsserver(dAtA[iNdEx:])
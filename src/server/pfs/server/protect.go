@@ -0,0 +1,182 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+func (d *driver) protectBranch(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, protection *pfs.BranchProtection) error {
+	if branch == nil || branch.Repo == nil {
+		return errors.New("branch cannot be nil")
+	}
+	if protection.GetRequiredApprovals() < 0 {
+		return errors.Errorf("required approvals cannot be negative")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+		return errors.EnsureStack(err)
+	}
+	branchInfo := &pfs.BranchInfo{}
+	return errors.EnsureStack(d.branches.ReadWrite(txnCtx.SqlTx).Update(branch, branchInfo, func() error {
+		if protection != nil && branchInfo.Trigger != nil {
+			// A trigger would move the head without approvals.
+			return errors.Errorf("a branch cannot have both a trigger and protection")
+		}
+		branchInfo.Protection = protection
+		return nil
+	}))
+}
+
+func (d *driver) approveCommit(txnCtx *txncontext.TransactionContext, commit *pfs.Commit) error {
+	if commit == nil || commit.Branch == nil || commit.Branch.Repo == nil {
+		return errors.New("commit cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, commit.Branch.Repo, auth.Permission_REPO_APPROVE_COMMIT); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var principal string
+	whoAmI, err := txnCtx.WhoAmI()
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "error authenticating")
+	} else if err == nil {
+		principal = whoAmI.Username
+	}
+	commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finished == nil {
+		return pfsserver.ErrCommitNotFinished{Commit: commitInfo.Commit}
+	}
+	if commitInfo.Error != "" {
+		return pfsserver.ErrCommitError{Commit: commitInfo.Commit}
+	}
+	if principal != "" && commitInfo.Author == principal {
+		return errors.Errorf("%s cannot approve commit %v, which they started", principal, commitInfo.Commit)
+	}
+	// Without auth, every approval has the same empty principal, so a commit
+	// has at most one approval.
+	for _, approval := range commitInfo.Approvals {
+		if approval.Principal == principal {
+			return nil
+		}
+	}
+	commitInfo.Approvals = append(commitInfo.Approvals, &pfs.Approval{
+		Principal: principal,
+		Approved:  txnCtx.Timestamp,
+	})
+	return errors.EnsureStack(d.commits.ReadWrite(txnCtx.SqlTx).Put(commitInfo.Commit, commitInfo))
+}
+
+// canBypassProtection returns whether the caller can bypass branch protection
+// in repo. Nobody can when auth isn't active.
+func (d *driver) canBypassProtection(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) (bool, error) {
+	if _, err := txnCtx.WhoAmI(); err != nil {
+		if auth.IsErrNotActivated(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(grpcutil.ScrubGRPC(err), "error authenticating")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_BYPASS_BRANCH_PROTECTION); err != nil {
+		if auth.IsErrNotAuthorized(err) {
+			return false, nil
+		}
+		return false, errors.EnsureStack(err)
+	}
+	return true, nil
+}
+
+// checkProtectedCommit returns an error if a commit can't be started directly
+// on the branch.
+func (d *driver) checkProtectedCommit(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo) error {
+	if branchInfo.Protection == nil {
+		return nil
+	}
+	if bypass, err := d.canBypassProtection(txnCtx, branchInfo.Branch.Repo); err != nil || bypass {
+		return err
+	}
+	return pfsserver.ErrBranchProtected{
+		Branch: branchInfo.Branch,
+		Reason: "commits cannot be started on it directly",
+	}
+}
+
+// checkProtectedHeadMove returns an error if the branch's head can't be moved
+// to the commit.
+func (d *driver) checkProtectedHeadMove(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo, commitInfo *pfs.CommitInfo) error {
+	if branchInfo.Protection == nil {
+		return nil
+	}
+	if bypass, err := d.canBypassProtection(txnCtx, branchInfo.Branch.Repo); err != nil || bypass {
+		return err
+	}
+	var reason string
+	switch {
+	case proto.Equal(commitInfo.Commit.Branch, branchInfo.Branch):
+		reason = "its head can only be moved to a commit from another branch"
+	case commitInfo.Finished == nil || commitInfo.Error != "":
+		reason = fmt.Sprintf("commit %v must be finished without an error", commitInfo.Commit)
+	case int64(len(commitInfo.Approvals)) < branchInfo.Protection.RequiredApprovals:
+		reason = fmt.Sprintf("commit %v has %d of the %d required approvals", commitInfo.Commit, len(commitInfo.Approvals), branchInfo.Protection.RequiredApprovals)
+	default:
+		return nil
+	}
+	return pfsserver.ErrBranchProtected{Branch: branchInfo.Branch, Reason: reason}
+}
+
+// checkProtectedSquash returns an error if one of the commits, which are
+// being dropped or squashed, is the head of a protected branch, since that
+// would move the head to a commit that wasn't approved.
+func (d *driver) checkProtectedSquash(txnCtx *txncontext.TransactionContext, commitInfos []*pfs.CommitInfo) error {
+	for _, commitInfo := range commitInfos {
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(commitInfo.Commit.Branch, branchInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return errors.EnsureStack(err)
+		}
+		if branchInfo.Protection == nil || branchInfo.Head.ID != commitInfo.Commit.ID {
+			continue
+		}
+		if bypass, err := d.canBypassProtection(txnCtx, branchInfo.Branch.Repo); err != nil {
+			return err
+		} else if !bypass {
+			return pfsserver.ErrBranchProtected{
+				Branch: branchInfo.Branch,
+				Reason: fmt.Sprintf("its head %v can only be dropped or squashed by a principal who can bypass its protection", commitInfo.Commit),
+			}
+		}
+	}
+	return nil
+}
+
+// checkProtectedDelete returns an error if the branch is protected and the
+// caller can't bypass its protection.
+func (d *driver) checkProtectedDelete(txnCtx *txncontext.TransactionContext, branch *pfs.Branch) error {
+	branchInfo, err := d.inspectBranch(txnCtx, branch)
+	if err != nil {
+		if pfsserver.IsBranchNotFoundErr(err) {
+			return nil
+		}
+		return err
+	}
+	if branchInfo.Protection == nil {
+		return nil
+	}
+	if bypass, err := d.canBypassProtection(txnCtx, branch.Repo); err != nil || bypass {
+		return err
+	}
+	return pfsserver.ErrBranchProtected{
+		Branch: branch,
+		Reason: "it can only be deleted by a principal who can bypass its protection",
+	}
+}
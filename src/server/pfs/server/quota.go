@@ -0,0 +1,422 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+func validateQuotaTarget(repo *pfs.Repo, principal string) error {
+	if (repo == nil) == (principal == "") {
+		return errors.Errorf("exactly one of repo and principal must be set")
+	}
+	if repo != nil && repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+	return nil
+}
+
+func (d *driver) createQuota(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, principal string, quota *pfs.Quota) error {
+	if err := validateQuotaTarget(repo, principal); err != nil {
+		return err
+	}
+	if quota.GetMaxSizeBytes() < 0 || quota.GetMaxFileCount() < 0 {
+		return errors.Errorf("quota limits cannot be negative")
+	}
+	if repo != nil {
+		if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(repo, &pfs.RepoInfo{}); err != nil {
+			if col.IsErrNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: repo}
+			}
+			return errors.EnsureStack(err)
+		}
+	}
+	quotas := d.quotas.ReadWrite(txnCtx.SqlTx)
+	key := pfsdb.QuotaKey(repo, principal)
+	if quota == nil || proto.Equal(quota, &pfs.Quota{}) {
+		if err := quotas.Delete(key); err != nil && !col.IsErrNotFound(err) {
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}
+	return errors.EnsureStack(quotas.Put(key, &pfs.QuotaInfo{
+		Repo:      repo,
+		Principal: principal,
+		Quota:     quota,
+	}))
+}
+
+// SetupQuotaUsageV0 creates the table of the usage counted against quotas.
+func SetupQuotaUsageV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE pfs.quota_usage (
+			target TEXT NOT NULL,
+			size_bytes BIGINT NOT NULL,
+			file_count BIGINT NOT NULL,
+			PRIMARY KEY(target)
+		);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// inspectQuota returns the quota on repo or principal along with its current
+// usage. The quota is unset if there is no quota. Only cluster admins can
+// inspect the quota of another principal.
+func (d *driver) inspectQuota(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, principal string) (*pfs.QuotaInfo, error) {
+	if err := validateQuotaTarget(repo, principal); err != nil {
+		return nil, err
+	}
+	if repo != nil {
+		if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(repo, &pfs.RepoInfo{}); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, pfsserver.ErrRepoNotFound{Repo: repo}
+			}
+			return nil, errors.EnsureStack(err)
+		}
+		if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_READ); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	} else {
+		whoAmI, err := txnCtx.WhoAmI()
+		if err != nil && !auth.IsErrNotActivated(err) {
+			return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "error authenticating")
+		}
+		if err == nil && whoAmI.Username != principal {
+			if err := d.env.AuthServer.CheckClusterIsAuthorizedInTransaction(txnCtx, auth.Permission_CLUSTER_PFS_MODIFY_QUOTAS); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		}
+	}
+	key := pfsdb.QuotaKey(repo, principal)
+	quotaInfo := &pfs.QuotaInfo{}
+	if err := d.quotas.ReadWrite(txnCtx.SqlTx).Get(key, quotaInfo); err != nil {
+		if !col.IsErrNotFound(err) {
+			return nil, errors.EnsureStack(err)
+		}
+		quotaInfo = &pfs.QuotaInfo{Repo: repo, Principal: principal}
+	}
+	var err error
+	quotaInfo.SizeBytes, quotaInfo.FileCount, err = getUsage(txnCtx.SqlTx, key)
+	if err != nil {
+		return nil, err
+	}
+	return quotaInfo, nil
+}
+
+// getUsage returns the usage counted against the quota with key.
+func getUsage(tx *pachsql.Tx, key string) (int64, int64, error) {
+	var sizeBytes, fileCount int64
+	if err := tx.QueryRow(`
+		SELECT size_bytes, file_count FROM pfs.quota_usage WHERE target = $1
+	`, key).Scan(&sizeBytes, &fileCount); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, nil
+		}
+		return 0, 0, errors.EnsureStack(err)
+	}
+	return sizeBytes, fileCount, nil
+}
+
+// addUsage adds to the usage counted against the quota with key. The usage
+// never goes below zero.
+func addUsage(tx *pachsql.Tx, key string, sizeBytes, fileCount int64) error {
+	_, err := tx.Exec(`
+		INSERT INTO pfs.quota_usage (target, size_bytes, file_count)
+		VALUES ($1, GREATEST($2, 0), GREATEST($3, 0))
+		ON CONFLICT (target) DO UPDATE SET
+			size_bytes = GREATEST(pfs.quota_usage.size_bytes + $2, 0),
+			file_count = GREATEST(pfs.quota_usage.file_count + $3, 0)
+	`, key, sizeBytes, fileCount)
+	return errors.EnsureStack(err)
+}
+
+// SetupQuotaUsageV1 creates the table of the usage that each commit was
+// charged, so that it can be credited back to the same targets, and backfills
+// the usage of the existing commits.
+func SetupQuotaUsageV1(ctx context.Context, tx *pachsql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TABLE pfs.quota_charges (
+			commit_key TEXT NOT NULL,
+			repo TEXT NOT NULL,
+			target TEXT NOT NULL,
+			size_bytes BIGINT NOT NULL,
+			file_count BIGINT NOT NULL,
+			PRIMARY KEY(commit_key, target)
+		);
+		CREATE INDEX ON pfs.quota_charges (repo);
+		DELETE FROM pfs.quota_usage;
+	`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var rows []struct {
+		Key   string `db:"key"`
+		Proto []byte `db:"proto"`
+	}
+	if err := tx.SelectContext(ctx, &rows, `SELECT key, proto FROM collections.commits`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	commitInfos := make(map[string]*pfs.CommitInfo)
+	for _, row := range rows {
+		commitInfo := &pfs.CommitInfo{}
+		if err := proto.Unmarshal(row.Proto, commitInfo); err != nil {
+			return errors.EnsureStack(err)
+		}
+		commitInfos[row.Key] = commitInfo
+	}
+	getCommit := func(commit *pfs.Commit) (*pfs.CommitInfo, error) {
+		ci, ok := commitInfos[pfsdb.CommitKey(commit)]
+		if !ok {
+			return nil, pfsserver.ErrCommitNotFound{Commit: commit}
+		}
+		return ci, nil
+	}
+	// the owners are read from the role bindings directly, since the auth
+	// server isn't running during migrations
+	roleBindings := col.NewPostgresCollection("role_bindings", nil, nil, &auth.RoleBinding{}, nil).ReadWrite(tx)
+	owners := make(map[string][]string)
+	for _, ci := range commitInfos {
+		sizeBytes, fileCount, err := commitUsage(getCommit, ci)
+		if err != nil {
+			return err
+		}
+		if sizeBytes == 0 && fileCount == 0 {
+			continue
+		}
+		repoName := ci.Commit.Branch.Repo.Name
+		if _, ok := owners[repoName]; !ok {
+			binding := &auth.RoleBinding{}
+			if err := roleBindings.Get(fmt.Sprintf("%s:%s", auth.ResourceType_REPO, repoName), binding); err != nil && !col.IsErrNotFound(err) {
+				return errors.EnsureStack(err)
+			}
+			owners[repoName] = bindingOwners(binding)
+		}
+		targets := append(ownerKeys(owners[repoName]), pfsdb.QuotaKey(ci.Commit.Branch.Repo, ""))
+		if err := chargeUsage(tx, ci.Commit, targets, sizeBytes, fileCount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commitUsage returns the size and file count which commitInfo adds to the
+// most recent finished ancestor commit without an error. Both are negative if
+// the commit deletes more than it adds. Commits which didn't finish
+// successfully don't use anything.
+func commitUsage(getCommit func(*pfs.Commit) (*pfs.CommitInfo, error), commitInfo *pfs.CommitInfo) (int64, int64, error) {
+	if commitInfo.Finished == nil || commitInfo.Error != "" || commitInfo.Details == nil {
+		return 0, 0, nil
+	}
+	var parentSize, parentCount int64
+	commit := commitInfo.ParentCommit
+	for commit != nil {
+		parentInfo, err := getCommit(commit)
+		if err != nil {
+			return 0, 0, err
+		}
+		if parentInfo.Finished != nil && parentInfo.Error == "" && parentInfo.Details != nil {
+			parentSize, parentCount = parentInfo.Details.SizeBytes, parentInfo.Details.FileCount
+			break
+		}
+		commit = parentInfo.ParentCommit
+	}
+	return commitInfo.Details.SizeBytes - parentSize, commitInfo.Details.FileCount - parentCount, nil
+}
+
+func (d *driver) commitGetter(txnCtx *txncontext.TransactionContext) func(*pfs.Commit) (*pfs.CommitInfo, error) {
+	return func(commit *pfs.Commit) (*pfs.CommitInfo, error) {
+		commitInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(commit, commitInfo); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return commitInfo, nil
+	}
+}
+
+// chargeUsage adds the usage of commit to each of targets, and records what
+// the commit was charged, so that it can be released later.
+func chargeUsage(tx *pachsql.Tx, commit *pfs.Commit, targets []string, sizeBytes, fileCount int64) error {
+	for _, target := range targets {
+		if err := addUsage(tx, target, sizeBytes, fileCount); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`
+			INSERT INTO pfs.quota_charges (commit_key, repo, target, size_bytes, file_count)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (commit_key, target) DO UPDATE SET
+				size_bytes = pfs.quota_charges.size_bytes + $4,
+				file_count = pfs.quota_charges.file_count + $5
+		`, pfsdb.CommitKey(commit), pfsdb.RepoKey(commit.Branch.Repo), target, sizeBytes, fileCount); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// releaseUsage removes the usage that commit, which is being deleted, was
+// charged from the targets it was charged to.
+func releaseUsage(tx *pachsql.Tx, commit *pfs.Commit) error {
+	var charges []struct {
+		Target    string `db:"target"`
+		SizeBytes int64  `db:"size_bytes"`
+		FileCount int64  `db:"file_count"`
+	}
+	if err := tx.Select(&charges, `
+		DELETE FROM pfs.quota_charges WHERE commit_key = $1
+		RETURNING target, size_bytes, file_count
+	`, pfsdb.CommitKey(commit)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, charge := range charges {
+		if err := addUsage(tx, charge.Target, -charge.SizeBytes, -charge.FileCount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveUsage moves the usage that commit, which is being squashed, was charged
+// to its child. The usage of the child was computed relative to commit, so
+// the two together are the usage of the child relative to commit's parent.
+func moveUsage(tx *pachsql.Tx, commit, child *pfs.Commit) error {
+	_, err := tx.Exec(`
+		WITH moved AS (
+			DELETE FROM pfs.quota_charges WHERE commit_key = $1
+			RETURNING repo, target, size_bytes, file_count
+		)
+		INSERT INTO pfs.quota_charges (commit_key, repo, target, size_bytes, file_count)
+		SELECT $2, repo, target, size_bytes, file_count FROM moved
+		ON CONFLICT (commit_key, target) DO UPDATE SET
+			size_bytes = pfs.quota_charges.size_bytes + EXCLUDED.size_bytes,
+			file_count = pfs.quota_charges.file_count + EXCLUDED.file_count
+	`, pfsdb.CommitKey(commit), pfsdb.CommitKey(child))
+	return errors.EnsureStack(err)
+}
+
+// deleteRepoUsage removes the usage of repo, which is being deleted, from the
+// targets that its commits were charged to.
+func deleteRepoUsage(tx *pachsql.Tx, repo *pfs.Repo) error {
+	var charges []struct {
+		Target    string `db:"target"`
+		SizeBytes int64  `db:"size_bytes"`
+		FileCount int64  `db:"file_count"`
+	}
+	if err := tx.Select(&charges, `
+		SELECT target, SUM(size_bytes) AS size_bytes, SUM(file_count) AS file_count
+		FROM pfs.quota_charges WHERE repo = $1 GROUP BY target
+	`, pfsdb.RepoKey(repo)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, charge := range charges {
+		if err := addUsage(tx, charge.Target, -charge.SizeBytes, -charge.FileCount); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec(`DELETE FROM pfs.quota_charges WHERE repo = $1`, pfsdb.RepoKey(repo)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err := tx.Exec(`DELETE FROM pfs.quota_usage WHERE target = $1`, pfsdb.QuotaKey(repo, ""))
+	return errors.EnsureStack(err)
+}
+
+func ownerKeys(owners []string) []string {
+	var keys []string
+	for _, owner := range owners {
+		keys = append(keys, pfsdb.QuotaKey(nil, owner))
+	}
+	return keys
+}
+
+// repoOwners returns the principals with the repo owner role on the repos
+// named repoName, or nothing if auth isn't active.
+func (d *driver) repoOwners(txnCtx *txncontext.TransactionContext, repoName string) ([]string, error) {
+	resp, err := d.env.AuthServer.GetRoleBindingInTransaction(txnCtx, &auth.GetRoleBindingRequest{
+		Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: repoName},
+	})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return bindingOwners(resp.Binding), nil
+}
+
+func bindingOwners(binding *auth.RoleBinding) []string {
+	var owners []string
+	for principal, roles := range binding.GetEntries() {
+		if roles.Roles[auth.RepoOwnerRole] {
+			owners = append(owners, principal)
+		}
+	}
+	return owners
+}
+
+// chargeQuotas adds the usage of the finished commit to its repo and to the
+// repo's owners. It returns an ErrQuotaExceeded, without charging anything, if
+// the usage would grow past the quota of one of them. Usage is charged to the
+// principals which own the repo when the commit finishes, and is released
+// from the same principals when the commit is deleted.
+func (d *driver) chargeQuotas(txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo) error {
+	sizeBytes, fileCount, err := commitUsage(d.commitGetter(txnCtx), commitInfo)
+	if err != nil {
+		return err
+	}
+	if sizeBytes == 0 && fileCount == 0 {
+		return nil
+	}
+	repo := commitInfo.Commit.Branch.Repo
+	owners, err := d.repoOwners(txnCtx, repo.Name)
+	if err != nil {
+		return err
+	}
+	targets := map[string]string{pfsdb.QuotaKey(repo, ""): fmt.Sprintf("repo %v", repo)}
+	for _, owner := range owners {
+		targets[pfsdb.QuotaKey(nil, owner)] = owner
+	}
+	quotas := d.quotas.ReadWrite(txnCtx.SqlTx)
+	quotaInfo := &pfs.QuotaInfo{}
+	var keys []string
+	for key, target := range targets {
+		keys = append(keys, key)
+		if err := quotas.Get(key, quotaInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return errors.EnsureStack(err)
+		}
+		usedSize, usedCount, err := getUsage(txnCtx.SqlTx, key)
+		if err != nil {
+			return err
+		}
+		if reason := quotaExceeded(quotaInfo.Quota, usedSize, usedCount, sizeBytes, fileCount); reason != "" {
+			return pfsserver.ErrQuotaExceeded{Commit: commitInfo.Commit, Target: target, Reason: reason}
+		}
+	}
+	return chargeUsage(txnCtx.SqlTx, commitInfo.Commit, keys, sizeBytes, fileCount)
+}
+
+// quotaExceeded returns why adding sizeBytes and fileCount to the usage puts
+// it over quota, or "" if it doesn't. Usage which doesn't grow is never over
+// quota, so that commits which delete data succeed even when the usage is
+// already over quota.
+func quotaExceeded(quota *pfs.Quota, usedSize, usedCount, sizeBytes, fileCount int64) string {
+	if quota.GetMaxSizeBytes() > 0 && sizeBytes > 0 && usedSize+sizeBytes > quota.MaxSizeBytes {
+		return fmt.Sprintf("%d bytes is more than the limit of %d bytes", usedSize+sizeBytes, quota.MaxSizeBytes)
+	}
+	if quota.GetMaxFileCount() > 0 && fileCount > 0 && usedCount+fileCount > quota.MaxFileCount {
+		return fmt.Sprintf("%d files is more than the limit of %d files", usedCount+fileCount, quota.MaxFileCount)
+	}
+	return ""
+}
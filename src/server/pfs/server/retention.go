@@ -0,0 +1,251 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func validateRetentionPolicy(policy *pfs.RetentionPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.KeepLast < 0 {
+		return errors.Errorf("retention policy keep_last cannot be negative")
+	}
+	if policy.KeepNewerThan != nil {
+		keepNewerThan, err := types.DurationFromProto(policy.KeepNewerThan)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if keepNewerThan < 0 {
+			return errors.Errorf("retention policy keep_newer_than cannot be negative")
+		}
+	}
+	return nil
+}
+
+// cleanRetentionPolicy returns nil for a policy with no rules set, so that
+// setting an empty policy removes the existing one.
+func cleanRetentionPolicy(policy *pfs.RetentionPolicy) *pfs.RetentionPolicy {
+	if policy == nil || policy.KeepLast == 0 && !policy.KeepDaily &&
+		(policy.KeepNewerThan == nil || policy.KeepNewerThan.Seconds == 0 && policy.KeepNewerThan.Nanos == 0) {
+		return nil
+	}
+	return policy
+}
+
+// expiredCommits returns the commits which policy doesn't keep, given the
+// commits on a branch ordered from the head back.
+func expiredCommits(policy *pfs.RetentionPolicy, commitInfos []*pfs.CommitInfo, now time.Time) ([]*pfs.CommitInfo, error) {
+	if cleanRetentionPolicy(policy) == nil {
+		return nil, nil
+	}
+	var keepNewerThan time.Duration
+	if policy.KeepNewerThan != nil {
+		var err error
+		keepNewerThan, err = types.DurationFromProto(policy.KeepNewerThan)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	days := make(map[string]bool)
+	var expired []*pfs.CommitInfo
+	for i, ci := range commitInfos {
+		if ci.Finished == nil {
+			continue
+		}
+		finished, err := types.TimestampFromProto(ci.Finished)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		keep := i == 0 || int64(i) < policy.KeepLast
+		if keepNewerThan > 0 && now.Sub(finished) < keepNewerThan {
+			keep = true
+		}
+		if day := finished.UTC().Format("2006-01-02"); policy.KeepDaily && !days[day] {
+			days[day] = true
+			keep = true
+		}
+		if !keep {
+			expired = append(expired, ci)
+		}
+	}
+	return expired, nil
+}
+
+// branchCommits returns the commits which were created on a branch, from its
+// head back to the first commit whose parent is on a different branch.
+func (d *driver) branchCommits(ctx context.Context, branchInfo *pfs.BranchInfo) ([]*pfs.CommitInfo, error) {
+	var commitInfos []*pfs.CommitInfo
+	commit := branchInfo.Head
+	for commit != nil && proto.Equal(commit.Branch, branchInfo.Branch) {
+		ci := &pfs.CommitInfo{}
+		if err := d.commits.ReadOnly(ctx).Get(pfsdb.CommitKey(commit), ci); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		commitInfos = append(commitInfos, ci)
+		commit = ci.ParentCommit
+	}
+	return commitInfos, nil
+}
+
+// applyRetention squashes the commit sets in which every commit has expired
+// under its branch's retention policy. A branch's retention policy overrides
+// its repo's, and the commits in a system repo use the policy of the user repo
+// with the same name. A commit set which contains a commit without a policy is
+// never squashed, so retention policies only squash the commits of repos which
+// have opted in. If repo is set, only the commit sets with a commit in repo are
+// squashed. The commit sets which are squashed, or would be squashed if dryRun
+// is set, are returned.
+func (d *driver) applyRetention(ctx context.Context, repo *pfs.Repo, dryRun bool) ([]*pfs.CommitSet, error) {
+	var repoInfos []*pfs.RepoInfo
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions(), func(string) error {
+		repoInfos = append(repoInfos, proto.Clone(repoInfo).(*pfs.RepoInfo))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	branchInfos := make(map[string]*pfs.BranchInfo)
+	for _, repoInfo := range repoInfos {
+		for _, branch := range repoInfo.Branches {
+			branchInfo := &pfs.BranchInfo{}
+			if err := d.branches.ReadOnly(ctx).Get(branch, branchInfo); err != nil {
+				if col.IsErrNotFound(err) {
+					continue
+				}
+				return nil, errors.EnsureStack(err)
+			}
+			branchInfos[pfsdb.BranchKey(branch)] = branchInfo
+		}
+	}
+	repoPolicies := make(map[string]*pfs.RetentionPolicy)
+	for _, repoInfo := range repoInfos {
+		if repoInfo.Repo.Type == pfs.UserRepoType {
+			repoPolicies[repoInfo.Repo.Name] = repoInfo.RetentionPolicy
+		}
+	}
+	// policy returns the retention policy which applies to branch, or nil.
+	policy := func(branch *pfs.Branch) *pfs.RetentionPolicy {
+		userBranch := client.NewBranch(branch.Repo.Name, branch.Name)
+		if branchInfo, ok := branchInfos[pfsdb.BranchKey(userBranch)]; ok && branchInfo.RetentionPolicy != nil {
+			return branchInfo.RetentionPolicy
+		}
+		return repoPolicies[branch.Repo.Name]
+	}
+	now := time.Now()
+	var expired []string
+	seen := make(map[string]bool)
+	// isExpired contains the keys of the commits which have expired.
+	isExpired := make(map[string]bool)
+	// Tagged commits are always kept
+	kept, err := d.taggedCommitSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, branchInfo := range branchInfos {
+		policy := policy(branchInfo.Branch)
+		if policy == nil {
+			continue
+		}
+		commitInfos, err := d.branchCommits(ctx, branchInfo)
+		if err != nil {
+			return nil, err
+		}
+		expiredInfos, err := expiredCommits(policy, commitInfos, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, ci := range expiredInfos {
+			isExpired[pfsdb.CommitKey(ci.Commit)] = true
+			if !seen[ci.Commit.ID] {
+				seen[ci.Commit.ID] = true
+				expired = append(expired, ci.Commit.ID)
+			}
+		}
+	}
+	withContext := d.txnEnv.WithWriteContext
+	if dryRun {
+		// The writes made by a read context are discarded.
+		withContext = d.txnEnv.WithReadContext
+	}
+	var squashed []*pfs.CommitSet
+	for _, id := range expired {
+		if kept[id] {
+			continue
+		}
+		commitSet := &pfs.CommitSet{ID: id}
+		var squash bool
+		if err := withContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			commitInfos, err := d.inspectCommitSetImmediate(txnCtx, commitSet)
+			if err != nil {
+				return err
+			}
+			inRepo := repo == nil
+			for _, ci := range commitInfos {
+				// Commits which no policy expires, including those in repos
+				// without a policy, keep the whole commit set.
+				if !isExpired[pfsdb.CommitKey(ci.Commit)] {
+					return nil
+				}
+				if repo != nil && proto.Equal(ci.Commit.Branch.Repo, repo) {
+					inRepo = true
+				}
+			}
+			if !inRepo {
+				return nil
+			}
+			squash = true
+			return d.squashCommitSet(txnCtx, commitSet)
+		}); err != nil {
+			if ctx.Err() != nil {
+				return nil, errors.EnsureStack(ctx.Err())
+			}
+			// The commit set can't be squashed yet, for example because it
+			// has an unfinished child commit, so it is retried next time.
+			d.log.Infof("not squashing expired commit set %v: %v", id, err)
+			continue
+		}
+		if squash {
+			squashed = append(squashed, commitSet)
+		}
+	}
+	return squashed, nil
+}
+
+// applyRetentionForever applies the retention policies every period until ctx
+// is canceled.
+func (d *driver) applyRetentionForever(ctx context.Context, period time.Duration) error {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-ticker.C:
+		}
+		commitSets, err := d.applyRetention(ctx, nil, false)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			d.log.Errorf("error applying retention policies: %v", err)
+			continue
+		}
+		if len(commitSets) > 0 {
+			d.log.Infof("squashed %d expired commit sets", len(commitSets))
+		}
+	}
+}
@@ -0,0 +1,718 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+const (
+	searchIndexTrackerPrefix      = "search-index/"
+	defaultSearchIndexMaxFileSize = units.MiB
+	minSearchTermLen              = 2
+	maxSearchTermLen              = 64
+)
+
+// A search index is a fileset with the following layout, where key is the ID
+// of a commit followed by the path of a file it added or modified:
+//
+//	/files/<key>          the FileInfo of the file
+//	/content/<term>/<key> empty, for each word in the file
+//	/names/<name>/<key>   empty, for the lower case base name of the file
+const (
+	searchFilesPrefix   = "/files/"
+	searchContentPrefix = "/content/"
+	searchNamesPrefix   = "/names/"
+)
+
+// searchIndexStore tracks the search index fileset of each indexed repo. A repo
+// is indexed if it has a row, which has no fileset until a commit is indexed.
+// The commits which finish while a repo is indexed are queued, and added to the
+// index in the background. An index is stale while it is missing commits which
+// couldn't be indexed, until it is rebuilt. The entries of squashed and dropped
+// commits are pruned the next time it is updated.
+type searchIndexStore struct {
+	db *pachsql.DB
+	tr track.Tracker
+}
+
+func newSearchIndexStore(db *pachsql.DB, tr track.Tracker) *searchIndexStore {
+	return &searchIndexStore{
+		db: db,
+		tr: tr,
+	}
+}
+
+// Get returns whether repo is indexed, and its search index fileset.
+func (s *searchIndexStore) Get(ctx context.Context, repo *pfs.Repo) (bool, *fileset.ID, error) {
+	var enabled bool
+	var id *fileset.ID
+	if err := dbutil.WithTx(ctx, s.db, func(tx *pachsql.Tx) error {
+		var err error
+		enabled, id, err = s.GetTx(tx, repo)
+		return err
+	}); err != nil {
+		return false, nil, err
+	}
+	return enabled, id, nil
+}
+
+// GetTx is like Get, but in a transaction.
+func (s *searchIndexStore) GetTx(tx *pachsql.Tx, repo *pfs.Repo) (bool, *fileset.ID, error) {
+	var id *fileset.ID
+	if err := tx.Get(&id, `SELECT fileset_id FROM pfs.search_indexes WHERE repo_id = $1`, pfsdb.RepoKey(repo)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil, nil
+		}
+		return false, nil, errors.EnsureStack(err)
+	}
+	return true, id, nil
+}
+
+// EnableTx starts indexing repo, if it isn't indexed already.
+func (s *searchIndexStore) EnableTx(tx *pachsql.Tx, repo *pfs.Repo) error {
+	_, err := tx.Exec(`INSERT INTO pfs.search_indexes (repo_id) VALUES ($1) ON CONFLICT (repo_id) DO NOTHING`, pfsdb.RepoKey(repo))
+	return errors.EnsureStack(err)
+}
+
+// IsStale returns whether the search index of repo is missing a commit.
+func (s *searchIndexStore) IsStale(ctx context.Context, repo *pfs.Repo) (bool, error) {
+	var stale bool
+	if err := s.db.GetContext(ctx, &stale, `SELECT stale FROM pfs.search_indexes WHERE repo_id = $1`, pfsdb.RepoKey(repo)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.EnsureStack(err)
+	}
+	return stale, nil
+}
+
+// MarkStaleTx records that a commit in repo couldn't be indexed, so the index
+// is rebuilt before it is updated again.
+func (s *searchIndexStore) MarkStaleTx(tx *pachsql.Tx, repo *pfs.Repo) error {
+	_, err := tx.Exec(`UPDATE pfs.search_indexes SET stale = TRUE WHERE repo_id = $1`, pfsdb.RepoKey(repo))
+	return errors.EnsureStack(err)
+}
+
+// AddCommitTx queues commit to be added to its repo's search index, if the repo
+// is indexed.
+func (s *searchIndexStore) AddCommitTx(tx *pachsql.Tx, commit *pfs.Commit) error {
+	_, err := tx.Exec(`
+		INSERT INTO pfs.search_index_commits (repo_id, commit_key)
+		SELECT repo_id, $2 FROM pfs.search_indexes WHERE repo_id = $1
+		ON CONFLICT DO NOTHING
+	`, pfsdb.RepoKey(commit.Branch.Repo), pfsdb.CommitKey(commit))
+	return errors.EnsureStack(err)
+}
+
+// Pending returns the keys of the queued commits which haven't been added to
+// the search index of repo yet.
+func (s *searchIndexStore) Pending(ctx context.Context, repo *pfs.Repo) ([]string, error) {
+	var keys []string
+	if err := s.db.SelectContext(ctx, &keys, `SELECT commit_key FROM pfs.search_index_commits WHERE repo_id = $1 AND NOT indexed`, pfsdb.RepoKey(repo)); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return keys, nil
+}
+
+// PruneTx records that the entries of commit should be removed from its repo's
+// search index, if the repo is indexed.
+func (s *searchIndexStore) PruneTx(tx *pachsql.Tx, commit *pfs.Commit) error {
+	if _, err := tx.Exec(`DELETE FROM pfs.search_index_commits WHERE repo_id = $1 AND commit_key = $2`, pfsdb.RepoKey(commit.Branch.Repo), pfsdb.CommitKey(commit)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err := tx.Exec(`
+		INSERT INTO pfs.search_index_prunes (repo_id, commit_id)
+		SELECT repo_id, $2 FROM pfs.search_indexes WHERE repo_id = $1
+		ON CONFLICT DO NOTHING
+	`, pfsdb.RepoKey(commit.Branch.Repo), commit.ID)
+	return errors.EnsureStack(err)
+}
+
+// Pruned returns the IDs of the commits whose entries should be removed from
+// the search index of repo.
+func (s *searchIndexStore) Pruned(ctx context.Context, repo *pfs.Repo) ([]string, error) {
+	var ids []string
+	if err := s.db.SelectContext(ctx, &ids, `SELECT commit_id FROM pfs.search_index_prunes WHERE repo_id = $1`, pfsdb.RepoKey(repo)); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return ids, nil
+}
+
+// DisableTx stops indexing repo, and discards its search index.
+func (s *searchIndexStore) DisableTx(tx *pachsql.Tx, repo *pfs.Repo) error {
+	if err := s.discardTx(tx, repo); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM pfs.search_index_commits WHERE repo_id = $1`, pfsdb.RepoKey(repo)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err := tx.Exec(`DELETE FROM pfs.search_indexes WHERE repo_id = $1`, pfsdb.RepoKey(repo))
+	return errors.EnsureStack(err)
+}
+
+// ResetTx discards the search index of repo, and queues every commit which was
+// in it to be indexed again. The index stays stale until the commits are
+// indexed, unless there aren't any.
+func (s *searchIndexStore) ResetTx(tx *pachsql.Tx, repo *pfs.Repo) error {
+	if err := s.discardTx(tx, repo); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`UPDATE pfs.search_index_commits SET indexed = FALSE WHERE repo_id = $1`, pfsdb.RepoKey(repo)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err := tx.Exec(`
+		UPDATE pfs.search_indexes
+		SET fileset_id = NULL, stale = EXISTS (SELECT 1 FROM pfs.search_index_commits WHERE repo_id = $1)
+		WHERE repo_id = $1
+	`, pfsdb.RepoKey(repo))
+	return errors.EnsureStack(err)
+}
+
+// discardTx deletes the search index fileset and pruned commits of repo.
+func (s *searchIndexStore) discardTx(tx *pachsql.Tx, repo *pfs.Repo) error {
+	_, id, err := s.GetTx(tx, repo)
+	if err != nil {
+		return err
+	}
+	if id != nil {
+		if err := s.tr.DeleteTx(tx, searchIndexTrackerID(repo, *id)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	_, err = tx.Exec(`DELETE FROM pfs.search_index_prunes WHERE repo_id = $1`, pfsdb.RepoKey(repo))
+	return errors.EnsureStack(err)
+}
+
+// UpdateTx replaces the search index of repo with id, if it is still prev,
+// records that it contains the queued commits with the given keys, and forgets
+// the pruned commits whose entries id no longer contains. The index is no
+// longer stale once it is updated. The update is dropped if the repo is no
+// longer indexed.
+func (s *searchIndexStore) UpdateTx(tx *pachsql.Tx, repo *pfs.Repo, prev *fileset.ID, id fileset.ID, indexed, pruned []string) error {
+	enabled, current, err := s.GetTx(tx, repo)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	if (current == nil) != (prev == nil) || (current != nil && *current != *prev) {
+		return errors.Errorf("search index of repo %v changed while it was being updated", repo)
+	}
+	if err := s.tr.CreateTx(tx, searchIndexTrackerID(repo, id), []string{id.TrackerID()}, track.NoTTL); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if current != nil {
+		if err := s.tr.DeleteTx(tx, searchIndexTrackerID(repo, *current)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	for _, key := range indexed {
+		if _, err := tx.Exec(`UPDATE pfs.search_index_commits SET indexed = TRUE WHERE repo_id = $1 AND commit_key = $2`, pfsdb.RepoKey(repo), key); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	for _, commitID := range pruned {
+		if _, err := tx.Exec(`DELETE FROM pfs.search_index_prunes WHERE repo_id = $1 AND commit_id = $2`, pfsdb.RepoKey(repo), commitID); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	_, err = tx.Exec(`UPDATE pfs.search_indexes SET fileset_id = $2, stale = FALSE WHERE repo_id = $1`, pfsdb.RepoKey(repo), id)
+	return errors.EnsureStack(err)
+}
+
+func searchIndexTrackerID(repo *pfs.Repo, id fileset.ID) string {
+	return searchIndexTrackerPrefix + pfsdb.RepoKey(repo) + "/" + id.HexString()
+}
+
+// SetupSearchIndexesV0 runs SQL to setup the search index store.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupSearchIndexesV0(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE pfs.search_indexes (
+			repo_id TEXT NOT NULL,
+			fileset_id UUID,
+			PRIMARY KEY(repo_id)
+		);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// SetupSearchIndexesV1 adds the stale flag and pruned commits of search indexes.
+func SetupSearchIndexesV1(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE pfs.search_indexes ADD COLUMN stale BOOLEAN NOT NULL DEFAULT FALSE;
+		CREATE TABLE pfs.search_index_prunes (
+			repo_id TEXT NOT NULL,
+			commit_id TEXT NOT NULL,
+			PRIMARY KEY(repo_id, commit_id)
+		);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// SetupSearchIndexesV2 adds the queue of commits to index. The finished
+// commits of indexed repos are recorded as indexed, so that they are indexed
+// again if the index is rebuilt, which happens to the indexes that are already
+// stale.
+func SetupSearchIndexesV2(ctx context.Context, tx *pachsql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TABLE pfs.search_index_commits (
+			repo_id TEXT NOT NULL,
+			commit_key TEXT NOT NULL,
+			indexed BOOLEAN NOT NULL DEFAULT FALSE,
+			PRIMARY KEY(repo_id, commit_key)
+		);
+	`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var repoKeys []string
+	if err := tx.SelectContext(ctx, &repoKeys, `SELECT repo_id FROM pfs.search_indexes`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	indexed := make(map[string]bool)
+	for _, key := range repoKeys {
+		indexed[key] = true
+	}
+	var protos [][]byte
+	if err := tx.SelectContext(ctx, &protos, `SELECT proto FROM collections.commits`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, data := range protos {
+		commitInfo := &pfs.CommitInfo{}
+		if err := proto.Unmarshal(data, commitInfo); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if !indexed[pfsdb.RepoKey(commitInfo.Commit.Branch.Repo)] || !isIndexable(commitInfo) {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO pfs.search_index_commits (repo_id, commit_key, indexed) VALUES ($1, $2, TRUE)`,
+			pfsdb.RepoKey(commitInfo.Commit.Branch.Repo), pfsdb.CommitKey(commitInfo.Commit)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// setSearchIndex enables or disables the search index of repo to match opts.
+func (d *driver) setSearchIndex(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, opts *pfs.SearchIndexOptions) error {
+	if opts.GetEnabled() {
+		return d.searchIndexes.EnableTx(txnCtx.SqlTx, repo)
+	}
+	return d.searchIndexes.DisableTx(txnCtx.SqlTx, repo)
+}
+
+func validateSearchIndexOptions(opts *pfs.SearchIndexOptions) error {
+	if opts.GetMaxFileSizeBytes() < 0 {
+		return errors.Errorf("search index max file size cannot be negative")
+	}
+	return nil
+}
+
+// cleanSearchIndexOptions returns nil for options which disable the index.
+func cleanSearchIndexOptions(opts *pfs.SearchIndexOptions) *pfs.SearchIndexOptions {
+	if !opts.GetEnabled() {
+		return nil
+	}
+	return opts
+}
+
+// isIndexable returns whether commitInfo is a finished commit with files to
+// index. Aliases have the same files as the commits they alias.
+func isIndexable(commitInfo *pfs.CommitInfo) bool {
+	return commitInfo.Finished != nil && commitInfo.Error == "" && commitInfo.Origin.Kind != pfs.OriginKind_ALIAS
+}
+
+// indexCommits adds the queued commits of each repo to its search index in the
+// background.
+func (d *driver) indexCommits(ctx context.Context) error {
+	compactor := newCompactor(d.storage, d.env.StorageConfig.StorageCompactionMaxFanIn)
+	return d.forEachRepo(ctx, "indexing commits", func(ctx context.Context, repoKey string) error {
+		return d.indexRepoCommits(ctx, compactor, repoKey)
+	})
+}
+
+// indexRepoCommits updates the search index of a repo whenever one of its
+// commits finishes. If the index can't be updated, it is marked stale and
+// rebuilt when this is retried.
+func (d *driver) indexRepoCommits(ctx context.Context, compactor *compactor, repoKey string) error {
+	err := d.commits.ReadOnly(ctx).WatchByIndexF(pfsdb.CommitsRepoIndex, repoKey, func(ev *watch.Event) error {
+		if ev.Type == watch.EventError {
+			return ev.Err
+		}
+		var key string
+		commitInfo := &pfs.CommitInfo{}
+		if err := ev.Unmarshal(&key, commitInfo); err != nil {
+			return err
+		}
+		if !isIndexable(commitInfo) {
+			return nil
+		}
+		repo := commitInfo.Commit.Branch.Repo
+		if err := d.indexRepo(ctx, compactor, repo); err != nil {
+			if ctx.Err() != nil {
+				return errors.EnsureStack(ctx.Err())
+			}
+			if err := dbutil.WithTx(ctx, d.env.DB, func(tx *pachsql.Tx) error {
+				return d.searchIndexes.MarkStaleTx(tx, repo)
+			}); err != nil {
+				return err
+			}
+			return err
+		}
+		return nil
+	}, watch.WithSort(col.SortByCreateRevision, col.SortAscend), watch.IgnoreDelete)
+	return errors.EnsureStack(err)
+}
+
+// indexRepo adds the files added or modified by the queued commits of repo to
+// its search index, and removes the entries of pruned commits. A stale index is
+// rebuilt from scratch.
+func (d *driver) indexRepo(ctx context.Context, compactor *compactor, repo *pfs.Repo) error {
+	enabled, prev, err := d.searchIndexes.Get(ctx, repo)
+	if err != nil || !enabled {
+		return err
+	}
+	stale, err := d.searchIndexes.IsStale(ctx, repo)
+	if err != nil {
+		return err
+	}
+	if stale {
+		if err := dbutil.WithTx(ctx, d.env.DB, func(tx *pachsql.Tx) error {
+			return d.searchIndexes.ResetTx(tx, repo)
+		}); err != nil {
+			return err
+		}
+		prev = nil
+	}
+	pending, err := d.searchIndexes.Pending(ctx, repo)
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+	var pruned []string
+	if prev != nil {
+		if pruned, err = d.searchIndexes.Pruned(ctx, repo); err != nil {
+			return err
+		}
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil {
+		return errors.EnsureStack(err)
+	}
+	maxFileSize := repoInfo.SearchIndex.GetMaxFileSizeBytes()
+	if maxFileSize == 0 {
+		maxFileSize = defaultSearchIndexMaxFileSize
+	}
+	return miscutil.LogStep(fmt.Sprintf("indexing %d commits in repo %v", len(pending), repo), func() error {
+		return d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+			var indexed []string
+			id, err := d.withUnorderedWriter(ctx, renewer, func(uw *fileset.UnorderedWriter) error {
+				if len(pruned) > 0 {
+					if err := pruneSearchIndex(ctx, d.storage, uw, *prev, pruned); err != nil {
+						return err
+					}
+				}
+				for _, key := range pending {
+					commitInfo := &pfs.CommitInfo{}
+					if err := d.commits.ReadOnly(ctx).Get(key, commitInfo); err != nil {
+						if col.IsErrNotFound(err) {
+							continue
+						}
+						return errors.EnsureStack(err)
+					}
+					if err := d.indexCommit(ctx, renewer, uw, commitInfo, maxFileSize); err != nil {
+						return err
+					}
+					indexed = append(indexed, key)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			ids := []fileset.ID{*id}
+			if prev != nil {
+				ids = append([]fileset.ID{*prev}, ids...)
+			}
+			taskDoer := d.env.TaskService.NewDoer(storageTaskNamespace, uuid.NewWithoutDashes(), nil)
+			indexID, err := compactor.Compact(ctx, taskDoer, ids, defaultTTL, nil)
+			if err != nil {
+				return err
+			}
+			return dbutil.WithTx(ctx, d.env.DB, func(tx *pachsql.Tx) error {
+				return d.searchIndexes.UpdateTx(tx, repo, prev, *indexID, indexed, pruned)
+			})
+		})
+	})
+}
+
+// indexCommit writes the search index entries of the files added or modified
+// by a commit to uw.
+func (d *driver) indexCommit(ctx context.Context, renewer *fileset.Renewer, uw *fileset.UnorderedWriter, commitInfo *pfs.CommitInfo, maxFileSize int64) error {
+	diffID, err := d.commitStore.GetDiffFileSet(ctx, commitInfo.Commit)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := renewer.Add(ctx, *diffID); err != nil {
+		return err
+	}
+	diff, err := d.storage.Open(ctx, []fileset.ID{*diffID})
+	if err != nil {
+		return err
+	}
+	return NewSource(commitInfo, diff).Iterate(ctx, func(fi *pfs.FileInfo, f fileset.File) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		return indexFile(ctx, uw, fi, f, maxFileSize)
+	})
+}
+
+// pruneSearchIndex deletes the entries of the pruned commits in the search
+// index prev.
+func pruneSearchIndex(ctx context.Context, storage *fileset.Storage, uw *fileset.UnorderedWriter, prev fileset.ID, pruned []string) error {
+	isPruned := make(map[string]bool)
+	for _, commitID := range pruned {
+		isPruned[commitID] = true
+	}
+	fs, err := storage.Open(ctx, []fileset.ID{prev})
+	if err != nil {
+		return err
+	}
+	var paths []string
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		if p := f.Index().Path; isPruned[searchIndexCommitID(p)] {
+			paths = append(paths, p)
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, p := range paths {
+		if err := uw.Delete(p, ""); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// searchIndexCommitID returns the ID of the commit of a search index entry.
+func searchIndexCommitID(p string) string {
+	var key string
+	switch {
+	case strings.HasPrefix(p, searchFilesPrefix):
+		key = strings.TrimPrefix(p, searchFilesPrefix)
+	case strings.HasPrefix(p, searchContentPrefix), strings.HasPrefix(p, searchNamesPrefix):
+		rest := strings.TrimPrefix(strings.TrimPrefix(p, searchContentPrefix), searchNamesPrefix)
+		i := strings.Index(rest, "/")
+		if i < 0 {
+			return ""
+		}
+		key = rest[i+1:]
+	default:
+		return ""
+	}
+	if i := strings.Index(key, "/"); i >= 0 {
+		return key[:i]
+	}
+	return key
+}
+
+func indexFile(ctx context.Context, uw *fileset.UnorderedWriter, fi *pfs.FileInfo, f fileset.File, maxFileSize int64) error {
+	key := fi.File.Commit.ID + fi.File.Path
+	info := &pfs.FileInfo{
+		File:      fi.File,
+		FileType:  fi.FileType,
+		SizeBytes: fi.SizeBytes,
+		Hash:      fi.Hash,
+	}
+	data, err := proto.Marshal(info)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := uw.Put(searchFilesPrefix+key, "", false, bytes.NewReader(data)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if name := strings.ToLower(path.Base(fi.File.Path)); name != "" {
+		if err := uw.Put(searchNamesPrefix+name+"/"+key, "", false, &bytes.Buffer{}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	if fi.SizeBytes > maxFileSize {
+		return nil
+	}
+	buf := &bytes.Buffer{}
+	if err := f.Content(ctx, buf); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if bytes.IndexByte(buf.Bytes(), 0) >= 0 {
+		// Binary files only have their names indexed.
+		return nil
+	}
+	for _, term := range searchTerms(buf.String()) {
+		if err := uw.Put(searchContentPrefix+term+"/"+key, "", false, &bytes.Buffer{}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// searchTerms returns the distinct lower case words in s. Words are runs of
+// ASCII letters and digits, and words which are too short or too long to be
+// useful are dropped.
+func searchTerms(s string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, term := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !('a' <= r && r <= 'z' || '0' <= r && r <= '9')
+	}) {
+		if len(term) < minSearchTermLen || len(term) > maxSearchTermLen || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// searchFile calls cb with the FileInfo of each file in repo's search index
+// which matches the request, ordered by commit ID and then by path. Files from
+// commits which have since been squashed or have errored are skipped.
+func (d *driver) searchFile(ctx context.Context, request *pfs.SearchFileRequest, cb func(*pfs.FileInfo) error) error {
+	repo := request.Repo
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_READ); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var prefixes []string
+	if request.Content != "" {
+		terms := searchTerms(request.Content)
+		if len(terms) == 0 {
+			return errors.Errorf("content %q doesn't contain any words of at least %d characters", request.Content, minSearchTermLen)
+		}
+		for _, term := range terms {
+			prefixes = append(prefixes, searchContentPrefix+term+"/")
+		}
+	}
+	if request.Name != "" {
+		prefixes = append(prefixes, searchNamesPrefix+strings.ToLower(request.Name)+"/")
+	}
+	if len(prefixes) == 0 {
+		return errors.Errorf("content or name must be set")
+	}
+	enabled, id, err := d.searchIndexes.Get(ctx, repo)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return errors.Errorf("repo %v doesn't have a search index", repo)
+	}
+	stale, err := d.searchIndexes.IsStale(ctx, repo)
+	if err != nil {
+		return err
+	}
+	if stale {
+		return errors.Errorf("the search index of repo %v is stale because a commit couldn't be indexed, it is rebuilt in the background", repo)
+	}
+	if id == nil {
+		return nil
+	}
+	var keys map[string]bool
+	for i, prefix := range prefixes {
+		fs, err := d.storage.Open(ctx, []fileset.ID{*id}, index.WithPrefix(prefix))
+		if err != nil {
+			return err
+		}
+		matches := make(map[string]bool)
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			key := strings.TrimPrefix(f.Index().Path, prefix)
+			if i == 0 || keys[key] {
+				matches[key] = true
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if len(matches) == 0 {
+			return nil
+		}
+		keys = matches
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	commitInfos := make(map[string]*pfs.CommitInfo)
+	for _, key := range sorted {
+		fi, err := d.searchIndexFileInfo(ctx, *id, key)
+		if err != nil {
+			return err
+		}
+		commitKey := pfsdb.CommitKey(fi.File.Commit)
+		commitInfo, ok := commitInfos[commitKey]
+		if !ok {
+			commitInfo = &pfs.CommitInfo{}
+			if err := d.commits.ReadOnly(ctx).Get(commitKey, commitInfo); err != nil {
+				if !col.IsErrNotFound(err) {
+					return errors.EnsureStack(err)
+				}
+				commitInfo = nil
+			}
+			commitInfos[commitKey] = commitInfo
+		}
+		if commitInfo == nil || commitInfo.Error != "" {
+			continue
+		}
+		fi.Committed = commitInfo.Finished
+		if err := cb(fi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *driver) searchIndexFileInfo(ctx context.Context, id fileset.ID, key string) (*pfs.FileInfo, error) {
+	p := searchFilesPrefix + key
+	fs, err := d.storage.Open(ctx, []fileset.ID{id}, index.WithExact(p))
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		return errors.EnsureStack(f.Content(ctx, buf))
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	fi := &pfs.FileInfo{}
+	if err := proto.Unmarshal(buf.Bytes(), fi); err != nil {
+		return nil, errors.Wrapf(err, "could not read search index entry %q", p)
+	}
+	return fi, nil
+}
@@ -55,6 +55,7 @@ func (s *source) Iterate(ctx context.Context, cb func(*pfs.FileInfo, fileset.Fil
 			File:      file,
 			FileType:  pfs.FileType_FILE,
 			Committed: s.commitInfo.Finishing,
+			Metadata:  idx.File.Metadata,
 		}
 		if fileset.IsDir(idx.Path) {
 			fi.FileType = pfs.FileType_DIR
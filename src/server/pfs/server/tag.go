@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+func (d *driver) createTag(txnCtx *txncontext.TransactionContext, tag *pfs.Tag, commit *pfs.Commit, description string) error {
+	if tag == nil || tag.Repo == nil {
+		return errors.New("tag cannot be nil")
+	}
+	if commit == nil {
+		return errors.New("commit cannot be nil")
+	}
+	if err := ancestry.ValidateName(tag.Name); err != nil {
+		return err
+	}
+	if uuid.IsUUIDWithoutDashes(tag.Name) {
+		return errors.Errorf("tag name cannot be a UUID V4")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo, auth.Permission_REPO_CREATE_BRANCH); err != nil {
+		return errors.EnsureStack(err)
+	}
+	commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
+	if err != nil {
+		return err
+	}
+	if !proto.Equal(commitInfo.Commit.Branch.Repo, tag.Repo) {
+		return errors.Errorf("cannot tag commit %v from a different repo than %v", commitInfo.Commit, tag.Repo)
+	}
+	if commitInfo.Finished == nil {
+		return pfsserver.ErrCommitNotFinished{Commit: commitInfo.Commit}
+	}
+	if commitInfo.Error != "" {
+		return pfsserver.ErrCommitError{Commit: commitInfo.Commit}
+	}
+	// Tags and branches share a namespace, so that repo@name is unambiguous.
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(tag.Repo.NewBranch(tag.Name), &pfs.BranchInfo{}); err == nil {
+		return errors.Errorf("cannot create tag %q, a branch with that name already exists in repo %v", tag.Name, tag.Repo)
+	} else if !col.IsErrNotFound(err) {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(d.tags.ReadWrite(txnCtx.SqlTx).Create(tag, &pfs.TagInfo{
+		Tag:         tag,
+		Commit:      commitInfo.Commit,
+		Created:     txnCtx.Timestamp,
+		Description: description,
+	}))
+}
+
+func (d *driver) inspectTag(ctx context.Context, tag *pfs.Tag) (*pfs.TagInfo, error) {
+	if tag == nil || tag.Repo == nil {
+		return nil, errors.New("tag cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, tag.Repo, auth.Permission_REPO_LIST_BRANCH); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags.ReadOnly(ctx).Get(tag, tagInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, pfsserver.ErrTagNotFound{Tag: tag}
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return tagInfo, nil
+}
+
+func (d *driver) listTag(ctx context.Context, repo *pfs.Repo, cb func(*pfs.TagInfo) error) error {
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_LIST_BRANCH); err != nil {
+		return errors.EnsureStack(err)
+	}
+	tagInfo := &pfs.TagInfo{}
+	return errors.EnsureStack(d.tags.ReadOnly(ctx).GetByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo), tagInfo, col.DefaultOptions(), func(string) error {
+		return cb(proto.Clone(tagInfo).(*pfs.TagInfo))
+	}))
+}
+
+func (d *driver) deleteTag(txnCtx *txncontext.TransactionContext, tag *pfs.Tag) error {
+	if tag == nil || tag.Repo == nil {
+		return errors.New("tag cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo, auth.Permission_REPO_DELETE_BRANCH); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Delete(tag); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrTagNotFound{Tag: tag}
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// checkTagName returns an error if a branch can't be named name, because a tag
+// in repo already has that name.
+func (d *driver) checkTagName(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, name string) error {
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Get(repo.NewTag(name), &pfs.TagInfo{}); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil
+		}
+		return errors.EnsureStack(err)
+	}
+	return errors.Errorf("cannot create branch %q, a tag with that name already exists in repo %v", name, repo)
+}
+
+// checkNotTagged returns an ErrCommitTagged if any of the commits is tagged.
+func (d *driver) checkNotTagged(txnCtx *txncontext.TransactionContext, commitInfos []*pfs.CommitInfo) error {
+	tagInfo := &pfs.TagInfo{}
+	for _, ci := range commitInfos {
+		if err := d.tags.ReadWrite(txnCtx.SqlTx).GetByIndex(pfsdb.TagsCommitIndex, pfsdb.CommitKey(ci.Commit), tagInfo, col.DefaultOptions(), func(string) error {
+			return pfsserver.ErrCommitTagged{Commit: ci.Commit, Tag: tagInfo.Tag}
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// taggedCommitSets returns the IDs of the commit sets which contain a tagged
+// commit.
+func (d *driver) taggedCommitSets(ctx context.Context) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags.ReadOnly(ctx).List(tagInfo, col.DefaultOptions(), func(string) error {
+		ids[tagInfo.Commit.ID] = true
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return ids, nil
+}
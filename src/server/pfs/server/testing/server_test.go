@@ -2,6 +2,7 @@ package testing
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 	"time"
 
 	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -35,6 +37,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
@@ -65,6 +68,24 @@ func FileInfoToPath(fileInfo interface{}) interface{} {
 	return fileInfo.(*pfs.FileInfo).File.Path
 }
 
+// searchFileEventually waits for a search of repo to return n files, since
+// commits are indexed in the background, and returns them.
+func searchFileEventually(t testing.TB, pachClient *client.APIClient, repo, content, name string, n int) []*pfs.FileInfo {
+	var fileInfos []*pfs.FileInfo
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		var err error
+		fileInfos, err = pachClient.SearchFileAll(repo, content, name)
+		if err != nil {
+			return err
+		}
+		if len(fileInfos) != n {
+			return errors.Errorf("expected %d files, got %d", n, len(fileInfos))
+		}
+		return nil
+	})
+	return fileInfos
+}
+
 func finishCommit(pachClient *client.APIClient, repo, branch, id string) error {
 	if err := pachClient.FinishCommit(repo, branch, id); err != nil {
 		if !pfsserver.IsCommitFinishedErr(err) {
@@ -597,6 +618,69 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, 1, len(commitInfos))
 	})
 
+	suite.Run("ListCommitPage", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		var commits []*pfs.Commit
+		for i := 0; i < 5; i++ {
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader(strings.Repeat("a", i))))
+			require.NoError(t, finishCommit(env.PachClient, repo, "master", commit.ID))
+			commits = append([]*pfs.Commit{commit}, commits...)
+		}
+		checkPages := func(to *pfs.Commit) {
+			var ids []string
+			var token string
+			for {
+				cis, err := env.PachClient.ListCommit(client.NewRepo(repo), to, nil, 0, client.WithPageListCommit(2, token))
+				require.NoError(t, err)
+				require.True(t, len(cis) <= 2)
+				if len(cis) == 0 {
+					break
+				}
+				for _, ci := range cis {
+					ids = append(ids, ci.Commit.ID)
+				}
+				token = pfs.NewCommitPageToken(cis[len(cis)-1])
+			}
+			var expected []string
+			for _, commit := range commits {
+				expected = append(expected, commit.ID)
+			}
+			require.Equal(t, expected, ids)
+		}
+		checkPages(nil)
+		checkPages(client.NewCommit(repo, "master", ""))
+
+		// The commits are filtered by size, which is the size of every file in
+		// the commit.
+		cis, err := env.PachClient.ListCommit(client.NewRepo(repo), nil, nil, 0, client.WithFilterListCommit(&pfs.CommitFilter{
+			MinSizeBytes: 3,
+			MaxSizeBytes: 6,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 2, len(cis))
+		require.Equal(t, commits[1].ID, cis[0].Commit.ID)
+		require.Equal(t, commits[2].ID, cis[1].Commit.ID)
+
+		// The commits are filtered by finished time.
+		ci, err := env.PachClient.InspectCommit(repo, "master", commits[2].ID)
+		require.NoError(t, err)
+		cis, err = env.PachClient.ListCommit(client.NewRepo(repo), nil, nil, 0, client.WithFilterListCommit(&pfs.CommitFilter{
+			FinishedBefore: ci.Finished,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 2, len(cis))
+		require.Equal(t, commits[3].ID, cis[0].Commit.ID)
+
+		_, err = env.PachClient.ListCommit(client.NewRepo(repo), nil, nil, 0, client.WithPageListCommit(2, "invalid"))
+		require.YesError(t, err)
+	})
+
 	// The DAG looks like this before the update:
 	// prov1 prov2
 	//   \    /
@@ -2564,6 +2648,49 @@ func TestPFS(suite *testing.T) {
 				}
 			}
 		})
+		t.Run("WithLength", func(t *testing.T) {
+			repo := "repo-length"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+
+			data := strings.Repeat("0123456789", 1000)
+			require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader(data)))
+
+			require.NoError(t, finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID))
+
+			for _, r := range [][2]int{{0, 1}, {0, 10}, {5, 10}, {4999, 2}, {9990, 10}, {9995, 100}, {len(data), 1}} {
+				var b bytes.Buffer
+				require.NoError(t, env.PachClient.GetFile(commit, "file", &b, client.WithOffset(int64(r[0])), client.WithLength(int64(r[1]))))
+				end := r[0] + r[1]
+				if end > len(data) {
+					end = len(data)
+				}
+				require.Equal(t, data[r[0]:end], b.String())
+			}
+			var b bytes.Buffer
+			require.YesError(t, env.PachClient.GetFile(commit, "file", &b, client.WithLength(-1)))
+
+			rs, err := env.PachClient.GetFileReadSeeker(commit, "file")
+			require.NoError(t, err)
+			_, err = rs.Seek(-10, io.SeekEnd)
+			require.NoError(t, err)
+			buf, err := ioutil.ReadAll(rs)
+			require.NoError(t, err)
+			require.Equal(t, data[len(data)-10:], string(buf))
+			_, err = rs.Seek(100, io.SeekStart)
+			require.NoError(t, err)
+			buf = make([]byte, 10)
+			_, err = io.ReadFull(rs, buf)
+			require.NoError(t, err)
+			require.Equal(t, data[100:110], string(buf))
+			_, err = rs.Seek(-5, io.SeekCurrent)
+			require.NoError(t, err)
+			_, err = io.ReadFull(rs, buf)
+			require.NoError(t, err)
+			require.Equal(t, data[105:115], string(buf))
+		})
 	})
 
 	suite.Run("ManyPutsSingleFileSingleCommit", func(t *testing.T) {
@@ -2961,234 +3088,125 @@ func TestPFS(suite *testing.T) {
 	})
 
 	suite.Run("PutFileSplit", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-		//
-		//	if testing.Short() {
-		//		t.Skip("Skipping integration tests in short mode")
-		//	}
-		//
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "none", pfs.Delimiter_NONE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line2", pfs.Delimiter_LINE, 2, 0, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line3", pfs.Delimiter_LINE, 0, 8, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json2", pfs.Delimiter_JSON, 2, 0, 0, false, strings.NewReader("{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json3", pfs.Delimiter_JSON, 0, 4, 0, false, strings.NewReader("{}{}{}{}"))
-		//	require.NoError(t, err)
-		//
-		//	files, err := env.PachClient.ListFileAll(repo, commit.ID, "line2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//
-		//	require.NoError(t, finishCommit(env.PachClient, repo, commit.ID))
-		//	commit2, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 9, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//
-		//	require.NoError(t, finishCommit(env.PachClient, repo, commit2.ID))
-		//	fileInfo, err := env.PachClient.InspectFile(repo, commit.ID, "none")
-		//	require.NoError(t, err)
-		//	require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 6, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 9, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line3")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 20, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(2), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "json")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 30, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(2), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json3")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit, "line", strings.NewReader("foo\nbar\nbuz\n"), client.WithSplitPutFile(pfs.Delimiter_LINE, 0, 0)))
+		require.NoError(t, env.PachClient.PutFile(commit, "line2", strings.NewReader("foo\nbar\nbuz\nfiz\n"), client.WithSplitPutFile(pfs.Delimiter_LINE, 2, 0)))
+		require.NoError(t, env.PachClient.PutFile(commit, "line3", strings.NewReader("foo\nbar\nbuz\nfiz\n"), client.WithSplitPutFile(pfs.Delimiter_LINE, 0, 8)))
+		require.NoError(t, env.PachClient.PutFile(commit, "json", strings.NewReader("{}{}{}{}{}{}{}{}{}{}"), client.WithSplitPutFile(pfs.Delimiter_JSON, 0, 0)))
+		require.NoError(t, env.PachClient.PutFile(commit, "json2", strings.NewReader("{}{}{}{}"), client.WithSplitPutFile(pfs.Delimiter_JSON, 2, 0)))
+		require.YesError(t, env.PachClient.PutFile(commit, "append", strings.NewReader("foo\n"), client.WithSplitPutFile(pfs.Delimiter_LINE, 0, 0), client.WithAppendPutFile()))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID))
+
+		checkFiles := func(path string, n int, size int64) {
+			fileInfos, err := env.PachClient.ListFileAll(commit, path)
+			require.NoError(t, err)
+			require.Equal(t, n, len(fileInfos))
+			for _, fileInfo := range fileInfos {
+				require.Equal(t, size, fileInfo.SizeBytes)
+			}
+		}
+		checkFiles("line", 3, 4)
+		checkFiles("line2", 2, 8)
+		checkFiles("line3", 2, 8)
+		checkFiles("json", 10, 3)
+		checkFiles("json2", 2, 6)
+
+		// Splitting a file again replaces all of the split files.
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "line2", strings.NewReader("foo\nbar\n"), client.WithSplitPutFile(pfs.Delimiter_LINE, 0, 0)))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit2.Branch.Name, commit2.ID))
+		fileInfos, err := env.PachClient.ListFileAll(commit2, "line2")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(commit2, "line2/0000000000000001", &buf))
+		require.Equal(t, "bar\n", buf.String())
 	})
 
 	suite.Run("PutFileSplitBig", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-		//
-		//	if testing.Short() {
-		//		t.Skip("Skipping integration tests in short mode")
-		//	}
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	w, err := env.PachClient.PutFileSplitWriter(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false)
-		//	require.NoError(t, err)
-		//	for i := 0; i < 1000; i++ {
-		//		_, err = w.Write([]byte("foo\n"))
-		//		require.NoError(t, err)
-		//	}
-		//	require.NoError(t, w.Close())
-		//	require.NoError(t, finishCommit(env.PachClient, repo, commit.ID))
-		//	files, err := env.PachClient.ListFileAll(repo, commit.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 1000, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit, "line", strings.NewReader(strings.Repeat("foo\n", 1000)), client.WithSplitPutFile(pfs.Delimiter_LINE, 0, 0)))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit.Branch.Name, commit.ID))
+		fileInfos, err := env.PachClient.ListFileAll(commit, "line")
+		require.NoError(t, err)
+		require.Equal(t, 1000, len(fileInfos))
+		for _, fileInfo := range fileInfos {
+			require.Equal(t, int64(4), fileInfo.SizeBytes)
+		}
 	})
 
 	suite.Run("PutFileSplitCSV", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	_, err := env.PachClient.PutFileSplit(repo, "master", "data", pfs.Delimiter_CSV, 0, 0, 0, false,
-		//		// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
-		//		strings.NewReader("this,is,a,test\n"+
-		//			"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"))
-		//	require.NoError(t, err)
-		//	fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/data")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(fileInfos))
-		//	var contents bytes.Buffer
-		//	env.PachClient.GetFile(repo, "master", "/data/0000000000000000", &contents)
-		//	require.Equal(t, "this,is,a,test\n", contents.String())
-		//	contents.Reset()
-		//	env.PachClient.GetFile(repo, "master", "/data/0000000000000001", &contents)
-		//	require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(commit, "data",
+			// Weird, but this is actually two lines ("is\nonly" is quoted, so one cell)
+			strings.NewReader("this,is,a,test\n"+
+				"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"+
+				"1,2,3,4\n"),
+			client.WithSplitPutFile(pfs.Delimiter_CSV, 0, 0),
+			client.WithHeaderRecordsPutFile(1)))
+		fileInfos, err := env.PachClient.ListFileAll(commit, "/data")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		// Every file starts with the header.
+		var contents bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(commit, "/data/0000000000000000", &contents))
+		require.Equal(t, "this,is,a,test\n\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(commit, "/data/0000000000000001", &contents))
+		require.Equal(t, "this,is,a,test\n1,2,3,4\n", contents.String())
 	})
 
 	suite.Run("PutFileSplitSQL", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//
-		//	_, err := env.PachClient.PutFileSplit(repo, "master", "/sql", pfs.Delimiter_SQL, 0, 0, 0,
-		//		false, strings.NewReader(tu.TestPGDump))
-		//	require.NoError(t, err)
-		//	fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/sql")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 5, len(fileInfos))
-		//
-		//	// Get one of the SQL records & validate it
-		//	var contents bytes.Buffer
-		//	env.PachClient.GetFile(repo, "master", "/sql/0000000000000000", &contents)
-		//	// Validate that the recieved pgdump file creates the cars table
-		//	require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-		//	// Validate the SQL header more generally by passing the output of GetFile
-		//	// back through the SQL library & confirm that it parses correctly but only
-		//	// has one row
-		//	pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
-		//	record, err := pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-		//	_, err = pgReader.ReadRow()
-		//	require.YesError(t, err)
-		//	require.True(t, errors.Is(err, io.EOF))
-		//
-		//	// Create a new commit that overwrites all existing data & puts it back with
-		//	// --header-records=1
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	require.NoError(t, env.PachClient.DeleteFile(repo, commit.ID, "/sql"))
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "/sql", pfs.Delimiter_SQL, 0, 0, 1,
-		//		false, strings.NewReader(tu.TestPGDump))
-		//	require.NoError(t, err)
-		//	require.NoError(t, finishCommit(env.PachClient, repo, commit.ID))
-		//	fileInfos, err = env.PachClient.ListFileAll(repo, "master", "/sql")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 4, len(fileInfos))
-		//
-		//	// Get one of the SQL records & validate it
-		//	contents.Reset()
-		//	env.PachClient.GetFile(repo, "master", "/sql/0000000000000003", &contents)
-		//	// Validate a that the recieved pgdump file creates the cars table
-		//	require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-		//	// Validate the SQL header more generally by passing the output of GetFile
-		//	// back through the SQL library & confirm that it parses correctly but only
-		//	// has one row
-		//	pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
-		//	record, err = pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-		//	record, err = pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
-		//	_, err = pgReader.ReadRow()
-		//	require.YesError(t, err)
-		//	require.True(t, errors.Is(err, io.EOF))
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(commit, "/sql", strings.NewReader(tu.TestPGDump), client.WithSplitPutFile(pfs.Delimiter_SQL, 2, 0)))
+		fileInfos, err := env.PachClient.ListFileAll(commit, "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 3, len(fileInfos))
+
+		// Every file is a pgdump file which creates the cars table, and contains
+		// its share of the rows.
+		var rows []string
+		for _, fileInfo := range fileInfos {
+			var contents bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(commit, fileInfo.File.Path, &contents))
+			require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+			pgReader := pachsql.NewPGDumpReader(bufio.NewReader(&contents))
+			for {
+				row, err := pgReader.ReadRow()
+				if err != nil {
+					require.True(t, errors.Is(err, io.EOF))
+					break
+				}
+				rows = append(rows, string(row))
+			}
+			require.Matches(t, "PostgreSQL database dump complete", string(pgReader.Footer))
+		}
+		require.Equal(t, 5, len(rows))
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", rows[0])
+		require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", rows[4])
 	})
 
 	suite.Run("DiffFile", func(t *testing.T) {
@@ -3414,55 +3432,191 @@ func TestPFS(suite *testing.T) {
 		checks()
 	})
 
-	suite.Run("GlobFile2", func(t *testing.T) {
+	suite.Run("ListFilePage", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
 
-		if testing.Short() {
-			t.Skip("Skipping integration tests in short mode")
-		}
-
-		repo := "test"
+		repo := "repo"
 		require.NoError(t, env.PachClient.CreateRepo(repo))
 		commit := client.NewCommit(repo, "master", "")
+		var paths []string
+		require.NoError(t, env.PachClient.WithModifyFileClient(commit, func(mf client.ModifyFile) error {
+			for i := 0; i < 20; i++ {
+				p := fmt.Sprintf("/dir/file%02d", i)
+				if err := mf.PutFile(p, strings.NewReader(strings.Repeat("a", i))); err != nil {
+					return err
+				}
+				paths = append(paths, p)
+			}
+			return nil
+		}))
+		listPages := func(list func(token string, cb func(*pfs.FileInfo) error) error) []string {
+			var result []string
+			var token string
+			for {
+				var fis []*pfs.FileInfo
+				require.NoError(t, list(token, func(fi *pfs.FileInfo) error {
+					fis = append(fis, fi)
+					return nil
+				}))
+				require.True(t, len(fis) <= 3)
+				if len(fis) == 0 {
+					return result
+				}
+				for _, fi := range fis {
+					result = append(result, fi.File.Path)
+				}
+				token = pfs.NewFilePageToken(fis[len(fis)-1])
+			}
+		}
+		require.Equal(t, paths, listPages(func(token string, cb func(*pfs.FileInfo) error) error {
+			return env.PachClient.ListFile(commit, "/dir", cb, client.WithPageListFile(3, token))
+		}))
+		require.Equal(t, paths, listPages(func(token string, cb func(*pfs.FileInfo) error) error {
+			return env.PachClient.GlobFile(commit, "/dir/*", cb, client.WithPageListFile(3, token))
+		}))
+		require.Equal(t, append([]string{"/", "/dir/"}, paths...), listPages(func(token string, cb func(*pfs.FileInfo) error) error {
+			return env.PachClient.WalkFile(commit, "/", cb, client.WithPageListFile(3, token))
+		}))
 
-		_, err := env.PachClient.StartCommit(repo, "master")
+		// A page can start after a path which doesn't exist, and directories
+		// after it still include all of their files.
+		token := pfs.NewFilePageToken(&pfs.FileInfo{File: commit.NewFile("/a")})
+		fis, err := env.PachClient.ListFileAll(commit, "/", client.WithPageListFile(1, token))
 		require.NoError(t, err)
-		expectedFileNames := []string{}
-		for i := 0; i < 100; i++ {
-			filename := fmt.Sprintf("/%d", i)
-			require.NoError(t, env.PachClient.PutFile(commit, filename, strings.NewReader(filename)))
+		require.Equal(t, 1, len(fis))
+		require.Equal(t, int64(190), fis[0].SizeBytes)
 
-			if strings.HasPrefix(filename, "/1") {
-				expectedFileNames = append(expectedFileNames, filename)
-			}
+		fis, err = env.PachClient.ListFileAll(commit, "/dir", client.WithFilterListFile(&pfs.FileFilter{
+			MinSizeBytes: 5,
+			MaxSizeBytes: 15,
+			PathRegex:    "[02468]$",
+		}))
+		require.NoError(t, err)
+		var result []string
+		for _, fi := range fis {
+			result = append(result, fi.File.Path)
 		}
-		require.NoError(t, finishCommit(env.PachClient, repo, "master", ""))
+		require.Equal(t, []string{"/dir/file06", "/dir/file08", "/dir/file10", "/dir/file12", "/dir/file14"}, result)
 
-		actualFileNames := []string{}
-		require.NoError(t, env.PachClient.GlobFile(commit, "/1*", func(fileInfo *pfs.FileInfo) error {
-			actualFileNames = append(actualFileNames, fileInfo.File.Path)
-			return nil
+		ci, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		fis, err = env.PachClient.ListFileAll(commit, "/dir", client.WithFilterListFile(&pfs.FileFilter{
+			CommittedBefore: ci.Finishing,
 		}))
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fis))
 
-		sort.Strings(expectedFileNames)
-		sort.Strings(actualFileNames)
-		require.Equal(t, expectedFileNames, actualFileNames)
+		_, err = env.PachClient.ListFileAll(commit, "/dir", client.WithFilterListFile(&pfs.FileFilter{PathRegex: "("}))
+		require.YesError(t, err)
+		_, err = env.PachClient.ListFileAll(commit, "/dir", client.WithPageListFile(3, pfs.NewCommitPageToken(ci)))
+		require.YesError(t, err)
 	})
 
-	suite.Run("GlobFile3", func(t *testing.T) {
+	suite.Run("Metadata", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
 
-		repo := "test"
+		repo := "repo"
 		require.NoError(t, env.PachClient.CreateRepo(repo))
-		commit1, err := env.PachClient.StartCommit(repo, "master")
+		commit1, err := env.PachClient.StartCommit(repo, "master", client.WithMetadataStartCommit(map[string]string{"experiment": "1"}))
 		require.NoError(t, err)
-		require.NoError(t, env.PachClient.PutFile(commit1, "/dir1/file1.1", &bytes.Buffer{}))
-		require.NoError(t, env.PachClient.PutFile(commit1, "/dir1/file1.2", &bytes.Buffer{}))
-		require.NoError(t, env.PachClient.PutFile(commit1, "/dir2/file2.1", &bytes.Buffer{}))
-		require.NoError(t, env.PachClient.PutFile(commit1, "/dir2/file2.2", &bytes.Buffer{}))
-		require.NoError(t, finishCommit(env.PachClient, repo, commit1.Branch.Name, commit1.ID))
+		require.NoError(t, env.PachClient.PutFile(commit1, "a", strings.NewReader("a"), client.WithMetadataPutFile(map[string]string{"labeler": "alice", "license": "mit"})))
+		require.NoError(t, env.PachClient.PutFile(commit1, "b", strings.NewReader("b"), client.WithMetadataPutFile(map[string]string{"labeler": "bob"})))
+		require.NoError(t, env.PachClient.PutFile(commit1, "c", strings.NewReader("c")))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit1.ID))
+
+		commit2, err := env.PachClient.StartCommit(repo, "master", client.WithMetadataStartCommit(map[string]string{"experiment": "2"}))
+		require.NoError(t, err)
+		// Appending merges the metadata, overwriting replaces it.
+		require.NoError(t, env.PachClient.PutFile(commit2, "a", strings.NewReader("a"), client.WithAppendPutFile(), client.WithMetadataPutFile(map[string]string{"license": "apache"})))
+		require.NoError(t, env.PachClient.PutFile(commit2, "b", strings.NewReader("b")))
+		require.NoError(t, env.PachClient.CopyFile(commit2, "d", commit1, "b"))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit2.ID))
+
+		ci, err := env.PachClient.InspectCommit(repo, "master", commit1.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"experiment": "1"}, ci.Metadata)
+		fi, err := env.PachClient.InspectFile(commit1, "a")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"labeler": "alice", "license": "mit"}, fi.Metadata)
+
+		metadata := make(map[string]map[string]string)
+		require.NoError(t, env.PachClient.ListFile(commit2, "/", func(fi *pfs.FileInfo) error {
+			metadata[fi.File.Path] = fi.Metadata
+			return nil
+		}))
+		require.Equal(t, map[string]map[string]string{
+			"/a": {"labeler": "alice", "license": "apache"},
+			"/b": nil,
+			"/c": nil,
+			"/d": {"labeler": "bob"},
+		}, metadata)
+
+		fis, err := env.PachClient.ListFileAll(commit1, "/", client.WithFilterListFile(&pfs.FileFilter{
+			Metadata: map[string]string{"labeler": "bob"},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fis))
+		require.Equal(t, "/b", fis[0].File.Path)
+
+		cis, err := env.PachClient.ListCommit(client.NewRepo(repo), nil, nil, 0, client.WithFilterListCommit(&pfs.CommitFilter{
+			Metadata: map[string]string{"experiment": "2"},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(cis))
+		require.Equal(t, commit2.ID, cis[0].Commit.ID)
+	})
+
+	suite.Run("GlobFile2", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		if testing.Short() {
+			t.Skip("Skipping integration tests in short mode")
+		}
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+
+		_, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		expectedFileNames := []string{}
+		for i := 0; i < 100; i++ {
+			filename := fmt.Sprintf("/%d", i)
+			require.NoError(t, env.PachClient.PutFile(commit, filename, strings.NewReader(filename)))
+
+			if strings.HasPrefix(filename, "/1") {
+				expectedFileNames = append(expectedFileNames, filename)
+			}
+		}
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", ""))
+
+		actualFileNames := []string{}
+		require.NoError(t, env.PachClient.GlobFile(commit, "/1*", func(fileInfo *pfs.FileInfo) error {
+			actualFileNames = append(actualFileNames, fileInfo.File.Path)
+			return nil
+		}))
+
+		sort.Strings(expectedFileNames)
+		sort.Strings(actualFileNames)
+		require.Equal(t, expectedFileNames, actualFileNames)
+	})
+
+	suite.Run("GlobFile3", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "/dir1/file1.1", &bytes.Buffer{}))
+		require.NoError(t, env.PachClient.PutFile(commit1, "/dir1/file1.2", &bytes.Buffer{}))
+		require.NoError(t, env.PachClient.PutFile(commit1, "/dir2/file2.1", &bytes.Buffer{}))
+		require.NoError(t, env.PachClient.PutFile(commit1, "/dir2/file2.2", &bytes.Buffer{}))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit1.Branch.Name, commit1.ID))
 		globFile := func(pattern string) []string {
 			var fis []*pfs.FileInfo
 			require.NoError(t, env.PachClient.GlobFile(commit1, pattern, func(fi *pfs.FileInfo) error {
@@ -4029,6 +4183,548 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, 3, len(commits))
 	})
 
+	suite.Run("Retention", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:            client.NewRepo("repo"),
+			RetentionPolicy: &pfs.RetentionPolicy{KeepLast: 2},
+		})
+		require.NoError(t, err)
+		repoInfo, err := env.PachClient.InspectRepo("repo")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), repoInfo.RetentionPolicy.KeepLast)
+
+		var commits []*pfs.Commit
+		for i := 0; i < 4; i++ {
+			commit, err := env.PachClient.StartCommit("repo", "master")
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.PutFile(commit, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+			require.NoError(t, finishCommit(env.PachClient, "repo", commit.Branch.Name, commit.ID))
+			commits = append(commits, commit)
+		}
+
+		// A dry run reports the two oldest commits without squashing them.
+		commitSets, err := env.PachClient.ApplyRetention("repo", true)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitSets))
+		require.ElementsEqual(t, []string{commits[0].ID, commits[1].ID}, []string{commitSets[0].ID, commitSets[1].ID})
+		commitInfos, err := env.PachClient.ListCommit(client.NewRepo("repo"), nil, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(commitInfos))
+
+		commitSets, err = env.PachClient.ApplyRetention("repo", false)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitSets))
+		commitInfos, err = env.PachClient.ListCommit(client.NewRepo("repo"), nil, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+		files, err := env.PachClient.ListFileAll(commits[3], "/")
+		require.NoError(t, err)
+		require.Equal(t, 4, len(files))
+
+		// A branch's policy overrides the repo's, and an empty policy removes it.
+		_, err = env.PachClient.PfsAPIClient.CreateBranch(env.PachClient.Ctx(), &pfs.CreateBranchRequest{
+			Branch:          client.NewBranch("repo", "master"),
+			Head:            client.NewCommit("repo", "master", ""),
+			RetentionPolicy: &pfs.RetentionPolicy{KeepLast: 5},
+		})
+		require.NoError(t, err)
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:            client.NewRepo("repo"),
+			Update:          true,
+			RetentionPolicy: &pfs.RetentionPolicy{},
+		})
+		require.NoError(t, err)
+		repoInfo, err = env.PachClient.InspectRepo("repo")
+		require.NoError(t, err)
+		require.Nil(t, repoInfo.RetentionPolicy)
+		commit, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit.Branch.Name, commit.ID))
+		commitSets, err = env.PachClient.ApplyRetention("repo", false)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commitSets))
+
+		// Commit sets which span a repo without a policy aren't squashed.
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:            client.NewRepo("upstream"),
+			RetentionPolicy: &pfs.RetentionPolicy{KeepLast: 1},
+		})
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.CreateRepo("downstream"))
+		require.NoError(t, env.PachClient.CreateBranch("downstream", "master", "", "", []*pfs.Branch{client.NewBranch("upstream", "master")}))
+		var upstreamCommits []*pfs.Commit
+		for i := 0; i < 3; i++ {
+			commit, err := env.PachClient.StartCommit("upstream", "master")
+			require.NoError(t, err)
+			require.NoError(t, finishCommit(env.PachClient, "upstream", commit.Branch.Name, commit.ID))
+			require.NoError(t, finishCommit(env.PachClient, "downstream", "master", commit.ID))
+			upstreamCommits = append(upstreamCommits, commit)
+		}
+		commitSets, err = env.PachClient.ApplyRetention("upstream", false)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commitSets))
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:            client.NewRepo("downstream"),
+			Update:          true,
+			RetentionPolicy: &pfs.RetentionPolicy{KeepLast: 1},
+		})
+		require.NoError(t, err)
+		commitSets, err = env.PachClient.ApplyRetention("upstream", false)
+		require.NoError(t, err)
+		var squashed []string
+		for _, commitSet := range commitSets {
+			squashed = append(squashed, commitSet.ID)
+		}
+		require.OneOfEquals(t, upstreamCommits[0].ID, squashed)
+		require.OneOfEquals(t, upstreamCommits[1].ID, squashed)
+		require.NoneEquals(t, upstreamCommits[2].ID, squashed)
+
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:            client.NewRepo("invalid"),
+			RetentionPolicy: &pfs.RetentionPolicy{KeepLast: -1},
+		})
+		require.YesError(t, err)
+	})
+
+	suite.Run("Quota", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		require.NoError(t, env.PachClient.CreateQuota("repo", "", &pfs.Quota{MaxFileCount: 2}))
+		require.YesError(t, env.PachClient.CreateQuota("repo", "user:alice", &pfs.Quota{MaxFileCount: 2}))
+		require.YesError(t, env.PachClient.CreateQuota("missing", "", &pfs.Quota{MaxFileCount: 2}))
+
+		commit1, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "file1", strings.NewReader("foo")))
+		require.NoError(t, env.PachClient.PutFile(commit1, "file2", strings.NewReader("bar")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit1.Branch.Name, commit1.ID))
+		commitInfo, err := env.PachClient.InspectCommit("repo", commit1.Branch.Name, commit1.ID)
+		require.NoError(t, err)
+		require.Equal(t, "", commitInfo.Error)
+
+		// A commit which exceeds the quota finishes with an error, and isn't
+		// counted towards the usage.
+		commit2, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "file3", strings.NewReader("baz")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit2.Branch.Name, commit2.ID))
+		commitInfo, err = env.PachClient.InspectCommit("repo", commit2.Branch.Name, commit2.ID)
+		require.NoError(t, err)
+		require.True(t, pfsserver.IsQuotaExceededErr(errors.New(commitInfo.Error)))
+
+		quotaInfo, err := env.PachClient.InspectQuota("repo", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), quotaInfo.Quota.MaxFileCount)
+		require.Equal(t, int64(2), quotaInfo.FileCount)
+		require.Equal(t, int64(6), quotaInfo.SizeBytes)
+
+		// Once the quota is removed the commit succeeds.
+		require.NoError(t, env.PachClient.CreateQuota("repo", "", &pfs.Quota{}))
+		quotaInfo, err = env.PachClient.InspectQuota("repo", "")
+		require.NoError(t, err)
+		require.Nil(t, quotaInfo.Quota)
+		commit3, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit3, "file3", strings.NewReader("baz")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit3.Branch.Name, commit3.ID))
+		commitInfo, err = env.PachClient.InspectCommit("repo", commit3.Branch.Name, commit3.ID)
+		require.NoError(t, err)
+		require.Equal(t, "", commitInfo.Error)
+		require.Equal(t, int64(3), commitInfo.Details.FileCount)
+		quotaInfo, err = env.PachClient.InspectQuota("repo", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), quotaInfo.FileCount)
+		require.Equal(t, int64(9), quotaInfo.SizeBytes)
+
+		// Dropping a commit releases its usage.
+		require.NoError(t, env.PachClient.DropCommitSet(commit3.ID))
+		quotaInfo, err = env.PachClient.InspectQuota("repo", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), quotaInfo.FileCount)
+		require.Equal(t, int64(6), quotaInfo.SizeBytes)
+
+		// Deleting files reduces the usage, even when it is over quota, which
+		// makes room for new files.
+		require.NoError(t, env.PachClient.CreateQuota("repo", "", &pfs.Quota{MaxFileCount: 1}))
+		commit4, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.DeleteFile(commit4, "file1"))
+		require.NoError(t, env.PachClient.DeleteFile(commit4, "file2"))
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit4.Branch.Name, commit4.ID))
+		commitInfo, err = env.PachClient.InspectCommit("repo", commit4.Branch.Name, commit4.ID)
+		require.NoError(t, err)
+		require.Equal(t, "", commitInfo.Error)
+		quotaInfo, err = env.PachClient.InspectQuota("repo", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(0), quotaInfo.FileCount)
+		require.Equal(t, int64(0), quotaInfo.SizeBytes)
+		commit5, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit5, "file4", strings.NewReader("quux")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit5.Branch.Name, commit5.ID))
+		commitInfo, err = env.PachClient.InspectCommit("repo", commit5.Branch.Name, commit5.ID)
+		require.NoError(t, err)
+		require.Equal(t, "", commitInfo.Error)
+		quotaInfo, err = env.PachClient.InspectQuota("repo", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), quotaInfo.FileCount)
+		require.Equal(t, int64(4), quotaInfo.SizeBytes)
+
+		// Squashing a commit keeps its usage, since its data is still in its
+		// children.
+		require.NoError(t, env.PachClient.SquashCommitSet(commit4.ID))
+		quotaInfo, err = env.PachClient.InspectQuota("repo", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), quotaInfo.FileCount)
+		require.Equal(t, int64(4), quotaInfo.SizeBytes)
+		require.NoError(t, env.PachClient.DropCommitSet(commit5.ID))
+		quotaInfo, err = env.PachClient.InspectQuota("repo", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), quotaInfo.FileCount)
+		require.Equal(t, int64(6), quotaInfo.SizeBytes)
+	})
+
+	suite.Run("BranchProtection", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		commit1, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit1.Branch.Name, commit1.ID))
+		require.NoError(t, env.PachClient.CreateBranch("repo", "dev", "master", "", nil))
+		require.NoError(t, env.PachClient.ProtectBranch("repo", "master", 1))
+		require.YesError(t, env.PachClient.ProtectBranch("repo", "missing", 1))
+		branchInfo, err := env.PachClient.InspectBranch("repo", "master")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), branchInfo.Protection.RequiredApprovals)
+
+		// Commits can't be started on a protected branch directly.
+		_, err = env.PachClient.StartCommit("repo", "master")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+
+		// The head can only be moved to an approved commit from another branch.
+		commit2, err := env.PachClient.StartCommit("repo", "dev")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "file", strings.NewReader("foo")))
+		require.YesError(t, env.PachClient.ApproveCommit("repo", "dev", commit2.ID))
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit2.Branch.Name, commit2.ID))
+		err = env.PachClient.CreateBranch("repo", "master", "dev", commit2.ID, nil)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+		require.NoError(t, env.PachClient.ApproveCommit("repo", "dev", commit2.ID))
+		// Without auth, approving again doesn't add another approval.
+		require.NoError(t, env.PachClient.ApproveCommit("repo", "dev", commit2.ID))
+		commitInfo, err := env.PachClient.InspectCommit("repo", "dev", commit2.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfo.Approvals))
+		require.NoError(t, env.PachClient.CreateBranch("repo", "master", "dev", commit2.ID, nil))
+		branchInfo, err = env.PachClient.InspectBranch("repo", "master")
+		require.NoError(t, err)
+		require.Equal(t, commit2.ID, branchInfo.Head.ID)
+
+		// The head can't be moved by dropping it.
+		err = env.PachClient.DropCommitSet(commit2.ID)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+
+		// A protected branch can't be deleted or given a trigger.
+		err = env.PachClient.DeleteBranch("repo", "master", false)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+		require.YesError(t, env.PachClient.CreateBranchTrigger("repo", "master", "", "", &pfs.Trigger{Branch: "dev", Commits: 1}))
+
+		// Once unprotected, commits can be started on the branch again.
+		require.NoError(t, env.PachClient.UnprotectBranch("repo", "master"))
+		branchInfo, err = env.PachClient.InspectBranch("repo", "master")
+		require.NoError(t, err)
+		require.Nil(t, branchInfo.Protection)
+		commit3, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, finishCommit(env.PachClient, "repo", commit3.Branch.Name, commit3.ID))
+		require.NoError(t, env.PachClient.DeleteBranch("repo", "master", false))
+	})
+
+	suite.Run("MergeBranch", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		putFiles := func(branch string, files map[string]string, deletes ...string) {
+			commit, err := env.PachClient.StartCommit("repo", branch)
+			require.NoError(t, err)
+			for path, content := range files {
+				require.NoError(t, env.PachClient.PutFile(commit, path, strings.NewReader(content)))
+			}
+			for _, path := range deletes {
+				require.NoError(t, env.PachClient.DeleteFile(commit, path))
+			}
+			require.NoError(t, finishCommit(env.PachClient, "repo", commit.Branch.Name, commit.ID))
+		}
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		putFiles("master", map[string]string{"a": "a", "b": "b", "c": "c"})
+		require.NoError(t, env.PachClient.CreateBranch("repo", "dev", "master", "", nil))
+		putFiles("dev", map[string]string{"a": "a2", "c": "c-dev", "d": "d"}, "b")
+		putFiles("master", map[string]string{"c": "c-master"})
+
+		// By default a conflict fails the merge.
+		resp, err := env.PachClient.MergeBranch("repo", "dev", "master", pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Nil(t, resp.Commit)
+		require.Equal(t, 1, len(resp.Conflicts))
+		require.Equal(t, "/c", resp.Conflicts[0].Path)
+
+		resp, err = env.PachClient.MergeBranch("repo", "dev", "master", pfs.MergeStrategy_THEIRS)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, 1, len(resp.Conflicts))
+		commitInfo, err := env.PachClient.WaitCommit("repo", "master", resp.Commit.ID)
+		require.NoError(t, err)
+		require.Equal(t, "dev", commitInfo.Merged.Branch.Name)
+		for path, content := range map[string]string{"a": "a2", "c": "c-dev", "d": "d"} {
+			var buf bytes.Buffer
+			require.NoError(t, env.PachClient.GetFile(resp.Commit, path, &buf))
+			require.Equal(t, content, buf.String())
+		}
+		_, err = env.PachClient.InspectFile(resp.Commit, "b")
+		require.YesError(t, err)
+
+		// Merging again does nothing, and later merges start from the last one.
+		resp, err = env.PachClient.MergeBranch("repo", "dev", "master", pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Nil(t, resp.Commit)
+		putFiles("master", map[string]string{"c": "c-master2"})
+		putFiles("dev", map[string]string{"e": "e"})
+		resp, err = env.PachClient.MergeBranch("repo", "dev", "master", pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, 0, len(resp.Conflicts))
+		_, err = env.PachClient.WaitCommit("repo", "master", resp.Commit.ID)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(resp.Commit, "c", &buf))
+		require.Equal(t, "c-master2", buf.String())
+	})
+
+	suite.Run("Tags", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		commit1, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "foo", strings.NewReader("foo1")))
+		// An unfinished commit can't be tagged.
+		require.YesError(t, env.PachClient.CreateTag("repo", "v1", "master", commit1.ID, ""))
+		require.NoError(t, finishCommit(env.PachClient, "repo", "master", commit1.ID))
+		require.NoError(t, env.PachClient.CreateTag("repo", "v1", "master", "", "first release"))
+
+		commit2, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "foo", strings.NewReader("foo2")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", "master", commit2.ID))
+
+		// The tag still refers to the first commit, and resolves as repo@tag.
+		tagInfo, err := env.PachClient.InspectTag("repo", "v1")
+		require.NoError(t, err)
+		require.Equal(t, commit1.ID, tagInfo.Commit.ID)
+		require.Equal(t, "first release", tagInfo.Description)
+		commitInfo, err := env.PachClient.InspectCommit("repo", "v1", "")
+		require.NoError(t, err)
+		require.Equal(t, commit1.ID, commitInfo.Commit.ID)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(client.NewCommit("repo", "v1", ""), "foo", &buf))
+		require.Equal(t, "foo1", buf.String())
+
+		err = env.PachClient.CreateTag("repo", "v1", "master", "", "")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsTagExistsErr(err))
+		// Tags and branches share a namespace.
+		require.YesError(t, env.PachClient.CreateTag("repo", "master", "master", "", ""))
+		require.YesError(t, env.PachClient.CreateBranch("repo", "v1", "master", "", nil))
+
+		// A tagged commit can't be squashed.
+		err = env.PachClient.SquashCommitSet(commit1.ID)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsCommitTaggedErr(err))
+
+		tagInfos, err := env.PachClient.ListTag("repo")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(tagInfos))
+		require.NoError(t, env.PachClient.DeleteTag("repo", "v1"))
+		err = env.PachClient.DeleteTag("repo", "v1")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsTagNotFoundErr(err))
+		require.NoError(t, env.PachClient.SquashCommitSet(commit1.ID))
+	})
+
+	suite.Run("SearchFile", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:        client.NewRepo("repo"),
+			SearchIndex: &pfs.SearchIndexOptions{Enabled: true},
+		})
+		require.NoError(t, err)
+		commit1, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "a.txt", strings.NewReader("Hello, world!")))
+		require.NoError(t, env.PachClient.PutFile(commit1, "b.bin", strings.NewReader("hello\x00world")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", "master", commit1.ID))
+		commit2, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "dir/c.txt", strings.NewReader("hello there")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", "master", commit2.ID))
+
+		// Binary files aren't indexed by content, and unchanged files aren't
+		// indexed again.
+		fileInfos := searchFileEventually(t, env.PachClient, "repo", "HELLO", "", 2)
+		paths := map[string]string{}
+		for _, fi := range fileInfos {
+			paths[fi.File.Path] = fi.File.Commit.ID
+			require.NotNil(t, fi.Committed)
+		}
+		require.Equal(t, commit1.ID, paths["/a.txt"])
+		require.Equal(t, commit2.ID, paths["/dir/c.txt"])
+
+		fileInfos, err = env.PachClient.SearchFileAll("repo", "hello world", "")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		require.Equal(t, "/a.txt", fileInfos[0].File.Path)
+		fileInfos, err = env.PachClient.SearchFileAll("repo", "", "B.bin")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		fileInfos, err = env.PachClient.SearchFileAll("repo", "hello", "c.txt")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		require.Equal(t, commit2.ID, fileInfos[0].File.Commit.ID)
+
+		// Files from squashed commits are no longer returned.
+		require.NoError(t, env.PachClient.SquashCommitSet(commit1.ID))
+		fileInfos, err = env.PachClient.SearchFileAll("repo", "world", "")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fileInfos))
+
+		// The entries of squashed commits are pruned when the next commit is
+		// indexed.
+		commit3, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit3, "d.txt", strings.NewReader("hello again")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", "master", commit3.ID))
+		searchFileEventually(t, env.PachClient, "repo", "hello", "", 2)
+		fileInfos, err = env.PachClient.SearchFileAll("repo", "", "a.txt")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fileInfos))
+
+		// A stale index is rebuilt from the commits it covers the next time a
+		// commit finishes.
+		_, err = env.ServiceEnv.GetDBClient().Exec(`UPDATE pfs.search_indexes SET stale = TRUE`)
+		require.NoError(t, err)
+		_, err = env.PachClient.SearchFileAll("repo", "hello", "")
+		require.YesError(t, err)
+		commit4, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit4, "e.txt", strings.NewReader("hello once more")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", "master", commit4.ID))
+		searchFileEventually(t, env.PachClient, "repo", "hello", "", 3)
+
+		require.NoError(t, env.PachClient.CreateRepo("unindexed"))
+		_, err = env.PachClient.SearchFileAll("unindexed", "hello", "")
+		require.YesError(t, err)
+	})
+
+	suite.Run("ExportImportRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		commit1, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "a.txt", strings.NewReader("foo")))
+		require.NoError(t, env.PachClient.PutFile(commit1, "b.txt", strings.NewReader("bar")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", "master", commit1.ID))
+		commit2, err := env.PachClient.StartCommit("repo", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "a.txt", strings.NewReader("foo2")))
+		require.NoError(t, env.PachClient.DeleteFile(commit2, "b.txt"))
+		require.NoError(t, env.PachClient.PutFile(commit2, "dir/c.txt", strings.NewReader("bar")))
+		require.NoError(t, finishCommit(env.PachClient, "repo", "master", commit2.ID))
+		require.NoError(t, env.PachClient.CreateBranch("repo", "dev", "master", commit1.ID, nil))
+		require.NoError(t, env.PachClient.CreateTag("repo", "v1", "master", commit1.ID, "first"))
+
+		archive := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.ExportRepo("repo", archive))
+		exported := archive.Bytes()
+
+		checkFiles := func(commit *pfs.Commit, files map[string]string) {
+			fileInfos, err := env.PachClient.GlobFileAll(commit, "/**")
+			require.NoError(t, err)
+			var paths []string
+			for _, fi := range fileInfos {
+				if fi.FileType == pfs.FileType_FILE {
+					paths = append(paths, fi.File.Path)
+				}
+			}
+			require.Equal(t, len(files), len(paths))
+			for path, content := range files {
+				buf := &bytes.Buffer{}
+				require.NoError(t, env.PachClient.GetFile(commit, path, buf))
+				require.Equal(t, content, buf.String())
+			}
+		}
+
+		response, err := env.PachClient.ImportRepo("imported", bytes.NewReader(exported), "", "")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), response.CommitCount)
+		masterInfo, err := env.PachClient.InspectBranch("imported", "master")
+		require.NoError(t, err)
+		checkFiles(masterInfo.Head, map[string]string{"/a.txt": "foo2", "/dir/c.txt": "bar"})
+		tagInfo, err := env.PachClient.InspectTag("imported", "v1")
+		require.NoError(t, err)
+		require.Equal(t, "first", tagInfo.Description)
+		checkFiles(tagInfo.Commit, map[string]string{"/a.txt": "foo", "/b.txt": "bar"})
+		devInfo, err := env.PachClient.InspectBranch("imported", "dev")
+		require.NoError(t, err)
+		require.Equal(t, tagInfo.Commit.ID, devInfo.Head.ID)
+		commitInfo, err := env.PachClient.InspectCommit("imported", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, tagInfo.Commit.ID, commitInfo.ParentCommit.ID)
+
+		// Only the commits in the range are imported, and tags of other commits
+		// are dropped.
+		response, err = env.PachClient.ImportRepo("partial", bytes.NewReader(exported), commit2.ID, "")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), response.CommitCount)
+		masterInfo, err = env.PachClient.InspectBranch("partial", "master")
+		require.NoError(t, err)
+		checkFiles(masterInfo.Head, map[string]string{"/a.txt": "foo2", "/dir/c.txt": "bar"})
+		tagInfos, err := env.PachClient.ListTag("partial")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(tagInfos))
+
+		_, err = env.PachClient.ImportRepo("missing", bytes.NewReader(exported), "", uuid.NewWithoutDashes())
+		require.YesError(t, err)
+		_, err = env.PachClient.InspectRepo("missing")
+		require.YesError(t, err)
+
+		// Archives without a supported version are rejected.
+		unversioned := &bytes.Buffer{}
+		tw := tar.NewWriter(unversioned)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "version", Size: 2, Mode: 0600}))
+		_, err = tw.Write([]byte("99"))
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+		_, err = env.PachClient.ImportRepo("unversioned", unversioned, "", "")
+		require.YesError(t, err)
+		require.Matches(t, "unsupported archive version", err.Error())
+	})
+
 	// SquashCommitSetMultipleChildrenSingleCommit tests that when you have the
 	// following commit graph in a repo:
 	// c   d
@@ -4797,6 +5493,46 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, desc, ri.Description)
 	})
 
+	suite.Run("RepoChunkingParams", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:           client.NewRepo(repo),
+			ChunkingParams: &pfs.ChunkingParams{MinChunkSizeBytes: 10 * units.MB, MaxChunkSizeBytes: 1 * units.MB},
+		})
+		require.YesError(t, err)
+		params := &pfs.ChunkingParams{AverageBits: 16, MinChunkSizeBytes: 16 * units.KB, MaxChunkSizeBytes: 256 * units.KB}
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:           client.NewRepo(repo),
+			ChunkingParams: params,
+		})
+		require.NoError(t, err)
+		ri, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(params, ri.ChunkingParams))
+
+		// data written with the repo's chunking params can be read back
+		data := random.String(5 * units.MB)
+		commit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader(data)))
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(commit, "file", buf))
+		require.Equal(t, data, buf.String())
+
+		// updating the params does not affect existing data
+		_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:           client.NewRepo(repo),
+			Update:         true,
+			ChunkingParams: &pfs.ChunkingParams{AverageBits: 20},
+		})
+		require.NoError(t, err)
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(commit, "file", buf))
+		require.Equal(t, data, buf.String())
+	})
+
 	suite.Run("DeferredProcessing", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -6159,6 +6895,12 @@ func TestPFS(suite *testing.T) {
 			data string
 			path string
 		}
+		schemaInfo := &pachsql.TableInfo{
+			Columns: []pachsql.ColumnInfo{
+				{Name: "ID", DataType: "INTEGER"},
+				{Name: "A", DataType: "VARCHAR"},
+			},
+		}
 
 		tests := []struct {
 			name           string
@@ -6196,6 +6938,18 @@ func TestPFS(suite *testing.T) {
 				tables:         []string{"test_table", "test_table2", "empty_table"},
 				expectedCounts: map[string]int64{"test_table": 4, "test_table2": 1, "empty_table": 0},
 			},
+			{
+				name: "PARQUET",
+				files: []File{
+					{makeParquet(_suite, schemaInfo, 1, "Foo", 2, "Bar"), "/test_table/0000"},
+					{makeParquet(_suite, schemaInfo, 3, "Hello", 4, "World"), "/test_table/subdir/0001"},
+					{makeParquet(_suite, schemaInfo, 1, "this is in test_table2"), "/test_table2/0000"},
+					{"", "/empty_table/0000"},
+				},
+				options:        &pfs.SQLDatabaseEgress{FileFormat: &pfs.SQLDatabaseEgress_FileFormat{Type: pfs.SQLDatabaseEgress_FileFormat_PARQUET}},
+				tables:         []string{"test_table", "test_table2", "empty_table"},
+				expectedCounts: map[string]int64{"test_table": 4, "test_table2": 1, "empty_table": 0},
+			},
 		}
 		for _, test := range tests {
 			_suite.Run(test.name, func(t *testing.T) {
@@ -6245,6 +6999,85 @@ func TestPFS(suite *testing.T) {
 			})
 		}
 	})
+
+	suite.Run("EgressToPostgresIncremental", func(t *testing.T) {
+		os.Setenv("PACHYDERM_SQL_PASSWORD", tu.DefaultPostgresPassword)
+
+		type Schema struct {
+			Id int    `column:"ID" dtype:"INT" constraint:"PRIMARY KEY"`
+			A  string `column:"A" dtype:"VARCHAR(100)"`
+		}
+
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		dbName := tu.GenerateEphemeralDBName(t)
+		tu.CreateEphemeralDB(t, sqlx.NewDb(env.ServiceEnv.GetDBClient().DB, "postgres"), dbName)
+		db := tu.OpenDB(t,
+			dbutil.WithMaxOpenConns(1),
+			dbutil.WithUserPassword(tu.DefaultPostgresUser, tu.DefaultPostgresPassword),
+			dbutil.WithHostPort(dockertestenv.PGBouncerHost(), dockertestenv.PGBouncerPort),
+			dbutil.WithDBName(dbName),
+		)
+		require.NoError(t, pachsql.CreateTestTable(db, "test_table", Schema{}))
+		require.NoError(t, env.PachClient.CreateRepo(dbName))
+
+		options := &pfs.SQLDatabaseEgress{
+			FileFormat: &pfs.SQLDatabaseEgress_FileFormat{Type: pfs.SQLDatabaseEgress_FileFormat_CSV},
+			Url:        fmt.Sprintf("postgres://%s@%s:%d/%s", tu.DefaultPostgresUser, dockertestenv.PGBouncerHost(), dockertestenv.PGBouncerPort, dbName),
+			Secret:     &pfs.SQLDatabaseEgress_Secret{Name: "does not matter", Key: "does not matter"},
+			Mode:       pfs.SQLDatabaseEgress_INCREMENTAL,
+			PrimaryKey: []string{"ID"},
+		}
+
+		// without a base commit, the full commit is egressed
+		commit1, err := env.PachClient.StartCommit(dbName, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "/test_table/0000", strings.NewReader("1,Foo\n2,Bar")))
+		require.NoError(t, env.PachClient.PutFile(commit1, "/test_table/0001", strings.NewReader("3,Hello\n4,World")))
+		require.NoError(t, env.PachClient.FinishCommit(dbName, "master", commit1.ID))
+		resp, err := env.PachClient.Egress(env.PachClient.Ctx(), &pfs.EgressRequest{
+			Commit: commit1,
+			Target: &pfs.EgressRequest_SqlDatabase{SqlDatabase: options},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]int64{"test_table": 4}, resp.GetSqlDatabase().GetRowsWritten())
+
+		// modify a row, add a row, delete a row, and move a row to another file
+		commit2, err := env.PachClient.StartCommit(dbName, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "/test_table/0000", strings.NewReader("1,Foo\n2,Baz\n5,New")))
+		require.NoError(t, env.PachClient.DeleteFile(commit2, "/test_table/0001"))
+		require.NoError(t, env.PachClient.PutFile(commit2, "/test_table/0002", strings.NewReader("4,World")))
+		require.NoError(t, env.PachClient.FinishCommit(dbName, "master", commit2.ID))
+		resp, err = env.PachClient.Egress(env.PachClient.Ctx(), &pfs.EgressRequest{
+			Commit:     commit2,
+			BaseCommit: commit1,
+			Target:     &pfs.EgressRequest_SqlDatabase{SqlDatabase: options},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]int64{"test_table": 3}, resp.GetSqlDatabase().GetRowsWritten())
+		require.Equal(t, map[string]int64{"test_table": 1}, resp.GetSqlDatabase().GetRowsDeleted())
+
+		var ids []int
+		require.NoError(t, db.Select(&ids, "select id from test_table order by id"))
+		require.Equal(t, []int{1, 2, 4, 5}, ids)
+		var a string
+		require.NoError(t, db.QueryRow("select a from test_table where id = 2").Scan(&a))
+		require.Equal(t, "Baz", a)
+	})
+}
+
+// makeParquet returns the contents of a Parquet file with the schema in info,
+// filled with rows of (id, string) pairs taken from values.
+func makeParquet(t testing.TB, info *pachsql.TableInfo, values ...interface{}) string {
+	buf := &bytes.Buffer{}
+	w, err := sdata.NewParquetWriter(buf, info)
+	require.NoError(t, err)
+	for i := 0; i < len(values); i += 2 {
+		id, a := int32(values[i].(int)), values[i+1].(string)
+		require.NoError(t, w.WriteTuple(sdata.Tuple{&id, &a}))
+	}
+	require.NoError(t, w.Flush())
+	return buf.String()
 }
 
 var (
@@ -23,6 +23,32 @@ func TestCheckStorage(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+// TestStorageKeyVersionsDisabled checks that the storage key rpcs are wired up correctly,
+// and fail when no key encryption key provider is configured.
+func TestStorageKeyVersionsDisabled(t *testing.T) {
+	ctx := context.Background()
+	t.Parallel()
+	client := newClient(t)
+	_, err := client.ListStorageKeyVersions(ctx, &pfs.ListStorageKeyVersionsRequest{})
+	require.YesError(t, err)
+	require.Matches(t, "no key encryption key provider", err.Error())
+	_, err = client.RotateStorageKey(ctx, &pfs.RotateStorageKeyRequest{})
+	require.YesError(t, err)
+}
+
+// TestGarbageCollectStorageDryRun checks that the GarbageCollectStorage rpc is wired up correctly.
+// A more extensive test of the report lives in the `fileset` package.
+func TestGarbageCollectStorageDryRun(t *testing.T) {
+	ctx := context.Background()
+	t.Parallel()
+	client := newClient(t)
+	res, err := client.GarbageCollectStorage(ctx, &pfs.GarbageCollectStorageRequest{
+		DryRun: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
 func newClient(t testing.TB) pfs.APIClient {
 	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
 	return env.PachClient.PfsAPIClient
@@ -557,6 +557,8 @@ each datum.`,
 
 	}
 
+	var pfsLogs bool
+	var logsFrom, logsTo string
 	getLogs := &cobra.Command{
 		Use:   "{{alias}} [--pipeline=<pipeline>|--job=<pipeline>@<job>] [--datum=<datum>]",
 		Short: "Return logs from a job.",
@@ -569,7 +571,13 @@ each datum.`,
 	$ {{alias}} --job=aedfa12aedf
 
 	# Return logs emitted by the pipeline \"filter\" while processing /apple.txt and a file with the hash 123aef
-	$ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
+	$ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef
+
+	# Return logs stored in PFS for the job aedfa12aedf, including for jobs that finished long ago
+	$ {{alias}} --job=filter@aedfa12aedf --pfs
+
+	# Return logs stored in PFS for the "filter" pipeline that were logged on 2021-10-01
+	$ {{alias}} --pipeline=filter --pfs --from=2021-10-01T00:00:00Z --to=2021-10-02T00:00:00Z`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
@@ -612,7 +620,29 @@ each datum.`,
 			}
 
 			// Issue RPC
-			iter := client.GetLogs(pipelineName, jobID, data, datumID, master, follow, since)
+			var iter *pachdclient.LogsIter
+			if pfsLogs {
+				if follow {
+					return errors.Errorf("--follow cannot be used with --pfs")
+				}
+				var from, to time.Time
+				if logsFrom != "" {
+					if from, err = time.Parse(time.RFC3339, logsFrom); err != nil {
+						return errors.Wrapf(err, "error parsing from(%q)", logsFrom)
+					}
+				}
+				if logsTo != "" {
+					if to, err = time.Parse(time.RFC3339, logsTo); err != nil {
+						return errors.Wrapf(err, "error parsing to(%q)", logsTo)
+					}
+				}
+				iter = client.GetLogsPFS(pipelineName, jobID, data, datumID, master, from, to)
+			} else {
+				if logsFrom != "" || logsTo != "" {
+					return errors.Errorf("--from and --to can only be used with --pfs")
+				}
+				iter = client.GetLogs(pipelineName, jobID, data, datumID, master, follow, since)
+			}
 			var buf bytes.Buffer
 			encoder := json.NewEncoder(&buf)
 			for iter.Next() {
@@ -650,6 +680,9 @@ each datum.`,
 	getLogs.Flags().BoolVarP(&follow, "follow", "f", false, "Follow logs as more are created.")
 	getLogs.Flags().Int64VarP(&tail, "tail", "t", 0, "Lines of recent logs to display.")
 	getLogs.Flags().StringVar(&since, "since", "24h", "Return log messages more recent than \"since\".")
+	getLogs.Flags().BoolVar(&pfsLogs, "pfs", false, "Return log messages stored in PFS, which are kept for every job.")
+	getLogs.Flags().StringVar(&logsFrom, "from", "", "Return log messages logged at or after this time (RFC3339, requires --pfs).")
+	getLogs.Flags().StringVar(&logsTo, "to", "", "Return log messages logged at or before this time (RFC3339, requires --pfs).")
 	shell.RegisterCompletionFunc(getLogs,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 			if flag == "--pipeline" || flag == "-p" {
@@ -1113,6 +1146,158 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	}
 	commands = append(commands, cmdutil.CreateAlias(listSecret, "list secret"))
 
+	var notifierURL, notifierSecret string
+	var notifierPipelines, notifierRepos, notifierJobStates, notifierPipelineStates []string
+	var notifierCommitFinished, updateNotifier bool
+	createNotifier := &cobra.Command{
+		Use:   "{{alias}} <notifier>",
+		Short: "Create a webhook that is notified of job, pipeline and commit events.",
+		Long: "Create a webhook that is notified of job, pipeline and commit events. " +
+			"Each matching event is POSTed to the webhook's URL as JSON. If a secret is set, " +
+			"the HMAC-SHA256 of the request body, keyed by the secret, is sent in the " +
+			"X-Pachyderm-Signature header as \"sha256=<hex digest>\".",
+		Example: `
+	# Notify https://example.com/hook whenever a job in the "edges" pipeline fails
+	$ {{alias}} edges-failures --url=https://example.com/hook --pipeline=edges --job-state=failure
+
+	# Notify https://example.com/hook whenever any pipeline is crashing, or a commit in the "images" repo finishes
+	$ {{alias}} alerts --url=https://example.com/hook --pipeline-state=crashing --commit-finished --repo=images`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			request := &ppsclient.CreateNotifierRequest{
+				Notifier:       &ppsclient.Notifier{Name: args[0]},
+				Url:            notifierURL,
+				Secret:         notifierSecret,
+				Pipelines:      notifierPipelines,
+				Repos:          notifierRepos,
+				CommitFinished: notifierCommitFinished,
+				Update:         updateNotifier,
+			}
+			for _, stateStr := range notifierJobStates {
+				state, err := ppsclient.JobStateFromName(stateStr)
+				if err != nil {
+					return err
+				}
+				request.JobStates = append(request.JobStates, state)
+			}
+			for _, stateStr := range notifierPipelineStates {
+				state, err := ppsclient.PipelineStateFromName(stateStr)
+				if err != nil {
+					return err
+				}
+				request.PipelineStates = append(request.PipelineStates, state)
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.CreateNotifier(request)
+		}),
+	}
+	createNotifier.Flags().StringVar(&notifierURL, "url", "", "The http or https URL that notifications are POSTed to.")
+	createNotifier.Flags().StringVar(&notifierSecret, "secret", "", "The secret used to sign notifications.")
+	createNotifier.Flags().StringSliceVarP(&notifierPipelines, "pipeline", "p", nil, "Only notify job and pipeline events for these pipelines.")
+	createNotifier.MarkFlagCustom("pipeline", "__pachctl_get_pipeline")
+	createNotifier.Flags().StringSliceVarP(&notifierRepos, "repo", "r", nil, "Only notify events in these repos (the output repo, for job and pipeline events).")
+	createNotifier.MarkFlagCustom("repo", "__pachctl_get_repo")
+	createNotifier.Flags().StringSliceVar(&notifierJobStates, "job-state", nil, "Notify when a job enters one of these states.")
+	createNotifier.Flags().StringSliceVar(&notifierPipelineStates, "pipeline-state", nil, "Notify when a pipeline enters one of these states.")
+	createNotifier.Flags().BoolVar(&notifierCommitFinished, "commit-finished", false, "Notify when a commit finishes.")
+	createNotifier.Flags().BoolVar(&updateNotifier, "update", false, "Replace the notifier if it already exists.")
+	commands = append(commands, cmdutil.CreateAlias(createNotifier, "create notifier"))
+
+	listNotifier := &cobra.Command{
+		Short: "List all notifiers.",
+		Long:  "List all notifiers. Only cluster admins can list notifiers, since their URLs may contain credentials.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			notifierInfos, err := client.ListNotifier()
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.NotifierHeader)
+			for _, ni := range notifierInfos {
+				pretty.PrintNotifierInfo(writer, ni)
+			}
+			return writer.Flush()
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(listNotifier, "list notifier"))
+
+	deleteNotifier := &cobra.Command{
+		Use:   "{{alias}} <notifier>",
+		Short: "Delete a notifier.",
+		Long:  "Delete a notifier.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.DeleteNotifier(args[0])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteNotifier, "delete notifier"))
+
+	var downstream bool
+	var traceDepth int64
+	traceFile := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
+		Short: "Return the file-level lineage of a file.",
+		Long: "Return the file-level lineage of a file: the datums that the file was derived from, " +
+			"and the input files that they read, through each upstream pipeline. With --downstream, " +
+			"return the datums that read the file, and the output files that they wrote, through each " +
+			"downstream pipeline in the file's commit set instead.",
+		Example: `
+	# Return the input files that "/model/part-7" in the output of the "train" pipeline was derived from
+	$ {{alias}} train@master:/model/part-7
+
+	# Return the files derived from "/raw/1.csv" in the "data" repo by the next pipeline downstream
+	$ {{alias}} data@master:/raw/1.csv --downstream --depth 1`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			file, err := cmdutil.ParseFile(args[0])
+			if err != nil {
+				return err
+			}
+			direction := ppsclient.TraceDirection_UPSTREAM
+			if downstream {
+				direction = ppsclient.TraceDirection_DOWNSTREAM
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if raw {
+				e := cmdutil.Encoder(output, os.Stdout)
+				return client.TraceFile(file, direction, traceDepth, func(lineage *ppsclient.FileLineage) error {
+					return errors.EnsureStack(e.EncodeProto(lineage))
+				})
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.FileLineageHeader)
+			defer func() {
+				if err := writer.Flush(); retErr == nil {
+					retErr = err
+				}
+			}()
+			return client.TraceFile(file, direction, traceDepth, func(lineage *ppsclient.FileLineage) error {
+				pretty.PrintFileLineage(writer, lineage)
+				return nil
+			})
+		}),
+	}
+	traceFile.Flags().BoolVar(&downstream, "downstream", false, "Trace the files derived from the file, rather than the files it was derived from.")
+	traceFile.Flags().Int64Var(&traceDepth, "depth", 0, "The number of pipelines to trace through, or 0 to trace through the whole DAG.")
+	traceFile.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(traceFile, shell.FileCompletion)
+	commands = append(commands, cmdutil.CreateAlias(traceFile, "trace file"))
+
 	var seed int64
 	runLoadTest := &cobra.Command{
 		Use:   "{{alias}} <spec-file> ",
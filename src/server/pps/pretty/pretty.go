@@ -32,6 +32,10 @@ const (
 	DatumHeader = "ID\tFILES\tSTATUS\tTIME\t\n"
 	// SecretHeader is the header for secrets
 	SecretHeader = "NAME\tTYPE\tCREATED\t\n"
+	// NotifierHeader is the header for notifiers
+	NotifierHeader = "NAME\tURL\tEVENTS\tFILTERS\tCREATED\t\n"
+	// FileLineageHeader is the header for file lineage
+	FileLineageHeader = "DEPTH\tJOB\tDATUM\tINPUTS\tOUTPUTS\t\n"
 	// jobReasonLen is the amount of the job reason that we print
 	jobReasonLen = 25
 )
@@ -373,6 +377,46 @@ func PrintSecretInfo(w io.Writer, secretInfo *ppsclient.SecretInfo) {
 	fmt.Fprintf(w, "%s\t%s\t%s\t\n", secretInfo.Secret.Name, secretInfo.Type, pretty.Ago(secretInfo.CreationTimestamp))
 }
 
+// PrintNotifierInfo pretty-prints notifier info.
+func PrintNotifierInfo(w io.Writer, notifierInfo *ppsclient.NotifierInfo) {
+	var events []string
+	for _, state := range notifierInfo.JobStates {
+		events = append(events, "job "+strings.ToLower(strings.TrimPrefix(state.String(), "JOB_")))
+	}
+	for _, state := range notifierInfo.PipelineStates {
+		events = append(events, "pipeline "+strings.ToLower(strings.TrimPrefix(state.String(), "PIPELINE_")))
+	}
+	if notifierInfo.CommitFinished {
+		events = append(events, "commit finished")
+	}
+	var filters []string
+	if len(notifierInfo.Pipelines) > 0 {
+		filters = append(filters, "pipelines: "+strings.Join(notifierInfo.Pipelines, ","))
+	}
+	if len(notifierInfo.Repos) > 0 {
+		filters = append(filters, "repos: "+strings.Join(notifierInfo.Repos, ","))
+	}
+	if len(filters) == 0 {
+		filters = append(filters, "-")
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", notifierInfo.Notifier.Name, notifierInfo.Url,
+		strings.Join(events, ", "), strings.Join(filters, "; "), pretty.Ago(notifierInfo.Created))
+}
+
+// PrintFileLineage pretty-prints the lineage of a datum in a file trace.
+func PrintFileLineage(w io.Writer, lineage *ppsclient.FileLineage) {
+	fmt.Fprintf(w, "%d\t%s@%s\t%s\t%s\t%s\t\n", lineage.Depth, lineage.Job.Pipeline.Name, lineage.Job.ID,
+		lineage.DatumID, compactPrintFiles(lineage.Inputs), compactPrintFiles(lineage.Outputs))
+}
+
+func compactPrintFiles(files []*pfsclient.File) string {
+	var result []string
+	for _, file := range files {
+		result = append(result, pfspretty.CompactPrintFile(file))
+	}
+	return strings.Join(result, ", ")
+}
+
 // PrintFileHeader prints the header for a pfs file.
 func PrintFileHeader(w io.Writer) {
 	fmt.Fprintf(w, "  REPO\tCOMMIT\tPATH\t\n")
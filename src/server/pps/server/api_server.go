@@ -1,10 +1,12 @@
 package server
 
 import (
+	"archive/tar"
 	"bufio"
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"path"
 	"sort"
 	"strings"
@@ -25,17 +27,20 @@ import (
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	enterpriseclient "github.com/pachyderm/pachyderm/v2/src/enterprise"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/lokiutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachtmpl"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
@@ -100,9 +105,11 @@ type apiServer struct {
 	port                  uint16
 	peerPort              uint16
 	gcPercent             int
+	infraDriver           InfraDriver
 	// collections
 	pipelines col.PostgresCollection
 	jobs      col.PostgresCollection
+	notifiers col.PostgresCollection
 }
 
 func merge(from, to map[string]bool) {
@@ -268,8 +275,13 @@ func validateTransform(transform *pps.Transform) error {
 }
 
 func (a *apiServer) validateKube(ctx context.Context) {
-	errors := false
 	kubeClient := a.env.KubeClient
+	if kubeClient == nil {
+		// pachd isn't running in kubernetes, e.g. with the local infrastructure
+		// driver
+		return
+	}
+	errors := false
 	_, err := kubeClient.CoreV1().Pods(a.namespace).Watch(ctx, metav1.ListOptions{Watch: true})
 	if err != nil {
 		errors = true
@@ -456,6 +468,9 @@ func (a *apiServer) UpdateJobStateInTransaction(txnCtx *txncontext.TransactionCo
 	jobInfo.DataRecovered = request.DataRecovered
 	jobInfo.DataTotal = request.DataTotal
 	jobInfo.Stats = request.Stats
+	if request.Egressed != nil {
+		jobInfo.Egressed = request.Egressed
+	}
 
 	return ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.SqlTx), jobs, jobInfo, request.State, request.Reason)
 }
@@ -1037,7 +1052,7 @@ func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_List
 func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb func(*datum.Meta) error) error {
 	setInputDefaults("", input)
 	if visitErr := pps.VisitInput(input, func(input *pps.Input) error {
-		if input.Pfs != nil {
+		if input.Pfs != nil && input.Pfs.Commit == "" {
 			pachClient := a.env.GetPachClient(ctx)
 			ci, err := pachClient.InspectCommit(input.Pfs.Repo, input.Pfs.Branch, "")
 			if err != nil {
@@ -1115,6 +1130,9 @@ func (a *apiServer) collectDatums(ctx context.Context, job *pps.Job, cb func(*da
 }
 
 func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
+	if request.UsePfsBackend {
+		return a.getLogsPFS(request, apiGetLogsServer)
+	}
 	// Set the default for the `Since` field.
 	if request.Since == nil || (request.Since.Seconds == 0 && request.Since.Nanos == 0) {
 		request.Since = types.DurationProto(DefaultLogsFrom)
@@ -1224,15 +1242,15 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 					tailLines = nil
 				}
 				// Get full set of logs from pod i
-				stream, err := a.env.KubeClient.CoreV1().Pods(a.namespace).GetLogs(
+				stream, err := a.infraDriver.GetPodLogs(apiGetLogsServer.Context(),
 					pod.ObjectMeta.Name, &v1.PodLogOptions{
 						Container:    containerName,
 						Follow:       request.Follow,
 						TailLines:    tailLines,
 						SinceSeconds: &sinceSeconds,
-					}).Timeout(10 * time.Second).Stream(apiGetLogsServer.Context())
+					})
 				if err != nil {
-					return errors.EnsureStack(err)
+					return err
 				}
 				defer func() {
 					if err := stream.Close(); err != nil && retErr == nil {
@@ -1395,6 +1413,180 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 	})
 }
 
+// getLogsPFS serves logs from the log files that workers write to each job's
+// meta commit. Datum logs are stored at /meta/<datum ID>/logs and the master's
+// logs for a job are stored at /logs/master.
+func (a *apiServer) getLogsPFS(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
+	ctx := apiGetLogsServer.Context()
+	if request.Follow {
+		return errors.Errorf("following logs is not supported by the PFS logs backend")
+	}
+	if request.Pipeline == nil && request.Job == nil {
+		return errors.Errorf("must specify the Job or Pipeline to get logs from the PFS logs backend")
+	} else if request.Job != nil && request.Job.GetPipeline().GetName() == "" {
+		return errors.Errorf("pipeline must be specified for the given job")
+	} else if request.Job != nil && request.Pipeline != nil && !proto.Equal(request.Job.Pipeline, request.Pipeline) {
+		return errors.Errorf("job is from the wrong pipeline")
+	}
+	var from, to time.Time
+	if request.From != nil {
+		var err error
+		if from, err = types.TimestampFromProto(request.From); err != nil {
+			return errors.Wrapf(err, "invalid from time")
+		}
+	}
+	if request.To != nil {
+		var err error
+		if to, err = types.TimestampFromProto(request.To); err != nil {
+			return errors.Wrapf(err, "invalid to time")
+		}
+	}
+	// before and after report whether ts is set and falls before from or
+	// after to, respectively.
+	before := func(ts *types.Timestamp) bool {
+		t, err := types.TimestampFromProto(ts)
+		return ts != nil && err == nil && !from.IsZero() && t.Before(from)
+	}
+	after := func(ts *types.Timestamp) bool {
+		t, err := types.TimestampFromProto(ts)
+		return ts != nil && err == nil && !to.IsZero() && t.After(to)
+	}
+
+	pipelineName := request.GetPipeline().GetName()
+	if request.Job != nil {
+		pipelineName = request.Job.Pipeline.Name
+	}
+	pipelineInfo, err := a.inspectPipeline(ctx, pipelineName, true)
+	if err != nil {
+		return errors.Wrapf(err, "could not get pipeline information for %s", pipelineName)
+	}
+	if err := a.authorizePipelineOp(ctx, pipelineOpGetLogs, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
+	}
+
+	// Collect the jobs whose logs may fall in the requested window.
+	var jobInfos []*pps.JobInfo
+	if request.Job != nil {
+		jobInfo := &pps.JobInfo{}
+		if err := a.jobs.ReadOnly(ctx).Get(ppsdb.JobKey(request.Job), jobInfo); err != nil {
+			return errors.Wrapf(err, "could not get job information for \"%s\"", request.Job.ID)
+		}
+		jobInfos = append(jobInfos, jobInfo)
+	} else {
+		jobInfo := &pps.JobInfo{}
+		opts := &col.Options{Target: col.SortByCreateRevision, Order: col.SortAscend}
+		if err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, pipelineName, jobInfo, opts, func(string) error {
+			if after(jobInfo.Started) || before(jobInfo.Finished) {
+				return nil
+			}
+			jobInfos = append(jobInfos, proto.Clone(jobInfo).(*pps.JobInfo))
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+
+	logsPath := path.Join("/", datum.MetaPrefix, "*", datum.LogsFileName)
+	if request.Master {
+		logsPath = path.Join("/", datum.LogsPrefix, datum.MasterLogsFileName)
+	} else if request.Datum != nil {
+		logsPath = path.Join("/", datum.MetaPrefix, request.Datum.ID, datum.LogsFileName)
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	for _, jobInfo := range jobInfos {
+		if err := a.sendJobLogsPFS(pachClient, jobInfo, logsPath, func(msg *pps.LogMessage) bool {
+			if request.Datum != nil && request.Datum.ID != msg.DatumID {
+				return false
+			}
+			if request.Master != msg.Master {
+				return false
+			}
+			if !common.MatchDatum(request.DataFilters, msg.Data) {
+				return false
+			}
+			return !before(msg.Ts) && !after(msg.Ts)
+		}, apiGetLogsServer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendJobLogsPFS streams the log files at logsPath in a job's meta commit, and
+// sends the messages that were written by the job and match filter.
+func (a *apiServer) sendJobLogsPFS(pachClient *client.APIClient, jobInfo *pps.JobInfo, logsPath string, filter func(*pps.LogMessage) bool, apiGetLogsServer pps.API_GetLogsServer) error {
+	readErr := func(err error) error {
+		if pfsServer.IsFileNotFoundErr(err) {
+			return nil
+		}
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not read logs for job %s", jobInfo.Job.ID)
+	}
+	r, err := pachClient.GetFileTAR(ppsutil.MetaCommit(jobInfo.OutputCommit), logsPath)
+	if err != nil {
+		return readErr(err)
+	}
+	defer r.Close()
+	tr := tar.NewReader(r)
+	for {
+		if _, err := tr.Next(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return readErr(err)
+		}
+		scanner := bufio.NewScanner(tr)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			msg := &pps.LogMessage{}
+			if err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), msg); err != nil {
+				continue
+			}
+			// Datum logs are carried over in the meta commit for datums that
+			// were skipped, so only return the logs written by this job.
+			if msg.JobID != jobInfo.Job.ID || !filter(msg) {
+				continue
+			}
+			msg.Message = strings.TrimSuffix(msg.Message, "\n")
+			if err := apiGetLogsServer.Send(msg); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return readErr(err)
+		}
+	}
+}
+
+// TraceFile implements the protobuf pps.TraceFile RPC
+func (a *apiServer) TraceFile(request *pps.TraceFileRequest, server pps.API_TraceFileServer) (retErr error) {
+	ctx := server.Context()
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "TraceFile")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	if request.File == nil || request.File.Commit == nil {
+		return errors.Errorf("must specify the file to trace")
+	}
+	if request.Depth < 0 {
+		return errors.Errorf("depth must be non-negative")
+	}
+	// Resolve the file's commit, which may be given as a branch, since the
+	// trace follows commit IDs through the DAG.
+	pachClient := a.env.GetPachClient(ctx)
+	commit := request.File.Commit
+	commitInfo, err := pachClient.InspectCommit(commit.Branch.Repo.Name, commit.Branch.Name, commit.ID)
+	if err != nil {
+		return err
+	}
+	file := commitInfo.Commit.NewFile(request.File.Path)
+	tracer, err := newFileTracer(ctx, pachClient, a.pipelines, a.jobs)
+	if err != nil {
+		return err
+	}
+	return tracer.trace(file, request.Direction, request.Depth, func(lineage *pps.FileLineage) error {
+		return errors.EnsureStack(server.Send(lineage))
+	})
+}
+
 func contains(s string) string {
 	return fmt.Sprintf(" |= %q", s)
 }
@@ -1453,6 +1645,14 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.Spout != nil && request.Autoscaling {
 		return errors.Errorf("autoscaling can't be used with spouts (spouts aren't triggered externally)")
 	}
+	if request.DatumCache {
+		if request.S3Out || request.Service != nil || request.Spout != nil {
+			return errors.New("the datum cache is not supported with s3 output, spouts or services")
+		}
+		if request.ReprocessSpec == client.ReprocessSpecEveryJob {
+			return errors.Errorf("the datum cache can't be used with reprocess_spec '%s'", client.ReprocessSpecEveryJob)
+		}
+	}
 	return nil
 }
 
@@ -1500,7 +1700,11 @@ func (a *apiServer) validateSecret(ctx context.Context, req *pps.CreatePipelineR
 		if s.EnvVar != "" && s.Key == "" {
 			return errors.Errorf("secret %s has env_var set but is missing key", s.Name)
 		}
-		ss, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Get(ctx, s.Name, metav1.GetOptions{})
+		secrets, err := a.secrets()
+		if err != nil {
+			return err
+		}
+		ss, err := secrets.Get(ctx, s.Name, metav1.GetOptions{})
 		if err != nil {
 			if k8serrors.IsNotFound(err) {
 				return errors.Errorf("missing Kubernetes secret %s", s.Name)
@@ -1824,6 +2028,7 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 			Metadata:              request.Metadata,
 			ReprocessSpec:         request.ReprocessSpec,
 			Autoscaling:           request.Autoscaling,
+			DatumCache:            request.DatumCache,
 		},
 	}
 
@@ -1883,6 +2088,14 @@ func (a *apiServer) CreatePipelineInTransaction(
 			); err != nil {
 				return errors.EnsureStack(err)
 			}
+			// An input may be pinned to a commit or tag
+			if input.Pfs.Commit != "" {
+				if _, err := a.env.PFSServer.InspectCommitInTransaction(txnCtx, &pfs.InspectCommitRequest{
+					Commit: client.NewSystemRepo(input.Pfs.Repo, input.Pfs.RepoType).NewCommit(input.Pfs.Branch, input.Pfs.Commit),
+				}); err != nil {
+					return errors.Wrapf(err, "could not resolve commit %q of input %q", input.Pfs.Commit, input.Pfs.Name)
+				}
+			}
 		}
 		if input.Cron != nil {
 			if err := a.env.PFSServer.CreateRepoInTransaction(txnCtx,
@@ -2209,7 +2422,7 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string, details bo
 		info.Details = nil // preserve old behavior
 	} else {
 		kubeClient := a.env.KubeClient
-		if info.Details.Service != nil {
+		if info.Details.Service != nil && kubeClient != nil {
 			rcName := ppsutil.PipelineRcName(info.Pipeline.Name, info.Version)
 			service, err := kubeClient.CoreV1().Services(a.namespace).Get(ctx, fmt.Sprintf("%s-user", rcName), metav1.GetOptions{})
 			if err != nil {
@@ -2764,7 +2977,11 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	labels["secret-source"] = "pachyderm-user"
 	s.SetLabels(labels)
 
-	if _, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Create(ctx, &s, metav1.CreateOptions{}); err != nil {
+	secrets, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := secrets.Create(ctx, &s, metav1.CreateOptions{}); err != nil {
 		return nil, errors.Wrapf(err, "failed to create secret")
 	}
 	return &types.Empty{}, nil
@@ -2775,7 +2992,11 @@ func (a *apiServer) DeleteSecret(ctx context.Context, request *pps.DeleteSecretR
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "DeleteSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	if err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Delete(ctx, request.Secret.Name, metav1.DeleteOptions{}); err != nil {
+	secrets, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	if err := secrets.Delete(ctx, request.Secret.Name, metav1.DeleteOptions{}); err != nil {
 		return nil, errors.Wrapf(err, "failed to delete secret")
 	}
 	return &types.Empty{}, nil
@@ -2786,7 +3007,11 @@ func (a *apiServer) InspectSecret(ctx context.Context, request *pps.InspectSecre
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "InspectSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	secret, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Get(ctx, request.Secret.Name, metav1.GetOptions{})
+	secrets, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := secrets.Get(ctx, request.Secret.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get secret")
 	}
@@ -2808,7 +3033,11 @@ func (a *apiServer) ListSecret(ctx context.Context, in *types.Empty) (response *
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ListSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	secrets, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).List(ctx, metav1.ListOptions{
+	secretsClient, err := a.secrets()
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := secretsClient.List(ctx, metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	})
 	if err != nil {
@@ -2835,16 +3064,93 @@ func (a *apiServer) ListSecret(ctx context.Context, in *types.Empty) (response *
 	}, nil
 }
 
+// CreateNotifier implements the protobuf pps.CreateNotifier RPC
+func (a *apiServer) CreateNotifier(ctx context.Context, request *pps.CreateNotifierRequest) (response *types.Empty, retErr error) {
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreateNotifier")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	notifierInfo := &pps.NotifierInfo{
+		Notifier:       request.Notifier,
+		Url:            request.Url,
+		Secret:         request.Secret,
+		Pipelines:      request.Pipelines,
+		Repos:          request.Repos,
+		JobStates:      request.JobStates,
+		PipelineStates: request.PipelineStates,
+		CommitFinished: request.CommitFinished,
+		Created:        now(),
+	}
+	if err := validateNotifier(notifierInfo); err != nil {
+		return nil, err
+	}
+	name := notifierInfo.Notifier.Name
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *pachsql.Tx) error {
+		notifiers := a.notifiers.ReadWrite(sqlTx)
+		if request.Update {
+			return errors.EnsureStack(notifiers.Put(name, notifierInfo))
+		}
+		return errors.EnsureStack(notifiers.Create(name, notifierInfo))
+	}); err != nil {
+		if col.IsErrExists(err) {
+			return nil, errors.Errorf("notifier %q already exists", name)
+		}
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ListNotifier implements the protobuf pps.ListNotifier RPC
+func (a *apiServer) ListNotifier(ctx context.Context, in *types.Empty) (response *pps.NotifierInfos, retErr error) {
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ListNotifier")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	notifierInfos := []*pps.NotifierInfo{}
+	notifierInfo := &pps.NotifierInfo{}
+	if err := a.notifiers.ReadOnly(ctx).List(notifierInfo, col.DefaultOptions(), func(string) error {
+		info := proto.Clone(notifierInfo).(*pps.NotifierInfo)
+		// Notifier secrets are write-only.
+		info.Secret = ""
+		notifierInfos = append(notifierInfos, info)
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &pps.NotifierInfos{
+		NotifierInfo: notifierInfos,
+	}, nil
+}
+
+// DeleteNotifier implements the protobuf pps.DeleteNotifier RPC
+func (a *apiServer) DeleteNotifier(ctx context.Context, request *pps.DeleteNotifierRequest) (response *types.Empty, retErr error) {
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "DeleteNotifier")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	if request.Notifier == nil || request.Notifier.Name == "" {
+		return nil, errors.Errorf("notifier name must be set")
+	}
+	if err := dbutil.WithTx(ctx, a.env.DB, func(sqlTx *pachsql.Tx) error {
+		return errors.EnsureStack(a.notifiers.ReadWrite(sqlTx).Delete(request.Notifier.Name))
+	}); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("notifier %q not found", request.Notifier.Name)
+		}
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 // DeleteAll implements the protobuf pps.DeleteAll RPC
 func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
 	if _, err := a.DeletePipeline(ctx, &pps.DeletePipelineRequest{All: true, Force: true}); err != nil {
 		return nil, err
 	}
 
-	if err := a.env.KubeClient.CoreV1().Secrets(a.namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
-		LabelSelector: "secret-source=pachyderm-user",
-	}); err != nil {
-		return nil, errors.EnsureStack(err)
+	if a.env.KubeClient != nil {
+		if err := a.env.KubeClient.CoreV1().Secrets(a.namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+			LabelSelector: "secret-source=pachyderm-user",
+		}); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
 	}
 	return &types.Empty{}, nil
 }
@@ -3015,17 +3321,16 @@ func RepoNameToEnvString(repoName string) string {
 }
 
 func (a *apiServer) rcPods(ctx context.Context, rcName string) ([]v1.Pod, error) {
-	podList, err := a.env.KubeClient.CoreV1().Pods(a.namespace).List(ctx, metav1.ListOptions{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "ListOptions",
-			APIVersion: "v1",
-		},
-		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{"app": rcName})),
-	})
-	if err != nil {
-		return nil, errors.EnsureStack(err)
+	return a.infraDriver.ListPods(ctx, rcName)
+}
+
+// secrets returns the client for the kubernetes secrets in pachd's namespace,
+// or an error if pachd isn't running in kubernetes.
+func (a *apiServer) secrets() (corev1.SecretInterface, error) {
+	if a.env.KubeClient == nil {
+		return nil, errors.Errorf("secrets are not supported by the %q PPS infrastructure driver", a.env.Config.PPSInfraDriver)
 	}
-	return podList.Items, nil
+	return a.env.KubeClient.CoreV1().Secrets(a.namespace), nil
 }
 
 func (a *apiServer) resolveCommit(ctx context.Context, commit *pfs.Commit) (*pfs.CommitInfo, error) {
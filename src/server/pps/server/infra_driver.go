@@ -1,6 +1,7 @@
 package server
 
 import (
+	"io"
 	"strconv"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -29,6 +30,29 @@ type InfraDriver interface {
 	UpdateReplicationController(ctx context.Context, old *v1.ReplicationController, update func(rc *v1.ReplicationController) bool) error
 	ListReplicationControllers(ctx context.Context) (*v1.ReplicationControllerList, error)
 	WatchPipelinePods(ctx context.Context) (<-chan watch.Event, func(), error)
+	// ListPods returns the pods (or the stand-ins for them) managed by the RC
+	// with the given name.
+	ListPods(ctx context.Context, rcName string) ([]v1.Pod, error)
+	// GetPodLogs returns a stream of the logs of one of the pods returned by
+	// ListPods.
+	GetPodLogs(ctx context.Context, podName string, opts *v1.PodLogOptions) (io.ReadCloser, error)
+}
+
+const (
+	kubeInfraDriver  = "kubernetes"
+	localInfraDriver = "local"
+)
+
+// newInfraDriver returns the InfraDriver selected by the pachd configuration.
+func newInfraDriver(env Env) (InfraDriver, error) {
+	switch env.Config.PPSInfraDriver {
+	case "", kubeInfraDriver:
+		return newKubeDriver(env.KubeClient, env.Config, env.Logger), nil
+	case localInfraDriver:
+		return newLocalDriver(env.Config, env.Logger)
+	default:
+		return nil, errors.Errorf("unknown PPS infrastructure driver %q", env.Config.PPSInfraDriver)
+	}
 }
 
 type mockInfraOp int32
@@ -110,6 +134,14 @@ func (d *mockInfraDriver) WatchPipelinePods(ctx context.Context) (<-chan watch.E
 	return ch, func() {}, nil
 }
 
+func (d *mockInfraDriver) ListPods(ctx context.Context, rcName string) ([]v1.Pod, error) {
+	return nil, nil
+}
+
+func (d *mockInfraDriver) GetPodLogs(ctx context.Context, podName string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+	return nil, errors.Errorf("pod %q not found", podName)
+}
+
 ////////////////////////////////////
 // -------- Mock Helpers -------- //
 ////////////////////////////////////
@@ -2,7 +2,9 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"path"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client/limit"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -173,3 +175,22 @@ func (kd *kubeDriver) WatchPipelinePods(ctx context.Context) (<-chan watch.Event
 	}
 	return kubePipelineWatch.ResultChan(), kubePipelineWatch.Stop, nil
 }
+
+func (kd *kubeDriver) ListPods(ctx context.Context, rcName string) ([]v1.Pod, error) {
+	podList, err := kd.kubeClient.CoreV1().Pods(kd.namespace).List(ctx, metav1.ListOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ListOptions",
+			APIVersion: "v1",
+		},
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{"app": rcName})),
+	})
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return podList.Items, nil
+}
+
+func (kd *kubeDriver) GetPodLogs(ctx context.Context, podName string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+	stream, err := kd.kubeClient.CoreV1().Pods(kd.namespace).GetLogs(podName, opts).Timeout(10 * time.Second).Stream(ctx)
+	return stream, errors.EnsureStack(err)
+}
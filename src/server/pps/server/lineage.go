@@ -0,0 +1,288 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+)
+
+// fileTracer traces the lineage of files through the DAG, using the input
+// files and output files that the workers record in the meta file of each
+// datum.
+type fileTracer struct {
+	ctx        context.Context
+	pachClient *client.APIClient
+	jobs       col.PostgresCollection
+	// pipelines are the names of the current pipelines, sorted, and inputs
+	// are their inputs, indexed by name.
+	pipelines []string
+	inputs    map[string]*pps.Input
+	// datums caches the datums of the jobs reached by the trace, indexed by
+	// job key. Jobs that don't exist or didn't succeed have no datums.
+	datums map[string]*jobDatums
+}
+
+// jobDatums are the parts of the datum metas of a job that a trace uses.
+type jobDatums struct {
+	jobInfo *pps.JobInfo
+	metas   []*datum.Meta
+}
+
+// traceStep is a file reached by a trace, and the number of pipelines between
+// it and the traced file.
+type traceStep struct {
+	file  *pfs.File
+	depth int64
+}
+
+func newFileTracer(ctx context.Context, pachClient *client.APIClient, pipelines, jobs col.PostgresCollection) (*fileTracer, error) {
+	var names []string
+	inputs := make(map[string]*pps.Input)
+	if err := ppsutil.ListPipelineInfo(ctx, pipelines, nil, 0, func(pipelineInfo *pps.PipelineInfo) error {
+		names = append(names, pipelineInfo.Pipeline.Name)
+		if input := pipelineInfo.Details.GetInput(); input != nil {
+			inputs[pipelineInfo.Pipeline.Name] = proto.Clone(input).(*pps.Input)
+		} else {
+			inputs[pipelineInfo.Pipeline.Name] = nil
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return &fileTracer{
+		ctx:        ctx,
+		pachClient: pachClient,
+		jobs:       jobs,
+		pipelines:  names,
+		inputs:     inputs,
+		datums:     make(map[string]*jobDatums),
+	}, nil
+}
+
+// trace calls cb with the lineage of each datum that file was derived from,
+// or that was derived from file, breadth first. Files in commits that weren't
+// output by a successful job, such as files in input repos, end the trace.
+// Downstream, only the jobs in the file's commit set are traced.
+func (t *fileTracer) trace(file *pfs.File, direction pps.TraceDirection, maxDepth int64, cb func(*pps.FileLineage) error) error {
+	steps := []traceStep{{file: file, depth: 1}}
+	visitedFiles := map[string]bool{fileLineageKey(file): true}
+	visitedDatums := make(map[string]bool)
+	for len(steps) > 0 {
+		step := steps[0]
+		steps = steps[1:]
+		var lineages []*pps.FileLineage
+		var err error
+		if direction == pps.TraceDirection_DOWNSTREAM {
+			lineages, err = t.downstream(step.file)
+		} else {
+			lineages, err = t.upstream(step.file)
+		}
+		if err != nil {
+			return err
+		}
+		for _, lineage := range lineages {
+			// A datum may be reached through several of its files, but it's
+			// only returned once.
+			datumKey := ppsdb.JobKey(lineage.Job) + "/" + lineage.DatumID
+			if visitedDatums[datumKey] {
+				continue
+			}
+			visitedDatums[datumKey] = true
+			lineage.Depth = step.depth
+			if err := cb(lineage); err != nil {
+				return err
+			}
+			if maxDepth > 0 && step.depth >= maxDepth {
+				continue
+			}
+			next := lineage.Inputs
+			if direction == pps.TraceDirection_DOWNSTREAM {
+				next = lineage.Outputs
+			}
+			for _, f := range next {
+				if key := fileLineageKey(f); !visitedFiles[key] {
+					visitedFiles[key] = true
+					steps = append(steps, traceStep{file: f, depth: step.depth + 1})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// upstream returns the lineage of the datums that wrote file, if file is in
+// the output of a pipeline.
+func (t *fileTracer) upstream(file *pfs.File) ([]*pps.FileLineage, error) {
+	repo := file.Commit.Branch.Repo
+	if repo.Type != pfs.UserRepoType {
+		return nil, nil
+	}
+	if _, ok := t.inputs[repo.Name]; !ok {
+		return nil, nil
+	}
+	var lineages []*pps.FileLineage
+	if err := t.iterateDatums(client.NewJob(repo.Name, file.Commit.ID), func(jobInfo *pps.JobInfo, meta *datum.Meta) error {
+		var outputs []*pfs.File
+		for _, output := range meta.OutputFiles {
+			if pathsOverlap(output, file.Path) {
+				outputs = append(outputs, jobInfo.OutputCommit.NewFile(output))
+			}
+		}
+		if len(outputs) > 0 {
+			lineages = append(lineages, &pps.FileLineage{
+				Job:     meta.Job,
+				DatumID: common.DatumID(meta.Inputs),
+				Inputs:  datumInputFiles(meta, nil),
+				Outputs: outputs,
+			})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return lineages, nil
+}
+
+// downstream returns the lineage of the datums that read file, in the jobs
+// of the pipelines that take file's repo as an input.
+func (t *fileTracer) downstream(file *pfs.File) ([]*pps.FileLineage, error) {
+	repo := file.Commit.Branch.Repo
+	if repo.Type != pfs.UserRepoType {
+		return nil, nil
+	}
+	var lineages []*pps.FileLineage
+	for _, pipeline := range t.pipelines {
+		if !readsRepo(t.inputs[pipeline], repo.Name) {
+			continue
+		}
+		if err := t.iterateDatums(client.NewJob(pipeline, file.Commit.ID), func(jobInfo *pps.JobInfo, meta *datum.Meta) error {
+			inputs := datumInputFiles(meta, func(input *pfs.File) bool {
+				return input.Commit.Branch.Repo.Name == repo.Name && pathsOverlap(input.Path, file.Path)
+			})
+			if len(inputs) > 0 {
+				var outputs []*pfs.File
+				for _, output := range meta.OutputFiles {
+					outputs = append(outputs, jobInfo.OutputCommit.NewFile(output))
+				}
+				lineages = append(lineages, &pps.FileLineage{
+					Job:     meta.Job,
+					DatumID: common.DatumID(meta.Inputs),
+					Inputs:  inputs,
+					Outputs: outputs,
+				})
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return lineages, nil
+}
+
+// iterateDatums calls cb with the meta of each datum in the output of job,
+// if the job exists and succeeded. The datums include the datums that the job
+// skipped, whose metas name the job that processed them.
+func (t *fileTracer) iterateDatums(job *pps.Job, cb func(*pps.JobInfo, *datum.Meta) error) error {
+	jd, err := t.jobDatums(job)
+	if err != nil || jd == nil {
+		return err
+	}
+	for _, meta := range jd.metas {
+		if err := cb(jd.jobInfo, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jobDatums returns the datums of job, reading them from the job's meta
+// commit the first time the trace reaches the job.
+func (t *fileTracer) jobDatums(job *pps.Job) (*jobDatums, error) {
+	key := ppsdb.JobKey(job)
+	if jd, ok := t.datums[key]; ok {
+		return jd, nil
+	}
+	jobInfo := &pps.JobInfo{}
+	if err := t.jobs.ReadOnly(t.ctx).Get(key, jobInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			t.datums[key] = nil
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	if jobInfo.State != pps.JobState_JOB_SUCCESS {
+		t.datums[key] = nil
+		return nil, nil
+	}
+	jd := &jobDatums{jobInfo: jobInfo}
+	dit := datum.NewCommitIterator(t.pachClient, ppsutil.MetaCommit(jobInfo.OutputCommit))
+	if err := dit.Iterate(func(meta *datum.Meta) error {
+		jd.metas = append(jd.metas, &datum.Meta{
+			Job:         meta.Job,
+			Inputs:      meta.Inputs,
+			OutputFiles: meta.OutputFiles,
+		})
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	t.datums[key] = jd
+	return jd, nil
+}
+
+// datumInputFiles returns the input files of a datum for which filter returns
+// true, or all of them if filter is nil.
+func datumInputFiles(meta *datum.Meta, filter func(*pfs.File) bool) []*pfs.File {
+	var files []*pfs.File
+	for _, input := range meta.Inputs {
+		if input.FileInfo == nil || input.FileInfo.File == nil {
+			continue
+		}
+		if filter == nil || filter(input.FileInfo.File) {
+			files = append(files, proto.Clone(input.FileInfo.File).(*pfs.File))
+		}
+	}
+	return files
+}
+
+// readsRepo returns true if one of a pipeline's PFS inputs is the given repo.
+func readsRepo(pipelineInput *pps.Input, repo string) bool {
+	var found bool
+	pps.VisitInput(pipelineInput, func(input *pps.Input) error {
+		if input.Pfs != nil && input.Pfs.Repo == repo {
+			found = true
+		}
+		return nil
+	})
+	return found
+}
+
+// pathsOverlap returns true if the paths are the same, or one is a directory
+// containing the other. Inputs may be directories that matched the glob, and
+// outputs may be symlinks to directories.
+func pathsOverlap(p1, p2 string) bool {
+	p1, p2 = path.Join("/", p1), path.Join("/", p2)
+	if p1 == "/" || p2 == "/" || p1 == p2 {
+		return true
+	}
+	return strings.HasPrefix(p1, p2+"/") || strings.HasPrefix(p2, p1+"/")
+}
+
+func fileLineageKey(file *pfs.File) string {
+	return fmt.Sprintf("%s@%s:%s", file.Commit.Branch.Repo, file.Commit.ID, path.Join("/", file.Path))
+}
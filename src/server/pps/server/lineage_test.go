@@ -0,0 +1,25 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestPathsOverlap(t *testing.T) {
+	for _, test := range []struct {
+		p1, p2   string
+		expected bool
+	}{
+		{"/a", "/a", true},
+		{"a", "/a/", true},
+		{"/", "/a/b", true},
+		{"/a", "/a/b", true},
+		{"/a/b", "/a", true},
+		{"/a", "/ab", false},
+		{"/a/b", "/a/c", false},
+	} {
+		require.Equal(t, test.expected, pathsOverlap(test.p1, test.p2), "%s and %s", test.p1, test.p2)
+		require.Equal(t, test.expected, pathsOverlap(test.p2, test.p1), "%s and %s", test.p2, test.p1)
+	}
+}
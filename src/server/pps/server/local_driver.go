@@ -0,0 +1,710 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/version"
+	log "github.com/sirupsen/logrus"
+	logrus "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	// A crashed local worker is restarted after a backoff, which doubles up to
+	// a maximum, and is reset once the worker has run for a while (as with the
+	// containers of a pod).
+	localWorkerBackoff    = 10 * time.Second
+	localWorkerMaxBackoff = 5 * time.Minute
+	localWorkerResetTime  = 10 * time.Minute
+	// A pod watch is closed if its consumer falls this many events behind, and
+	// is then restarted by the consumer.
+	localWatchBufferSize = 100
+	// The number of lines of output kept for each local worker, which are
+	// returned by GetPodLogs.
+	localWorkerLogLines = 10000
+)
+
+// localDriver is an InfraDriver which runs pipeline workers as processes on
+// the same host as pachd, rather than as pods. Its replication controllers
+// only exist in memory, and each of their replicas is a worker process (which
+// runs the user code as its own child process). Workers that exit are
+// restarted, and the changes to their state are reported by WatchPipelinePods
+// as pod events.
+//
+// Workers find each other by IP, and all listen on the same port, so each
+// local worker gets its own loopback address.
+type localDriver struct {
+	config     serviceenv.Configuration
+	etcdPrefix string
+	logger     *logrus.Logger
+	binary     string
+	root       string
+
+	mu      sync.Mutex
+	rcs     map[string]*localRC // indexed by RC name
+	ips     map[string]bool     // worker IPs in use
+	watches map[chan watch.Event]bool
+}
+
+type localRC struct {
+	rc      *v1.ReplicationController
+	env     []string
+	workers []*localWorker
+}
+
+type localWorker struct {
+	lrc    *localRC
+	name   string
+	ip     string
+	dir    string
+	cancel context.CancelFunc
+	done   chan struct{}
+	logs   *localLogs
+	// phase and status are protected by localDriver.mu
+	phase  v1.PodPhase
+	status v1.ContainerStatus
+}
+
+func newLocalDriver(config serviceenv.Configuration, logger *logrus.Logger) (InfraDriver, error) {
+	if !localDriverSupported {
+		return nil, errors.New("the local PPS infrastructure driver is only supported on linux")
+	}
+	binary, err := exec.LookPath(config.LocalWorkerBinary)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find the worker binary %q", config.LocalWorkerBinary)
+	}
+	root := config.LocalWorkerRoot
+	if root == "" {
+		root = filepath.Join(os.TempDir(), "pachyderm-workers")
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &localDriver{
+		config:     config,
+		etcdPrefix: path.Join(config.EtcdPrefix, config.PPSEtcdPrefix),
+		logger:     logger,
+		binary:     binary,
+		root:       root,
+		rcs:        make(map[string]*localRC),
+		ips:        make(map[string]bool),
+		watches:    make(map[chan watch.Event]bool),
+	}, nil
+}
+
+// Creates a pipeline's replication controller, with no workers.
+func (ld *localDriver) CreatePipelineResources(ctx context.Context, pi *pps.PipelineInfo) error {
+	log.Infof("PPS master: creating local resources for pipeline %q", pi.Pipeline.Name)
+	env, err := ld.workerEnv(pi)
+	if err != nil {
+		// these errors indicate invalid pipelineInfo, don't retry
+		return stepError{
+			error:        errors.Wrap(err, "could not generate worker options"),
+			failPipeline: true,
+		}
+	}
+	rcName := ppsutil.PipelineRcName(pi.Pipeline.Name, pi.Version)
+	rcLabels := labels(rcName)
+	rcLabels[pipelineNameLabel] = pi.Pipeline.Name
+	annotations := map[string]string{
+		pipelineNameLabel:            pi.Pipeline.Name,
+		pachVersionAnnotation:        version.PrettyVersion(),
+		pipelineVersionAnnotation:    strconv.FormatUint(pi.Version, 10),
+		pipelineSpecCommitAnnotation: pi.SpecCommit.ID,
+		hashedAuthTokenAnnotation:    hashAuthToken(pi.AuthToken),
+	}
+	if metadata := pi.Details.GetMetadata(); metadata != nil {
+		for k, v := range metadata.Annotations {
+			if annotations[k] == "" {
+				annotations[k] = v
+			}
+		}
+		for k, v := range metadata.Labels {
+			if rcLabels[k] == "" {
+				rcLabels[k] = v
+			}
+		}
+	}
+	replicas := int32(0) // pipelines start w/ 0 workers & are scaled up
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	if _, ok := ld.rcs[rcName]; ok {
+		return nil
+	}
+	ld.rcs[rcName] = &localRC{
+		rc: &v1.ReplicationController{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ReplicationController",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        rcName,
+				Labels:      rcLabels,
+				Annotations: annotations,
+			},
+			Spec: v1.ReplicationControllerSpec{
+				Selector: rcLabels,
+				Replicas: &replicas,
+			},
+		},
+		env: env,
+	}
+	return nil
+}
+
+// Deletes a pipeline's replication controllers, and stops their workers.
+func (ld *localDriver) DeletePipelineResources(ctx context.Context, pipeline string) error {
+	log.Infof("PPS master: deleting local resources for pipeline %q", pipeline)
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	for name, lrc := range ld.rcs {
+		if lrc.rc.Labels[pipelineNameLabel] == pipeline {
+			ld.scaleLocked(lrc, 0)
+			delete(ld.rcs, name)
+		}
+	}
+	return nil
+}
+
+func (ld *localDriver) ReadReplicationController(ctx context.Context, pi *pps.PipelineInfo) (*v1.ReplicationControllerList, error) {
+	return ld.listRCs(func(rc *v1.ReplicationController) bool {
+		return rc.Labels[pipelineNameLabel] == pi.Pipeline.Name
+	}), nil
+}
+
+// UpdateReplicationController starts or stops workers to match the updated
+// number of replicas.
+func (ld *localDriver) UpdateReplicationController(ctx context.Context, old *v1.ReplicationController, update func(rc *v1.ReplicationController) bool) error {
+	rc := old.DeepCopy()
+	if !update(rc) {
+		return nil
+	}
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	lrc, ok := ld.rcs[rc.Name]
+	if !ok {
+		return newRetriableError(errors.Errorf("RC %q not found", rc.Name), "error updating RC")
+	}
+	lrc.rc = rc
+	var replicas int
+	if rc.Spec.Replicas != nil {
+		replicas = int(*rc.Spec.Replicas)
+	}
+	ld.scaleLocked(lrc, replicas)
+	return nil
+}
+
+func (ld *localDriver) ListReplicationControllers(ctx context.Context) (*v1.ReplicationControllerList, error) {
+	return ld.listRCs(func(*v1.ReplicationController) bool { return true }), nil
+}
+
+// WatchPipelinePods returns the events for the local workers, starting with an
+// added event for each of the current ones.
+func (ld *localDriver) WatchPipelinePods(ctx context.Context) (<-chan watch.Event, func(), error) {
+	ch := make(chan watch.Event, localWatchBufferSize)
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	ld.watches[ch] = true
+	for _, lrc := range ld.rcs {
+		for _, w := range lrc.workers {
+			ld.sendLocked(ch, watch.Event{Type: watch.Added, Object: w.podLocked()})
+		}
+	}
+	return ch, func() {
+		ld.mu.Lock()
+		defer ld.mu.Unlock()
+		if ld.watches[ch] {
+			delete(ld.watches, ch)
+			close(ch)
+		}
+	}, nil
+}
+
+// ListPods returns the pods which the workers of the given RC stand in for.
+func (ld *localDriver) ListPods(ctx context.Context, rcName string) ([]v1.Pod, error) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	var pods []v1.Pod
+	for _, lrc := range ld.rcs {
+		if lrc.rc.Labels["app"] != rcName {
+			continue
+		}
+		for _, w := range lrc.workers {
+			pods = append(pods, *w.podLocked())
+		}
+	}
+	return pods, nil
+}
+
+// GetPodLogs returns the output of a local worker, which is kept in memory.
+// Only the TailLines, SinceSeconds and Follow options are supported.
+func (ld *localDriver) GetPodLogs(ctx context.Context, podName string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+	w := ld.findWorker(podName)
+	if w == nil {
+		return nil, errors.Errorf("local worker %q not found", podName)
+	}
+	var since time.Time
+	if opts.SinceSeconds != nil && *opts.SinceSeconds > 0 {
+		since = time.Now().Add(-time.Duration(*opts.SinceSeconds) * time.Second)
+	}
+	from := w.logs.start(since, opts.TailLines)
+	ctx, cancel := context.WithCancel(ctx)
+	r, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(w.logs.copy(ctx, pw, from, opts.Follow, w.done))
+	}()
+	return &localLogStream{PipeReader: r, cancel: cancel}, nil
+}
+
+func (ld *localDriver) findWorker(name string) *localWorker {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	for _, lrc := range ld.rcs {
+		for _, w := range lrc.workers {
+			if w.name == name {
+				return w
+			}
+		}
+	}
+	return nil
+}
+
+func (ld *localDriver) listRCs(filter func(*v1.ReplicationController) bool) *v1.ReplicationControllerList {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	rcs := &v1.ReplicationControllerList{}
+	for _, lrc := range ld.rcs {
+		if filter(lrc.rc) {
+			rcs.Items = append(rcs.Items, *lrc.rc.DeepCopy())
+		}
+	}
+	sort.Slice(rcs.Items, func(i, j int) bool {
+		return rcs.Items[i].Name < rcs.Items[j].Name
+	})
+	return rcs
+}
+
+// localWorkerHostEnv lists the variables in pachd's environment which are
+// passed on to local workers. Nothing else is, so that pachd's credentials and
+// configuration don't leak into user code.
+var localWorkerHostEnv = []string{
+	"PATH",
+	"HOME",
+	"USER",
+	"TMPDIR",
+	"LANG",
+	"LC_ALL",
+	"TZ",
+	"JAEGER_ENDPOINT",
+}
+
+// workerEnv returns the environment shared by the workers of a pipeline. The
+// workers talk to the same etcd and postgres as pachd, and use pachd itself
+// in place of a storage sidecar.
+func (ld *localDriver) workerEnv(pi *pps.PipelineInfo) ([]string, error) {
+	details := pi.Details
+	if len(details.Transform.Secrets) > 0 {
+		return nil, errors.New("secrets are not supported by local workers")
+	}
+	if ppsutil.ContainsS3Inputs(details.Input) || details.S3Out {
+		return nil, errors.New("s3 inputs and outputs are not supported by local workers")
+	}
+	var env []string
+	for _, name := range localWorkerHostEnv {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	for name, value := range details.Transform.Env {
+		env = append(env, name+"="+value)
+	}
+	c := ld.config
+	env = append(env,
+		"PACH_ROOT="+c.StorageRoot,
+		"PACH_NAMESPACE="+c.Namespace,
+		"STORAGE_BACKEND="+c.StorageBackend,
+		"ETCD_SERVICE_HOST="+c.EtcdHost,
+		"ETCD_SERVICE_PORT="+c.EtcdPort,
+		"POSTGRES_USER="+c.PostgresUser,
+		"POSTGRES_PASSWORD="+c.PostgresPassword,
+		"POSTGRES_DATABASE="+c.PostgresDBName,
+		"POSTGRES_HOST="+c.PostgresHost,
+		"POSTGRES_PORT="+strconv.Itoa(c.PostgresPort),
+		"POSTGRES_SSL="+c.PostgresSSL,
+		"PG_BOUNCER_HOST="+c.PGBouncerHost,
+		"PG_BOUNCER_PORT="+strconv.Itoa(c.PGBouncerPort),
+		"TASK_SERVICE_BACKEND="+c.TaskServiceBackend,
+		client.PeerPortEnv+"="+strconv.FormatUint(uint64(c.PeerPort), 10),
+		client.PPSSpecCommitEnv+"="+pi.SpecCommit.ID,
+		client.PPSPipelineNameEnv+"="+pi.Pipeline.Name,
+		client.PPSEtcdPrefixEnv+"="+ld.etcdPrefix,
+		client.PPSWorkerPortEnv+"="+strconv.FormatUint(uint64(c.PPSWorkerPort), 10),
+		"PACH_IN_WORKER=true",
+		"PPS_WORKER_LOCAL=true",
+	)
+	// Propagate feature flags to workers
+	if c.DisableCommitProgressCounter {
+		env = append(env, "DISABLE_COMMIT_PROGRESS_COUNTER=true")
+	}
+	if c.LokiLogging {
+		env = append(env, "LOKI_LOGGING=true")
+	}
+	if p := c.GoogleCloudProfilerProject; p != "" {
+		env = append(env, "GOOGLE_CLOUD_PROFILER_PROJECT="+p)
+	}
+	return env, nil
+}
+
+// scaleLocked starts or stops workers until lrc has the given number of them.
+// Stopped workers clean up after themselves in the background.
+func (ld *localDriver) scaleLocked(lrc *localRC, replicas int) {
+	for len(lrc.workers) < replicas {
+		lrc.workers = append(lrc.workers, ld.startWorkerLocked(lrc))
+	}
+	for len(lrc.workers) > replicas {
+		lrc.workers[len(lrc.workers)-1].cancel()
+		lrc.workers = lrc.workers[:len(lrc.workers)-1]
+	}
+}
+
+func (ld *localDriver) startWorkerLocked(lrc *localRC) *localWorker {
+	name := fmt.Sprintf("%s-%s", lrc.rc.Name, uuid.NewWithoutDashes()[:5])
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &localWorker{
+		lrc:    lrc,
+		name:   name,
+		ip:     ld.allocateIPLocked(),
+		dir:    filepath.Join(ld.root, name),
+		cancel: cancel,
+		done:   make(chan struct{}),
+		logs:   newLocalLogs(localWorkerLogLines),
+		phase:  v1.PodPending,
+		status: v1.ContainerStatus{
+			Name: client.PPSWorkerUserContainerName,
+			State: v1.ContainerState{
+				Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"},
+			},
+		},
+	}
+	env := append(lrc.env[:len(lrc.env):len(lrc.env)],
+		client.PPSWorkerIPEnv+"="+w.ip,
+		client.PPSPodNameEnv+"="+w.name,
+		"PPS_WORKER_ROOT="+w.dir,
+	)
+	ld.publishLocked(watch.Added, w)
+	go ld.runWorker(ctx, w, env)
+	return w
+}
+
+// allocateIPLocked returns an unused loopback address for a worker. Addresses
+// outside of 127.0.0.0/24 are used, so they don't collide with other services
+// on the host.
+func (ld *localDriver) allocateIPLocked() string {
+	for n := 1; ; n++ {
+		if byte(n) == 0 || byte(n) == 255 {
+			continue
+		}
+		ip := net.IPv4(127, byte(1+n>>16), byte(n>>8), byte(n)).String()
+		if !ld.ips[ip] {
+			ld.ips[ip] = true
+			return ip
+		}
+	}
+}
+
+// runWorker runs a worker process, and restarts it whenever it exits, until
+// ctx is cancelled.
+func (ld *localDriver) runWorker(ctx context.Context, w *localWorker, env []string) {
+	defer close(w.done)
+	defer func() {
+		if err := os.RemoveAll(w.dir); err != nil {
+			log.Errorf("PPS master: could not remove the directory of local worker %q: %v", w.name, err)
+		}
+		ld.mu.Lock()
+		defer ld.mu.Unlock()
+		delete(ld.ips, w.ip)
+		ld.publishLocked(watch.Deleted, w)
+	}()
+	logger := ld.logger.WithFields(logrus.Fields{
+		"pipelineName": w.lrc.rc.Labels[pipelineNameLabel],
+		"pod":          w.name,
+	})
+	backoff := localWorkerBackoff
+	for {
+		started := time.Now()
+		startErr, exitErr := ld.runWorkerProcess(ctx, w, env, logger)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) > localWorkerResetTime {
+			backoff = localWorkerBackoff
+		}
+		ld.mu.Lock()
+		w.phase = v1.PodPending
+		w.status.Ready = false
+		if startErr != nil {
+			logger.Errorf("could not start local worker: %v", startErr)
+			w.status.State = v1.ContainerState{
+				Waiting: &v1.ContainerStateWaiting{
+					Reason:  "CreateContainerError",
+					Message: startErr.Error(),
+				},
+			}
+		} else {
+			logger.Errorf("local worker exited: %v", exitErr)
+			terminated := &v1.ContainerStateTerminated{
+				Reason:     "Error",
+				StartedAt:  metav1.NewTime(started),
+				FinishedAt: metav1.Now(),
+			}
+			var exitCodeErr *exec.ExitError
+			if errors.As(exitErr, &exitCodeErr) {
+				terminated.ExitCode = int32(exitCodeErr.ExitCode())
+			}
+			w.status.LastTerminationState = v1.ContainerState{Terminated: terminated}
+			w.status.RestartCount++
+			w.status.State = v1.ContainerState{
+				Waiting: &v1.ContainerStateWaiting{
+					Reason:  "CrashLoopBackOff",
+					Message: fmt.Sprintf("back-off %v restarting failed worker", backoff),
+				},
+			}
+		}
+		ld.publishLocked(watch.Modified, w)
+		ld.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > localWorkerMaxBackoff {
+			backoff = localWorkerMaxBackoff
+		}
+	}
+}
+
+// runWorkerProcess runs a worker process until it exits or ctx is cancelled.
+// It returns an error if the process couldn't be started, or else the error
+// it exited with.
+func (ld *localDriver) runWorkerProcess(ctx context.Context, w *localWorker, env []string, logger *logrus.Entry) (startErr, exitErr error) {
+	if err := os.MkdirAll(w.dir, 0755); err != nil {
+		return errors.EnsureStack(err), nil
+	}
+	// The output is read from a pipe, rather than given to exec as a writer,
+	// so that waiting for the worker doesn't also wait for any user code which
+	// outlives it.
+	r, pw, err := os.Pipe()
+	if err != nil {
+		return errors.EnsureStack(err), nil
+	}
+	cmd := exec.Command(ld.binary)
+	cmd.Dir = w.dir
+	cmd.Env = env
+	cmd.Stdout = pw
+	cmd.Stderr = pw
+	setWorkerProcAttr(cmd)
+	err = cmd.Start()
+	pw.Close()
+	if err != nil {
+		r.Close()
+		return errors.EnsureStack(err), nil
+	}
+	go func() {
+		defer r.Close()
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			logger.Info(scanner.Text())
+			w.logs.append(scanner.Text())
+		}
+	}()
+	ld.mu.Lock()
+	w.phase = v1.PodRunning
+	w.status.Ready = true
+	w.status.State = v1.ContainerState{
+		Running: &v1.ContainerStateRunning{StartedAt: metav1.Now()},
+	}
+	ld.publishLocked(watch.Modified, w)
+	ld.mu.Unlock()
+	exited := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			if err := killWorkerProcess(cmd.Process); err != nil {
+				logger.Errorf("could not kill local worker: %v", err)
+			}
+		case <-exited:
+		}
+	}()
+	exitErr = errors.EnsureStack(cmd.Wait())
+	close(exited)
+	// Clean up any user code left behind by the worker.
+	if err := killWorkerProcess(cmd.Process); err != nil {
+		logger.Errorf("could not kill the processes of local worker: %v", err)
+	}
+	return nil, exitErr
+}
+
+// publishLocked sends an event for w to all of the pod watches.
+func (ld *localDriver) publishLocked(eventType watch.EventType, w *localWorker) {
+	event := watch.Event{Type: eventType, Object: w.podLocked()}
+	for ch := range ld.watches {
+		ld.sendLocked(ch, event)
+	}
+}
+
+// sendLocked sends an event to a pod watch, or closes the watch if its
+// consumer has fallen behind.
+func (ld *localDriver) sendLocked(ch chan watch.Event, event watch.Event) {
+	if !ld.watches[ch] {
+		return
+	}
+	select {
+	case ch <- event:
+	default:
+		delete(ld.watches, ch)
+		close(ch)
+	}
+}
+
+// podLocked returns the pod which w stands in for.
+func (w *localWorker) podLocked() *v1.Pod {
+	rc := w.lrc.rc
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        w.name,
+			Labels:      rc.Labels,
+			Annotations: rc.Annotations,
+		},
+		Status: v1.PodStatus{
+			Phase:             w.phase,
+			PodIP:             w.ip,
+			ContainerStatuses: []v1.ContainerStatus{*w.status.DeepCopy()},
+		},
+	}
+}
+
+// localLogs holds the most recent lines of output of a local worker.
+type localLogs struct {
+	max int
+
+	mu    sync.Mutex
+	lines []localLogLine
+	// next is the number of lines appended so far, and so the index of the
+	// next one. The first of lines has index next-len(lines).
+	next int
+	// updated is closed, and replaced, whenever a line is appended.
+	updated chan struct{}
+}
+
+type localLogLine struct {
+	time time.Time
+	text string
+}
+
+func newLocalLogs(max int) *localLogs {
+	return &localLogs{
+		max:     max,
+		updated: make(chan struct{}),
+	}
+}
+
+func (l *localLogs) append(text string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, localLogLine{time: time.Now(), text: text})
+	if len(l.lines) > l.max {
+		l.lines = append(l.lines[:0], l.lines[len(l.lines)-l.max:]...)
+	}
+	l.next++
+	close(l.updated)
+	l.updated = make(chan struct{})
+}
+
+// start returns the index of the first line which was appended after since,
+// and is at most tail lines from the end.
+func (l *localLogs) start(since time.Time, tail *int64) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	i := sort.Search(len(l.lines), func(i int) bool {
+		return !l.lines[i].time.Before(since)
+	})
+	if tail != nil && int64(len(l.lines)-i) > *tail {
+		i = len(l.lines) - int(*tail)
+	}
+	return l.next - len(l.lines) + i
+}
+
+// read returns the lines with index from or greater which are still held, the
+// index of the next line, and a channel which is closed when it's appended.
+func (l *localLogs) read(from int) ([]localLogLine, int, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	first := l.next - len(l.lines)
+	if from < first {
+		from = first
+	}
+	lines := make([]localLogLine, l.next-from)
+	copy(lines, l.lines[from-first:])
+	return lines, l.next, l.updated
+}
+
+// copy writes the lines from the given index to w. If follow is set, it then
+// keeps writing new lines until ctx is cancelled or the worker is done.
+func (l *localLogs) copy(ctx context.Context, w io.Writer, from int, follow bool, done <-chan struct{}) error {
+	for {
+		lines, next, updated := l.read(from)
+		for _, line := range lines {
+			if _, err := io.WriteString(w, line.text+"\n"); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		from = next
+		if !follow {
+			return nil
+		}
+		select {
+		case <-updated:
+		case <-done:
+			follow = false
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}
+}
+
+// localLogStream is the stream returned by GetPodLogs, which stops copying the
+// logs once it's closed.
+type localLogStream struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (s *localLogStream) Close() error {
+	s.cancel()
+	return errors.EnsureStack(s.PipeReader.Close())
+}
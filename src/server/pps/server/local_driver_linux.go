@@ -0,0 +1,30 @@
+// +build linux
+
+package server
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+const localDriverSupported = true
+
+// setWorkerProcAttr puts a local worker in its own process group, along with
+// the user code it runs, and kills it if pachd exits.
+func setWorkerProcAttr(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}
+}
+
+// killWorkerProcess kills the process group of a local worker.
+func killWorkerProcess(p *os.Process) error {
+	if err := syscall.Kill(-p.Pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+// +build !linux
+
+package server
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Note: these are stubs only meant for builds - the local driver only runs
+// workers on linux
+
+const localDriverSupported = false
+
+func setWorkerProcAttr(cmd *exec.Cmd) {}
+
+func killWorkerProcess(p *os.Process) error {
+	return nil
+}
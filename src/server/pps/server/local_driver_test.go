@@ -0,0 +1,208 @@
+// +build linux
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	logrus "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// newTestLocalDriver returns a local driver whose worker binary is a script,
+// which records the worker's IP in its directory, prints $HOST_SECRET, and
+// then either sleeps or exits with an error if $CRASH is set.
+func newTestLocalDriver(t *testing.T) *localDriver {
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "worker")
+	require.NoError(t, ioutil.WriteFile(binary, []byte(`#!/bin/sh
+echo "$PPS_WORKER_IP" > ip
+echo "secret=$HOST_SECRET"
+if [ -n "$CRASH" ]; then
+	exit 3
+fi
+exec sleep 1000
+`), 0755))
+	config := serviceenv.Configuration{
+		GlobalConfiguration: &serviceenv.GlobalConfiguration{},
+		PachdSpecificConfiguration: &serviceenv.PachdSpecificConfiguration{
+			LocalWorkerBinary: binary,
+			LocalWorkerRoot:   filepath.Join(dir, "workers"),
+		},
+	}
+	ld, err := newLocalDriver(config, logrus.New())
+	require.NoError(t, err)
+	return ld.(*localDriver)
+}
+
+func testPipelineInfo(name string, env map[string]string) *pps.PipelineInfo {
+	return &pps.PipelineInfo{
+		Pipeline:   client.NewPipeline(name),
+		Version:    1,
+		SpecCommit: client.NewSystemRepo(name, "spec").NewCommit("master", "0123456789abcdef0123456789abcdef"),
+		Details: &pps.PipelineInfo_Details{
+			Transform: &pps.Transform{
+				Cmd: []string{"true"},
+				Env: env,
+			},
+		},
+	}
+}
+
+func scaleLocalRC(t *testing.T, ld *localDriver, pi *pps.PipelineInfo, replicas int32) {
+	rcs, err := ld.ReadReplicationController(context.Background(), pi)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	require.NoError(t, ld.UpdateReplicationController(context.Background(), &rcs.Items[0], func(rc *v1.ReplicationController) bool {
+		rc.Spec.Replicas = &replicas
+		return true
+	}))
+}
+
+// nextPodEvent returns the next event of the given type from the pod watch.
+func nextPodEvent(t *testing.T, events <-chan watch.Event, eventType watch.EventType, cond func(*v1.Pod) bool) *v1.Pod {
+	timeout := time.After(30 * time.Second)
+	for {
+		select {
+		case event, ok := <-events:
+			require.True(t, ok, "pod watch closed")
+			pod := event.Object.(*v1.Pod)
+			if event.Type == eventType && (cond == nil || cond(pod)) {
+				return pod
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for a %v pod event", eventType)
+		}
+	}
+}
+
+func TestLocalDriverScaling(t *testing.T) {
+	ld := newTestLocalDriver(t)
+	events, stop, err := ld.WatchPipelinePods(context.Background())
+	require.NoError(t, err)
+	defer stop()
+
+	pi := testPipelineInfo("pipeline", nil)
+	require.NoError(t, ld.CreatePipelineResources(context.Background(), pi))
+	rcs, err := ld.ListReplicationControllers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	require.Equal(t, int32(0), *rcs.Items[0].Spec.Replicas)
+	require.Equal(t, "pipeline", rcs.Items[0].Labels[pipelineNameLabel])
+	require.True(t, rcIsFresh(pi, &rcs.Items[0]))
+
+	// Each worker runs in its own directory, with its own IP.
+	scaleLocalRC(t, ld, pi, 2)
+	running := func(pod *v1.Pod) bool { return pod.Status.Phase == v1.PodRunning }
+	pods := []*v1.Pod{
+		nextPodEvent(t, events, watch.Modified, running),
+		nextPodEvent(t, events, watch.Modified, running),
+	}
+	require.NotEqual(t, pods[0].Status.PodIP, pods[1].Status.PodIP)
+	for _, pod := range pods {
+		require.Equal(t, "pipeline", pod.Annotations[pipelineNameLabel])
+		require.NoError(t, backoffUntilFile(filepath.Join(ld.root, pod.Name, "ip"), pod.Status.PodIP+"\n"))
+	}
+
+	scaleLocalRC(t, ld, pi, 0)
+	nextPodEvent(t, events, watch.Deleted, nil)
+	nextPodEvent(t, events, watch.Deleted, nil)
+	for _, pod := range pods {
+		_, err := os.Stat(filepath.Join(ld.root, pod.Name))
+		require.True(t, os.IsNotExist(err))
+	}
+	require.NoError(t, ld.DeletePipelineResources(context.Background(), "pipeline"))
+	rcs, err = ld.ListReplicationControllers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rcs.Items))
+}
+
+func TestLocalDriverCrash(t *testing.T) {
+	ld := newTestLocalDriver(t)
+	events, stop, err := ld.WatchPipelinePods(context.Background())
+	require.NoError(t, err)
+	defer stop()
+
+	// Workers that exit are reported as crash looping, and restarted.
+	pi := testPipelineInfo("crash", map[string]string{"CRASH": "1"})
+	require.NoError(t, ld.CreatePipelineResources(context.Background(), pi))
+	scaleLocalRC(t, ld, pi, 1)
+	pod := nextPodEvent(t, events, watch.Modified, func(pod *v1.Pod) bool {
+		return pod.Status.ContainerStatuses[0].State.Waiting != nil &&
+			pod.Status.ContainerStatuses[0].State.Waiting.Reason == "CrashLoopBackOff"
+	})
+	status := pod.Status.ContainerStatuses[0]
+	require.Equal(t, int32(1), status.RestartCount)
+	require.Equal(t, int32(3), status.LastTerminationState.Terminated.ExitCode)
+
+	require.NoError(t, ld.DeletePipelineResources(context.Background(), "crash"))
+	require.Equal(t, pod.Name, nextPodEvent(t, events, watch.Deleted, nil).Name)
+
+	// Pipelines which need kubernetes fail.
+	pi = testPipelineInfo("secret", nil)
+	pi.Details.Transform.Secrets = []*pps.SecretMount{{Name: "secret", EnvVar: "SECRET"}}
+	err = ld.CreatePipelineResources(context.Background(), pi)
+	require.YesError(t, err)
+	var stepErr stepError
+	require.True(t, errors.As(err, &stepErr))
+	require.True(t, stepErr.failPipeline)
+}
+
+func TestLocalDriverLogs(t *testing.T) {
+	// Only the allowlisted parts of pachd's environment are passed on.
+	t.Setenv("HOST_SECRET", "hunter2")
+	ld := newTestLocalDriver(t)
+	events, stop, err := ld.WatchPipelinePods(context.Background())
+	require.NoError(t, err)
+	defer stop()
+
+	pi := testPipelineInfo("logs", nil)
+	require.NoError(t, ld.CreatePipelineResources(context.Background(), pi))
+	scaleLocalRC(t, ld, pi, 1)
+	nextPodEvent(t, events, watch.Modified, func(pod *v1.Pod) bool { return pod.Status.Phase == v1.PodRunning })
+	pods, err := ld.ListPods(context.Background(), ppsutil.PipelineRcName("logs", 1))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pods))
+
+	require.NoError(t, backoff.Retry(func() error {
+		stream, err := ld.GetPodLogs(context.Background(), pods[0].Name, &v1.PodLogOptions{})
+		if err != nil {
+			return err
+		}
+		defer stream.Close()
+		logs, err := ioutil.ReadAll(stream)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if string(logs) != "secret=\n" {
+			return errors.Errorf("unexpected logs %q", logs)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+	require.NoError(t, ld.DeletePipelineResources(context.Background(), "logs"))
+}
+
+func backoffUntilFile(path, content string) error {
+	var data []byte
+	for i := 0; i < 100; i++ {
+		var err error
+		if data, err = ioutil.ReadFile(path); err == nil && string(data) == content {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return errors.Errorf("%s contains %q, not %q", path, data, content)
+}
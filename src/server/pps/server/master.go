@@ -84,6 +84,10 @@ type ppsMaster struct {
 	eventCh         chan *pipelineEvent
 	scaleUpInterval time.Duration
 	crashingBackoff time.Duration
+
+	// notifications is nil if the master doesn't dispatch notifications
+	notifications       *notificationDispatcher
+	notificationsCancel func() // protected by pollPipelinesMu
 }
 
 func newMaster(ctx context.Context, env Env, etcdPrefix string, kd InfraDriver, sd PipelineStateDriver) *ppsMaster {
@@ -103,6 +107,9 @@ func newMaster(ctx context.Context, env Env, etcdPrefix string, kd InfraDriver,
 // pipelines are created/removed.
 func (a *apiServer) master() {
 	masterLock := dlock.NewDLock(a.env.EtcdClient, path.Join(a.etcdPrefix, masterLockPath))
+	// The infrastructure driver outlives each run of the master, since the local
+	// driver owns the workers it starts.
+	kd := a.infraDriver
 	backoff.RetryNotify(func() error {
 		ctx, cancel := context.WithCancel(context.Background())
 		// set internal auth for basic operations
@@ -114,9 +121,9 @@ func (a *apiServer) master() {
 		}
 		defer masterLock.Unlock(ctx)
 		log.Infof("PPS master: launching master process")
-		kd := newKubeDriver(a.env.KubeClient, a.env.Config, a.env.Logger)
 		sd := newPipelineStateDriver(a.env.DB, a.pipelines, a.txnEnv, a.env.PFSServer)
 		m := newMaster(ctx, a.env, a.etcdPrefix, kd, sd)
+		m.notifications = newNotificationDispatcher(a.env, a.notifiers, a.pipelines, a.jobs, sd)
 		m.run()
 		return errors.Wrapf(ctx.Err(), "ppsMaster.Run() exited unexpectedly")
 	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
@@ -161,6 +168,10 @@ func (m *ppsMaster) run() {
 	defer m.cancelPipelinePodsPoller()
 	m.startPipelineWatcher()
 	defer m.cancelPipelineWatcher()
+	if m.notifications != nil {
+		m.startNotificationDispatcher()
+		defer m.cancelNotificationDispatcher()
+	}
 
 eventLoop:
 	for {
@@ -0,0 +1,507 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/v2/src/client/limit"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// notificationSignatureHeader carries the HMAC-SHA256 of a notification's
+	// body, keyed by the notifier's secret, as "sha256=<hex digest>".
+	notificationSignatureHeader = "X-Pachyderm-Signature"
+	// notificationTypeHeader carries the notification's type.
+	notificationTypeHeader = "X-Pachyderm-Notification"
+
+	maxConcurrentNotifications = 16
+	notificationRequestTimeout = 30 * time.Second
+	notificationRetryTimeout   = 10 * time.Minute
+	// maxQueuedNotifications is the most notifications that are queued for a
+	// notifier. The oldest are dropped when a notifier falls further behind.
+	maxQueuedNotifications = 1000
+	// settledStateWindow is how long the state of a value that can't change
+	// anymore (e.g. a finished commit) is kept, to recognize updates to it
+	// which aren't state transitions. It covers the delay of watch events and
+	// the skew between pachd clocks.
+	settledStateWindow = 10 * time.Minute
+)
+
+func validateNotifier(info *pps.NotifierInfo) error {
+	if info.Notifier == nil || info.Notifier.Name == "" {
+		return errors.Errorf("notifier name must be set")
+	}
+	u, err := url.Parse(info.Url)
+	if err != nil {
+		return errors.Wrapf(err, "invalid notifier url %q", info.Url)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("notifier url %q must be an absolute http or https url", info.Url)
+	}
+	if len(info.JobStates) == 0 && len(info.PipelineStates) == 0 && !info.CommitFinished {
+		return errors.Errorf("notifier %q must be notified of at least one job state, pipeline state or finished commits", info.Notifier.Name)
+	}
+	return nil
+}
+
+// notifierMatches reports whether the notifier's filters match the
+// notification.
+func notifierMatches(info *pps.NotifierInfo, n *pps.Notification) bool {
+	var repo string
+	switch n.Type {
+	case pps.NotificationType_JOB_STATE_CHANGED:
+		if !containsJobState(info.JobStates, n.JobState) {
+			return false
+		}
+	case pps.NotificationType_PIPELINE_STATE_CHANGED:
+		if !containsPipelineState(info.PipelineStates, n.PipelineState) {
+			return false
+		}
+	case pps.NotificationType_COMMIT_FINISHED:
+		if !info.CommitFinished {
+			return false
+		}
+		repo = n.Commit.Branch.Repo.Name
+	default:
+		return false
+	}
+	if n.Pipeline != nil {
+		if len(info.Pipelines) > 0 && !containsString(info.Pipelines, n.Pipeline.Name) {
+			return false
+		}
+		repo = n.Pipeline.Name
+	}
+	return len(info.Repos) == 0 || containsString(info.Repos, repo)
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsJobState(states []pps.JobState, state pps.JobState) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPipelineState(states []pps.PipelineState, state pps.PipelineState) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// signNotification returns the signature of a notification body, in the form
+// sent in the notificationSignatureHeader.
+func signNotification(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// permanentNotificationError is returned when a notifier rejects a
+// notification in a way that retrying will not fix.
+type permanentNotificationError struct {
+	error
+}
+
+// notificationSource is a collection whose values' state transitions generate
+// notifications.
+type notificationSource struct {
+	name     string
+	col      col.PostgresCollection
+	template proto.Message
+	watch    func(ctx context.Context) (<-chan *watch.Event, func(), error)
+	// describe returns a value's state, when the value settled into that state
+	// if it's one that the value can't leave (or nil), and, if the value's
+	// state is one that notifications are sent for, the notification for it.
+	// Values which never generate notifications are settled at the epoch.
+	describe func(val proto.Message) (string, *types.Timestamp, *pps.Notification)
+}
+
+// notificationStates records the states of the values of a source which can
+// still generate notifications: those whose state can change, and those which
+// settled into their final state recently. The states of values that settled
+// before the watermark aren't kept, since they can't transition anymore.
+type notificationStates struct {
+	states    map[string]trackedState
+	watermark time.Time
+}
+
+type trackedState struct {
+	state   string
+	settled time.Time // zero if the state can change
+}
+
+func newNotificationStates(now time.Time) *notificationStates {
+	return &notificationStates{
+		states:    make(map[string]trackedState),
+		watermark: now.Add(-settledStateWindow),
+	}
+}
+
+// observe records the state of the value with the given key, and reports
+// whether it's a state transition.
+func (ns *notificationStates) observe(key, state string, settled *types.Timestamp) bool {
+	var settledTime time.Time
+	if settled != nil {
+		t, err := types.TimestampFromProto(settled)
+		if err == nil && !t.After(ns.watermark) {
+			delete(ns.states, key)
+			return false
+		}
+		settledTime = t
+	}
+	if prev, ok := ns.states[key]; ok && prev.state == state {
+		return false
+	}
+	ns.states[key] = trackedState{state: state, settled: settledTime}
+	return true
+}
+
+func (ns *notificationStates) remove(key string) {
+	delete(ns.states, key)
+}
+
+// prune advances the watermark to now, less the settled state window, and
+// forgets the states that settled before it.
+func (ns *notificationStates) prune(now time.Time) {
+	ns.watermark = now.Add(-settledStateWindow)
+	for key, ts := range ns.states {
+		if !ts.settled.IsZero() && !ts.settled.After(ns.watermark) {
+			delete(ns.states, key)
+		}
+	}
+}
+
+// notificationDispatcher watches pipelines, jobs and commits, and POSTs a
+// notification to every notifier whose filters match each state transition.
+// Delivery is best effort: failed deliveries are retried with backoff, but a
+// notification is dropped once its retries give up, when its notifier already
+// has too many notifications queued, or when this pachd stops being the PPS
+// master before delivering it.
+type notificationDispatcher struct {
+	notifiers  col.PostgresCollection
+	sources    []*notificationSource
+	client     *http.Client
+	limiter    limit.ConcurrencyLimiter
+	newBackOff func() backoff.BackOff
+
+	mu sync.Mutex
+	// queues holds the notifications waiting to be delivered to each notifier,
+	// indexed by notifier name. A notifier has a queue while a goroutine is
+	// delivering its notifications.
+	queues map[string][]*queuedNotification
+}
+
+type queuedNotification struct {
+	info *pps.NotifierInfo
+	n    *pps.Notification
+}
+
+func newNotificationDispatcher(env Env, notifiers, pipelines, jobs col.PostgresCollection, sd PipelineStateDriver) *notificationDispatcher {
+	commits := pfsdb.Commits(env.DB, env.Listener)
+	return &notificationDispatcher{
+		notifiers: notifiers,
+		sources: []*notificationSource{
+			{
+				name:     "pipelines",
+				col:      pipelines,
+				template: &pps.PipelineInfo{},
+				watch:    sd.Watch,
+				describe: func(val proto.Message) (string, *types.Timestamp, *pps.Notification) {
+					pipelineInfo := val.(*pps.PipelineInfo)
+					return pipelineInfo.State.String(), nil, &pps.Notification{
+						Type:          pps.NotificationType_PIPELINE_STATE_CHANGED,
+						Pipeline:      pipelineInfo.Pipeline,
+						PipelineState: pipelineInfo.State,
+						Reason:        pipelineInfo.Reason,
+					}
+				},
+			},
+			{
+				name:     "jobs",
+				col:      jobs,
+				template: &pps.JobInfo{},
+				watch:    watchCollection(jobs),
+				describe: func(val proto.Message) (string, *types.Timestamp, *pps.Notification) {
+					jobInfo := val.(*pps.JobInfo)
+					var settled *types.Timestamp
+					if pps.IsTerminal(jobInfo.State) {
+						settled = jobInfo.Finished
+					}
+					return jobInfo.State.String(), settled, &pps.Notification{
+						Type:     pps.NotificationType_JOB_STATE_CHANGED,
+						Job:      jobInfo.Job,
+						JobState: jobInfo.State,
+						Pipeline: jobInfo.Job.Pipeline,
+						Reason:   jobInfo.Reason,
+					}
+				},
+			},
+			{
+				name:     "commits",
+				col:      commits,
+				template: &pfs.CommitInfo{},
+				watch:    watchCollection(commits),
+				describe: func(val proto.Message) (string, *types.Timestamp, *pps.Notification) {
+					commitInfo := val.(*pfs.CommitInfo)
+					if commitInfo.Commit.Branch.Repo.Type != pfs.UserRepoType {
+						return "", &types.Timestamp{}, nil
+					}
+					if commitInfo.Finished == nil {
+						return "open", nil, nil
+					}
+					return "finished", commitInfo.Finished, &pps.Notification{
+						Type:   pps.NotificationType_COMMIT_FINISHED,
+						Commit: commitInfo.Commit,
+					}
+				},
+			},
+		},
+		client:  &http.Client{Timeout: notificationRequestTimeout},
+		limiter: limit.New(maxConcurrentNotifications),
+		queues:  make(map[string][]*queuedNotification),
+		newBackOff: func() backoff.BackOff {
+			b := backoff.NewExponentialBackOff()
+			b.MaxElapsedTime = notificationRetryTimeout
+			return b
+		},
+	}
+}
+
+func watchCollection(c col.PostgresCollection) func(context.Context) (<-chan *watch.Event, func(), error) {
+	return func(ctx context.Context) (<-chan *watch.Event, func(), error) {
+		watcher, err := c.ReadOnly(ctx).Watch()
+		if err != nil {
+			return nil, nil, errors.EnsureStack(err)
+		}
+		return watcher.Watch(), watcher.Close, nil
+	}
+}
+
+func (d *notificationDispatcher) run(ctx context.Context) {
+	var eg errgroup.Group
+	for _, src := range d.sources {
+		src := src
+		eg.Go(func() error {
+			d.watchSource(ctx, src)
+			return nil
+		})
+	}
+	eg.Wait()
+}
+
+// watchSource dispatches a notification for every state transition of a
+// value in src. The states of the existing values are recorded before the
+// watch starts, so that the existing values, which the watch replays first,
+// only generate notifications if they changed in the meantime.
+func (d *notificationDispatcher) watchSource(ctx context.Context, src *notificationSource) {
+	var states *notificationStates
+	var pruned time.Time
+	if err := backoff.RetryUntilCancel(ctx, backoff.MustLoop(func() error {
+		if states == nil {
+			listed := newNotificationStates(time.Now())
+			val := proto.Clone(src.template)
+			if err := src.col.ReadOnly(ctx).List(val, col.DefaultOptions(), func(key string) error {
+				state, settled, _ := src.describe(val)
+				listed.observe(key, state, settled)
+				return nil
+			}); err != nil {
+				return errors.Wrapf(err, "error listing %s", src.name)
+			}
+			states, pruned = listed, time.Now()
+		}
+		events, close, err := src.watch(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error creating %s watch", src.name)
+		}
+		defer close()
+		for event := range events {
+			if time.Since(pruned) > settledStateWindow {
+				states.prune(time.Now())
+				pruned = time.Now()
+			}
+			switch event.Type {
+			case watch.EventError:
+				return errors.Wrapf(event.Err, "error watching %s", src.name)
+			case watch.EventDelete:
+				states.remove(string(event.Key))
+			case watch.EventPut:
+				var key string
+				val := proto.Clone(src.template)
+				if err := event.Unmarshal(&key, val); err != nil {
+					return errors.Wrapf(err, "error unmarshalling %s", src.name)
+				}
+				state, settled, n := src.describe(val)
+				if !states.observe(key, state, settled) {
+					continue
+				}
+				if n != nil {
+					if err := d.dispatch(ctx, n); err != nil {
+						log.Errorf("error dispatching notifications for %s %q: %v", src.name, key, err)
+					}
+				}
+			}
+		}
+		return nil
+	}), backoff.NewInfiniteBackOff(), backoff.NotifyContinue("notifications for "+src.name),
+	); err != nil && ctx.Err() == nil {
+		log.Errorf("notifications for %s exited unexpectedly: %v", src.name, err)
+	}
+}
+
+// dispatch queues n for delivery to every notifier that matches it.
+func (d *notificationDispatcher) dispatch(ctx context.Context, n *pps.Notification) error {
+	var notifierInfos []*pps.NotifierInfo
+	notifierInfo := &pps.NotifierInfo{}
+	if err := d.notifiers.ReadOnly(ctx).List(notifierInfo, col.DefaultOptions(), func(string) error {
+		if notifierMatches(notifierInfo, n) {
+			notifierInfos = append(notifierInfos, proto.Clone(notifierInfo).(*pps.NotifierInfo))
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, info := range notifierInfos {
+		n := proto.Clone(n).(*pps.Notification)
+		n.Notifier = info.Notifier
+		n.Time = now()
+		d.enqueue(ctx, info, n)
+	}
+	return nil
+}
+
+// enqueue queues n for delivery to the notifier, and starts delivering the
+// notifier's queue if it isn't being delivered already. It doesn't block, so
+// that slow notifiers don't hold up the watches.
+func (d *notificationDispatcher) enqueue(ctx context.Context, info *pps.NotifierInfo, n *pps.Notification) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	name := info.Notifier.Name
+	queue, ok := d.queues[name]
+	if len(queue) >= maxQueuedNotifications {
+		log.Errorf("dropping %v notification for notifier %q, which has %d notifications queued", queue[0].n.Type, name, len(queue))
+		queue[0] = nil
+		queue = queue[1:]
+	}
+	d.queues[name] = append(queue, &queuedNotification{info: info, n: n})
+	if !ok {
+		go d.deliverQueue(ctx, name)
+	}
+}
+
+// deliverQueue delivers the notifications queued for a notifier in order,
+// until its queue is empty.
+func (d *notificationDispatcher) deliverQueue(ctx context.Context, name string) {
+	for {
+		d.mu.Lock()
+		queue := d.queues[name]
+		if len(queue) == 0 || ctx.Err() != nil {
+			delete(d.queues, name)
+			d.mu.Unlock()
+			return
+		}
+		qn := queue[0]
+		queue[0] = nil
+		d.queues[name] = queue[1:]
+		d.mu.Unlock()
+		d.limiter.Acquire()
+		if err := d.deliver(ctx, qn.info, qn.n); err != nil && ctx.Err() == nil {
+			log.Errorf("could not deliver %v notification to notifier %q: %v", qn.n.Type, name, err)
+		}
+		d.limiter.Release()
+	}
+}
+
+// deliver POSTs n to the notifier's URL, retrying until the notifier accepts
+// it, rejects it with a client error, or the backoff gives up.
+func (d *notificationDispatcher) deliver(ctx context.Context, info *pps.NotifierInfo, n *pps.Notification) error {
+	body := &bytes.Buffer{}
+	if err := (&jsonpb.Marshaler{}).Marshal(body, n); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return backoff.RetryUntilCancel(ctx, func() error {
+		return d.post(ctx, info, n.Type, body.Bytes())
+	}, d.newBackOff(), func(err error, retryAfter time.Duration) error {
+		if errors.As(err, &permanentNotificationError{}) {
+			return err
+		}
+		log.Warnf("could not deliver notification to notifier %q: %v; retrying in %v", info.Notifier.Name, err, retryAfter)
+		return nil
+	})
+}
+
+func (d *notificationDispatcher) post(ctx context.Context, info *pps.NotifierInfo, notificationType pps.NotificationType, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, info.Url, bytes.NewReader(body))
+	if err != nil {
+		return permanentNotificationError{errors.EnsureStack(err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(notificationTypeHeader, notificationType.String())
+	if info.Secret != "" {
+		req.Header.Set(notificationSignatureHeader, signNotification(info.Secret, body))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body) //nolint:errcheck
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests:
+		return permanentNotificationError{errors.Errorf("notifier responded with %s", resp.Status)}
+	default:
+		return errors.Errorf("notifier responded with %s", resp.Status)
+	}
+}
+
+func (m *ppsMaster) startNotificationDispatcher() {
+	m.pollPipelinesMu.Lock()
+	defer m.pollPipelinesMu.Unlock()
+	m.notificationsCancel = startMonitorThread(m.masterCtx, "dispatchNotifications", m.notifications.run)
+}
+
+func (m *ppsMaster) cancelNotificationDispatcher() {
+	m.pollPipelinesMu.Lock()
+	defer m.pollPipelinesMu.Unlock()
+	if m.notificationsCancel != nil {
+		m.notificationsCancel()
+		m.notificationsCancel = nil
+	}
+}
@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/client/limit"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// webhookStandIn is a local HTTP server that records the notifications POSTed
+// to it, responding with the given status codes in order and then 200.
+type webhookStandIn struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newWebhookStandIn(t *testing.T, statuses ...int) *webhookStandIn {
+	w := &webhookStandIn{statuses: statuses}
+	w.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.requests = append(w.requests, r)
+		w.bodies = append(w.bodies, body)
+		if len(w.statuses) > 0 {
+			rw.WriteHeader(w.statuses[0])
+			w.statuses = w.statuses[1:]
+		}
+	}))
+	t.Cleanup(w.Close)
+	return w
+}
+
+func newTestDispatcher(w *webhookStandIn) *notificationDispatcher {
+	return &notificationDispatcher{
+		client:  w.Client(),
+		limiter: limit.New(1),
+		queues:  make(map[string][]*queuedNotification),
+		newBackOff: func() backoff.BackOff {
+			return backoff.RetryEvery(time.Millisecond).For(time.Second)
+		},
+	}
+}
+
+func TestNotificationDelivery(t *testing.T) {
+	w := newWebhookStandIn(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	d := newTestDispatcher(w)
+	info := &pps.NotifierInfo{
+		Notifier: &pps.Notifier{Name: "failures"},
+		Url:      w.URL,
+		Secret:   "s3cr3t",
+	}
+	n := &pps.Notification{
+		Notifier: info.Notifier,
+		Type:     pps.NotificationType_JOB_STATE_CHANGED,
+		Job:      client.NewJob("edges", "0123456789abcdef0123456789abcdef"),
+		JobState: pps.JobState_JOB_FAILURE,
+		Pipeline: client.NewPipeline("edges"),
+		Reason:   "datum failed",
+	}
+	require.NoError(t, d.deliver(context.Background(), info, n))
+
+	// The notification is retried until it's accepted, and each attempt is
+	// signed.
+	require.Equal(t, 3, len(w.requests))
+	for i, r := range w.requests {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "JOB_STATE_CHANGED", r.Header.Get(notificationTypeHeader))
+		require.Equal(t, signNotification("s3cr3t", w.bodies[i]), r.Header.Get(notificationSignatureHeader))
+	}
+	require.True(t, strings.HasPrefix(signNotification("s3cr3t", w.bodies[0]), "sha256="))
+	require.NotEqual(t, signNotification("other", w.bodies[0]), signNotification("s3cr3t", w.bodies[0]))
+	received := &pps.Notification{}
+	require.NoError(t, jsonpb.UnmarshalString(string(w.bodies[2]), received))
+	require.Equal(t, n, received)
+}
+
+func TestNotificationRejected(t *testing.T) {
+	w := newWebhookStandIn(t, http.StatusBadRequest)
+	d := newTestDispatcher(w)
+	info := &pps.NotifierInfo{
+		Notifier: &pps.Notifier{Name: "rejected"},
+		Url:      w.URL,
+	}
+	n := &pps.Notification{
+		Type:   pps.NotificationType_COMMIT_FINISHED,
+		Commit: client.NewCommit("images", "master", "0123456789abcdef0123456789abcdef"),
+	}
+	// Client errors are not retried, and unsigned notifications have no
+	// signature header.
+	require.YesError(t, d.deliver(context.Background(), info, n))
+	require.Equal(t, 1, len(w.requests))
+	require.Equal(t, "", w.requests[0].Header.Get(notificationSignatureHeader))
+
+	// Server errors are returned once the backoff gives up.
+	w = newWebhookStandIn(t, http.StatusInternalServerError)
+	d = newTestDispatcher(w)
+	d.newBackOff = func() backoff.BackOff {
+		return &backoff.StopBackOff{}
+	}
+	info.Url = w.URL
+	require.YesError(t, d.deliver(context.Background(), info, n))
+	require.Equal(t, 1, len(w.requests))
+}
+
+func TestNotificationQueue(t *testing.T) {
+	w := newWebhookStandIn(t, http.StatusServiceUnavailable)
+	d := newTestDispatcher(w)
+	info := &pps.NotifierInfo{
+		Notifier: &pps.Notifier{Name: "queued"},
+		Url:      w.URL,
+	}
+	// Queued notifications are delivered in order, each after the previous one
+	// is accepted.
+	states := []pps.JobState{pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING, pps.JobState_JOB_SUCCESS}
+	for _, state := range states {
+		d.enqueue(context.Background(), info, &pps.Notification{
+			Notifier: info.Notifier,
+			Type:     pps.NotificationType_JOB_STATE_CHANGED,
+			JobState: state,
+		})
+	}
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if len(d.queues) > 0 {
+			return errors.Errorf("notifications still queued")
+		}
+		return nil
+	})
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	require.Equal(t, 4, len(w.bodies))
+	for i, state := range states {
+		received := &pps.Notification{}
+		require.NoError(t, jsonpb.UnmarshalString(string(w.bodies[i+1]), received))
+		require.Equal(t, state, received.JobState)
+	}
+}
+
+func TestNotificationStates(t *testing.T) {
+	start := time.Now()
+	ns := newNotificationStates(start)
+	ts := func(t time.Time) *types.Timestamp {
+		pbts, err := types.TimestampProto(t)
+		if err != nil {
+			panic(err)
+		}
+		return pbts
+	}
+	// Values that settled before the window never generate notifications, and
+	// aren't kept.
+	require.False(t, ns.observe("old", "finished", ts(start.Add(-time.Hour))))
+	require.False(t, ns.observe("system", "", &types.Timestamp{}))
+	require.Equal(t, 0, len(ns.states))
+
+	// Transitions of values whose state can change are reported once.
+	require.True(t, ns.observe("commit", "open", nil))
+	require.False(t, ns.observe("commit", "open", nil))
+	require.True(t, ns.observe("commit", "finished", ts(start)))
+	require.False(t, ns.observe("commit", "finished", ts(start)))
+	require.Equal(t, 1, len(ns.states))
+
+	// Settled values are forgotten once they fall out of the window, after
+	// which updates to them still aren't transitions.
+	ns.prune(start.Add(2 * settledStateWindow))
+	require.Equal(t, 0, len(ns.states))
+	require.False(t, ns.observe("commit", "finished", ts(start)))
+}
+
+func TestNotifierMatches(t *testing.T) {
+	jobFailed := &pps.Notification{
+		Type:     pps.NotificationType_JOB_STATE_CHANGED,
+		Job:      client.NewJob("edges", "0123456789abcdef0123456789abcdef"),
+		JobState: pps.JobState_JOB_FAILURE,
+		Pipeline: client.NewPipeline("edges"),
+	}
+	pipelineCrashing := &pps.Notification{
+		Type:          pps.NotificationType_PIPELINE_STATE_CHANGED,
+		Pipeline:      client.NewPipeline("montage"),
+		PipelineState: pps.PipelineState_PIPELINE_CRASHING,
+	}
+	commitFinished := &pps.Notification{
+		Type:   pps.NotificationType_COMMIT_FINISHED,
+		Commit: client.NewCommit("images", "master", "0123456789abcdef0123456789abcdef"),
+	}
+	for _, test := range []struct {
+		name     string
+		notifier *pps.NotifierInfo
+		matches  []*pps.Notification
+	}{
+		{
+			name:     "job states",
+			notifier: &pps.NotifierInfo{JobStates: []pps.JobState{pps.JobState_JOB_FAILURE}},
+			matches:  []*pps.Notification{jobFailed},
+		},
+		{
+			name:     "pipeline states",
+			notifier: &pps.NotifierInfo{PipelineStates: []pps.PipelineState{pps.PipelineState_PIPELINE_CRASHING}},
+			matches:  []*pps.Notification{pipelineCrashing},
+		},
+		{
+			name:     "commit finished",
+			notifier: &pps.NotifierInfo{CommitFinished: true},
+			matches:  []*pps.Notification{commitFinished},
+		},
+		{
+			name: "pipeline filter",
+			notifier: &pps.NotifierInfo{
+				Pipelines:      []string{"montage"},
+				JobStates:      []pps.JobState{pps.JobState_JOB_FAILURE},
+				PipelineStates: []pps.PipelineState{pps.PipelineState_PIPELINE_CRASHING},
+				CommitFinished: true,
+			},
+			matches: []*pps.Notification{pipelineCrashing, commitFinished},
+		},
+		{
+			name: "repo filter",
+			notifier: &pps.NotifierInfo{
+				Repos:          []string{"edges", "images"},
+				JobStates:      []pps.JobState{pps.JobState_JOB_FAILURE},
+				PipelineStates: []pps.PipelineState{pps.PipelineState_PIPELINE_CRASHING},
+				CommitFinished: true,
+			},
+			matches: []*pps.Notification{jobFailed, commitFinished},
+		},
+		{
+			name:     "other states",
+			notifier: &pps.NotifierInfo{JobStates: []pps.JobState{pps.JobState_JOB_SUCCESS}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			for _, n := range []*pps.Notification{jobFailed, pipelineCrashing, commitFinished} {
+				expected := false
+				for _, m := range test.matches {
+					expected = expected || m == n
+				}
+				require.Equal(t, expected, notifierMatches(test.notifier, n), n.Type.String())
+			}
+		})
+	}
+}
+
+func TestValidateNotifier(t *testing.T) {
+	valid := func() *pps.NotifierInfo {
+		return &pps.NotifierInfo{
+			Notifier:  &pps.Notifier{Name: "notifier"},
+			Url:       "https://example.com/hook",
+			JobStates: []pps.JobState{pps.JobState_JOB_FAILURE},
+		}
+	}
+	require.NoError(t, validateNotifier(valid()))
+	info := valid()
+	info.Notifier = nil
+	require.YesError(t, validateNotifier(info))
+	info = valid()
+	info.Url = "ftp://example.com/hook"
+	require.YesError(t, validateNotifier(info))
+	info = valid()
+	info.Url = "/hook"
+	require.YesError(t, validateNotifier(info))
+	info = valid()
+	info.JobStates = nil
+	require.YesError(t, validateNotifier(info))
+}
@@ -101,11 +101,17 @@ func NewAPIServerNoMaster(env Env) (ppsiface.APIServer, error) {
 		workerUsesRoot:        config.WorkerUsesRoot,
 		pipelines:             ppsdb.Pipelines(env.DB, env.Listener),
 		jobs:                  ppsdb.Jobs(env.DB, env.Listener),
+		notifiers:             ppsdb.Notifiers(env.DB, env.Listener),
 		workerGrpcPort:        config.PPSWorkerPort,
 		port:                  config.Port,
 		peerPort:              config.PeerPort,
 		gcPercent:             config.GCPercent,
 	}
+	infraDriver, err := newInfraDriver(env)
+	if err != nil {
+		return nil, err
+	}
+	apiServer.infraDriver = infraDriver
 	return apiServer, nil
 }
 
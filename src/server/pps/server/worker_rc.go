@@ -42,6 +42,33 @@ const (
 	// UploadConcurrencyLimitEnvVar is the environment variable for the upload concurrency limit.
 	// EnvVar defined in src/internal/serviceenv/config.go
 	UploadConcurrencyLimitEnvVar = "STORAGE_UPLOAD_CONCURRENCY_LIMIT"
+	// CompressionEnvVar is the environment variable for the chunk compression algorithm.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	CompressionEnvVar = "STORAGE_COMPRESSION"
+	// CompressionLevelEnvVar is the environment variable for the chunk compression level.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	CompressionLevelEnvVar = "STORAGE_COMPRESSION_LEVEL"
+	// ChunkKeySecretEnvVar is the environment variable that enables secret chunk keys.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	ChunkKeySecretEnvVar = "STORAGE_CHUNK_KEY_SECRET"
+	// KEKProviderEnvVar is the environment variable for the chunk key encryption key provider.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	KEKProviderEnvVar = "STORAGE_KEK_PROVIDER"
+	// KEKLocalPathEnvVar is the environment variable for the local key encryption key file.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	KEKLocalPathEnvVar = "STORAGE_KEK_LOCAL_PATH"
+	// KEKVaultAddressEnvVar is the environment variable for the vault address of the key encryption key.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	KEKVaultAddressEnvVar = "STORAGE_KEK_VAULT_ADDRESS"
+	// KEKVaultTokenEnvVar is the environment variable for the vault token of the key encryption key.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	KEKVaultTokenEnvVar = "STORAGE_KEK_VAULT_TOKEN"
+	// KEKVaultMountEnvVar is the environment variable for the vault transit mount of the key encryption key.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	KEKVaultMountEnvVar = "STORAGE_KEK_VAULT_MOUNT"
+	// KEKVaultKeyEnvVar is the environment variable for the vault transit key name of the key encryption key.
+	// EnvVar defined in src/internal/serviceenv/config.go
+	KEKVaultKeyEnvVar = "STORAGE_KEK_VAULT_KEY"
 )
 
 // Parameters used when creating the kubernetes replication controller in charge
@@ -62,6 +89,7 @@ type workerOptions struct {
 	volumes               []v1.Volume           // Volumes that we expose to the user container
 	volumeMounts          []v1.VolumeMount      // Paths where we mount each volume in 'volumes'
 	postgresSecret        *v1.SecretKeySelector // the reference to the postgres password
+	kekVaultTokenSecret   *v1.SecretKeySelector // the reference to the vault token of the key encryption key, if any
 	schedulingSpec        *pps.SchedulingSpec   // the SchedulingSpec for the pipeline
 	podSpec               string
 	podPatch              string
@@ -152,6 +180,9 @@ func (kd *kubeDriver) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pi
 	}, {
 		Name:  "LOKI_SERVICE_PORT_VAR",
 		Value: kd.config.LokiPortVar,
+	}, {
+		Name:  "TASK_SERVICE_BACKEND",
+		Value: kd.config.TaskServiceBackend,
 	},
 		// These are set explicitly below to prevent kubernetes from setting them to the service host and port.
 		{
@@ -180,7 +211,7 @@ func (kd *kubeDriver) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pi
 		Value: strconv.FormatInt(int64(kd.config.GCPercent), 10),
 	}}
 
-	sidecarEnv = append(sidecarEnv, kd.getStorageEnvVars(pipelineInfo)...)
+	sidecarEnv = append(sidecarEnv, kd.getStorageEnvVars(pipelineInfo, options)...)
 	sidecarEnv = append(sidecarEnv, commonEnv...)
 	sidecarEnv = append(sidecarEnv, kd.getEgressSecretEnvVars(pipelineInfo)...)
 
@@ -486,11 +517,38 @@ func (kd *kubeDriver) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pi
 	return podSpec, nil
 }
 
-func (kd *kubeDriver) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.EnvVar {
+func (kd *kubeDriver) getStorageEnvVars(pipelineInfo *pps.PipelineInfo, options *workerOptions) []v1.EnvVar {
 	vars := []v1.EnvVar{
 		{Name: UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(kd.config.StorageUploadConcurrencyLimit)},
 		{Name: client.PPSPipelineNameEnv, Value: pipelineInfo.Pipeline.Name},
 	}
+	if kd.config.StorageCompression != "" {
+		vars = append(vars, v1.EnvVar{Name: CompressionEnvVar, Value: kd.config.StorageCompression})
+	}
+	if kd.config.StorageCompressionLevel != 0 {
+		vars = append(vars, v1.EnvVar{Name: CompressionLevelEnvVar, Value: strconv.Itoa(kd.config.StorageCompressionLevel)})
+	}
+	if kd.config.StorageChunkKeySecret {
+		vars = append(vars, v1.EnvVar{Name: ChunkKeySecretEnvVar, Value: "true"})
+	}
+	if kd.config.StorageKEKProvider != "" {
+		vars = append(vars,
+			v1.EnvVar{Name: KEKProviderEnvVar, Value: kd.config.StorageKEKProvider},
+			v1.EnvVar{Name: KEKLocalPathEnvVar, Value: kd.config.StorageKEKLocalPath},
+			v1.EnvVar{Name: KEKVaultAddressEnvVar, Value: kd.config.StorageKEKVaultAddress},
+			v1.EnvVar{Name: KEKVaultMountEnvVar, Value: kd.config.StorageKEKVaultMount},
+			v1.EnvVar{Name: KEKVaultKeyEnvVar, Value: kd.config.StorageKEKVaultKey},
+		)
+		// The vault token is only passed by reference, so it doesn't appear in the worker spec.
+		if options.kekVaultTokenSecret != nil {
+			vars = append(vars, v1.EnvVar{
+				Name: KEKVaultTokenEnvVar,
+				ValueFrom: &v1.EnvVarSource{
+					SecretKeyRef: options.kekVaultTokenSecret,
+				},
+			})
+		}
+	}
 	return vars
 }
 
@@ -683,17 +741,26 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 	if err != nil {
 		return nil, errors.EnsureStack(err)
 	}
-	var postgresSecretRef *v1.SecretKeySelector
+	var postgresSecretRef, kekVaultTokenSecretRef *v1.SecretKeySelector
 	for _, container := range selfPodInfo.Spec.Containers {
 		for _, envVar := range container.Env {
-			if envVar.Name == "POSTGRES_PASSWORD" && envVar.ValueFrom != nil && envVar.ValueFrom.SecretKeyRef != nil {
+			if envVar.ValueFrom == nil || envVar.ValueFrom.SecretKeyRef == nil {
+				continue
+			}
+			switch envVar.Name {
+			case "POSTGRES_PASSWORD":
 				postgresSecretRef = envVar.ValueFrom.SecretKeyRef
+			case KEKVaultTokenEnvVar:
+				kekVaultTokenSecretRef = envVar.ValueFrom.SecretKeyRef
 			}
 		}
 	}
 	if postgresSecretRef == nil {
 		return nil, errors.New("could not load the existing postgres secret reference from kubernetes")
 	}
+	if strings.EqualFold(kd.config.StorageKEKProvider, "vault") && kekVaultTokenSecretRef == nil {
+		return nil, errors.Errorf("could not load the %s secret reference from kubernetes, the vault token must be set from a secret", KEKVaultTokenEnvVar)
+	}
 
 	// Generate options for new RC
 	return &workerOptions{
@@ -711,6 +778,7 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 		volumes:               volumes,
 		volumeMounts:          volumeMounts,
 		postgresSecret:        postgresSecretRef,
+		kekVaultTokenSecret:   kekVaultTokenSecretRef,
 		imagePullSecrets:      imagePullSecrets,
 		service:               service,
 		schedulingSpec:        pipelineInfo.Details.SchedulingSpec,
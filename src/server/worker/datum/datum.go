@@ -38,6 +38,13 @@ const (
 	PFSPrefix = "pfs"
 	// OutputPrefix is the prefix for the output path.
 	OutputPrefix = "out"
+	// LogsFileName is the name of a datum's logs file, next to its meta file.
+	LogsFileName = "logs"
+	// LogsPrefix is the prefix for the job logs path.
+	LogsPrefix = "logs"
+	// MasterLogsFileName is the name of the file with the logs of the job's
+	// master, under the job logs path.
+	MasterLogsFileName = "master"
 	// TmpFileName is the name of the tmp file.
 	TmpFileName       = "tmp"
 	defaultNumRetries = 3
@@ -152,8 +159,13 @@ func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 
 // WithDatum provides a scoped environment for a datum within the datum set.
 // TODO: Handle datum concurrency here, and potentially move symlinking here.
-func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error {
+func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) (retErr error) {
 	d := newDatum(s, meta, opts...)
+	defer func() {
+		if err := d.logs.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
 
 	var err error
 	for i := 0; i <= d.numRetries; i++ {
@@ -177,6 +189,41 @@ func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error
 	return err
 }
 
+// RestoreDatum restores the output of a datum from output, which contains the
+// output files of a previous run of the same datum, rather than processing the
+// datum again.
+func (s *Set) RestoreDatum(meta *Meta, output *pfs.File, opts ...Option) error {
+	d := newDatum(s, meta, opts...)
+	d.meta.State = State_PROCESSED
+	d.meta.OutputFiles = nil
+	if err := s.cacheClient.WalkFile(output.Commit, output.Path, func(fi *pfs.FileInfo) error {
+		if fi.FileType == pfs.FileType_FILE {
+			d.meta.OutputFiles = append(d.meta.OutputFiles, path.Join("/", strings.TrimPrefix(fi.File.Path, output.Path)))
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if s.pfsOutputClient != nil {
+		if err := s.pfsOutputClient.CopyFile("/", output, client.WithAppendCopyFile(), client.WithDatumCopyFile(d.ID)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	if s.metaOutputClient != nil {
+		if err := d.uploadMetaFile(s.metaOutputClient); err != nil {
+			return err
+		}
+		// The output is also copied to the same place in the meta output as
+		// uploadMetaOutput puts it, so the datum can be deleted.
+		dst := path.Join(PFSPrefix, d.ID, OutputPrefix)
+		if err := s.metaOutputClient.CopyFile(dst, output, client.WithAppendCopyFile(), client.WithDatumCopyFile(d.ID)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	s.stats.Processed++
+	return nil
+}
+
 // Datum manages a datum.
 type Datum struct {
 	set              *Set
@@ -185,8 +232,10 @@ type Datum struct {
 	storageRoot      string
 	numRetries       int
 	recoveryCallback func(context.Context) error
+	outputCallback   func(func(client.ModifyFile) error) error
 	timeout          time.Duration
 	IDPrefix         string
+	logs             *logFile
 }
 
 func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
@@ -202,6 +251,7 @@ func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
 	for _, opt := range opts {
 		opt(d)
 	}
+	d.logs = &logFile{path: path.Join(d.MetaStorageRoot(), LogsFileName)}
 	return d
 }
 
@@ -215,6 +265,13 @@ func (d *Datum) MetaStorageRoot() string {
 	return path.Join(d.storageRoot, MetaPrefix, d.ID)
 }
 
+// Logs returns a writer for the datum's logs, which are uploaded to the meta
+// output along with the datum's meta file. Logs written across retries of the
+// datum are kept.
+func (d *Datum) Logs() io.Writer {
+	return d.logs
+}
+
 func (d *Datum) finish(err error) (retErr error) {
 	defer func() {
 		if err := MergeProcessStats(d.set.stats.ProcessStats, d.meta.Stats); retErr == nil {
@@ -341,6 +398,10 @@ func (d *Datum) uploadMetaOutput() (retErr error) {
 		if err := d.uploadMetaFile(d.set.metaOutputClient); err != nil {
 			return err
 		}
+		// The logs file is in the meta storage root, so it is uploaded with it.
+		if err := d.logs.Close(); err != nil {
+			return err
+		}
 		return d.upload(d.set.metaOutputClient, d.storageRoot)
 	}
 	return nil
@@ -360,6 +421,11 @@ func (d *Datum) uploadMetaFile(mf client.ModifyFile) error {
 func (d *Datum) uploadOutput() error {
 	if d.set.pfsOutputClient != nil {
 		start := time.Now()
+		outputFiles, err := d.outputFiles()
+		if err != nil {
+			return err
+		}
+		d.meta.OutputFiles = outputFiles
 		d.meta.Stats.UploadBytes = 0
 		if err := d.upload(d.set.pfsOutputClient, path.Join(d.PFSStorageRoot(), OutputPrefix), func(hdr *tar.Header) error {
 			d.meta.Stats.UploadBytes += hdr.Size
@@ -375,10 +441,43 @@ func (d *Datum) uploadOutput() error {
 		workerStats.DatumUploadBytesCount.With(labels).Add(float64(d.meta.Stats.UploadBytes))
 		workerStats.DatumUploadTime.With(labels).Observe(duration.Seconds())
 		workerStats.DatumUploadSecondsCount.With(labels).Add(duration.Seconds())
+		if d.outputCallback != nil {
+			if err := d.outputCallback(func(mf client.ModifyFile) error {
+				return d.upload(mf, path.Join(d.PFSStorageRoot(), OutputPrefix))
+			}); err != nil {
+				return err
+			}
+		}
 	}
 	return d.uploadMetaOutput()
 }
 
+// outputFiles returns the paths of the files in the datum's output directory,
+// as they will be uploaded to the output commit. Symlinks are recorded by their
+// own path, even if they point to a directory.
+func (d *Datum) outputFiles() ([]string, error) {
+	outputRoot := path.Join(d.PFSStorageRoot(), OutputPrefix)
+	var files []string
+	err := filepath.Walk(outputRoot, func(file string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if file == outputRoot && os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if fi.IsDir() || fi.Mode()&os.ModeNamedPipe != 0 {
+			return nil
+		}
+		relPath, err := filepath.Rel(outputRoot, file)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		files = append(files, path.Join("/", relPath))
+		return nil
+	})
+	return files, errors.EnsureStack(err)
+}
+
 func (d *Datum) upload(mf client.ModifyFile, storageRoot string, cb ...func(*tar.Header) error) (retErr error) {
 	if err := miscutil.WithPipe(func(w io.Writer) (retErr error) {
 		bufW := bufio.NewWriterSize(w, grpcutil.MaxMsgPayloadSize)
@@ -483,6 +582,42 @@ func (d *Datum) uploadSymlink(mf client.ModifyFile, dstPath, file string, fi os.
 	return cb(dstPath, file)
 }
 
+// logFile is a file that is created on the first write, and can be reopened
+// for appending after it is closed.
+type logFile struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+}
+
+func (lf *logFile) Write(p []byte) (int, error) {
+	lf.mu.Lock()
+	defer lf.mu.Unlock()
+	if lf.f == nil {
+		if err := os.MkdirAll(path.Dir(lf.path), 0777); err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		f, err := os.OpenFile(lf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+		if err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		lf.f = f
+	}
+	n, err := lf.f.Write(p)
+	return n, errors.EnsureStack(err)
+}
+
+func (lf *logFile) Close() error {
+	lf.mu.Lock()
+	defer lf.mu.Unlock()
+	if lf.f == nil {
+		return nil
+	}
+	err := lf.f.Close()
+	lf.f = nil
+	return errors.EnsureStack(err)
+}
+
 // TODO: I think these types would be unecessary if the dependencies were shuffled around a bit.
 type fileWalkerFunc func(string) ([]string, error)
 
@@ -494,10 +629,13 @@ func NewDeleter(metaFileWalker fileWalkerFunc, metaOutputClient, pfsOutputClient
 	return func(meta *Meta) error {
 		ID := common.DatumID(meta.Inputs)
 		tagOption := client.WithDatumDeleteFile(ID)
-		// Delete the datum's meta file from the meta commit.
+		// Delete the datum's meta and logs files from the meta commit.
 		if err := metaOutputClient.DeleteFile(path.Join(MetaPrefix, ID, MetaFileName), tagOption); err != nil {
 			return errors.EnsureStack(err)
 		}
+		if err := metaOutputClient.DeleteFile(path.Join(MetaPrefix, ID, LogsFileName), tagOption); err != nil {
+			return errors.EnsureStack(err)
+		}
 		pfsDir := "/" + path.Join(PFSPrefix, ID)
 		outDir := path.Join(pfsDir, OutputPrefix)
 		files, err := metaFileWalker(pfsDir)
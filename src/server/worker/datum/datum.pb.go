@@ -54,17 +54,20 @@ func (State) EnumDescriptor() ([]byte, []int) {
 }
 
 type Meta struct {
-	Job                  *pps.Job          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	Inputs               []*common.Input   `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	Hash                 string            `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
-	State                State             `protobuf:"varint,4,opt,name=state,proto3,enum=datum.State" json:"state,omitempty"`
-	Reason               string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	Stats                *pps.ProcessStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
-	Index                int64             `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
-	ImageId              string            `protobuf:"bytes,8,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Job     *pps.Job          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Inputs  []*common.Input   `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Hash    string            `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	State   State             `protobuf:"varint,4,opt,name=state,proto3,enum=datum.State" json:"state,omitempty"`
+	Reason  string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Stats   *pps.ProcessStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	Index   int64             `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+	ImageId string            `protobuf:"bytes,8,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	// output_files are the paths of the files the datum wrote to the output
+	// commit, which, along with inputs, record the datum's file-level lineage.
+	OutputFiles          []string `protobuf:"bytes,9,rep,name=output_files,json=outputFiles,proto3" json:"output_files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Meta) Reset()         { *m = Meta{} }
@@ -156,6 +159,13 @@ func (m *Meta) GetImageId() string {
 	return ""
 }
 
+func (m *Meta) GetOutputFiles() []string {
+	if m != nil {
+		return m.OutputFiles
+	}
+	return nil
+}
+
 type Stats struct {
 	ProcessStats         *pps.ProcessStats `protobuf:"bytes,1,opt,name=process_stats,json=processStats,proto3" json:"process_stats,omitempty"`
 	Processed            int64             `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
@@ -252,36 +262,38 @@ func init() {
 func init() { proto.RegisterFile("server/worker/datum/datum.proto", fileDescriptor_96ec7427544ac634) }
 
 var fileDescriptor_96ec7427544ac634 = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x71, 0xd3, 0xa6, 0x8d, 0xdb, 0xa2, 0xca, 0xaa, 0x90, 0x99, 0xa0, 0x0b, 0x95, 0x90,
-	0xc2, 0x0e, 0x89, 0x08, 0xa7, 0x1d, 0xd9, 0x9a, 0xa1, 0x20, 0xd0, 0x26, 0x57, 0xe2, 0xc0, 0xa5,
-	0x4a, 0xe3, 0x47, 0x1b, 0x46, 0x6b, 0xcb, 0x4e, 0x0b, 0x7c, 0x43, 0x8e, 0xdc, 0x91, 0x10, 0xea,
-	0xb7, 0xe0, 0x86, 0x6c, 0x67, 0xda, 0x90, 0x10, 0x97, 0xe4, 0xfd, 0xfe, 0xcf, 0xfe, 0x3f, 0xbf,
-	0x67, 0xe3, 0x63, 0x0d, 0x6a, 0x0f, 0x2a, 0xf9, 0x2c, 0xd4, 0x35, 0xa8, 0x84, 0x17, 0xf5, 0x6e,
-	0xe3, 0xbe, 0xb1, 0x54, 0xa2, 0x16, 0xa4, 0x63, 0xe1, 0x68, 0xbc, 0x12, 0x2b, 0x61, 0x95, 0xc4,
-	0x44, 0x2e, 0x79, 0x34, 0x94, 0x52, 0x27, 0x52, 0xea, 0x06, 0x9f, 0xfc, 0x6d, 0x56, 0x8a, 0xcd,
-	0x46, 0x6c, 0x9b, 0x9f, 0x5b, 0x32, 0xfd, 0x8d, 0x70, 0xfb, 0x2d, 0xd4, 0x05, 0x79, 0x8c, 0xbd,
-	0x8f, 0x62, 0x49, 0x51, 0x88, 0xa2, 0x7e, 0xda, 0x8f, 0xa5, 0xd4, 0x8b, 0x7d, 0x1a, 0xbf, 0x16,
-	0x4b, 0x66, 0x74, 0xf2, 0x14, 0xfb, 0xd5, 0x56, 0xee, 0x6a, 0x4d, 0x5b, 0xa1, 0x17, 0xf5, 0xd3,
-	0x61, 0xdc, 0xd8, 0xe4, 0x46, 0x65, 0x4d, 0x92, 0x10, 0xdc, 0x5e, 0x17, 0x7a, 0x4d, 0xbd, 0x10,
-	0x45, 0x01, 0xb3, 0x31, 0x99, 0xe2, 0x8e, 0xae, 0x8b, 0x1a, 0x68, 0x3b, 0x44, 0xd1, 0xfd, 0x74,
-	0x10, 0xbb, 0x76, 0xe6, 0x46, 0x63, 0x2e, 0x45, 0x1e, 0x60, 0x5f, 0x41, 0xa1, 0xc5, 0x96, 0x76,
-	0xec, 0xce, 0x86, 0xc8, 0x89, 0xdb, 0xab, 0xa9, 0x6f, 0xcf, 0x35, 0xbe, 0x39, 0xd7, 0x95, 0x12,
-	0x25, 0x68, 0x6d, 0x3c, 0xb4, 0xf3, 0xd0, 0x64, 0x8c, 0x3b, 0xd5, 0x96, 0xc3, 0x17, 0xda, 0x0d,
-	0x51, 0xe4, 0x31, 0x07, 0xe4, 0x21, 0xee, 0x55, 0x9b, 0x62, 0x05, 0x8b, 0x8a, 0xd3, 0x9e, 0xf5,
-	0xee, 0x5a, 0xce, 0xf9, 0xf4, 0x07, 0xc2, 0x1d, 0xeb, 0x40, 0x4e, 0xf1, 0x50, 0x3a, 0xc7, 0x85,
-	0x2b, 0x87, 0xfe, 0x53, 0x6e, 0x20, 0xef, 0x10, 0x79, 0x84, 0x83, 0x86, 0x81, 0xd3, 0x96, 0xad,
-	0x7c, 0x2b, 0x10, 0x8a, 0xbb, 0xfa, 0xba, 0x92, 0x12, 0xb8, 0x1d, 0x89, 0xc7, 0x6e, 0xd0, 0x74,
-	0xfc, 0xa1, 0xa8, 0x3e, 0x01, 0xb7, 0x63, 0xf1, 0x58, 0x43, 0xc6, 0x4f, 0x41, 0x29, 0xf6, 0xa0,
-	0x80, 0xdb, 0x61, 0x78, 0xec, 0x56, 0x20, 0xcf, 0x70, 0xe0, 0xd6, 0x99, 0x76, 0xcc, 0x4c, 0x82,
-	0xb3, 0xc1, 0xe1, 0xe7, 0x71, 0xef, 0xc2, 0x8a, 0xf9, 0x8c, 0xf5, 0x5c, 0x3a, 0xe7, 0x27, 0xcf,
-	0x5d, 0x73, 0x40, 0x86, 0x38, 0xb8, 0x62, 0x97, 0xe7, 0xd9, 0x7c, 0x9e, 0xcd, 0x46, 0xf7, 0x08,
-	0xc6, 0xfe, 0xc5, 0xcb, 0xfc, 0x4d, 0x36, 0x1b, 0x21, 0x93, 0x62, 0xd9, 0xf9, 0xe5, 0xbb, 0x8c,
-	0x65, 0xb3, 0x51, 0xeb, 0xec, 0xd5, 0xb7, 0xc3, 0x04, 0x7d, 0x3f, 0x4c, 0xd0, 0xaf, 0xc3, 0x04,
-	0xbd, 0x3f, 0x5d, 0x55, 0xf5, 0x7a, 0xb7, 0x34, 0x17, 0x9d, 0xc8, 0xa2, 0x5c, 0x7f, 0xe5, 0xa0,
-	0xee, 0x46, 0xfb, 0x34, 0xd1, 0xaa, 0x4c, 0xfe, 0xf1, 0x60, 0x97, 0xbe, 0x7d, 0x5c, 0x2f, 0xfe,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xe1, 0x0e, 0xde, 0xce, 0x02, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xc5, 0x4d, 0x93, 0x36, 0x6e, 0x8b, 0x2a, 0xab, 0x42, 0x66, 0x82, 0x2e, 0xab, 0x84, 0x14,
+	0x76, 0x48, 0x44, 0x38, 0xed, 0xc8, 0xd6, 0x14, 0x05, 0x81, 0x36, 0xb9, 0x12, 0x07, 0x2e, 0x55,
+	0x1a, 0x7b, 0x6d, 0xd8, 0x5a, 0x5b, 0x76, 0x52, 0xe0, 0xbf, 0xf0, 0x83, 0x38, 0x72, 0x47, 0x42,
+	0xa8, 0xbf, 0x04, 0xd9, 0xce, 0xb4, 0x22, 0xa1, 0x5d, 0x92, 0xef, 0xbd, 0xcf, 0x7e, 0x7e, 0xdf,
+	0xd3, 0x07, 0x8f, 0x15, 0x93, 0x3b, 0x26, 0xe3, 0x2f, 0x5c, 0xde, 0x30, 0x19, 0xd3, 0xbc, 0xaa,
+	0x37, 0xf6, 0x1b, 0x09, 0xc9, 0x2b, 0x8e, 0x5c, 0x03, 0x8e, 0x46, 0x2b, 0xbe, 0xe2, 0x86, 0x89,
+	0x75, 0x65, 0x9b, 0x47, 0x03, 0x21, 0x54, 0x2c, 0x84, 0x6a, 0xe0, 0xc9, 0xbf, 0x62, 0x05, 0xdf,
+	0x6c, 0xf8, 0xb6, 0xf9, 0xd9, 0x23, 0x93, 0xef, 0x2d, 0xd8, 0xfe, 0xc0, 0xaa, 0x1c, 0x3d, 0x87,
+	0xce, 0x67, 0xbe, 0xc4, 0x20, 0x00, 0x61, 0x2f, 0xe9, 0x45, 0x42, 0xa8, 0xc5, 0x2e, 0x89, 0xde,
+	0xf1, 0x25, 0xd1, 0x3c, 0x7a, 0x01, 0xbd, 0x72, 0x2b, 0xea, 0x4a, 0xe1, 0x56, 0xe0, 0x84, 0xbd,
+	0x64, 0x10, 0x35, 0x32, 0x99, 0x66, 0x49, 0xd3, 0x44, 0x08, 0xb6, 0xd7, 0xb9, 0x5a, 0x63, 0x27,
+	0x00, 0xa1, 0x4f, 0x4c, 0x8d, 0x26, 0xd0, 0x55, 0x55, 0x5e, 0x31, 0xdc, 0x0e, 0x40, 0xf8, 0x38,
+	0xe9, 0x47, 0x76, 0x9c, 0xb9, 0xe6, 0x88, 0x6d, 0xa1, 0x27, 0xd0, 0x93, 0x2c, 0x57, 0x7c, 0x8b,
+	0x5d, 0x73, 0xb3, 0x41, 0xe8, 0xd4, 0xde, 0x55, 0xd8, 0x33, 0xbe, 0x46, 0x77, 0xbe, 0xae, 0x24,
+	0x2f, 0x98, 0x52, 0x5a, 0x43, 0x59, 0x0d, 0x85, 0x46, 0xd0, 0x2d, 0xb7, 0x94, 0x7d, 0xc5, 0x9d,
+	0x00, 0x84, 0x0e, 0xb1, 0x00, 0x3d, 0x85, 0xdd, 0x72, 0x93, 0xaf, 0xd8, 0xa2, 0xa4, 0xb8, 0x6b,
+	0xb4, 0x3b, 0x06, 0x67, 0x14, 0x9d, 0xc0, 0x3e, 0xaf, 0x2b, 0x51, 0x57, 0x8b, 0xeb, 0xf2, 0x96,
+	0x29, 0xec, 0x07, 0x4e, 0xe8, 0x93, 0x9e, 0xe5, 0x66, 0x9a, 0x9a, 0xfc, 0x02, 0xd0, 0x35, 0x8f,
+	0xa0, 0x33, 0x38, 0x10, 0xf6, 0xd1, 0x85, 0x75, 0x04, 0x1e, 0x70, 0xd4, 0x17, 0x07, 0x08, 0x3d,
+	0x83, 0x7e, 0x83, 0x19, 0xc5, 0x2d, 0x63, 0xee, 0x9e, 0x40, 0x18, 0x76, 0xd4, 0x4d, 0x29, 0x04,
+	0xa3, 0x26, 0x35, 0x87, 0xdc, 0x41, 0x1d, 0xca, 0x75, 0x5e, 0xde, 0x32, 0x6a, 0x92, 0x73, 0x48,
+	0x83, 0xb4, 0x9e, 0x64, 0x05, 0xdf, 0x31, 0xc9, 0xa8, 0xc9, 0xcb, 0x21, 0xf7, 0x04, 0x7a, 0x09,
+	0x7d, 0x7b, 0x4e, 0x4f, 0xac, 0x63, 0xf3, 0xcf, 0xfb, 0xfb, 0xdf, 0xc7, 0xdd, 0x99, 0x21, 0xb3,
+	0x29, 0xe9, 0xda, 0x76, 0x46, 0x4f, 0x5f, 0xd9, 0xe1, 0x18, 0x1a, 0x40, 0xff, 0x8a, 0x5c, 0x5e,
+	0xa4, 0xf3, 0x79, 0x3a, 0x1d, 0x3e, 0x42, 0x10, 0x7a, 0xb3, 0x37, 0xd9, 0xfb, 0x74, 0x3a, 0x04,
+	0xba, 0x45, 0xd2, 0x8b, 0xcb, 0x8f, 0x29, 0x49, 0xa7, 0xc3, 0xd6, 0xf9, 0xdb, 0x1f, 0xfb, 0x31,
+	0xf8, 0xb9, 0x1f, 0x83, 0x3f, 0xfb, 0x31, 0xf8, 0x74, 0xb6, 0x2a, 0xab, 0x75, 0xbd, 0xd4, 0xbb,
+	0x10, 0x8b, 0xbc, 0x58, 0x7f, 0xa3, 0x4c, 0x1e, 0x56, 0xbb, 0x24, 0x56, 0xb2, 0x88, 0xff, 0xb3,
+	0xd3, 0x4b, 0xcf, 0xec, 0xdf, 0xeb, 0xbf, 0x03, 0x00, 0xb2, 0x54, 0x28, 0xdc, 0xf1, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Meta) Marshal() (dAtA []byte, err error) {
@@ -308,6 +320,15 @@ func (m *Meta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.OutputFiles) > 0 {
+		for iNdEx := len(m.OutputFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutputFiles[iNdEx])
+			copy(dAtA[i:], m.OutputFiles[iNdEx])
+			i = encodeVarintDatum(dAtA, i, uint64(len(m.OutputFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.ImageId) > 0 {
 		i -= len(m.ImageId)
 		copy(dAtA[i:], m.ImageId)
@@ -495,6 +516,12 @@ func (m *Meta) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDatum(uint64(l))
 	}
+	if len(m.OutputFiles) > 0 {
+		for _, s := range m.OutputFiles {
+			l = len(s)
+			n += 1 + l + sovDatum(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -808,6 +835,38 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 			}
 			m.ImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputFiles = append(m.OutputFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDatum(dAtA[iNdEx:])
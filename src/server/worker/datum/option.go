@@ -62,3 +62,13 @@ func WithPrefixIndex() Option {
 		d.IDPrefix = fmt.Sprintf("%016d", d.meta.Index) + "-"
 	}
 }
+
+// WithOutputCallback sets a callback which is called after the output of a
+// successfully processed datum has been uploaded. The callback is passed a
+// function which uploads the output of the datum again, so the output can also
+// be stored somewhere else, such as the datum cache.
+func WithOutputCallback(cb func(upload func(client.ModifyFile) error) error) Option {
+	return func(d *Datum) {
+		d.outputCallback = cb
+	}
+}
@@ -539,6 +539,10 @@ func (d *driver) UserCodeEnv(
 }
 
 func (d *driver) GetContainerImageID(ctx context.Context, containerName string) (string, error) {
+	// Local workers run the user code on their host, rather than in an image.
+	if d.env.Config().PPSWorkerLocal {
+		return "", nil
+	}
 	pod, err := d.env.GetKubeClient().CoreV1().Pods(d.env.Config().Namespace).Get(
 		ctx,
 		d.env.Config().WorkerSpecificConfiguration.PodName,
@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -41,6 +42,10 @@ type TaggedLogger interface {
 	WithJob(jobID string) TaggedLogger
 	WithData(data []*common.Input) TaggedLogger
 	WithUserCode() TaggedLogger
+	// WithDurableLog clones the current logger and constructs a new logger that
+	// also writes its log messages to w, one JSON message per line, so that
+	// they can be persisted in PFS.
+	WithDurableLog(w io.Writer) TaggedLogger
 
 	JobID() string
 }
@@ -49,6 +54,7 @@ type taggedLogger struct {
 	template  pps.LogMessage
 	stderrLog *log.Logger
 	marshaler *jsonpb.Marshaler
+	durable   io.Writer
 
 	buffer bytes.Buffer
 }
@@ -143,6 +149,15 @@ func (logger *taggedLogger) WithUserCode() TaggedLogger {
 	return result
 }
 
+// WithDurableLog clones the current logger and returns a new one that will
+// also write its log statements to w. Writes to w are serialized, so w may be
+// shared between loggers.
+func (logger *taggedLogger) WithDurableLog(w io.Writer) TaggedLogger {
+	result := logger.clone()
+	result.durable = &syncWriter{w: w}
+	return result
+}
+
 // JobID returns the current job that the logger is configured with.
 func (logger *taggedLogger) JobID() string {
 	return logger.template.JobID
@@ -153,6 +168,7 @@ func (logger *taggedLogger) clone() *taggedLogger {
 		template:  logger.template,  // Copy struct
 		stderrLog: logger.stderrLog, // logger should be goroutine-safe
 		marshaler: &jsonpb.Marshaler{},
+		durable:   logger.durable,
 	}
 }
 
@@ -174,6 +190,11 @@ func (logger *taggedLogger) Logf(formatString string, args ...interface{}) {
 		return
 	}
 	fmt.Println(msg)
+	if logger.durable != nil {
+		if _, err := io.WriteString(logger.durable, msg+"\n"); err != nil {
+			logger.Errf("could not write durable log message: %s\n", err)
+		}
+	}
 }
 
 // LogStep will log before and after the given callback function runs, using
@@ -220,3 +241,17 @@ func (logger *taggedLogger) Write(p []byte) (_ int, retErr error) {
 		logger.Logf("%s", strings.TrimSuffix(message, "\n"))
 	}
 }
+
+// syncWriter serializes writes to a writer that is shared between loggers,
+// such as the user code's stdout and stderr.
+type syncWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (sw *syncWriter) Write(p []byte) (int, error) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	n, err := sw.w.Write(p)
+	return n, errors.EnsureStack(err)
+}
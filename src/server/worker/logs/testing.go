@@ -20,6 +20,7 @@ type MockLogger struct {
 	Job      string
 	Data     []*common.Input
 	UserCode bool
+	Durable  io.Writer
 }
 
 // Not used - forces a compile-time error in this file if MockLogger does not
@@ -49,6 +50,9 @@ func (ml *MockLogger) Logf(formatString string, args ...interface{}) {
 		str := fmt.Sprintf("LOGF %s (%v, %v, %v): "+formatString+"\n", params...)
 		ml.Writer.Write([]byte(str))
 	}
+	if ml.Durable != nil {
+		fmt.Fprintf(ml.Durable, formatString+"\n", args...)
+	}
 }
 
 // Errf optionally logs an error statement using string formatting
@@ -107,6 +111,14 @@ func (ml *MockLogger) WithUserCode() TaggedLogger {
 	return result
 }
 
+// WithDurableLog duplicates the MockLogger and returns a new one that also
+// writes log statements to w.
+func (ml *MockLogger) WithDurableLog(w io.Writer) TaggedLogger {
+	result := ml.clone()
+	result.Durable = w
+	return result
+}
+
 // JobID returns the currently tagged job ID for the logger.
 // This is redundant for MockLogger, as you can access ml.Job directly,
 // but it is needed for the TaggedLogger interface.
@@ -2,13 +2,30 @@ package transform
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 )
 
+// datumCacheTTL is how long the output of a datum is kept in the datum cache.
+const datumCacheTTL = 7 * 24 * time.Hour
+
+// datumCacheTag is the tag of the entries in the datum cache. The entries are
+// shared by every pipeline, so they are only removed when they expire or are
+// evicted. The tag starts with a character that pipeline names can't contain,
+// so it doesn't match the tag prefix which is cleared when a pipeline is
+// deleted.
+const datumCacheTag = "/datum-cache"
+
 type cache struct {
 	pachClient *client.APIClient
 	tag        string
@@ -80,3 +97,108 @@ func (c *cache) clear(ctx context.Context) error {
 	})
 	return errors.EnsureStack(err)
 }
+
+// datumCache stores the output of datums, so that a datum with the same user
+// image, transform and input files can be restored rather than processed again
+// by any pipeline which uses the datum cache.
+type datumCache struct {
+	pachClient *client.APIClient
+}
+
+func newDatumCache(pachClient *client.APIClient) *datumCache {
+	return &datumCache{
+		pachClient: pachClient,
+	}
+}
+
+// datumCacheKey computes the datum cache key of a datum. The key does not
+// include the pipeline name or salt, so datums are shared between pipelines
+// with the same transform.
+func datumCacheKey(imageID string, transform *pps.Transform, inputs []*common.Input) string {
+	hash := pfs.NewHash()
+	write := func(s string) {
+		hash.Write([]byte(s))
+		binary.Write(hash, binary.BigEndian, int64(len(s)))
+	}
+	writeStrings := func(ss []string) {
+		binary.Write(hash, binary.BigEndian, int64(len(ss)))
+		for _, s := range ss {
+			write(s)
+		}
+	}
+	write(imageID)
+	writeStrings(transform.Cmd)
+	writeStrings(transform.Stdin)
+	writeStrings(transform.ErrCmd)
+	writeStrings(transform.ErrStdin)
+	binary.Write(hash, binary.BigEndian, int64(len(transform.AcceptReturnCode)))
+	for _, code := range transform.AcceptReturnCode {
+		binary.Write(hash, binary.BigEndian, code)
+	}
+	var envKeys []string
+	for k := range transform.Env {
+		envKeys = append(envKeys, k)
+	}
+	sort.Strings(envKeys)
+	binary.Write(hash, binary.BigEndian, int64(len(envKeys)))
+	for _, k := range envKeys {
+		write(k)
+		write(transform.Env[k])
+	}
+	// Secrets are identified by their reference, not their contents.
+	binary.Write(hash, binary.BigEndian, int64(len(transform.Secrets)))
+	for _, secret := range transform.Secrets {
+		write(secret.Name)
+		write(secret.Key)
+		write(secret.MountPath)
+		write(secret.EnvVar)
+	}
+	write(transform.WorkingDir)
+	write(transform.User)
+	binary.Write(hash, binary.BigEndian, int64(len(inputs)))
+	for _, input := range inputs {
+		write(input.Name)
+		write(input.FileInfo.File.Path)
+		hash.Write(input.FileInfo.Hash)
+		binary.Write(hash, binary.BigEndian, input.EmptyFiles)
+	}
+	return "datum/" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// get returns the output of the datum with the given key, or nil if the datum
+// is not in the cache.
+func (dc *datumCache) get(ctx context.Context, key string) (*pfs.File, error) {
+	resp, err := dc.pachClient.PfsAPIClient.GetCache(ctx, &pfs.GetCacheRequest{Key: key})
+	if err != nil {
+		if pfsserver.IsCacheNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	entry := &DatumCacheEntry{}
+	if err := types.UnmarshalAny(resp.Value, entry); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return client.NewRepo(client.FileSetsRepoName).NewCommit("", entry.FileSetId).NewFile("/"), nil
+}
+
+// put uploads the output of the datum with the given key to a new file set,
+// and adds it to the cache.
+func (dc *datumCache) put(ctx context.Context, key string, upload func(client.ModifyFile) error) error {
+	resp, err := dc.pachClient.WithCtx(ctx).WithCreateFileSetClient(upload)
+	if err != nil {
+		return err
+	}
+	value, err := types.MarshalAny(&DatumCacheEntry{FileSetId: resp.FileSetId})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = dc.pachClient.PfsAPIClient.PutCache(ctx, &pfs.PutCacheRequest{
+		Key:        key,
+		Value:      value,
+		FileSetIds: []string{resp.FileSetId},
+		Tag:        datumCacheTag,
+		TTL:        types.DurationProto(datumCacheTTL),
+	})
+	return errors.EnsureStack(err)
+}
@@ -0,0 +1,53 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+func TestDatumCacheKey(t *testing.T) {
+	transform := &pps.Transform{
+		Cmd:   []string{"bash"},
+		Stdin: []string{"cp /pfs/in/* /pfs/out"},
+		Env:   map[string]string{"A": "1", "B": "2"},
+	}
+	inputs := []*common.Input{{
+		Name: "in",
+		FileInfo: &pfs.FileInfo{
+			File: client.NewFile("repo", "master", "0123456789abcdef0123456789abcdef", "/file"),
+			Hash: []byte("hash"),
+		},
+	}}
+	key := datumCacheKey("sha256:image", transform, inputs)
+	// The key does not depend on the commit or branch of the inputs.
+	inputs2 := []*common.Input{proto.Clone(inputs[0]).(*common.Input)}
+	inputs2[0].FileInfo.File = client.NewFile("repo2", "other", "fedcba9876543210fedcba9876543210", "/file")
+	require.Equal(t, key, datumCacheKey("sha256:image", proto.Clone(transform).(*pps.Transform), inputs2))
+
+	// The key depends on everything that the user code sees.
+	require.NotEqual(t, key, datumCacheKey("sha256:image2", transform, inputs))
+	transform2 := proto.Clone(transform).(*pps.Transform)
+	transform2.Env["B"] = "3"
+	require.NotEqual(t, key, datumCacheKey("sha256:image", transform2, inputs))
+	transform2 = proto.Clone(transform).(*pps.Transform)
+	transform2.Cmd = []string{"bash", "-x"}
+	require.NotEqual(t, key, datumCacheKey("sha256:image", transform2, inputs))
+	inputs2[0].FileInfo.Hash = []byte("hash2")
+	require.NotEqual(t, key, datumCacheKey("sha256:image", transform, inputs2))
+	inputs2 = []*common.Input{proto.Clone(inputs[0]).(*common.Input)}
+	inputs2[0].Name = "in2"
+	require.NotEqual(t, key, datumCacheKey("sha256:image", transform, inputs2))
+	transform2 = proto.Clone(transform).(*pps.Transform)
+	transform2.ErrCmd = []string{"true"}
+	require.NotEqual(t, key, datumCacheKey("sha256:image", transform2, inputs))
+	transform2 = proto.Clone(transform).(*pps.Transform)
+	transform2.Secrets = []*pps.SecretMount{{Name: "secret", Key: "key", EnvVar: "SECRET"}}
+	require.NotEqual(t, key, datumCacheKey("sha256:image", transform2, inputs))
+}
@@ -1,8 +1,16 @@
 package transform
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"time"
 
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -18,6 +26,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
 )
 
+var masterLogsPath = path.Join(datum.LogsPrefix, datum.MasterLogsFileName)
+
 type pendingJob struct {
 	driver                     driver.Driver
 	logger                     logs.TaggedLogger
@@ -27,6 +37,163 @@ type pendingJob struct {
 	baseMetaCommit             *pfs.Commit
 	noSkip                     bool
 	cache                      *cache
+	logs                       *jobLogs
+}
+
+const (
+	// jobLogsFlushInterval is how often the master's log messages for a job
+	// are appended to the job's meta commit.
+	jobLogsFlushInterval = 30 * time.Second
+	// maxJobLogsBufferSize is the most log messages that are buffered between
+	// flushes. Messages beyond it are dropped, which is noted in the logs.
+	maxJobLogsBufferSize = 4 * units.MB
+)
+
+// jobLogs buffers the log messages written by the master for a job, which are
+// periodically appended to /logs/master in the job's meta commit.
+type jobLogs struct {
+	commit *pfs.Commit
+
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	dropped int
+	// full is signalled when the buffer is half full, to flush it early.
+	full chan struct{}
+
+	// flushMu serializes flushes, and the clearing of the meta commit.
+	flushMu sync.Mutex
+	flushed bool // whether the meta commit has the flushed messages
+	closed  bool // whether the final flush has happened
+}
+
+func newJobLogs(metaCommit *pfs.Commit) *jobLogs {
+	return &jobLogs{
+		commit: metaCommit,
+		full:   make(chan struct{}, 1),
+	}
+}
+
+func (jl *jobLogs) Write(p []byte) (int, error) {
+	jl.mu.Lock()
+	defer jl.mu.Unlock()
+	if jl.buf.Len()+len(p) > maxJobLogsBufferSize {
+		jl.dropped += len(p)
+		return len(p), nil
+	}
+	jl.buf.Write(p)
+	if jl.buf.Len() > maxJobLogsBufferSize/2 {
+		select {
+		case jl.full <- struct{}{}:
+		default:
+		}
+	}
+	return len(p), nil
+}
+
+// take returns the buffered messages and the number of bytes of messages
+// that were dropped, and empties the buffer.
+func (jl *jobLogs) take() ([]byte, int) {
+	jl.mu.Lock()
+	defer jl.mu.Unlock()
+	data := append([]byte{}, jl.buf.Bytes()...)
+	dropped := jl.dropped
+	jl.buf.Reset()
+	jl.dropped = 0
+	return data, dropped
+}
+
+func (jl *jobLogs) drop(n int) {
+	jl.mu.Lock()
+	defer jl.mu.Unlock()
+	jl.dropped += n
+}
+
+// flush appends the buffered messages to the job's meta commit. The first
+// flush replaces the messages inherited from the parent commit. After the
+// final flush, which happens before the job finishes, nothing else is
+// written. Failing to flush the logs does not fail the job.
+func (jl *jobLogs) flush(pachClient *client.APIClient, logger logs.TaggedLogger, final bool) {
+	jl.flushMu.Lock()
+	defer jl.flushMu.Unlock()
+	if jl.closed {
+		return
+	}
+	jl.closed = final
+	data, dropped := jl.take()
+	if dropped > 0 {
+		// The buffer was just emptied, so there's room for the note.
+		logger.Logf("%d bytes of log messages were dropped", dropped)
+		note, _ := jl.take()
+		data = append(data, note...)
+	}
+	if len(data) == 0 && jl.flushed {
+		return
+	}
+	var opts []client.PutFileOption
+	if jl.flushed {
+		opts = append(opts, client.WithAppendPutFile())
+	}
+	if err := pachClient.PutFile(jl.commit, masterLogsPath, bytes.NewReader(data), opts...); err != nil {
+		logger.Errf("errored uploading job logs: %v", err)
+		jl.drop(len(data))
+		return
+	}
+	jl.flushed = true
+}
+
+// flushPeriodically flushes the messages until ctx is done.
+func (jl *jobLogs) flushPeriodically(ctx context.Context, pachClient *client.APIClient, logger logs.TaggedLogger) {
+	ticker := time.NewTicker(jobLogsFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-jl.full:
+		case <-ctx.Done():
+			return
+		}
+		jl.flush(pachClient.WithCtx(ctx), logger, false)
+	}
+}
+
+// clearCommit clears the job's meta commit, keeping the messages that have
+// been flushed to it.
+func (jl *jobLogs) clearCommit(pachClient *client.APIClient) (retErr error) {
+	jl.flushMu.Lock()
+	defer jl.flushMu.Unlock()
+	var saved *os.File
+	if jl.flushed {
+		f, err := ioutil.TempFile("", "master-logs-")
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil && retErr == nil {
+				retErr = errors.EnsureStack(err)
+			}
+			if err := os.Remove(f.Name()); err != nil && retErr == nil {
+				retErr = errors.EnsureStack(err)
+			}
+		}()
+		if err := pachClient.GetFile(jl.commit, masterLogsPath, f); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return errors.EnsureStack(err)
+		}
+		saved = f
+	}
+	if _, err := pachClient.PfsAPIClient.ClearCommit(
+		pachClient.Ctx(),
+		&pfs.ClearCommitRequest{
+			Commit: jl.commit,
+		}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if saved == nil {
+		return nil
+	}
+	return pachClient.PutFile(jl.commit, masterLogsPath, saved)
 }
 
 func (pj *pendingJob) writeJobInfo() error {
@@ -77,12 +244,8 @@ func (pj *pendingJob) load() error {
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
-	if _, err := pachClient.PfsAPIClient.ClearCommit(
-		pachClient.Ctx(),
-		&pfs.ClearCommitRequest{
-			Commit: ppsutil.MetaCommit(pj.ji.OutputCommit),
-		}); err != nil {
-		return errors.EnsureStack(err)
+	if err := pj.logs.clearCommit(pachClient); err != nil {
+		return err
 	}
 	// Find the most recent successful ancestor commit to use as the
 	// base for this job.
@@ -127,6 +290,12 @@ func (pj *pendingJob) clearJobStats() {
 	pj.ji.DataTotal = 0
 }
 
+// uploadLogs writes the rest of the master's log messages for the job to the
+// job's meta commit, before the job finishes.
+func (pj *pendingJob) uploadLogs() {
+	pj.logs.flush(pj.driver.PachClient(), pj.logger, true)
+}
+
 // TODO: Remove when job state transition operations are handled by a background process.
 func (pj *pendingJob) clearCache() {
 	if err := pj.cache.clear(pj.driver.PachClient().Ctx()); err != nil {
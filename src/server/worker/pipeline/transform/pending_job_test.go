@@ -0,0 +1,46 @@
+package transform
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestJobLogsBuffer(t *testing.T) {
+	jl := newJobLogs(nil)
+	line := bytes.Repeat([]byte("x"), maxJobLogsBufferSize/4)
+
+	// The buffer asks to be flushed once it's half full.
+	n, err := jl.Write(line)
+	require.NoError(t, err)
+	require.Equal(t, len(line), n)
+	select {
+	case <-jl.full:
+		t.Fatal("buffer flushed early")
+	default:
+	}
+	_, err = jl.Write(line)
+	require.NoError(t, err)
+	_, err = jl.Write(line)
+	require.NoError(t, err)
+	select {
+	case <-jl.full:
+	default:
+		t.Fatal("buffer not flushed")
+	}
+
+	// Messages that don't fit are dropped.
+	_, err = jl.Write(line)
+	require.NoError(t, err)
+	n, err = jl.Write(line)
+	require.NoError(t, err)
+	require.Equal(t, len(line), n)
+	data, dropped := jl.take()
+	require.Equal(t, 4*len(line), len(data))
+	require.Equal(t, len(line), dropped)
+
+	data, dropped = jl.take()
+	require.Equal(t, 0, len(data))
+	require.Equal(t, 0, dropped)
+}
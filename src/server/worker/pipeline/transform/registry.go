@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -40,6 +41,11 @@ type registry struct {
 	driver  driver.Driver
 	logger  logs.TaggedLogger
 	limiter limit.ConcurrencyLimiter
+
+	// lastEgressed is the output commit of the last job this registry egressed.
+	// Jobs are processed concurrently, so it is protected by mu.
+	mu           sync.Mutex
+	lastEgressed *pfs.Commit
 }
 
 // TODO:
@@ -60,6 +66,7 @@ func newRegistry(driver driver.Driver, logger logs.TaggedLogger) (*registry, err
 
 func (reg *registry) succeedJob(pj *pendingJob) error {
 	pj.logger.Logf("job successful, closing commits")
+	pj.uploadLogs()
 	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
 	if err := ppsutil.FinishJob(reg.driver.PachClient(), pj.ji, pps.JobState_JOB_FINISHING, ""); err != nil {
 		return err
@@ -70,6 +77,7 @@ func (reg *registry) succeedJob(pj *pendingJob) error {
 
 func (reg *registry) failJob(pj *pendingJob, reason string) error {
 	pj.logger.Logf("failing job with reason: %s", reason)
+	pj.uploadLogs()
 	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
 	if err := ppsutil.FinishJob(reg.driver.PachClient(), pj.ji, pps.JobState_JOB_FAILURE, reason); err != nil {
 		return err
@@ -80,6 +88,7 @@ func (reg *registry) failJob(pj *pendingJob, reason string) error {
 
 func (reg *registry) markJobUnrunnable(pj *pendingJob, reason string) error {
 	pj.logger.Logf("marking job unrunnable with reason: %s", reason)
+	pj.uploadLogs()
 	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
 	if err := ppsutil.FinishJob(reg.driver.PachClient(), pj.ji, pps.JobState_JOB_UNRUNNABLE, reason); err != nil {
 		return err
@@ -112,12 +121,14 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 		}
 	}()
 	pi := reg.driver.PipelineInfo()
+	jl := newJobLogs(ppsutil.MetaCommit(jobInfo.OutputCommit))
 	pj := &pendingJob{
 		driver: reg.driver,
-		logger: reg.logger.WithJob(jobInfo.Job.ID),
+		logger: reg.logger.WithJob(jobInfo.Job.ID).WithDurableLog(jl),
 		ji:     jobInfo,
 		noSkip: pi.Details.ReprocessSpec == client.ReprocessSpecEveryJob || pi.Details.S3Out,
 		cache:  newCache(reg.driver.PachClient(), ppsdb.JobKey(jobInfo.Job)),
+		logs:   jl,
 	}
 	if pj.ji.State == pps.JobState_JOB_CREATED {
 		pj.ji.State = pps.JobState_JOB_STARTING
@@ -149,6 +160,9 @@ func (reg *registry) startJob(jobInfo *pps.JobInfo) (retErr error) {
 	}
 	go func() {
 		defer reg.limiter.Release()
+		logsCtx, cancelLogs := context.WithCancel(reg.driver.PachClient().Ctx())
+		defer cancelLogs()
+		go jl.flushPeriodically(logsCtx, reg.driver.PachClient(), pj.logger)
 		if pj.ji.Details.JobTimeout != nil {
 			pj.logger.Logf("cancelling job at: %+v", afterTime)
 			timer := time.AfterFunc(afterTime, func() {
@@ -486,6 +500,13 @@ func (reg *registry) processJobEgressing(pj *pendingJob) error {
 			request.Target = &pfs.EgressRequest_ObjectStorage{ObjectStorage: egress.GetObjectStorage()}
 		case *pps.Egress_SqlDatabase:
 			request.Target = &pfs.EgressRequest_SqlDatabase{SqlDatabase: egress.GetSqlDatabase()}
+			if egress.GetSqlDatabase().Mode == pfs.SQLDatabaseEgress_INCREMENTAL {
+				baseCommit, err := reg.egressBaseCommit(client, pj.commitInfo)
+				if err != nil {
+					return err
+				}
+				request.BaseCommit = baseCommit
+			}
 		}
 	}
 	if err := backoff.RetryUntilCancel(client.Ctx(), func() error {
@@ -501,9 +522,55 @@ func (reg *registry) processJobEgressing(pj *pendingJob) error {
 	}); err != nil {
 		return err
 	}
+	// Record that the commit was egressed, so later incremental egresses can
+	// use it as their base commit.
+	pj.ji.Egressed = types.TimestampNow()
+	reg.mu.Lock()
+	reg.lastEgressed = pj.commitInfo.Commit
+	reg.mu.Unlock()
 	return reg.succeedJob(pj)
 }
 
+// maxEgressBaseSearch bounds the number of ancestors lastEgressedCommit inspects
+// when looking for the base commit of an incremental egress.
+const maxEgressBaseSearch = 16
+
+// egressBaseCommit returns the base commit for an incremental egress of commitInfo,
+// or nil if the whole commit should be egressed.
+// In the common case the parent commit was egressed by this registry, which
+// doesn't require any RPCs; otherwise a bounded number of ancestors are searched.
+func (reg *registry) egressBaseCommit(pachClient *client.APIClient, commitInfo *pfs.CommitInfo) (*pfs.Commit, error) {
+	reg.mu.Lock()
+	lastEgressed := reg.lastEgressed
+	reg.mu.Unlock()
+	if parent := commitInfo.ParentCommit; parent != nil && lastEgressed != nil && parent.ID == lastEgressed.ID {
+		return lastEgressed, nil
+	}
+	return lastEgressedCommit(pachClient, commitInfo, maxEgressBaseSearch)
+}
+
+// lastEgressedCommit returns the most recent of the first limit ancestors of
+// commitInfo whose job finished egressing it, or nil if there is no such commit.
+// Ancestors without a job, such as the commits created when the pipeline is
+// updated, are skipped.
+func lastEgressedCommit(pachClient *client.APIClient, commitInfo *pfs.CommitInfo, limit int) (*pfs.Commit, error) {
+	for parent := commitInfo.ParentCommit; parent != nil && limit > 0; limit-- {
+		ci, err := pachClient.InspectCommit(parent.Branch.Repo.Name, parent.Branch.Name, parent.ID)
+		if err != nil {
+			return nil, err
+		}
+		jobInfo, err := pachClient.InspectJob(ci.Commit.Branch.Repo.Name, ci.Commit.ID, false)
+		if err != nil && !errutil.IsNotFoundError(err) {
+			return nil, err
+		}
+		if jobInfo != nil && jobInfo.Egressed != nil {
+			return ci.Commit, nil
+		}
+		parent = ci.ParentCommit
+	}
+	return nil, nil
+}
+
 func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string, error) {
 	var failed []string
 	waitCommit := func(name string, commit *pfs.Commit) error {
@@ -350,11 +350,15 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 	if err != nil {
 		return errors.Wrap(err, "could not get user image ID")
 	}
+	var dc *datumCache
+	if driver.PipelineInfo().Details.DatumCache {
+		dc = newDatumCache(pachClient)
+	}
 	return pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
 		// Setup file operation client for output meta commit.
 		resp, err := pachClient.WithCreateFileSetClient(func(mfMeta client.ModifyFile) error {
 			// Setup file operation client for output PFS commit.
-			resp, err := pachClient.WithCreateFileSetClient(func(mfPFS client.ModifyFile) (retErr error) {
+			resp, err := pachClient.WithRepoCreateFileSetClient(datumSet.OutputCommit.Branch.Repo, func(mfPFS client.ModifyFile) (retErr error) {
 				opts := []datum.SetOption{
 					datum.WithMetaOutput(mfMeta),
 					datum.WithPFSOutput(mfPFS),
@@ -373,6 +377,8 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						inputs := meta.Inputs
 						logger = logger.WithData(inputs)
 						env := driver.UserCodeEnv(logger.JobID(), datumSet.OutputCommit, inputs)
+						// datumLogger also writes to the datum's logs once the datum is set up.
+						datumLogger := logger
 						var opts []datum.Option
 						if driver.PipelineInfo().Details.DatumTimeout != nil {
 							timeout, err := types.DurationFromProto(driver.PipelineInfo().Details.DatumTimeout)
@@ -386,16 +392,35 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						}
 						if driver.PipelineInfo().Details.Transform.ErrCmd != nil {
 							opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
-								return errors.EnsureStack(driver.RunUserErrorHandlingCode(runCtx, logger, env))
+								return errors.EnsureStack(driver.RunUserErrorHandlingCode(runCtx, datumLogger, env))
+							}))
+						}
+						if dc != nil {
+							key := datumCacheKey(userImageID, driver.PipelineInfo().Details.Transform, inputs)
+							output, err := dc.get(ctx, key)
+							if err != nil {
+								return err
+							}
+							if output != nil {
+								logger.Logf("restoring datum output from the datum cache")
+								return errors.EnsureStack(s.RestoreDatum(meta, output))
+							}
+							opts = append(opts, datum.WithOutputCallback(func(upload func(client.ModifyFile) error) error {
+								// Failing to cache the output does not fail the datum.
+								if err := dc.put(ctx, key, upload); err != nil {
+									logger.Logf("could not add datum output to the datum cache: %v", err)
+								}
+								return nil
 							}))
 						}
 						return s.WithDatum(meta, func(d *datum.Datum) error {
+							datumLogger = logger.WithDurableLog(d.Logs())
 							cancelCtx, cancel := context.WithCancel(ctx)
 							defer cancel()
 							err := status.withDatum(inputs, cancel, func() error {
 								err := driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
 									err := d.Run(cancelCtx, func(runCtx context.Context) error {
-										return errors.EnsureStack(driver.RunUserCode(runCtx, logger, env))
+										return errors.EnsureStack(driver.RunUserCode(runCtx, datumLogger, env))
 									})
 									return errors.EnsureStack(err)
 								})